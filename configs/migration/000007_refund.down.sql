@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "refund" CASCADE;
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TABLE "refund" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "refund_id" varchar,
+  "payment_method_uid" varchar NOT NULL,
+  "payment_method_id" varchar NOT NULL,
+  "refund_reference_id" varchar NOT NULL,
+  "refund_status" varchar NOT NULL,
+  "refund_amount" numeric(15,2) NOT NULL,
+  "refund_reason" varchar NOT NULL,
+  "refund_failure_code" text,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT ('0001-01-01 00:00:00Z')
+);
+
+CREATE UNIQUE INDEX ON "refund" ("refund_id");
+
+CREATE INDEX ON "refund" ("payment_method_uid");
+
+CREATE INDEX ON "refund" ("payment_method_id");
+
+CREATE INDEX ON "refund" ("refund_status");
+
+COMMENT ON COLUMN "refund"."refund_id" IS 'empty until the gateway accepted the refund';
+
+COMMENT ON COLUMN "refund"."refund_reason" IS 'FRAUDULENT, DUPLICATE, REQUESTED_BY_CUSTOMER, CANCELLATION or OTHERS';
+
+ALTER TABLE "refund" ADD FOREIGN KEY ("payment_method_uid") REFERENCES "payment_method" ("uid");
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "payment_method_payment_status_expires_at_idx";
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX ON "payment_method" ("payment_status", "expires_at");
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "payment_method_payment_customer_id_created_at_uid_idx";
+
+DROP INDEX IF EXISTS "payment_method_created_at_uid_idx";
\ No newline at end of file
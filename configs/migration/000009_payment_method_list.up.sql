@@ -0,0 +1,3 @@
+CREATE INDEX ON "payment_method" ("created_at", "uid");
+
+CREATE INDEX ON "payment_method" ("payment_customer_id", "created_at", "uid");
\ No newline at end of file
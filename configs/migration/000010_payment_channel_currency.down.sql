@@ -0,0 +1,19 @@
+ALTER TABLE "payment_method" DROP CONSTRAINT IF EXISTS "payment_method_payment_channel_currency_fkey";
+
+DELETE FROM "payment_channel" WHERE "currency" <> 'IDR';
+
+DROP INDEX IF EXISTS "payment_method_currency_idx";
+
+DROP INDEX IF EXISTS "payment_channel_pcname_currency_idx";
+
+CREATE UNIQUE INDEX ON "payment_channel" ("pcname");
+
+ALTER TABLE "payment_method" ADD FOREIGN KEY ("payment_channel") REFERENCES "payment_channel" ("pcname");
+
+ALTER TABLE "refund" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "payment_channel" DROP COLUMN IF EXISTS "country";
+
+ALTER TABLE "payment_channel" DROP COLUMN IF EXISTS "currency";
\ No newline at end of file
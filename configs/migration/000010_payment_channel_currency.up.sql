@@ -0,0 +1,36 @@
+ALTER TABLE "payment_channel" ADD COLUMN "currency" varchar NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "payment_channel" ADD COLUMN "country" varchar NOT NULL DEFAULT 'ID';
+
+ALTER TABLE "payment_method" ADD COLUMN "currency" varchar NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "refund" ADD COLUMN "currency" varchar NOT NULL DEFAULT 'IDR';
+
+COMMENT ON COLUMN "payment_channel"."currency" IS 'ISO 4217 currency code, min_amount and max_amount are in this currency';
+
+COMMENT ON COLUMN "payment_channel"."country" IS 'ISO 3166-1 alpha-2 country code';
+
+ALTER TABLE "payment_method" DROP CONSTRAINT IF EXISTS "payment_method_payment_channel_fkey";
+
+DROP INDEX IF EXISTS "payment_channel_pcname_idx";
+
+CREATE UNIQUE INDEX ON "payment_channel" ("pcname", "currency");
+
+CREATE INDEX ON "payment_method" ("currency");
+
+ALTER TABLE "payment_method" ADD FOREIGN KEY ("payment_channel", "currency") REFERENCES "payment_channel" ("pcname", "currency");
+
+INSERT INTO "public"."payment_channel" ("uid","pcname","logo_src","pc_type","currency","country","min_amount","max_amount","tax","is_tax_percentage","is_active","is_available") VALUES 
+('01HZ32VY0DXZHA2ZM4FQ2T8PRT','GCASH','gcash','EWALLET','PHP','PH',1.00,100000.00,2.30,'TRUE','TRUE','TRUE'),
+('01HZ3JGXBKQ8XFWR20F8CKQFMW','GRABPAY','grab-pay','EWALLET','PHP','PH',1.00,100000.00,2.00,'TRUE','TRUE','TRUE'),
+('01HZ3VY4MAETF22ZK459RQ976W','PAYMAYA','paymaya','EWALLET','PHP','PH',1.00,100000.00,1.80,'TRUE','TRUE','TRUE'),
+('01HZ3ACPVTWFH9B7HXKABBYPMV','SHOPEEPAY','shopee-pay','EWALLET','PHP','PH',1.00,100000.00,2.00,'TRUE','TRUE','TRUE'),
+('01HZ3E02NMF5GWSARZFHYZ4AZX','TRUEMONEY','truemoney','EWALLET','THB','TH',20.00,100000.00,1.70,'TRUE','TRUE','TRUE'),
+('01HZ3S8TPRZAW6T20W43P596XZ','SHOPEEPAY','shopee-pay','EWALLET','THB','TH',1.00,100000.00,1.90,'TRUE','TRUE','TRUE'),
+('01HZ38XVWNGXVN9FM2CY17JVFJ','PROMPTPAY','promptpay','QR_CODE','THB','TH',1.00,2000000.00,0.90,'TRUE','TRUE','TRUE'),
+('01HZ3526JAY9QSCPB5CVD6KXVW','MOMO','momo','EWALLET','VND','VN',10000.00,50000000.00,1.80,'TRUE','TRUE','TRUE'),
+('01HZ3DHQ70DVTRC4V53PGQXVCJ','ZALOPAY','zalopay','EWALLET','VND','VN',10000.00,50000000.00,1.80,'TRUE','TRUE','TRUE'),
+('01HZ3XJZGSWNPHYWYY5QSAT4K1','SHOPEEPAY','shopee-pay','EWALLET','VND','VN',10000.00,50000000.00,1.80,'TRUE','TRUE','TRUE'),
+('01HZ3MM4SQJBFAK63E4TCW1SCN','TOUCHNGO','touchngo','EWALLET','MYR','MY',1.00,5000.00,1.50,'TRUE','TRUE','TRUE'),
+('01HZ3CM332QSMAA7PA1P5SWP39','GRABPAY','grab-pay','EWALLET','MYR','MY',1.00,5000.00,1.50,'TRUE','TRUE','TRUE'),
+('01HZ3WYWWBPVNXRC6V92BCVQR3','SHOPEEPAY','shopee-pay','EWALLET','MYR','MY',1.00,5000.00,1.50,'TRUE','TRUE','TRUE');
\ No newline at end of file
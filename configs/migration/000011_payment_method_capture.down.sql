@@ -0,0 +1,9 @@
+DELETE FROM "refund" WHERE "payment_method_uid" IN (SELECT "uid" FROM "payment_method" WHERE "payment_type" = 'CARD');
+
+DELETE FROM "payment_method" WHERE "payment_type" = 'CARD';
+
+DELETE FROM "payment_channel" WHERE "pc_type" = 'CARD';
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_captured_amount";
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_capture_method";
\ No newline at end of file
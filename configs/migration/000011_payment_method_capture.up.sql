@@ -0,0 +1,11 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_capture_method" varchar NOT NULL DEFAULT 'AUTOMATIC';
+
+ALTER TABLE "payment_method" ADD COLUMN "payment_captured_amount" numeric(15,2) NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "payment_method"."payment_capture_method" IS 'MANUAL holds an authorized CARD payment in AWAITING_CAPTURE until it is captured or voided';
+
+COMMENT ON COLUMN "payment_method"."payment_captured_amount" IS 'for CARD payment type, the amount captured out of the authorized payment_amount';
+
+INSERT INTO "public"."payment_channel" ("uid","pcname","logo_src","pc_type","currency","country","min_amount","max_amount","tax","is_tax_percentage","is_active","is_available") VALUES 
+('01HZ4B7TQ3V8MJ6W2X0R5KDC9N','CARDS','cards','CARD','IDR','ID',5000.00,200000000.00,2.90,'TRUE','TRUE','TRUE'),
+('01HZ4B7TQ3ZP4N1E8F6S2GHY0A','CARDS','cards','CARD','PHP','PH',20.00,1000000.00,3.20,'TRUE','TRUE','TRUE');
\ No newline at end of file
@@ -0,0 +1,7 @@
+DELETE FROM "refund" WHERE "payment_method_uid" IN (SELECT "uid" FROM "payment_method" WHERE "payment_type" = 'DIRECT_DEBIT');
+
+DELETE FROM "payment_method" WHERE "payment_type" = 'DIRECT_DEBIT';
+
+DELETE FROM "payment_channel" WHERE "pc_type" = 'DIRECT_DEBIT';
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_linked_method_id";
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_linked_method_id" varchar;
+
+CREATE INDEX ON "payment_method" ("payment_linked_method_id");
+
+COMMENT ON COLUMN "payment_method"."payment_linked_method_id" IS 'for DIRECT_DEBIT charges, the MULTIPLE_USE payment method linked to the customer bank account, the charge itself is keyed by its payment request';
+
+INSERT INTO "public"."payment_channel" ("uid","pcname","logo_src","pc_type","currency","country","min_amount","max_amount","tax","is_tax_percentage","is_active","is_available") VALUES 
+('01HZ6N1D8W3K7QX4T2B9FJ5MVA','BCA_ONEKLIK','bca-oneklik','DIRECT_DEBIT','IDR','ID',10000.00,20000000.00,1.90,'TRUE','TRUE','TRUE'),
+('01HZ6N1D8W5R2CY8P6H3NE0TGB','BPI','bpi','DIRECT_DEBIT','PHP','PH',50.00,100000.00,1.50,'TRUE','TRUE','TRUE'),
+('01HZ6N1D8WA9VD1M7K4SQ2ZXHC','UBP','ubp','DIRECT_DEBIT','PHP','PH',50.00,100000.00,1.50,'TRUE','TRUE','TRUE'),
+('01HZ6N1D8WF6XG8R3T0CWB7JKD','RCBC','rcbc','DIRECT_DEBIT','PHP','PH',50.00,100000.00,1.50,'TRUE','TRUE','TRUE');
\ No newline at end of file
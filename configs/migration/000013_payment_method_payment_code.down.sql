@@ -0,0 +1,7 @@
+DELETE FROM "refund" WHERE "payment_method_uid" IN (SELECT "uid" FROM "payment_method" WHERE "payment_type" = 'OVER_THE_COUNTER');
+
+DELETE FROM "payment_method" WHERE "payment_type" = 'OVER_THE_COUNTER';
+
+DELETE FROM "payment_channel" WHERE "pc_type" = 'OVER_THE_COUNTER';
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_code";
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_code" varchar(255);
+
+COMMENT ON COLUMN "payment_method"."payment_code" IS 'for OVER_THE_COUNTER payment type, the code the customer pays with at the retail outlet';
+
+INSERT INTO "public"."payment_channel" ("uid","pcname","logo_src","pc_type","currency","country","min_amount","max_amount","tax","is_tax_percentage","is_active","is_available") VALUES 
+('01HZ9QW3T6B2M8XK4R7D1NCFPA','ALFAMART','alfamart','OVER_THE_COUNTER','IDR','ID',10000.00,5000000.00,5000.00,'FALSE','TRUE','TRUE'),
+('01HZ9QW3T6E5N1YH8V3G6PKJSB','INDOMARET','indomaret','OVER_THE_COUNTER','IDR','ID',10000.00,5000000.00,5000.00,'FALSE','TRUE','TRUE'),
+('01HZ9QW3T6H9P4ZJ2W6K0QMRTC','7ELEVEN','7eleven','OVER_THE_COUNTER','PHP','PH',50.00,10000.00,15.00,'FALSE','TRUE','TRUE'),
+('01HZ9QW3T6M3R7AK5X9N4SBVWD','CEBUANA','cebuana','OVER_THE_COUNTER','PHP','PH',50.00,30000.00,15.00,'FALSE','TRUE','TRUE');
\ No newline at end of file
@@ -0,0 +1,5 @@
+DELETE FROM "refund" WHERE "payment_method_uid" IN (SELECT "uid" FROM "payment_method" WHERE "payment_parent_uid" IS NOT NULL);
+
+DELETE FROM "payment_method" WHERE "payment_parent_uid" IS NOT NULL;
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_parent_uid";
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_parent_uid" varchar;
+
+ALTER TABLE "payment_method" ADD FOREIGN KEY ("payment_parent_uid") REFERENCES "payment_method" ("uid");
+
+CREATE UNIQUE INDEX ON "payment_method" ("payment_parent_uid", "payment_method_id");
+
+COMMENT ON COLUMN "payment_method"."payment_parent_uid" IS 'for payments made to a MULTIPLE_USE VIRTUAL_ACCOUNT or QR_CODE payment method, the uid of that payment method';
\ No newline at end of file
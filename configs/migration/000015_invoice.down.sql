@@ -0,0 +1,3 @@
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_invoice_uid";
+
+DROP TABLE IF EXISTS "invoice" CASCADE;
\ No newline at end of file
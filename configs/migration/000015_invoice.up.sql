@@ -0,0 +1,37 @@
+CREATE TABLE "invoice" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "invoice_id" varchar NOT NULL,
+  "invoice_reference_id" varchar NOT NULL,
+  "invoice_business_id" varchar NOT NULL,
+  "payment_customer_id" varchar NOT NULL,
+  "invoice_status" varchar NOT NULL,
+  "invoice_amount" numeric(15,2) NOT NULL,
+  "currency" varchar NOT NULL,
+  "invoice_allowed_channels" varchar[] NOT NULL,
+  "invoice_url" text NOT NULL,
+  "invoice_description" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT ('0001-01-01 00:00:00Z'),
+  "expires_at" timestamptz NOT NULL,
+  "paid_at" timestamptz NOT NULL DEFAULT ('0001-01-01 00:00:00Z')
+);
+
+CREATE UNIQUE INDEX ON "invoice" ("invoice_id");
+
+CREATE INDEX ON "invoice" ("invoice_reference_id");
+
+CREATE INDEX ON "invoice" ("payment_customer_id");
+
+CREATE INDEX ON "invoice" ("invoice_status");
+
+COMMENT ON COLUMN "invoice"."invoice_status" IS 'PENDING, PAID, SETTLED or EXPIRED';
+
+COMMENT ON COLUMN "invoice"."invoice_allowed_channels" IS 'the payment channels the customer can choose from on the hosted checkout page';
+
+ALTER TABLE "payment_method" ADD COLUMN "payment_invoice_uid" varchar;
+
+ALTER TABLE "payment_method" ADD FOREIGN KEY ("payment_invoice_uid") REFERENCES "invoice" ("uid");
+
+CREATE UNIQUE INDEX ON "payment_method" ("payment_invoice_uid");
+
+COMMENT ON COLUMN "payment_method"."payment_invoice_uid" IS 'for payments made through a hosted checkout page, the uid of the invoice it paid';
\ No newline at end of file
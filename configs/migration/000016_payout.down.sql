@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "payout" CASCADE;
\ No newline at end of file
@@ -0,0 +1,32 @@
+CREATE TABLE "payout" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "payout_id" varchar,
+  "idempotency_key" varchar NOT NULL,
+  "payout_reference_id" varchar NOT NULL,
+  "payout_business_id" varchar,
+  "payout_channel" varchar NOT NULL,
+  "account_number" varchar NOT NULL,
+  "account_holder_name" varchar NOT NULL,
+  "payout_amount" numeric(15,2) NOT NULL,
+  "currency" varchar NOT NULL,
+  "payout_description" text NOT NULL,
+  "payout_status" varchar NOT NULL,
+  "payout_failure_code" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT ('0001-01-01 00:00:00Z'),
+  "estimated_arrival_at" timestamptz NOT NULL DEFAULT ('0001-01-01 00:00:00Z')
+);
+
+CREATE UNIQUE INDEX ON "payout" ("idempotency_key");
+
+CREATE UNIQUE INDEX ON "payout" ("payout_id");
+
+CREATE INDEX ON "payout" ("payout_reference_id");
+
+CREATE INDEX ON "payout" ("payout_status");
+
+COMMENT ON COLUMN "payout"."payout_id" IS 'the id of the payout at the gateway, null until the gateway accepted it';
+
+COMMENT ON COLUMN "payout"."idempotency_key" IS 'sent to the gateway as well, a retried request with the same key never pays out twice';
+
+COMMENT ON COLUMN "payout"."payout_status" IS 'PENDING until the gateway accepted it, then ACCEPTED, REQUESTED, SUCCEEDED, FAILED, CANCELLED or REVERSED';
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "payment_split" CASCADE;
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_for_user_id";
\ No newline at end of file
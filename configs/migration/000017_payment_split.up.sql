@@ -0,0 +1,27 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_for_user_id" varchar;
+
+CREATE INDEX ON "payment_method" ("payment_for_user_id");
+
+COMMENT ON COLUMN "payment_method"."payment_for_user_id" IS 'for marketplace payments, the sub-account the payment is collected on behalf of';
+
+CREATE TABLE "payment_split" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "payment_method_uid" varchar NOT NULL,
+  "split_rule_id" varchar,
+  "split_type" varchar NOT NULL,
+  "destination_account_id" varchar NOT NULL,
+  "split_amount" numeric(15,2) NOT NULL,
+  "currency" varchar NOT NULL,
+  "split_reference_id" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_split" ADD FOREIGN KEY ("payment_method_uid") REFERENCES "payment_method" ("uid");
+
+CREATE INDEX ON "payment_split" ("payment_method_uid");
+
+CREATE INDEX ON "payment_split" ("destination_account_id");
+
+COMMENT ON COLUMN "payment_split"."split_type" IS 'PLATFORM_FEE, ROUTE or REMAINDER';
+
+COMMENT ON COLUMN "payment_split"."split_rule_id" IS 'the split rule the gateway routed the payment with, the REMAINDER stays with the sub-account';
\ No newline at end of file
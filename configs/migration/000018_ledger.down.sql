@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS "ledger_posting" CASCADE;
+
+DROP TABLE IF EXISTS "ledger_entry" CASCADE;
+
+DROP TABLE IF EXISTS "ledger_account" CASCADE;
+
+DROP FUNCTION IF EXISTS ledger_entry_balanced();
+
+DROP FUNCTION IF EXISTS ledger_append_only();
+
+ALTER TABLE "payment_method" DROP COLUMN IF EXISTS "payment_fee_amount";
\ No newline at end of file
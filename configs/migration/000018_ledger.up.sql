@@ -0,0 +1,93 @@
+ALTER TABLE "payment_method" ADD COLUMN "payment_fee_amount" numeric(15,2) NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "payment_method"."payment_fee_amount" IS 'the channel fee the payment was priced with, booked to the ledger once the payment succeeds';
+
+CREATE TABLE "ledger_account" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "account_code" varchar NOT NULL,
+  "account_owner" varchar NOT NULL DEFAULT '',
+  "account_type" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "ledger_account" ("account_code", "account_owner", "currency");
+
+COMMENT ON COLUMN "ledger_account"."account_code" IS 'GATEWAY_CLEARING, MERCHANT_PAYABLE, SUB_ACCOUNT_PAYABLE, PLATFORM_FEE_REVENUE or GATEWAY_FEE_EXPENSE';
+
+COMMENT ON COLUMN "ledger_account"."account_owner" IS 'the sub-account a SUB_ACCOUNT_PAYABLE is owed to, empty for the accounts of the platform';
+
+COMMENT ON COLUMN "ledger_account"."account_type" IS 'ASSET, LIABILITY, REVENUE or EXPENSE, it tells which side the balance of the account is on';
+
+CREATE TABLE "ledger_entry" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "entry_type" varchar NOT NULL,
+  "payment_method_uid" varchar NOT NULL,
+  "refund_uid" varchar,
+  "currency" varchar NOT NULL,
+  "entry_description" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "ledger_entry" ADD FOREIGN KEY ("payment_method_uid") REFERENCES "payment_method" ("uid");
+
+ALTER TABLE "ledger_entry" ADD FOREIGN KEY ("refund_uid") REFERENCES "refund" ("uid");
+
+CREATE UNIQUE INDEX ON "ledger_entry" ("idempotency_key");
+
+CREATE INDEX ON "ledger_entry" ("payment_method_uid");
+
+COMMENT ON COLUMN "ledger_entry"."idempotency_key" IS 'the entry type and the row it books, an event is never booked twice';
+
+COMMENT ON COLUMN "ledger_entry"."entry_type" IS 'PAYMENT, FEE or REFUND';
+
+CREATE TABLE "ledger_posting" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "entry_uid" varchar NOT NULL,
+  "account_uid" varchar NOT NULL,
+  "direction" varchar NOT NULL CHECK ("direction" IN ('DEBIT', 'CREDIT')),
+  "amount" numeric(15,2) NOT NULL CHECK ("amount" > 0),
+  "currency" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "ledger_posting" ADD FOREIGN KEY ("entry_uid") REFERENCES "ledger_entry" ("uid");
+
+ALTER TABLE "ledger_posting" ADD FOREIGN KEY ("account_uid") REFERENCES "ledger_account" ("uid");
+
+CREATE INDEX ON "ledger_posting" ("entry_uid");
+
+CREATE INDEX ON "ledger_posting" ("account_uid");
+
+COMMENT ON COLUMN "ledger_posting"."amount" IS 'always positive, the direction tells the side of the account it is posted to';
+
+CREATE FUNCTION ledger_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_entry_append_only BEFORE UPDATE OR DELETE ON "ledger_entry"
+  FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+CREATE TRIGGER ledger_posting_append_only BEFORE UPDATE OR DELETE ON "ledger_posting"
+  FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+CREATE FUNCTION ledger_entry_balanced() RETURNS trigger AS $$
+BEGIN
+  IF (
+    SELECT COALESCE(SUM(CASE WHEN "direction" = 'DEBIT' THEN "amount" ELSE -"amount" END), 0)
+    FROM "ledger_posting"
+    WHERE "entry_uid" = NEW."entry_uid"
+  ) <> 0 THEN
+    RAISE EXCEPTION 'ledger entry % does not balance', NEW."entry_uid";
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_posting_balanced AFTER INSERT ON "ledger_posting"
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE FUNCTION ledger_entry_balanced();
\ No newline at end of file
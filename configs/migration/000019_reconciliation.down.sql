@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "reconciliation_discrepancy" CASCADE;
\ No newline at end of file
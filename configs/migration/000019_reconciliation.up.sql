@@ -0,0 +1,42 @@
+CREATE TABLE "reconciliation_discrepancy" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "discrepancy_type" varchar NOT NULL,
+  "discrepancy_key" varchar NOT NULL,
+  "payment_method_uid" varchar,
+  "payment_method_id" varchar,
+  "payment_request_id" varchar,
+  "payment_reference_id" varchar,
+  "expected_amount" numeric(15,2) NOT NULL DEFAULT 0,
+  "reported_amount" numeric(15,2) NOT NULL DEFAULT 0,
+  "expected_status" varchar,
+  "reported_status" varchar,
+  "currency" varchar NOT NULL,
+  "report_source" varchar NOT NULL,
+  "report_reference" varchar NOT NULL,
+  "detected_at" timestamptz NOT NULL DEFAULT (now()),
+  "last_detected_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "reconciliation_discrepancy" ADD FOREIGN KEY ("payment_method_uid") REFERENCES "payment_method" ("uid");
+
+CREATE UNIQUE INDEX ON "reconciliation_discrepancy" ("discrepancy_type", "discrepancy_key");
+
+CREATE INDEX ON "reconciliation_discrepancy" ("payment_method_uid");
+
+CREATE INDEX ON "reconciliation_discrepancy" ("payment_method_id");
+
+CREATE INDEX ON "reconciliation_discrepancy" ("last_detected_at");
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."discrepancy_type" IS 'MISSING, EXTRA, AMOUNT_MISMATCH or STATUS_MISMATCH';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."discrepancy_key" IS 'the payment the discrepancy is about, a discrepancy found again by a later report is updated in place';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."payment_method_uid" IS 'the matching payment, empty for an EXTRA row of the report';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."expected_amount" IS 'the amount of our record, 0 for an EXTRA row of the report';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."reported_amount" IS 'the amount of the report, 0 for a MISSING payment';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."report_source" IS 'GATEWAY for a report fetched from the gateway or FILE for a report dropped on disk';
+
+COMMENT ON COLUMN "reconciliation_discrepancy"."report_reference" IS 'the gateway report id or the name of the file the discrepancy was last found in';
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE "payment_status_history" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "payment_method_uid" varchar NOT NULL,
+  "previous_status" varchar NOT NULL,
+  "new_status" varchar NOT NULL,
+  "failure_code" varchar,
+  "payment_event" varchar NOT NULL,
+  "event_id" varchar,
+  "gateway_updated_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_status_history" ADD FOREIGN KEY ("payment_method_uid") REFERENCES "payment_method" ("uid");
+
+CREATE INDEX ON "payment_status_history" ("payment_method_uid", "created_at");
+
+COMMENT ON COLUMN "payment_status_history"."payment_event" IS 'the event the update came from, the callback event of the gateway or the operation which made it';
+
+COMMENT ON COLUMN "payment_status_history"."event_id" IS 'the kafka topic/partition/offset or the webhook id of the event, empty when it has none';
+
+COMMENT ON COLUMN "payment_status_history"."gateway_updated_at" IS 'when the gateway updated the payment, as the event reported it';
\ No newline at end of file
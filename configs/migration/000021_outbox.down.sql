@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "outbox" CASCADE;
\ No newline at end of file
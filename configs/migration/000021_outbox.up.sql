@@ -0,0 +1,24 @@
+CREATE TABLE "outbox" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "sequence" bigserial NOT NULL,
+  "aggregate_uid" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "outbox_status" varchar NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "sent_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "outbox" ("sequence");
+
+CREATE INDEX ON "outbox" ("outbox_status", "sequence");
+
+COMMENT ON COLUMN "outbox"."sequence" IS 'the order the events were written in, the relay publishes them in the same order';
+
+COMMENT ON COLUMN "outbox"."aggregate_uid" IS 'the uid of the payment method the event is about';
+
+COMMENT ON COLUMN "outbox"."payload" IS 'the snapshot of the payment the event publishes, taken in the transaction which changed it';
+
+COMMENT ON COLUMN "outbox"."attempts" IS 'how many times publishing the event has failed';
\ No newline at end of file
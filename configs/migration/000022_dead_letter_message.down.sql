@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "dead_letter_message" CASCADE;
\ No newline at end of file
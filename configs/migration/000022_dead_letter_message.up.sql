@@ -0,0 +1,32 @@
+CREATE TABLE "dead_letter_message" (
+  "uid" varchar PRIMARY KEY NOT NULL,
+  "source_topic" varchar NOT NULL,
+  "source_partition" int NOT NULL,
+  "source_offset" bigint NOT NULL,
+  "dead_letter_topic" varchar NOT NULL,
+  "dead_letter_partition" int NOT NULL,
+  "dead_letter_offset" bigint NOT NULL,
+  "message_key" bytea,
+  "payload" bytea NOT NULL,
+  "headers" jsonb NOT NULL,
+  "error_message" varchar NOT NULL,
+  "attempts" int NOT NULL DEFAULT 0,
+  "first_seen_at" timestamptz NOT NULL,
+  "dead_lettered_at" timestamptz NOT NULL,
+  "dead_letter_status" varchar NOT NULL,
+  "replay_count" int NOT NULL DEFAULT 0,
+  "replayed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "dead_letter_message" ("dead_letter_topic", "dead_letter_partition", "dead_letter_offset");
+
+CREATE INDEX ON "dead_letter_message" ("dead_lettered_at", "uid");
+
+COMMENT ON COLUMN "dead_letter_message"."source_topic" IS 'the topic the message was first published to, a replay publishes it there again';
+
+COMMENT ON COLUMN "dead_letter_message"."headers" IS 'the headers the message was first published with';
+
+COMMENT ON COLUMN "dead_letter_message"."attempts" IS 'how many times processing the message failed before it was dead-lettered';
+
+COMMENT ON COLUMN "dead_letter_message"."first_seen_at" IS 'when the message first failed';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "idempotency_key" CASCADE;
\ No newline at end of file
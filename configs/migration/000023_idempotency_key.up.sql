@@ -0,0 +1,15 @@
+CREATE TABLE "idempotency_key" (
+  "idempotency_key" varchar PRIMARY KEY NOT NULL,
+  "request_fingerprint" varchar NOT NULL,
+  "idempotency_status" varchar NOT NULL,
+  "fencing_token" bigint NOT NULL,
+  "response_payload" bytea,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz
+);
+
+COMMENT ON COLUMN "idempotency_key"."request_fingerprint" IS 'sha256 of the create payment request without its idempotency key, a reused key with another body is rejected';
+
+COMMENT ON COLUMN "idempotency_key"."fencing_token" IS 'the token of the request holding the reservation, only that request may complete it';
+
+COMMENT ON COLUMN "idempotency_key"."response_payload" IS 'the create payment response replayed to exact retries once the key is completed';
\ No newline at end of file
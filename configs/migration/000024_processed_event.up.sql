@@ -0,0 +1,12 @@
+CREATE TABLE "processed_event" (
+  "event_id" varchar PRIMARY KEY NOT NULL,
+  "payment_event" varchar NOT NULL,
+  "payment_method_uid" varchar NOT NULL,
+  "processed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "processed_event" ("payment_method_uid");
+
+COMMENT ON COLUMN "processed_event"."event_id" IS 'the gateway event id, or the kafka topic/partition/offset of an event without one';
+
+COMMENT ON COLUMN "processed_event"."payment_method_uid" IS 'the payment the event was applied to, or rejected for';
\ No newline at end of file
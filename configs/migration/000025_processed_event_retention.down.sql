@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "processed_event_processed_at_idx";
\ No newline at end of file
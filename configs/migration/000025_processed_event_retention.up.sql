@@ -0,0 +1 @@
+CREATE INDEX ON "processed_event" ("processed_at");
\ No newline at end of file
@@ -0,0 +1 @@
+COMMENT ON COLUMN "customer"."customer_app_id" IS NULL;
\ No newline at end of file
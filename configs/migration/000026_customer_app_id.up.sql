@@ -0,0 +1,5 @@
+-- customers used to be stored under a generated uid, which was written as their customer_app_id too, so no
+-- caller token ever matched them. The uid of the caller was never recorded for those rows, they stay reachable
+-- by system level callers only.
+
+COMMENT ON COLUMN "customer"."customer_app_id" IS 'the uid of the app user the customer belongs to, the uid of their access token';
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "idempotency_key" ADD COLUMN "reserved_until" timestamptz NOT NULL DEFAULT (now());
+
+COMMENT ON COLUMN "idempotency_key"."reserved_until" IS 'the end of the lease of the request holding the reservation, another request may only take it over after it';
\ No newline at end of file
@@ -209,6 +209,12 @@ func (m *Manager) WithTokenMaker(tokenMaker token.Maker) {
 	m.tokenMaker = tokenMaker
 }
 
+// InitTokenMaker builds the token maker from the paseto keys of the configuration, the watcher rebuilds it
+// the same way whenever the keys are updated.
+func (m *Manager) InitTokenMaker() error {
+	return m.setTokenMaker()
+}
+
 func (m *Manager) Close() error {
 	return m.client.Close()
 }
@@ -5,6 +5,11 @@ type External struct {
 }
 
 type ExtSvc struct {
-	ID   string `mapstructure:"id"`
-	Name string `mapstructure:"name"`
+	ID          string `mapstructure:"id"`
+	Name        string `mapstructure:"name"`
+	WebhookPath string `mapstructure:"webhookPath"`
+	// PayoutWebhookPath receives the payout.* callbacks, they are registered separately at the gateway.
+	PayoutWebhookPath string `mapstructure:"payoutWebhookPath"`
+	// PlatformAccountID is the xenPlatform account receiving the platform fee of split payments.
+	PlatformAccountID string `mapstructure:"platformAccountId"`
 }
@@ -14,6 +14,18 @@ type Internal struct {
 	OperationTimeout   time.Duration       `mapstructure:"operationTimeout"`
 	PlatformKeys       *PlatformKeys       `mapstructure:"platformKeys"`
 	PaymentGatewayKeys *PaymentGatewayKeys `mapstructure:"paymentGatewayKeys"`
+	CallbackTokens     *PaymentGatewayKeys `mapstructure:"callbackTokens"`
+	ExpirySweeper      *ExpirySweeper      `mapstructure:"expirySweeper"`
+	Reconciliation     *Reconciliation     `mapstructure:"reconciliation"`
+	OutboxRelay        *OutboxRelay        `mapstructure:"outboxRelay"`
+	Pricing            *Pricing            `mapstructure:"pricing"`
+	// UniqueActiveReference blocks a new payment for a reference id while another one is still outstanding.
+	UniqueActiveReference bool `mapstructure:"uniqueActiveReference"`
+	// IdempotencyKeyLease is how long a create payment request holds the reservation of its idempotency key,
+	// a retry may only take it over once the lease ran out. It should outlast the slowest create payment request.
+	IdempotencyKeyLease time.Duration `mapstructure:"idempotencyKeyLease"`
+	// ProcessedEventPruner runs next to the expiry sweeper, under its lock.
+	ProcessedEventPruner *ProcessedEventPruner `mapstructure:"processedEventPruner"`
 }
 
 type PlatformKeys struct {
@@ -25,3 +37,52 @@ type PaymentGatewayKeys struct {
 	Development string `mapstructure:"development"`
 	Production  string `mapstructure:"production"`
 }
+
+type ExpirySweeper struct {
+	Enable    bool          `mapstructure:"enable"`
+	Interval  time.Duration `mapstructure:"interval"`
+	BatchSize int32         `mapstructure:"batchSize"`
+}
+
+// ProcessedEventPruner forgets the ids of the applied status updates every Interval, it runs on the replica
+// holding the expiry sweeper lock.
+type ProcessedEventPruner struct {
+	Enable   bool          `mapstructure:"enable"`
+	Interval time.Duration `mapstructure:"interval"`
+	// Retention is how long the id of an applied status update is kept to drop its redeliveries,
+	// it should outlast the period the gateway retries a callback for, zero keeps them forever.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+type Reconciliation struct {
+	Enable   bool          `mapstructure:"enable"`
+	Interval time.Duration `mapstructure:"interval"`
+	// Source is GATEWAY to fetch the transaction report of the period from the gateway,
+	// or FILE to import the CSV reports dropped into ReportDir.
+	Source    string `mapstructure:"source"`
+	ReportDir string `mapstructure:"reportDir"`
+	// Lookback is the period a gateway report covers, it ends Delay ago so that payments which
+	// succeeded moments before are not reported missing while the gateway has yet to report them.
+	Lookback      time.Duration `mapstructure:"lookback"`
+	Delay         time.Duration `mapstructure:"delay"`
+	ReportTimeout time.Duration `mapstructure:"reportTimeout"`
+	BatchSize     int32         `mapstructure:"batchSize"`
+}
+
+// OutboxRelay publishes the events written to the outbox, every Interval it publishes up to BatchSize of
+// them in the order they were written. An event which has failed to publish MaxAttempts times is parked,
+// zero retries it forever.
+type OutboxRelay struct {
+	Enable      bool          `mapstructure:"enable"`
+	Interval    time.Duration `mapstructure:"interval"`
+	BatchSize   int32         `mapstructure:"batchSize"`
+	MaxAttempts int32         `mapstructure:"maxAttempts"`
+}
+
+type Pricing struct {
+	// FeeBearer is either CUSTOMER, the fee is added on top of the amount, or MERCHANT, the fee is absorbed.
+	FeeBearer string `mapstructure:"feeBearer"`
+	// RoundingMode is one of HALF_UP, HALF_EVEN, UP or DOWN, applied to the fee at RoundingScale decimal places.
+	RoundingMode  string `mapstructure:"roundingMode"`
+	RoundingScale int32  `mapstructure:"roundingScale"`
+}
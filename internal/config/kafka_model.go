@@ -3,11 +3,16 @@ package config
 import "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
 
 type Kafka struct {
-	Config *kafka.Config `mapstructure:"config"`
-	Topics *KafkaTopics  `mapstructure:"topics"`
+	Config     *kafka.Config     `mapstructure:"config"`
+	Topics     *KafkaTopics      `mapstructure:"topics"`
+	DeadLetter *kafka.DeadLetter `mapstructure:"deadLetter"`
 }
 
 type KafkaTopics struct {
 	PaymentStatusUpdate  *kafka.Topic `mapstructure:"payment_status_update"`
 	PaymentStatusUpdated *kafka.Topic `mapstructure:"payment_status_updated"`
+	RefundStatusUpdated  *kafka.Topic `mapstructure:"refund_status_updated"`
+	InvoicePaid          *kafka.Topic `mapstructure:"invoice_paid"`
+	PayoutStatusUpdate   *kafka.Topic `mapstructure:"payout_status_update"`
+	PayoutStatusUpdated  *kafka.Topic `mapstructure:"payout_status_updated"`
 }
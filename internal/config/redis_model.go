@@ -20,8 +20,14 @@ type Redis struct {
 
 type RedisPrefixes struct {
 	CreatePayment *Prefixes `mapstructure:"create_payment"`
-	Customer      *Prefixes `mapstructure:"customer"`
-	Payment       *Prefixes `mapstructure:"payment"`
+	// CreatePaymentLock reserves an idempotency key while its create payment request is in flight,
+	// the expiration should outlast a gateway call.
+	CreatePaymentLock *Prefixes `mapstructure:"create_payment_lock"`
+	Customer          *Prefixes `mapstructure:"customer"`
+	Payment           *Prefixes `mapstructure:"payment"`
+	ExpirySweeper     *Prefixes `mapstructure:"expiry_sweeper"`
+	Reconciliation    *Prefixes `mapstructure:"reconciliation"`
+	OutboxRelay       *Prefixes `mapstructure:"outbox_relay"`
 }
 
 type Prefixes struct {
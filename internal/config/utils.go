@@ -2,10 +2,7 @@ package config
 
 import (
 	"context"
-	"crypto/ed25519"
-	"crypto/x509"
 	"encoding/base64"
-	"encoding/pem"
 	"sort"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/consul"
@@ -155,37 +152,13 @@ func (m *Manager) setKafkaProducerConn() error {
 }
 
 func (m *Manager) setTokenMaker() error {
-	privateKey, err := base64.StdEncoding.DecodeString(m.app.TLS.Paseto.PrivateKey)
-	if err != nil {
-		m.log.Errorf("error while decoding tokenMaker privateKey: %v", err)
-		return err
-	}
-
-	publicKey, err := base64.StdEncoding.DecodeString(m.app.TLS.Paseto.PublicKey)
-	if err != nil {
-		m.log.Errorf("error while ecoding tokenMaker publicKey: %v", err)
-		return err
-	}
-
-	decodePrivateKey, _ := pem.Decode(privateKey)
-	decodeBlockPrvKey, err := x509.ParsePKCS8PrivateKey(decodePrivateKey.Bytes)
-	if err != nil {
-		m.log.Errorf("error decoding block private key: %v", err)
-		return err
-	}
-	ed25519privateKey, _ := decodeBlockPrvKey.(ed25519.PrivateKey)
-
-	decodePublicKey, _ := pem.Decode(publicKey)
-	decodeBlockPubKey, err := x509.ParsePKIXPublicKey(decodePublicKey.Bytes)
-	if err != nil {
-		m.log.Errorf("error decoding block public key: %v", err)
-		return err
+	if m.app.TLS.Paseto == nil {
+		return errors.New("tls.paseto configuration block is missing")
 	}
-	ed25519publicKey, _ := decodeBlockPubKey.(ed25519.PublicKey)
 
-	tokenCreator, err := token.NewPaseto(ed25519privateKey, ed25519publicKey, "", m.app.Etcd.Nonce)
+	tokenCreator, err := token.NewPasetoFromPEM(m.app.TLS.Paseto.PrivateKey, m.app.TLS.Paseto.PublicKey, "", m.app.Etcd.Nonce)
 	if err != nil {
-		m.log.Errorf("token.NewPaseto.err: %v", err)
+		m.log.Errorf("token.NewPasetoFromPEM.err: %v", err)
 		return err
 	}
 
@@ -245,6 +218,8 @@ func (m *Manager) setConsulConn() error {
 func (m *Manager) getConsumerTopics() []string {
 	return []string{
 		helper.StringBuilder(m.app.Services.External.PaymentGateway.ID, "_", m.app.Brokers.Kafka.Topics.PaymentStatusUpdate.TopicName),
+		helper.StringBuilder(m.app.Services.Internal.ID, "_", m.app.Brokers.Kafka.Topics.PaymentStatusUpdate.DeadLetterTopicName),
+		helper.StringBuilder(m.app.Services.Internal.ID, "_", m.app.Brokers.Kafka.Topics.PayoutStatusUpdate.DeadLetterTopicName),
 	}
 }
 
@@ -12,6 +12,9 @@ import (
 	"github.com/handysuherman/clean-arch-payment-service/internal/config"
 	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	payoutDomain "github.com/handysuherman/clean-arch-payment-service/internal/payout/domain"
+	payoutGateway "github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/grpc_interceptor"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
 	"github.com/labstack/echo/v4"
@@ -27,9 +30,15 @@ type app struct {
 	metrics    *metrics.Metrics
 
 	xenditPaymentGateway *xendit.APIClient
+	paymentGateways      *gateway.Registry
+	payoutGateways       *payoutGateway.Registry
 
-	usecase domain.Usecase
-	doneCh  chan struct{}
+	usecase       domain.Usecase
+	expirySweeper domain.ExpirySweeper
+	reconciler    domain.Reconciler
+	outboxRelay   domain.OutboxRelay
+	payoutUsecase payoutDomain.Usecase
+	doneCh        chan struct{}
 
 	im                grpc_interceptor.InterceptorManager
 	jaegerCloser      io.Closer
@@ -69,6 +78,10 @@ func (a *app) Run() error {
 		return err
 	}
 
+	if err := a.tokenMaker(); err != nil {
+		return err
+	}
+
 	if err := a.redis(ctx); err != nil {
 		return err
 	}
@@ -99,6 +112,10 @@ func (a *app) Run() error {
 		a.cfgManager.Watch(ctx, a.configurationPriorities())
 	}()
 
+	go a.expirySweeper.Run(ctx)
+	go a.reconciler.Run(ctx)
+	go a.outboxRelay.Run(ctx)
+
 	closeGrpcServer, grpcServer, err := a.newGrpcServer(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,86 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/grpc_interceptor"
+)
+
+func (a *app) tokenMaker() error {
+	if err := a.cfgManager.InitTokenMaker(); err != nil {
+		a.log.Warnf("unable to initiate paseto token maker: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (a *app) authInterceptor() *grpc_interceptor.AuthInterceptor {
+	ai := grpc_interceptor.NewAuthInterceptor(
+		a.log,
+		a.cfgManager.TokenMaker(),
+		accessRules(),
+		func(ctx context.Context, paymentCustomerID string) (string, error) {
+			customer, err := a.usecase.GetCustomer(ctx, &models.GetCustomerRequest{PaymentCustomerId: paymentCustomerID})
+			if err != nil {
+				return "", err
+			}
+			return customer.GetCustomerAppId(), nil
+		},
+	)
+	a.cfgManager.RegisterTokenMakerObserver(ai)
+
+	return ai
+}
+
+// accessRules maps every gRPC method to the callers allowed to invoke it. Customers only ever see their
+// own payments, back office aplikator roles get the money movement and reporting calls, and dead letter
+// handling stays with other services.
+func accessRules() map[string]grpc_interceptor.AccessRule {
+	customer := grpc_interceptor.AccessRule{
+		SystemLevels: []int32{constants.StokistLevel, constants.PelangganLevel, constants.SystemLevel},
+		OwnCustomer:  true,
+	}
+	channels := grpc_interceptor.AccessRule{
+		SystemLevels: []int32{constants.StokistLevel, constants.PelangganLevel, constants.AplikatorLevel, constants.SystemLevel},
+	}
+	backOffice := grpc_interceptor.AccessRule{
+		SystemLevels: []int32{constants.AplikatorLevel, constants.SystemLevel},
+		RoleLevels:   []int32{constants.ManagerLevel, constants.AkuntingLevel},
+	}
+	system := grpc_interceptor.AccessRule{
+		SystemLevels: []int32{constants.SystemLevel},
+	}
+
+	payment := "/" + pb.PaymentService_ServiceDesc.ServiceName + "/"
+	payout := "/" + pb.PayoutService_ServiceDesc.ServiceName + "/"
+
+	return map[string]grpc_interceptor.AccessRule{
+		payment + "Create":                          customer,
+		payment + "GetByID":                         customer,
+		payment + "GetByReferenceID":                customer,
+		payment + "ListPayments":                    customer,
+		payment + "Cancel":                          customer,
+		payment + "LinkDirectDebit":                 customer,
+		payment + "ValidateDirectDebitLink":         customer,
+		payment + "CreateInvoice":                   customer,
+		payment + "GetPaymentTimeline":              customer,
+		payment + "GetChannel":                      channels,
+		payment + "GetAvailableChannels":            channels,
+		payment + "Refund":                          backOffice,
+		payment + "Capture":                         backOffice,
+		payment + "Void":                            backOffice,
+		payment + "GetLedgerBalances":               backOffice,
+		payment + "ListPaymentLedgerEntries":        backOffice,
+		payment + "ListReconciliationDiscrepancies": backOffice,
+		payment + "ListDeadLetterMessages":          system,
+		payment + "GetDeadLetterMessage":            system,
+		payment + "ReplayDeadLetterMessage":         system,
+		payout + "ValidateBankAccount":              backOffice,
+		payout + "CreatePayout":                     backOffice,
+		payout + "GetPayout":                        backOffice,
+	}
+}
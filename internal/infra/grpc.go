@@ -19,6 +19,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	grpc2 "github.com/handysuherman/clean-arch-payment-service/internal/payment/delivery/grpc"
+	payoutGrpc "github.com/handysuherman/clean-arch-payment-service/internal/payout/delivery/grpc"
 	healthGrpc "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -35,6 +36,8 @@ func (a *app) newGrpcServer(ctx context.Context) (func() error, *grpc.Server, er
 		return nil, nil, errors.Wrap(err, "net.Listen")
 	}
 
+	auth := a.authInterceptor()
+
 	serverOptions := []grpc.ServerOption{
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: maxConnectionIdle * time.Minute,
@@ -48,6 +51,14 @@ func (a *app) newGrpcServer(ctx context.Context) (func() error, *grpc.Server, er
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_recovery.UnaryServerInterceptor(),
 			a.im.GrpcLogger,
+			auth.Unary,
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_ctxtags.StreamServerInterceptor(),
+			grpc_opentracing.StreamServerInterceptor(),
+			grpc_prometheus.StreamServerInterceptor,
+			grpc_recovery.StreamServerInterceptor(),
+			auth.Stream,
 		)),
 	}
 
@@ -64,6 +75,7 @@ func (a *app) newGrpcServer(ctx context.Context) (func() error, *grpc.Server, er
 	go func() {
 		defer hs.SetServingStatus("", healthGrpc.HealthCheckResponse_NOT_SERVING)
 		defer hs.SetServingStatus(pb.PaymentService_ServiceDesc.ServiceName, healthGrpc.HealthCheckResponse_NOT_SERVING)
+		defer hs.SetServingStatus(pb.PayoutService_ServiceDesc.ServiceName, healthGrpc.HealthCheckResponse_NOT_SERVING)
 
 		a.log.Info("Health check goroutine started.")
 		defer a.log.Info("Health check goroutine stopped.")
@@ -91,6 +103,16 @@ func (a *app) newGrpcServer(ctx context.Context) (func() error, *grpc.Server, er
 	)
 	a.cfgManager.RegisterObserver(deliveryGrpc, 3)
 	pb.RegisterPaymentServiceServer(grpcServer, deliveryGrpc)
+
+	payoutDeliveryGrpc := payoutGrpc.New(
+		a.log,
+		a.cfg,
+		a.v,
+		a.payoutUsecase,
+		a.metrics,
+	)
+	a.cfgManager.RegisterObserver(payoutDeliveryGrpc, 3)
+	pb.RegisterPayoutServiceServer(grpcServer, payoutDeliveryGrpc)
 	grpc_prometheus.Register(grpcServer)
 
 	if a.cfg.Services.Internal.Environment != "production" {
@@ -109,4 +131,5 @@ func (a *app) toggleHealthStatus(hs *health.Server) {
 	status := healthGrpc.HealthCheckResponse_SERVING
 	hs.SetServingStatus("", status)
 	hs.SetServingStatus(pb.PaymentService_ServiceDesc.ServiceName, status)
+	hs.SetServingStatus(pb.PayoutService_ServiceDesc.ServiceName, status)
 }
@@ -1,9 +1,14 @@
 package infra
 
 import (
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/usecase"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/worker"
+	payoutGateway "github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	payoutRepository "github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	payoutUsecase "github.com/handysuherman/clean-arch-payment-service/internal/payout/usecase"
+	payoutWorker "github.com/handysuherman/clean-arch-payment-service/internal/payout/worker"
 	"github.com/xendit/xendit-go/v5"
 )
 
@@ -14,21 +19,53 @@ func (a *app) handlers() {
 		a.xenditPaymentGateway = xendit.NewClient(a.cfg.Services.Internal.PaymentGatewayKeys.Production)
 	}
 
+	a.paymentGateways = gateway.NewRegistry()
+	a.paymentGateways.Register(
+		a.cfg.Services.External.PaymentGateway.ID,
+		gateway.NewXenditProviderImpl(a.log, a.cfg, a.xenditPaymentGateway),
+	)
+
 	repo := repository.NewStore(
 		a.log,
 		a.cfg,
 		a.cfgManager.PqsqlConnection(),
 		a.cfgManager.RedisConnection(),
-		a.xenditPaymentGateway,
 	)
-	worker := worker.New(a.log, a.cfg, a.cfgManager.ProducerWorker())
-	a.usecase = usecase.New(a.log, a.cfg, repo, worker)
+	producerWorker := worker.New(a.log, a.cfg, a.cfgManager.ProducerWorker())
+	a.usecase = usecase.New(a.log, a.cfg, repo, a.paymentGateways, producerWorker)
+	a.expirySweeper = worker.NewExpirySweeper(a.log, a.cfg, repo, a.paymentGateways, a.usecase, a.metrics)
+	a.reconciler = worker.NewReconciler(a.log, a.cfg, repo, a.paymentGateways, a.metrics)
+	a.outboxRelay = worker.NewOutboxRelay(a.log, a.cfg, repo, producerWorker, a.metrics)
 
 	a.cfgManager.RegisterPqsqlObserver(repo)
 	a.cfgManager.RegisterRedisObserver(repo)
-	a.cfgManager.RegisterProducerWorkerObserver(worker)
+	a.cfgManager.RegisterProducerWorkerObserver(producerWorker)
 
 	a.cfgManager.RegisterObserver(repo, 1)
-	a.cfgManager.RegisterObserver(worker, 2)
+	a.cfgManager.RegisterObserver(producerWorker, 2)
 	a.cfgManager.RegisterObserver(a.usecase, 3)
+	a.cfgManager.RegisterObserver(a.expirySweeper, 3)
+	a.cfgManager.RegisterObserver(a.reconciler, 3)
+	a.cfgManager.RegisterObserver(a.outboxRelay, 3)
+
+	a.payoutHandlers()
+}
+
+func (a *app) payoutHandlers() {
+	xenditPayoutGateway := payoutGateway.NewXenditProviderImpl(a.log, a.cfg)
+
+	a.payoutGateways = payoutGateway.NewRegistry()
+	a.payoutGateways.Register(a.cfg.Services.External.PaymentGateway.ID, xenditPayoutGateway)
+
+	repo := payoutRepository.NewStore(a.log, a.cfg, a.cfgManager.PqsqlConnection())
+	producerWorker := payoutWorker.New(a.log, a.cfg, a.cfgManager.ProducerWorker())
+	a.payoutUsecase = payoutUsecase.New(a.log, a.cfg, repo, a.payoutGateways, producerWorker)
+
+	a.cfgManager.RegisterPqsqlObserver(repo)
+	a.cfgManager.RegisterProducerWorkerObserver(producerWorker)
+
+	a.cfgManager.RegisterObserver(repo, 1)
+	a.cfgManager.RegisterObserver(xenditPayoutGateway, 1)
+	a.cfgManager.RegisterObserver(producerWorker, 2)
+	a.cfgManager.RegisterObserver(a.payoutUsecase, 3)
 }
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	kafkaConsumer "github.com/handysuherman/clean-arch-payment-service/internal/payment/delivery/kafka"
+	payoutKafkaConsumer "github.com/handysuherman/clean-arch-payment-service/internal/payout/delivery/kafka"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
 	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
 	"github.com/segmentio/kafka-go"
@@ -49,6 +50,66 @@ func (a *app) kafkaConsumer(ctx context.Context) error {
 		kafkaReader,
 	)
 
+	retryGroupID := a.cfg.Brokers.Kafka.Config.GroupID + kafkaClient.RetryGroupIDSuffix
+	retryBrokerClient := kafkaClient.NewConsumerGroup(a.cfg.Brokers.Kafka.Config.Brokers, retryGroupID, a.log)
+	retryConsumer := kafkaConsumer.NewRetry(a.log, a.cfg, a.v, a.usecase, a.metrics, a.getRetryConsumerGroupTopics(), a.cfgManager)
+
+	retryKafkaReader := retryBrokerClient.GetNewKafkaReader(
+		a.cfg.Brokers.Kafka.Config.Brokers,
+		a.getRetryConsumerGroupTopics(),
+		retryGroupID,
+		a.cfg.Brokers.Kafka.Config.EnableTLS,
+		kafkaTls,
+	)
+
+	go retryBrokerClient.ConsumeTopic(
+		ctx,
+		a.getRetryConsumerGroupTopics(),
+		kafkaConsumer.PoolSize,
+		retryConsumer.ProcessMessages,
+		retryKafkaReader,
+	)
+
+	payoutGroupID := a.cfg.Brokers.Kafka.Config.GroupID + payoutKafkaConsumer.GroupIDSuffix
+	payoutBrokerClient := kafkaClient.NewConsumerGroup(a.cfg.Brokers.Kafka.Config.Brokers, payoutGroupID, a.log)
+	payoutConsumer := payoutKafkaConsumer.New(a.log, a.cfg, a.v, a.payoutUsecase, a.metrics, a.getPayoutConsumerGroupTopics(), a.cfgManager)
+
+	payoutKafkaReader := payoutBrokerClient.GetNewKafkaReader(
+		a.cfg.Brokers.Kafka.Config.Brokers,
+		a.getPayoutConsumerGroupTopics(),
+		payoutGroupID,
+		a.cfg.Brokers.Kafka.Config.EnableTLS,
+		kafkaTls,
+	)
+
+	go payoutBrokerClient.ConsumeTopic(
+		ctx,
+		a.getPayoutConsumerGroupTopics(),
+		payoutKafkaConsumer.PoolSize,
+		payoutConsumer.ProcessMessages,
+		payoutKafkaReader,
+	)
+
+	payoutRetryGroupID := payoutGroupID + kafkaClient.RetryGroupIDSuffix
+	payoutRetryBrokerClient := kafkaClient.NewConsumerGroup(a.cfg.Brokers.Kafka.Config.Brokers, payoutRetryGroupID, a.log)
+	payoutRetryConsumer := payoutKafkaConsumer.NewRetry(a.log, a.cfg, a.v, a.payoutUsecase, a.metrics, a.getPayoutRetryConsumerGroupTopics(), a.cfgManager)
+
+	payoutRetryKafkaReader := payoutRetryBrokerClient.GetNewKafkaReader(
+		a.cfg.Brokers.Kafka.Config.Brokers,
+		a.getPayoutRetryConsumerGroupTopics(),
+		payoutRetryGroupID,
+		a.cfg.Brokers.Kafka.Config.EnableTLS,
+		kafkaTls,
+	)
+
+	go payoutRetryBrokerClient.ConsumeTopic(
+		ctx,
+		a.getPayoutRetryConsumerGroupTopics(),
+		payoutKafkaConsumer.PoolSize,
+		payoutRetryConsumer.ProcessMessages,
+		payoutRetryKafkaReader,
+	)
+
 	return nil
 }
 
@@ -132,17 +193,79 @@ func (a *app) initKafkaTopic(ctx context.Context) error {
 		ReplicationFactor: int(a.cfg.Brokers.Kafka.Topics.PaymentStatusUpdated.ReplicationFactor),
 	}
 
-	if err := a.kafkaConn.CreateTopics(paymentStatusUpdate, paymentStatusUpdated); err != nil {
+	refundStatusUpdated := kafka.TopicConfig{
+		Topic:             helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.RefundStatusUpdated.TopicName),
+		NumPartitions:     int(a.cfg.Brokers.Kafka.Topics.RefundStatusUpdated.Partitions),
+		ReplicationFactor: int(a.cfg.Brokers.Kafka.Topics.RefundStatusUpdated.ReplicationFactor),
+	}
+
+	invoicePaid := kafka.TopicConfig{
+		Topic:             helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.InvoicePaid.TopicName),
+		NumPartitions:     int(a.cfg.Brokers.Kafka.Topics.InvoicePaid.Partitions),
+		ReplicationFactor: int(a.cfg.Brokers.Kafka.Topics.InvoicePaid.ReplicationFactor),
+	}
+
+	payoutStatusUpdate := kafka.TopicConfig{
+		Topic:             helper.StringBuilder(a.cfg.Services.External.PaymentGateway.ID, "_", a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.TopicName),
+		NumPartitions:     int(a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.Partitions),
+		ReplicationFactor: int(a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.ReplicationFactor),
+	}
+
+	payoutStatusUpdated := kafka.TopicConfig{
+		Topic:             helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdated.TopicName),
+		NumPartitions:     int(a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdated.Partitions),
+		ReplicationFactor: int(a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdated.ReplicationFactor),
+	}
+
+	topics := []kafka.TopicConfig{paymentStatusUpdate, paymentStatusUpdated, refundStatusUpdated, invoicePaid, payoutStatusUpdate, payoutStatusUpdated}
+
+	// the retry and dead-letter topics are owned by this service, they follow the partitioning of the topic they back.
+	for _, topic := range []*kafkaClient.Topic{a.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate, a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate} {
+		topics = append(topics,
+			kafka.TopicConfig{
+				Topic:             helper.StringBuilder(a.cfg.Services.Internal.ID, "_", topic.RetryTopicName),
+				NumPartitions:     int(topic.Partitions),
+				ReplicationFactor: int(topic.ReplicationFactor),
+			},
+			kafka.TopicConfig{
+				Topic:             helper.StringBuilder(a.cfg.Services.Internal.ID, "_", topic.DeadLetterTopicName),
+				NumPartitions:     int(topic.Partitions),
+				ReplicationFactor: int(topic.ReplicationFactor),
+			},
+		)
+	}
+
+	if err := a.kafkaConn.CreateTopics(topics...); err != nil {
 		a.log.Warnf("initKafkaTopic.kafkaConn.CreateTopics.err: %v", err)
 		return err
 	}
 
-	a.log.Infof("kafka topics created or already exists: %+v", []kafka.TopicConfig{paymentStatusUpdate, paymentStatusUpdated})
+	a.log.Infof("kafka topics created or already exists: %+v", topics)
 	return nil
 }
 
 func (a *app) getConsumerGroupTopics() []string {
 	return []string{
 		helper.StringBuilder(a.cfg.Services.External.PaymentGateway.ID, "_", a.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.TopicName),
+		helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.DeadLetterTopicName),
+		helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.DeadLetterTopicName),
+	}
+}
+
+func (a *app) getRetryConsumerGroupTopics() []string {
+	return []string{
+		helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.RetryTopicName),
+	}
+}
+
+func (a *app) getPayoutConsumerGroupTopics() []string {
+	return []string{
+		helper.StringBuilder(a.cfg.Services.External.PaymentGateway.ID, "_", a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.TopicName),
+	}
+}
+
+func (a *app) getPayoutRetryConsumerGroupTopics() []string {
+	return []string{
+		helper.StringBuilder(a.cfg.Services.Internal.ID, "_", a.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.RetryTopicName),
 	}
 }
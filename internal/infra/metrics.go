@@ -2,8 +2,11 @@ package infra
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/delivery/webhook"
+	payoutWebhook "github.com/handysuherman/clean-arch-payment-service/internal/payout/delivery/webhook"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -18,13 +21,24 @@ const (
 
 func (a *app) runMetrics(cancel context.CancelFunc) {
 	a.metricsServer = echo.New()
+
+	webhookHandler := webhook.New(a.log, a.cfg, a.v, a.usecase, a.metrics)
+	a.cfgManager.RegisterObserver(webhookHandler, 3)
+
+	payoutWebhookHandler := payoutWebhook.New(a.log, a.cfg, a.v, a.payoutUsecase, a.metrics)
+	a.cfgManager.RegisterObserver(payoutWebhookHandler, 3)
+
 	go func() {
 		a.metricsServer.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{
 			StackSize:         stackSize,
 			DisableStackAll:   true,
 			DisablePrintStack: true,
 		}))
+		a.metricsServer.Use(middleware.BodyLimit(fmt.Sprintf("%dB", bodyLimit)))
 		a.metricsServer.GET(a.cfg.Monitoring.Probes.Prometheus.Path, echo.WrapHandler(promhttp.Handler()))
+		webhookHandler.MapRoutes(a.metricsServer)
+		payoutWebhookHandler.MapRoutes(a.metricsServer)
+
 		a.log.Infof("metrics server is running on port: %v", a.cfg.Monitoring.Probes.Prometheus.Port)
 		if err := a.metricsServer.Start(a.cfg.Monitoring.Probes.Prometheus.Port); err != nil {
 			a.log.Errorf("a.runMetrics.Start: %v", err)
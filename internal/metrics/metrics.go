@@ -10,15 +10,59 @@ type Metrics struct {
 	SuccessGrpcRequest prometheus.Counter
 	ErrorGrpcRequest   prometheus.Counter
 
-	CreatePaymentGrpcRequests              prometheus.Counter
-	GetPaymentByIDGrpcRequests             prometheus.Counter
-	GetPaymentChannelGrpcRequests          prometheus.Counter
-	GetAvailablePaymentChannelsGrpcRequest prometheus.Counter
+	CreatePaymentGrpcRequests                   prometheus.Counter
+	GetPaymentByIDGrpcRequests                  prometheus.Counter
+	GetPaymentByReferenceIDGrpcRequests         prometheus.Counter
+	ListPaymentsGrpcRequests                    prometheus.Counter
+	GetPaymentChannelGrpcRequests               prometheus.Counter
+	GetAvailablePaymentChannelsGrpcRequest      prometheus.Counter
+	RefundPaymentGrpcRequests                   prometheus.Counter
+	CancelPaymentGrpcRequests                   prometheus.Counter
+	CapturePaymentGrpcRequests                  prometheus.Counter
+	VoidPaymentGrpcRequests                     prometheus.Counter
+	LinkDirectDebitGrpcRequests                 prometheus.Counter
+	ValidateDirectDebitLinkGrpcRequests         prometheus.Counter
+	CreateInvoiceGrpcRequests                   prometheus.Counter
+	GetLedgerBalancesGrpcRequests               prometheus.Counter
+	ListPaymentLedgerEntriesGrpcRequests        prometheus.Counter
+	ListReconciliationDiscrepanciesGrpcRequests prometheus.Counter
+	GetPaymentTimelineGrpcRequests              prometheus.Counter
+	ListDeadLetterMessagesGrpcRequests          prometheus.Counter
+	GetDeadLetterMessageGrpcRequests            prometheus.Counter
+	ReplayDeadLetterMessageGrpcRequests         prometheus.Counter
+	ValidateBankAccountGrpcRequests             prometheus.Counter
+	CreatePayoutGrpcRequests                    prometheus.Counter
+	GetPayoutGrpcRequests                       prometheus.Counter
 
 	SuccessKafkaRequest prometheus.Counter
 	ErrorKafkaRequest   prometheus.Counter
 
 	PaymentStatusUpdateKafkaMessages prometheus.Counter
+	PayoutStatusUpdateKafkaMessages  prometheus.Counter
+	// RejectedPaymentStatusUpdateKafkaMessages counts the duplicate, stale or out of order status updates
+	// which were skipped instead of applied.
+	RejectedPaymentStatusUpdateKafkaMessages prometheus.Counter
+	RetriedKafkaMessages                     prometheus.Counter
+	DeadLetteredKafkaMessages                prometheus.Counter
+	QuarantinedKafkaMessages                 prometheus.Counter
+
+	SuccessHttpRequest prometheus.Counter
+	ErrorHttpRequest   prometheus.Counter
+
+	PaymentStatusUpdateWebhookRequests prometheus.Counter
+	PayoutStatusUpdateWebhookRequests  prometheus.Counter
+	// RejectedPaymentStatusUpdateWebhookRequests counts the callbacks whose status update was skipped.
+	RejectedPaymentStatusUpdateWebhookRequests prometheus.Counter
+
+	ExpiredPaymentsReconciled   prometheus.Counter
+	ReconciliationDiscrepancies prometheus.Counter
+
+	OutboxPublishedMessages prometheus.Counter
+	OutboxFailedMessages    prometheus.Counter
+	// OutboxPendingMessages and OutboxLagSeconds tell how far the relay is behind, the number of
+	// messages waiting to be published and the age of the oldest one.
+	OutboxPendingMessages prometheus.Gauge
+	OutboxLagSeconds      prometheus.Gauge
 }
 
 func New(cfg *config.App) *Metrics {
@@ -26,14 +70,53 @@ func New(cfg *config.App) *Metrics {
 		SuccessGrpcRequest: NewCounter(cfg, "success_grpc", constants.GRPC),
 		ErrorGrpcRequest:   NewCounter(cfg, "error_grpc", constants.GRPC),
 
-		CreatePaymentGrpcRequests:              NewCounter(cfg, "create_payment_grpc", constants.GRPC),
-		GetPaymentByIDGrpcRequests:             NewCounter(cfg, "get_payment_by_id_grpc", constants.GRPC),
-		GetPaymentChannelGrpcRequests:          NewCounter(cfg, "get_payment_channel_grpc", constants.GRPC),
-		GetAvailablePaymentChannelsGrpcRequest: NewCounter(cfg, "get_available_payment_channels_grpc", constants.GRPC),
+		CreatePaymentGrpcRequests:                   NewCounter(cfg, "create_payment_grpc", constants.GRPC),
+		GetPaymentByIDGrpcRequests:                  NewCounter(cfg, "get_payment_by_id_grpc", constants.GRPC),
+		GetPaymentByReferenceIDGrpcRequests:         NewCounter(cfg, "get_payment_by_reference_id_grpc", constants.GRPC),
+		ListPaymentsGrpcRequests:                    NewCounter(cfg, "list_payments_grpc", constants.GRPC),
+		GetPaymentChannelGrpcRequests:               NewCounter(cfg, "get_payment_channel_grpc", constants.GRPC),
+		GetAvailablePaymentChannelsGrpcRequest:      NewCounter(cfg, "get_available_payment_channels_grpc", constants.GRPC),
+		RefundPaymentGrpcRequests:                   NewCounter(cfg, "refund_payment_grpc", constants.GRPC),
+		CancelPaymentGrpcRequests:                   NewCounter(cfg, "cancel_payment_grpc", constants.GRPC),
+		CapturePaymentGrpcRequests:                  NewCounter(cfg, "capture_payment_grpc", constants.GRPC),
+		VoidPaymentGrpcRequests:                     NewCounter(cfg, "void_payment_grpc", constants.GRPC),
+		LinkDirectDebitGrpcRequests:                 NewCounter(cfg, "link_direct_debit_grpc", constants.GRPC),
+		ValidateDirectDebitLinkGrpcRequests:         NewCounter(cfg, "validate_direct_debit_link_grpc", constants.GRPC),
+		CreateInvoiceGrpcRequests:                   NewCounter(cfg, "create_invoice_grpc", constants.GRPC),
+		GetLedgerBalancesGrpcRequests:               NewCounter(cfg, "get_ledger_balances_grpc", constants.GRPC),
+		ListPaymentLedgerEntriesGrpcRequests:        NewCounter(cfg, "list_payment_ledger_entries_grpc", constants.GRPC),
+		ListReconciliationDiscrepanciesGrpcRequests: NewCounter(cfg, "list_reconciliation_discrepancies_grpc", constants.GRPC),
+		GetPaymentTimelineGrpcRequests:              NewCounter(cfg, "get_payment_timeline_grpc", constants.GRPC),
+		ListDeadLetterMessagesGrpcRequests:          NewCounter(cfg, "list_dead_letter_messages_grpc", constants.GRPC),
+		GetDeadLetterMessageGrpcRequests:            NewCounter(cfg, "get_dead_letter_message_grpc", constants.GRPC),
+		ReplayDeadLetterMessageGrpcRequests:         NewCounter(cfg, "replay_dead_letter_message_grpc", constants.GRPC),
+		ValidateBankAccountGrpcRequests:             NewCounter(cfg, "validate_bank_account_grpc", constants.GRPC),
+		CreatePayoutGrpcRequests:                    NewCounter(cfg, "create_payout_grpc", constants.GRPC),
+		GetPayoutGrpcRequests:                       NewCounter(cfg, "get_payout_grpc", constants.GRPC),
 
 		SuccessKafkaRequest: NewCounter(cfg, "success_kafka", constants.Kafka),
 		ErrorKafkaRequest:   NewCounter(cfg, "error_kafka", constants.Kafka),
 
-		PaymentStatusUpdateKafkaMessages: NewCounter(cfg, "payment_status_update_kafka", constants.Kafka),
+		PaymentStatusUpdateKafkaMessages:         NewCounter(cfg, "payment_status_update_kafka", constants.Kafka),
+		PayoutStatusUpdateKafkaMessages:          NewCounter(cfg, "payout_status_update_kafka", constants.Kafka),
+		RejectedPaymentStatusUpdateKafkaMessages: NewCounter(cfg, "rejected_payment_status_update_kafka", constants.Kafka),
+		RetriedKafkaMessages:                     NewCounter(cfg, "retried_kafka", constants.Kafka),
+		DeadLetteredKafkaMessages:                NewCounter(cfg, "dead_lettered_kafka", constants.Kafka),
+		QuarantinedKafkaMessages:                 NewCounter(cfg, "quarantined_kafka", constants.Kafka),
+
+		SuccessHttpRequest: NewCounter(cfg, "success_http", constants.HTTP),
+		ErrorHttpRequest:   NewCounter(cfg, "error_http", constants.HTTP),
+
+		PaymentStatusUpdateWebhookRequests:         NewCounter(cfg, "payment_status_update_webhook", constants.HTTP),
+		PayoutStatusUpdateWebhookRequests:          NewCounter(cfg, "payout_status_update_webhook", constants.HTTP),
+		RejectedPaymentStatusUpdateWebhookRequests: NewCounter(cfg, "rejected_payment_status_update_webhook", constants.HTTP),
+
+		ExpiredPaymentsReconciled:   NewCounter(cfg, "expired_payments_reconciled", constants.Worker),
+		ReconciliationDiscrepancies: NewCounter(cfg, "reconciliation_discrepancies", constants.Worker),
+
+		OutboxPublishedMessages: NewCounter(cfg, "outbox_published", constants.Worker),
+		OutboxFailedMessages:    NewCounter(cfg, "outbox_failed", constants.Worker),
+		OutboxPendingMessages:   NewGauge(cfg, "outbox_pending_messages", constants.Worker),
+		OutboxLagSeconds:        NewGauge(cfg, "outbox_lag_seconds", constants.Worker),
 	}
 }
@@ -24,3 +24,10 @@ func NewCounter(cfg *config.App, name string, protocol string) prometheus.Counte
 
 	return promauto.NewCounter(promCounter)
 }
+
+func NewGauge(cfg *config.App, name string, protocol string) prometheus.Gauge {
+	return promauto.NewGauge(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s_%s_%s", cfg.Services.Internal.Name, protocol, name),
+		Help: fmt.Sprintf("The current %s", strings.ReplaceAll(name, "_", " ")),
+	})
+}
@@ -82,6 +82,186 @@ func (h *grpcHandler) GetByID(ctx context.Context, arg *pb.GetByIDPaymentRequest
 	return res, nil
 }
 
+func (h *grpcHandler) GetByReferenceID(ctx context.Context, arg *pb.GetByReferenceIDPaymentRequest) (*pb.GetByReferenceIDPaymentResponse, error) {
+	h.metrics.GetPaymentByReferenceIDGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.GetByReferenceID")
+	defer span.Finish()
+
+	params := models.NewGetByReferenceIDPaymentRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.GetByReferenceID(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.GetByReferenceID.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ListPayments(ctx context.Context, arg *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	h.metrics.ListPaymentsGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ListPayments")
+	defer span.Finish()
+
+	params := models.NewListPaymentsRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ListPayments(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ListPayments.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) Cancel(ctx context.Context, arg *pb.CancelPaymentRequest) (*pb.CancelPaymentResponse, error) {
+	h.metrics.CancelPaymentGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.Cancel")
+	defer span.Finish()
+
+	params := models.NewCancelPaymentRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.Cancel(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.Cancel.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) Capture(ctx context.Context, arg *pb.CapturePaymentRequest) (*pb.CapturePaymentResponse, error) {
+	h.metrics.CapturePaymentGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.Capture")
+	defer span.Finish()
+
+	params := models.NewCapturePaymentRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.Capture(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.Capture.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) Void(ctx context.Context, arg *pb.VoidPaymentRequest) (*pb.VoidPaymentResponse, error) {
+	h.metrics.VoidPaymentGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.Void")
+	defer span.Finish()
+
+	params := models.NewVoidPaymentRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.Void(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.Void.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) LinkDirectDebit(ctx context.Context, arg *pb.LinkDirectDebitRequest) (*pb.LinkDirectDebitResponse, error) {
+	h.metrics.LinkDirectDebitGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.LinkDirectDebit")
+	defer span.Finish()
+
+	params := models.NewLinkDirectDebitRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.LinkDirectDebit(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.LinkDirectDebit.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ValidateDirectDebitLink(ctx context.Context, arg *pb.ValidateDirectDebitLinkRequest) (*pb.ValidateDirectDebitLinkResponse, error) {
+	h.metrics.ValidateDirectDebitLinkGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ValidateDirectDebitLink")
+	defer span.Finish()
+
+	params := models.NewValidateDirectDebitLinkRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ValidateDirectDebitLink(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ValidateDirectDebitLink.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) CreateInvoice(ctx context.Context, arg *pb.CreateInvoiceRequest) (*pb.CreateInvoiceResponse, error) {
+	h.metrics.CreateInvoiceGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.CreateInvoice")
+	defer span.Finish()
+
+	params := models.NewCreateInvoiceRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.CreateInvoice(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.CreateInvoice.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) Refund(ctx context.Context, arg *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	h.metrics.RefundPaymentGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.Refund")
+	defer span.Finish()
+
+	params := models.NewRefundPaymentRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.Refund(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.Refund.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
 func (h *grpcHandler) GetChannel(ctx context.Context, arg *pb.GetPaymentChannelRequest) (*pb.GetPaymentChannelResponse, error) {
 	h.metrics.GetPaymentChannelGrpcRequests.Inc()
 
@@ -122,6 +302,146 @@ func (h *grpcHandler) GetAvailableChannels(ctx context.Context, arg *pb.GetPayme
 	return res, nil
 }
 
+func (h *grpcHandler) GetLedgerBalances(ctx context.Context, arg *pb.GetLedgerBalancesRequest) (*pb.GetLedgerBalancesResponse, error) {
+	h.metrics.GetLedgerBalancesGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.GetLedgerBalances")
+	defer span.Finish()
+
+	params := models.NewGetLedgerBalancesRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.GetLedgerBalances(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.GetLedgerBalances.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ListPaymentLedgerEntries(ctx context.Context, arg *pb.ListPaymentLedgerEntriesRequest) (*pb.ListPaymentLedgerEntriesResponse, error) {
+	h.metrics.ListPaymentLedgerEntriesGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ListPaymentLedgerEntries")
+	defer span.Finish()
+
+	params := models.NewListPaymentLedgerEntriesRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ListPaymentLedgerEntries(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ListPaymentLedgerEntries.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) GetPaymentTimeline(ctx context.Context, arg *pb.GetPaymentTimelineRequest) (*pb.GetPaymentTimelineResponse, error) {
+	h.metrics.GetPaymentTimelineGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.GetPaymentTimeline")
+	defer span.Finish()
+
+	params := models.NewGetPaymentTimelineRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.GetPaymentTimeline(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.GetPaymentTimeline.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ListReconciliationDiscrepancies(ctx context.Context, arg *pb.ListReconciliationDiscrepanciesRequest) (*pb.ListReconciliationDiscrepanciesResponse, error) {
+	h.metrics.ListReconciliationDiscrepanciesGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ListReconciliationDiscrepancies")
+	defer span.Finish()
+
+	params := models.NewListReconciliationDiscrepanciesRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ListReconciliationDiscrepancies(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ListReconciliationDiscrepancies.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ListDeadLetterMessages(ctx context.Context, arg *pb.ListDeadLetterMessagesRequest) (*pb.ListDeadLetterMessagesResponse, error) {
+	h.metrics.ListDeadLetterMessagesGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ListDeadLetterMessages")
+	defer span.Finish()
+
+	params := models.NewListDeadLetterMessagesRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ListDeadLetterMessages(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ListDeadLetterMessages.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) GetDeadLetterMessage(ctx context.Context, arg *pb.GetDeadLetterMessageRequest) (*pb.GetDeadLetterMessageResponse, error) {
+	h.metrics.GetDeadLetterMessageGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.GetDeadLetterMessage")
+	defer span.Finish()
+
+	params := models.NewGetDeadLetterMessageRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.GetDeadLetterMessage(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.GetDeadLetterMessage.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) ReplayDeadLetterMessage(ctx context.Context, arg *pb.ReplayDeadLetterMessageRequest) (*pb.ReplayDeadLetterMessageResponse, error) {
+	h.metrics.ReplayDeadLetterMessageGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ReplayDeadLetterMessage")
+	defer span.Finish()
+
+	params := models.NewReplayDeadLetterMessageRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ReplayDeadLetterMessage(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ReplayDeadLetterMessage.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
 func (h *grpcHandler) errorResponse(span opentracing.Span, err error, details string, logError bool) error {
 	if logError {
 		errfmt := fmt.Errorf("%s: %v", details, err)
@@ -11,19 +11,31 @@ import (
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
 	"github.com/segmentio/kafka-go"
 )
 
+// messageReader is the part of the consumer group reader the processor uses.
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Config() kafka.ReaderConfig
+}
+
 type messageProcessor struct {
 	metrics             *metrics.Metrics
 	log                 logger.Logger
 	v                   *validator.Validate
 	cfg                 *config.App
 	cfgManager          *config.Manager
+	producer            func() kafkaClient.Producer
 	usecase             domain.Usecase
-	r                   *kafka.Reader
+	mu                  sync.RWMutex
+	r                   messageReader
 	consumerGroupTopics []string
+	groupIDSuffix       string
+	partitions          *kafkaClient.PartitionDispatcher
 }
 
 func New(
@@ -43,9 +55,32 @@ func New(
 		metrics:             metrics,
 		consumerGroupTopics: topics,
 		cfgManager:          cfgManager,
+		producer: func() kafkaClient.Producer {
+			return cfgManager.ProducerWorker()
+		},
+		partitions: kafkaClient.NewPartitionDispatcher(PoolSize),
 	}
 }
 
+// NewRetry creates the processor of the retry topic, it consumes in its own consumer group so a message
+// waiting for its retry never holds back the payment status updates.
+func NewRetry(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+	topics []string,
+	cfgManager *config.Manager,
+) *messageProcessor {
+	m := New(log, cfg, v, usecase, metrics, topics, cfgManager)
+	m.groupIDSuffix = kafkaClient.RetryGroupIDSuffix
+
+	return m
+}
+
+// ProcessMessages fetches the messages on the FetchWorkerID worker and hands them to the other workers of
+// the pool, every partition is processed by a single worker in the order it was read.
 func (m *messageProcessor) ProcessMessages(
 	ctx context.Context,
 	r *kafka.Reader,
@@ -54,7 +89,12 @@ func (m *messageProcessor) ProcessMessages(
 ) {
 	defer wg.Done()
 
-	m.r = r
+	if workerId != kafkaClient.FetchWorkerID {
+		m.handleMessages(ctx, workerId)
+		return
+	}
+
+	m.setReader(r)
 
 	m.cfgManager.RegisterObserver(m, 3)
 	m.cfgManager.RegisterConsumerWorkerObserver(m)
@@ -66,17 +106,47 @@ func (m *messageProcessor) ProcessMessages(
 		default:
 		}
 
-		msg, err := m.r.FetchMessage(ctx)
+		msg, err := m.reader().FetchMessage(ctx)
 		if err != nil {
 			m.log.Warnf("workerId: %v, err: %v", workerId, err)
 			continue
 		}
 
-		m.logProcessMessage(msg, workerId)
+		if err := m.partitions.Dispatch(ctx, msg); err != nil {
+			return
+		}
+	}
+}
+
+// handleMessages processes the messages of the partitions owned by workerId one after the other.
+func (m *messageProcessor) handleMessages(ctx context.Context, workerId int) {
+	messages := m.partitions.Messages(workerId)
 
-		switch msg.Topic {
-		case helper.StringBuilder(m.cfg.Services.External.PaymentGateway.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.TopicName):
-			m.processUpdatePaymentStatus(ctx, msg)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-messages:
+			m.processMessage(ctx, msg, workerId)
 		}
 	}
 }
+
+func (m *messageProcessor) processMessage(ctx context.Context, msg kafka.Message, workerId int) {
+	m.logProcessMessage(msg, workerId)
+
+	switch msg.Topic {
+	case helper.StringBuilder(m.cfg.Services.External.PaymentGateway.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.TopicName):
+		m.processUpdatePaymentStatus(ctx, msg)
+	case helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.RetryTopicName):
+		if err := kafkaClient.WaitForRetry(ctx, msg); err != nil {
+			m.log.Warnf("workerId: %v, kafkaClient.WaitForRetry.err: %v", workerId, err)
+			return
+		}
+
+		m.processUpdatePaymentStatus(ctx, msg)
+	case helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.DeadLetterTopicName),
+		helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.DeadLetterTopicName):
+		m.processDeadLetter(ctx, msg)
+	}
+}
@@ -0,0 +1,69 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/avast/retry-go"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/segmentio/kafka-go"
+)
+
+func (m *messageProcessor) processDeadLetter(ctx context.Context, msg kafka.Message) {
+	m.metrics.QuarantinedKafkaMessages.Inc()
+
+	ctx, span := tracing.StartKafkaConsumerTracerSpan(ctx, msg.Headers, "messageProcessor.processDeadLetter")
+	defer span.Finish()
+
+	params := newQuarantineDeadLetterRequest(msg)
+
+	// a dead-letter topic has no dead-letter topic of its own, a message which cannot be quarantined is dropped.
+	if err := m.v.StructCtx(ctx, params); err != nil {
+		m.log.Warnf("validate: %v", err)
+		m.commitErrorMessage(ctx, msg)
+		return
+	}
+
+	if err := retry.Do(func() error {
+		return m.usecase.QuarantineDeadLetter(ctx, params)
+	}, append(retryOption, retry.Context(ctx))...); err != nil {
+		m.log.Warnf("m.usecase.QuarantineDeadLetter.err: %v", err)
+		m.commitErrorMessage(ctx, msg)
+		return
+	}
+
+	m.commitMessage(ctx, msg)
+}
+
+// newQuarantineDeadLetterRequest describes a dead-lettered message together with where it was first published
+// and why it failed, msg is kept at its own topic, partition and offset.
+func newQuarantineDeadLetterRequest(msg kafka.Message) *models.QuarantineDeadLetterRequest {
+	failed := kafkaClient.ReadFailedMessage(msg)
+
+	headers := make([]*models.KafkaHeader, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers = append(headers, &models.KafkaHeader{Key: header.Key, Value: string(header.Value)})
+	}
+
+	deadLetteredAt := failed.DeadLetteredAt
+	if deadLetteredAt.IsZero() {
+		deadLetteredAt = msg.Time
+	}
+
+	return &models.QuarantineDeadLetterRequest{
+		SourceTopic:         failed.OriginalTopic,
+		SourcePartition:     int32(failed.OriginalPartition),
+		SourceOffset:        failed.OriginalOffset,
+		DeadLetterTopic:     msg.Topic,
+		DeadLetterPartition: int32(msg.Partition),
+		DeadLetterOffset:    msg.Offset,
+		MessageKey:          msg.Key,
+		Payload:             msg.Value,
+		Headers:             headers,
+		ErrorMessage:        failed.Error,
+		Attempts:            int32(failed.Attempts),
+		FirstSeenAt:         failed.FirstSeenAt,
+		DeadLetteredAt:      deadLetteredAt,
+	}
+}
@@ -2,10 +2,12 @@ package kafka
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/avast/retry-go"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
 	_kafkaMessage "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
 	"github.com/segmentio/kafka-go"
@@ -21,7 +23,7 @@ func (m *messageProcessor) processUpdatePaymentStatus(ctx context.Context, msg k
 	var _msg _kafkaMessage.KafkaPaymentStatusUpdate
 	if err := proto.Unmarshal(msg.Value, &_msg); err != nil {
 		m.log.Warnf("proto.Unmarshal: %v", err)
-		m.commitErrorMessage(ctx, msg)
+		m.failMessage(ctx, msg, err, true)
 		return
 	}
 
@@ -35,22 +37,40 @@ func (m *messageProcessor) processUpdatePaymentStatus(ctx context.Context, msg k
 		UpdatedAt:          _msg.GetUpdatedAt(),
 		PaymentStatus:      _msg.GetPaymentStatus(),
 		PaymentFailureCode: _msg.PaymentFailureCode,
+		PaymentId:          _msg.PaymentId,
+		PaymentRequestId:   _msg.PaymentRequestId,
+		PaymentReusability: _msg.PaymentReusability,
+		PaymentAmount:      _msg.PaymentAmount,
+		CapturedAmount:     _msg.CapturedAmount,
 	}
 
 	params := models.NewUpdatePaymentRequestParams(dto)
+	params.EventId = _msg.GetEventId()
+	if params.EventId == "" {
+		// a retried message keeps the event id of the offset it was first published at.
+		failed := kafkaClient.ReadFailedMessage(msg)
+		params.EventId = fmt.Sprintf("%s/%d/%d", failed.OriginalTopic, failed.OriginalPartition, failed.OriginalOffset)
+	}
 	if err := m.v.StructCtx(ctx, params); err != nil {
 		m.log.Warnf("validate", err)
-		m.commitErrorMessage(ctx, msg)
+		m.failMessage(ctx, msg, err, true)
 		return
 	}
 
+	var res *models.UpdatePaymentResult
 	if err := retry.Do(func() error {
-		return m.usecase.Update(ctx, params)
+		var err error
+		res, err = m.usecase.Update(ctx, params)
+		return err
 	}, append(retryOption, retry.Context(ctx))...); err != nil {
 		m.log.Warnf("m.usecase.Update.err: %v", err)
-		m.commitErrorMessage(ctx, msg)
+		m.failMessage(ctx, msg, err, false)
 		return
 	}
 
+	if res.Rejection != "" {
+		m.metrics.RejectedPaymentStatusUpdateKafkaMessages.Inc()
+	}
+
 	m.commitMessage(ctx, msg)
 }
@@ -2,10 +2,14 @@ package kafka
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -18,41 +22,68 @@ var (
 		retry.Delay(retryDelay),
 		retry.DelayType(retry.BackOffDelay),
 	}
+	// failedMessageRetryDelay is how long a failed message which could be moved nowhere waits before
+	// it is tried again.
+	failedMessageRetryDelay = 5 * time.Second
 )
 
 func (m *messageProcessor) OnConfigUpdate(key string, cfg *config.App) {
 	m.cfg = cfg
 }
 
+// OnConsumerWorkerUpdate receives the rebuilt payment reader, the retry processor rebuilds its own reader
+// from its brokers and dialer since it consumes in its own consumer group.
 func (m *messageProcessor) OnConsumerWorkerUpdate(key string, workerConnection *kafka.Reader) {
 	switch key {
-	case m.cfg.Etcd.Keys.Configurations.Brokers:
+	case m.cfg.Etcd.Keys.Configurations.Brokers, m.cfg.Etcd.Keys.TLS.Kafka:
 		m.log.Info("closing previous reader connection due to changes...")
 
-		m.r = workerConnection
+		if m.groupIDSuffix == "" {
+			m.setReader(workerConnection)
+		} else {
+			readerCfg := workerConnection.Config()
+			readerCfg.GroupID = m.cfg.Brokers.Kafka.Config.GroupID + m.groupIDSuffix
+			readerCfg.GroupTopics = m.consumerGroupTopics
 
-		m.log.Infof("worker re-connected to brokers: %v", m.r.Config().Brokers[0])
-		m.log.Infof("worker re-connected with id: %v", m.r.Config().GroupID)
-		m.log.Infof("worker re-subscribe to topics: %v", m.r.Config().GroupTopics)
+			previous := m.setReader(kafka.NewReader(readerCfg))
+			if closer, ok := previous.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					m.log.Warnf("previous.Close.err: %v", err)
+				}
+			}
+		}
+
+		readerCfg := m.reader().Config()
+		m.log.Infof("worker re-connected to brokers: %v", readerCfg.Brokers[0])
+		m.log.Infof("worker re-connected with id: %v", readerCfg.GroupID)
+		m.log.Infof("worker re-subscribe to topics: %v", readerCfg.GroupTopics)
 
 		m.log.Info("reader connection successfully updated...")
-	case m.cfg.Etcd.Keys.TLS.Kafka:
-		m.log.Info("closing previous reader connection due to changes...")
+	}
+}
 
-		m.r = workerConnection
+func (m *messageProcessor) reader() messageReader {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-		m.log.Infof("worker re-connected to brokers: %v", m.r.Config().Brokers[0])
-		m.log.Infof("worker re-connected with id: %v", m.r.Config().GroupID)
-		m.log.Infof("worker re-subscribe to topics: %v", m.r.Config().GroupTopics)
+	return m.r
+}
 
-		m.log.Info("reader connection successfully updated...")
-	}
+// setReader swaps the reader of the processor and returns the previous one.
+func (m *messageProcessor) setReader(r messageReader) messageReader {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.r
+	m.r = r
+
+	return previous
 }
 
 func (m *messageProcessor) commitMessage(ctx context.Context, msg kafka.Message) {
 	m.metrics.SuccessKafkaRequest.Inc()
 	m.log.KafkaLogCommitedMessage(msg.Topic, msg.Partition, msg.Offset)
-	if err := m.r.CommitMessages(ctx, msg); err != nil {
+	if err := m.reader().CommitMessages(ctx, msg); err != nil {
 		m.log.Infof("commitMessages.err: %v", err)
 	}
 }
@@ -60,11 +91,80 @@ func (m *messageProcessor) commitMessage(ctx context.Context, msg kafka.Message)
 func (m *messageProcessor) commitErrorMessage(ctx context.Context, msg kafka.Message) {
 	m.metrics.ErrorKafkaRequest.Inc()
 	m.log.KafkaLogCommitedMessage(msg.Topic, msg.Partition, msg.Offset)
-	if err := m.r.CommitMessages(ctx, msg); err != nil {
+	if err := m.reader().CommitMessages(ctx, msg); err != nil {
 		m.log.Infof("commitErrorMessages.err: %v", err)
 	}
 }
 
+// failMessage moves a payment status update which could not be processed to its retry or dead-letter topic
+// before committing it, and quarantines it straight away when neither topic takes it. A message which could
+// be moved nowhere is not committed, it is tried again until ctx is done. Its partition is processed by this
+// worker alone, so no later offset of the partition is committed meanwhile and the message is read again
+// after a restart. Without a dead-letter configuration the message is committed and dropped.
+func (m *messageProcessor) failMessage(ctx context.Context, msg kafka.Message, cause error, permanent bool) {
+	deadLetter := m.cfg.Brokers.Kafka.DeadLetter
+	if deadLetter == nil || !deadLetter.Enable {
+		m.commitErrorMessage(ctx, msg)
+		return
+	}
+
+	retryTopic := helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.RetryTopicName)
+	deadLetterTopic := helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PaymentStatusUpdate.DeadLetterTopicName)
+
+	failed := kafkaClient.NextFailedMessage(msg, deadLetter, retryTopic, deadLetterTopic, cause, permanent)
+	for {
+		err := m.moveFailedMessage(ctx, msg, failed, retryTopic)
+		if err == nil {
+			m.commitErrorMessage(ctx, msg)
+			return
+		}
+
+		m.log.Errorf("failed message of topic %s partition %d offset %d is left uncommitted: %v", msg.Topic, msg.Partition, msg.Offset, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(failedMessageRetryDelay):
+		}
+	}
+}
+
+// moveFailedMessage publishes failed to the retry or dead-letter topic, or quarantines it under the position
+// msg was read from when publishing keeps failing.
+func (m *messageProcessor) moveFailedMessage(ctx context.Context, msg kafka.Message, failed kafka.Message, retryTopic string) error {
+	err := retry.Do(func() error {
+		return m.producer().PublishMessage(ctx, failed)
+	}, append(retryOption, retry.Context(ctx))...)
+	if err == nil {
+		if failed.Topic == retryTopic {
+			m.metrics.RetriedKafkaMessages.Inc()
+		} else {
+			m.metrics.DeadLetteredKafkaMessages.Inc()
+		}
+
+		return nil
+	}
+
+	m.log.Warnf("m.producer.PublishMessage.err: %v", err)
+
+	// the message never reached the dead-letter topic, the offset it was read from is unique just as well.
+	quarantined := failed
+	quarantined.Topic = msg.Topic
+	quarantined.Partition = msg.Partition
+	quarantined.Offset = msg.Offset
+
+	params := newQuarantineDeadLetterRequest(quarantined)
+	if err := retry.Do(func() error {
+		return m.usecase.QuarantineDeadLetter(ctx, params)
+	}, append(retryOption, retry.Context(ctx))...); err != nil {
+		return fmt.Errorf("m.usecase.QuarantineDeadLetter.err: %v", err)
+	}
+
+	m.metrics.DeadLetteredKafkaMessages.Inc()
+
+	return nil
+}
+
 func (m *messageProcessor) logProcessMessage(msg kafka.Message, workerId int) {
 	m.log.KafkaProcessMessage(msg.Topic, msg.Partition, string(msg.Value), workerId, msg.Offset, msg.Time)
 }
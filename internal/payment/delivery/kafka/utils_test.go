@@ -0,0 +1,221 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	producerMock "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testCfg = &config.App{
+		Services: &config.Services{
+			Internal: &config.Internal{
+				ID:   "payment",
+				Name: "payment_kafka_test",
+			},
+		},
+		Brokers: &config.Brokers{
+			Kafka: &config.Kafka{
+				Topics: &config.KafkaTopics{
+					PaymentStatusUpdate: &kafkaClient.Topic{
+						TopicName:           "payment_status_update",
+						RetryTopicName:      "payment_status_update_retry",
+						DeadLetterTopicName: "payment_status_update_dlq",
+					},
+				},
+				DeadLetter: &kafkaClient.DeadLetter{
+					Enable:     true,
+					RetryDelay: time.Minute,
+					MaxRetries: 3,
+				},
+			},
+		},
+	}
+	testMetrics = metrics.New(testCfg)
+)
+
+func init() {
+	retryOption = []retry.Option{retry.Attempts(1)}
+	failedMessageRetryDelay = time.Millisecond
+}
+
+func TestFailMessage(t *testing.T) {
+	retryTopic := "payment_payment_status_update_retry"
+	deadLetterTopic := "payment_payment_status_update_dlq"
+	cause := errors.New("database unavailable")
+
+	testCases := []struct {
+		tname     string
+		permanent bool
+		stubs     func(cancel context.CancelFunc, msg kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase)
+		commits   int
+	}{
+		{
+			tname: "OK_PUBLISHED_TO_RETRY_TOPIC",
+			stubs: func(_ context.CancelFunc, msg kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase) {
+				producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedTo(t, msg, retryTopic, nil))
+				usecase.EXPECT().QuarantineDeadLetter(gomock.Any(), gomock.Any()).Times(0)
+			},
+			commits: 1,
+		},
+		{
+			tname:     "OK_PUBLISHED_TO_DEAD_LETTER_TOPIC",
+			permanent: true,
+			stubs: func(_ context.CancelFunc, msg kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase) {
+				producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedTo(t, msg, deadLetterTopic, nil))
+				usecase.EXPECT().QuarantineDeadLetter(gomock.Any(), gomock.Any()).Times(0)
+			},
+			commits: 1,
+		},
+		{
+			tname: "OK_QUARANTINED_WHEN_PUBLISH_FAILS",
+			stubs: func(_ context.CancelFunc, msg kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase) {
+				gomock.InOrder(
+					producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedTo(t, msg, retryTopic, errors.New("broker unavailable"))),
+					usecase.EXPECT().QuarantineDeadLetter(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *models.QuarantineDeadLetterRequest) error {
+							require.Equal(t, msg.Topic, arg.SourceTopic)
+							require.Equal(t, int32(msg.Partition), arg.SourcePartition)
+							require.Equal(t, msg.Offset, arg.SourceOffset)
+							require.Equal(t, msg.Topic, arg.DeadLetterTopic)
+							require.Equal(t, int32(msg.Partition), arg.DeadLetterPartition)
+							require.Equal(t, msg.Offset, arg.DeadLetterOffset)
+							require.Equal(t, msg.Value, arg.Payload)
+							require.Equal(t, cause.Error(), arg.ErrorMessage)
+							require.Equal(t, int32(1), arg.Attempts)
+							require.False(t, arg.DeadLetteredAt.IsZero())
+							return nil
+						},
+					),
+				)
+			},
+			commits: 1,
+		},
+		{
+			tname: "OK_RETRIED_UNTIL_MOVED",
+			stubs: func(_ context.CancelFunc, msg kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase) {
+				gomock.InOrder(
+					producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("broker unavailable")),
+					usecase.EXPECT().QuarantineDeadLetter(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("database unavailable")),
+					producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedTo(t, msg, retryTopic, nil)),
+				)
+			},
+			commits: 1,
+		},
+		{
+			tname: "ERR_LEFT_UNCOMMITTED_WHEN_PUBLISH_AND_QUARANTINE_FAIL",
+			stubs: func(cancel context.CancelFunc, _ kafka.Message, producer *producerMock.MockProducer, usecase *wkmock.MockUsecase) {
+				gomock.InOrder(
+					producer.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("broker unavailable")),
+					usecase.EXPECT().QuarantineDeadLetter(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, _ *models.QuarantineDeadLetterRequest) error {
+							// the worker is shut down while the message still could not be moved.
+							cancel()
+							return errors.New("database unavailable")
+						},
+					),
+				)
+			},
+			commits: 0,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			producer := producerMock.NewMockProducer(ctrl)
+			usecase := wkmock.NewMockUsecase(ctrl)
+			reader := &fakeReader{}
+
+			m := New(logger.NewLogger(), testCfg, validator.New(), usecase, testMetrics, nil, nil)
+			m.r = reader
+			m.producer = func() kafkaClient.Producer {
+				return producer
+			}
+
+			msg := kafka.Message{
+				Topic:     "xendit_payment_status_update",
+				Partition: 2,
+				Offset:    int64(helper.RandomInt(1, 1000)),
+				Key:       []byte(helper.RandomString(12)),
+				Value:     []byte(helper.RandomString(32)),
+				Time:      time.Now().UTC(),
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			tc.stubs(cancel, msg, producer, usecase)
+
+			m.failMessage(ctx, msg, cause, tc.permanent)
+			require.Len(t, reader.committed(), tc.commits)
+			for _, committed := range reader.committed() {
+				require.Equal(t, msg.Offset, committed.Offset)
+			}
+		})
+	}
+}
+
+// publishedTo checks a failed message is published to topic with the failure it carries.
+func publishedTo(t *testing.T, msg kafka.Message, topic string, err error) func(any, ...kafka.Message) error {
+	return func(_ any, msgs ...kafka.Message) error {
+		require.Len(t, msgs, 1)
+		require.Equal(t, topic, msgs[0].Topic)
+		require.Equal(t, msg.Value, msgs[0].Value)
+
+		failed := kafkaClient.ReadFailedMessage(msgs[0])
+		require.Equal(t, msg.Topic, failed.OriginalTopic)
+		require.Equal(t, msg.Offset, failed.OriginalOffset)
+		require.Equal(t, 1, failed.Attempts)
+		return err
+	}
+}
+
+type fakeReader struct {
+	mu      sync.Mutex
+	commits []kafka.Message
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (r *fakeReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.commits = append(r.commits, msgs...)
+	return nil
+}
+
+func (r *fakeReader) Config() kafka.ReaderConfig {
+	return kafka.ReaderConfig{}
+}
+
+func (r *fakeReader) committed() []kafka.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.commits
+}
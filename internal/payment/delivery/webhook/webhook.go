@@ -0,0 +1,176 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	XCallbackToken = "x-callback-token"
+	// XWebhookID identifies a callback, xendit sends a retried callback with the same id.
+	XWebhookID = "webhook-id"
+)
+
+type webhookHandler struct {
+	log     logger.Logger
+	cfg     *config.App
+	v       *validator.Validate
+	usecase domain.Usecase
+	metrics *metrics.Metrics
+}
+
+func New(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+) *webhookHandler {
+	return &webhookHandler{
+		log:     log.WithPrefix(fmt.Sprintf("%s-%s", "payment-webhook", constants.Handler)),
+		cfg:     cfg,
+		v:       v,
+		usecase: usecase,
+		metrics: metrics,
+	}
+}
+
+func (h *webhookHandler) MapRoutes(e *echo.Echo) {
+	e.POST(h.cfg.Services.External.PaymentGateway.WebhookPath, h.XenditCallback)
+}
+
+// XenditCallback receives the payment_method.*, payment.*, payment_request.* and refund.* callbacks
+// as well as the invoice callbacks.
+// It only answers 200 once the update has been persisted, any other status makes xendit retry the callback.
+func (h *webhookHandler) XenditCallback(c echo.Context) error {
+	h.metrics.PaymentStatusUpdateWebhookRequests.Inc()
+
+	span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "webhookHandler.XenditCallback")
+	defer span.Finish()
+
+	if err := h.verifyCallbackToken(c.Request().Header.Get(XCallbackToken)); err != nil {
+		return h.errorResponse(c, span, http.StatusUnauthorized, err, "h.verifyCallbackToken.err")
+	}
+
+	var callback xenditCallback
+	if err := c.Bind(&callback); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "c.Bind.err")
+	}
+
+	if invoiceParams, ok := callback.toUpdateInvoiceRequest(); ok {
+		return h.updateInvoice(ctx, c, span, invoiceParams)
+	}
+
+	if refundParams, ok := callback.toUpdateRefundRequest(); ok {
+		return h.updateRefund(ctx, c, span, refundParams)
+	}
+
+	params, ok := callback.toUpdatePaymentRequest()
+	if !ok {
+		h.log.Infof("ignoring unsupported callback event: %s", callback.Event)
+		h.metrics.SuccessHttpRequest.Inc()
+		return c.NoContent(http.StatusOK)
+	}
+	params.EventId = c.Request().Header.Get(XWebhookID)
+
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "h.v.StructCtx.err")
+	}
+
+	res, err := h.usecase.Update(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return h.errorResponse(c, span, http.StatusNotFound, err, "h.usecase.Update.err")
+		}
+
+		return h.errorResponse(c, span, http.StatusInternalServerError, err, "h.usecase.Update.err")
+	}
+
+	// a rejected update was already superseded, xendit must not retry it.
+	if res.Rejection != "" {
+		h.metrics.RejectedPaymentStatusUpdateWebhookRequests.Inc()
+	}
+
+	h.metrics.SuccessHttpRequest.Inc()
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *webhookHandler) updateRefund(ctx context.Context, c echo.Context, span opentracing.Span, params *models.UpdateRefundRequest) error {
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "h.v.StructCtx.err")
+	}
+
+	if err := h.usecase.UpdateRefund(ctx, params); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return h.errorResponse(c, span, http.StatusNotFound, err, "h.usecase.UpdateRefund.err")
+		}
+
+		return h.errorResponse(c, span, http.StatusInternalServerError, err, "h.usecase.UpdateRefund.err")
+	}
+
+	h.metrics.SuccessHttpRequest.Inc()
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *webhookHandler) updateInvoice(ctx context.Context, c echo.Context, span opentracing.Span, params *models.UpdateInvoiceRequest) error {
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "h.v.StructCtx.err")
+	}
+
+	if err := h.usecase.UpdateInvoice(ctx, params); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return h.errorResponse(c, span, http.StatusNotFound, err, "h.usecase.UpdateInvoice.err")
+		}
+
+		return h.errorResponse(c, span, http.StatusInternalServerError, err, "h.usecase.UpdateInvoice.err")
+	}
+
+	h.metrics.SuccessHttpRequest.Inc()
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *webhookHandler) OnConfigUpdate(key string, config *config.App) {
+	h.log.Infof("received an update from '%s' key", key)
+
+	h.cfg = config
+
+	h.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
+
+func (h *webhookHandler) errorResponse(c echo.Context, span opentracing.Span, status int, err error, details string) error {
+	errfmt := fmt.Errorf("%s: %v", details, err)
+	h.log.Warn(errfmt)
+	tracing.TraceWithError(span, errfmt)
+
+	h.metrics.ErrorHttpRequest.Inc()
+	return c.JSON(status, map[string]string{"error": http.StatusText(status)})
+}
+
+func (h *webhookHandler) verifyCallbackToken(token string) error {
+	expected := h.cfg.Services.Internal.CallbackTokens.Development
+	if h.cfg.Services.Internal.Environment == "production" {
+		expected = h.cfg.Services.Internal.CallbackTokens.Production
+	}
+
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return unierror.ErrInvalidCallbackToken
+	}
+
+	return nil
+}
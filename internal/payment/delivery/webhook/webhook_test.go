@@ -0,0 +1,493 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCallbackToken = "test-callback-token"
+	testWebhookPath   = "/webhooks/xendit"
+)
+
+var (
+	testCfg = &config.App{
+		Services: &config.Services{
+			Internal: &config.Internal{
+				Name:        "payment_webhook_test",
+				Environment: "develop",
+				CallbackTokens: &config.PaymentGatewayKeys{
+					Development: testCallbackToken,
+					Production:  helper.RandomString(32),
+				},
+			},
+			External: &config.External{
+				PaymentGateway: &config.ExtSvc{
+					ID:          helper.RandomString(12),
+					WebhookPath: testWebhookPath,
+				},
+			},
+		},
+	}
+	testMetrics = metrics.New(testCfg)
+)
+
+func TestXenditCallback(t *testing.T) {
+	paymentSucceeded := `{
+		"event": "payment.succeeded",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "py-1",
+			"customer_id": "cust-1",
+			"status": "SUCCEEDED",
+			"updated": "2024-03-01T10:00:01Z",
+			"payment_method": {
+				"id": "pm-1",
+				"type": "QR_CODE",
+				"qr_code": {"channel_code": "QRIS"}
+			}
+		}
+	}`
+
+	paymentMethodExpired := `{
+		"event": "payment_method.expired",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "pm-2",
+			"business_id": "biz-1",
+			"customer_id": "cust-2",
+			"type": "VIRTUAL_ACCOUNT",
+			"status": "EXPIRED",
+			"failure_code": "EXPIRED",
+			"virtual_account": {"channel_code": "BCA"}
+		}
+	}`
+
+	directDebitSucceeded := `{
+		"event": "payment.succeeded",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "py-3",
+			"payment_request_id": "pr-3",
+			"customer_id": "cust-3",
+			"status": "SUCCEEDED",
+			"updated": "2024-03-01T10:00:01Z",
+			"payment_method": {
+				"id": "pm-3",
+				"type": "DIRECT_DEBIT",
+				"reusability": "MULTIPLE_USE",
+				"direct_debit": {"channel_code": "BCA_ONEKLIK"}
+			}
+		}
+	}`
+
+	reusableVirtualAccountSucceeded := `{
+		"event": "payment.succeeded",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "py-4",
+			"payment_request_id": "pr-4",
+			"customer_id": "cust-4",
+			"status": "SUCCEEDED",
+			"amount": 15000,
+			"updated": "2024-03-01T10:00:01Z",
+			"payment_method": {
+				"id": "pm-4",
+				"type": "VIRTUAL_ACCOUNT",
+				"reusability": "MULTIPLE_USE",
+				"virtual_account": {"channel_code": "BCA"}
+			}
+		}
+	}`
+
+	cardPaymentCaptured := `{
+		"event": "payment.capture",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "py-5",
+			"payment_request_id": "pr-5",
+			"customer_id": "cust-5",
+			"status": "SUCCEEDED",
+			"amount": 20000,
+			"captured_amount": 15000,
+			"updated": "2024-03-01T10:00:01Z",
+			"payment_method": {
+				"id": "pm-5",
+				"type": "CARD",
+				"reusability": "ONE_TIME_USE"
+			}
+		}
+	}`
+
+	refundSucceeded := `{
+		"event": "refund.succeeded",
+		"business_id": "biz-1",
+		"created": "2024-03-02T10:00:00Z",
+		"data": {
+			"id": "rfd-1",
+			"payment_request_id": "pr-1",
+			"reference_id": "ref-1",
+			"status": "SUCCEEDED",
+			"updated": "2024-03-02T10:00:01Z"
+		}
+	}`
+
+	invoicePaid := `{
+		"id": "inv-1",
+		"external_id": "ref-inv-1",
+		"user_id": "biz-1",
+		"payment_method": "CREDIT_CARD",
+		"status": "PAID",
+		"amount": 50000,
+		"paid_amount": 50000,
+		"paid_at": "2024-03-03T10:00:00Z",
+		"created": "2024-03-03T09:00:00Z",
+		"updated": "2024-03-03T10:00:01Z",
+		"currency": "IDR",
+		"payment_channel": "CREDIT_CARD",
+		"payment_id": "py-inv-1"
+	}`
+
+	invoiceExpired := `{
+		"id": "inv-2",
+		"external_id": "ref-inv-2",
+		"user_id": "biz-1",
+		"status": "EXPIRED",
+		"amount": 50000,
+		"created": "2024-03-03T09:00:00Z",
+		"updated": "2024-03-06T09:00:00Z",
+		"currency": "IDR"
+	}`
+
+	testCases := []struct {
+		tname      string
+		token      string
+		body       string
+		stubs      func(usecase *wkmock.MockUsecase)
+		statusCode int
+	}{
+		{
+			tname: "OK_PAYMENT_SUCCEEDED",
+			token: testCallbackToken,
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, "payment.succeeded", arg.PaymentEvent)
+						require.Equal(t, "pm-1", arg.PaymentMethodId)
+						require.Equal(t, "cust-1", arg.PaymentCustomerId)
+						require.Equal(t, "biz-1", arg.PaymentBusinessId)
+						require.Equal(t, payment.METHODE_TYPE_QR_CODE, arg.PaymentType)
+						require.Equal(t, "QRIS", arg.PaymentChannel)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+						require.NotNil(t, arg.UpdatedAt)
+						require.Equal(t, "OK_PAYMENT_SUCCEEDED", arg.EventId)
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_PAYMENT_SUCCEEDED_REJECTED_DUPLICATE_EVENT",
+			token: testCallbackToken,
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(&models.UpdatePaymentResult{
+					Rejection: payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT,
+				}, nil)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_PAYMENT_METHOD_EXPIRED",
+			token: testCallbackToken,
+			body:  paymentMethodExpired,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, "pm-2", arg.PaymentMethodId)
+						require.Equal(t, "cust-2", arg.PaymentCustomerId)
+						require.Equal(t, "BCA", arg.PaymentChannel)
+						require.Equal(t, payment.STATUS_EXPIRED, arg.PaymentStatus)
+						require.Equal(t, "EXPIRED", *arg.PaymentFailureCode)
+						require.NotNil(t, arg.UpdatedAt)
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_DIRECT_DEBIT_PAYMENT_SUCCEEDED",
+			token: testCallbackToken,
+			body:  directDebitSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, "pr-3", arg.PaymentMethodId)
+						require.Equal(t, "cust-3", arg.PaymentCustomerId)
+						require.Equal(t, payment.METHODE_TYPE_DIRECT_DEBIT, arg.PaymentType)
+						require.Equal(t, "BCA_ONEKLIK", arg.PaymentChannel)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_REUSABLE_VIRTUAL_ACCOUNT_PAYMENT_SUCCEEDED",
+			token: testCallbackToken,
+			body:  reusableVirtualAccountSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, "pm-4", arg.PaymentMethodId)
+						require.Equal(t, "py-4", arg.PaymentId)
+						require.Equal(t, "pr-4", arg.PaymentRequestId)
+						require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, arg.PaymentReusability)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+						require.NotNil(t, arg.PaymentAmount)
+						require.Equal(t, "15000", arg.PaymentAmount.String())
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_CARD_PAYMENT_CAPTURED",
+			token: testCallbackToken,
+			body:  cardPaymentCaptured,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, "payment.capture", arg.PaymentEvent)
+						require.Equal(t, "pm-5", arg.PaymentMethodId)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+						require.NotNil(t, arg.CapturedAmount)
+						require.Equal(t, "15000", arg.CapturedAmount.String())
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_REFUND_SUCCEEDED",
+			token: testCallbackToken,
+			body:  refundSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+				usecase.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdateRefundRequest) error {
+						require.Equal(t, "refund.succeeded", arg.RefundEvent)
+						require.Equal(t, "rfd-1", arg.RefundId)
+						require.Equal(t, payment.REFUND_STATUS_SUCCEEDED, arg.RefundStatus)
+						require.Nil(t, arg.RefundFailureCode)
+						require.NotNil(t, arg.UpdatedAt)
+						return nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "ERR_REFUND_NOT_FOUND",
+			token: testCallbackToken,
+			body:  refundSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(pgx.ErrNoRows)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "ERR_REFUND_NOT_FOUND_WRAPPED",
+			token: testCallbackToken,
+			body:  refundSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(fmt.Errorf("q.UpdateRefund.err: %w", pgx.ErrNoRows))
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "OK_INVOICE_PAID",
+			token: testCallbackToken,
+			body:  invoicePaid,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+				usecase.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdateInvoiceRequest) error {
+						require.Equal(t, "inv-1", arg.InvoiceId)
+						require.Equal(t, payment.INVOICE_STATUS_PAID, arg.InvoiceStatus)
+						require.Equal(t, "py-inv-1", arg.PaymentId)
+						require.Equal(t, "CARDS", arg.PaymentChannel)
+						require.NotNil(t, arg.PaymentAmount)
+						require.Equal(t, "50000", arg.PaymentAmount.String())
+						require.NotNil(t, arg.PaidAt)
+						require.NotNil(t, arg.UpdatedAt)
+						return nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_INVOICE_EXPIRED",
+			token: testCallbackToken,
+			body:  invoiceExpired,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdateInvoiceRequest) error {
+						require.Equal(t, "inv-2", arg.InvoiceId)
+						require.Equal(t, payment.INVOICE_STATUS_EXPIRED, arg.InvoiceStatus)
+						require.Empty(t, arg.PaymentChannel)
+						require.Nil(t, arg.PaymentAmount)
+						require.Nil(t, arg.PaidAt)
+						return nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "ERR_INVOICE_NOT_FOUND",
+			token: testCallbackToken,
+			body:  invoiceExpired,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(1).Return(pgx.ErrNoRows)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "ERR_INVOICE_NOT_FOUND_WRAPPED",
+			token: testCallbackToken,
+			body:  invoiceExpired,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdateInvoice(gomock.Any(), gomock.Any()).Times(1).Return(fmt.Errorf("q.GetInvoiceByInvoiceIDForUpdate.err: %w", pgx.ErrNoRows))
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "OK_UNSUPPORTED_EVENT_IGNORED",
+			token: testCallbackToken,
+			body:  `{"event": "recurring.cycle.created", "data": {"id": "rc-1"}}`,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "ERR_INVALID_CALLBACK_TOKEN",
+			token: helper.RandomString(16),
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			tname: "ERR_MISSING_CALLBACK_TOKEN",
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			tname: "ERR_INVALID_PAYLOAD",
+			token: testCallbackToken,
+			body:  `{"event": `,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			tname: "ERR_VALIDATION_FAILED",
+			token: testCallbackToken,
+			body:  `{"event": "payment.succeeded", "data": {"status": "SUCCEEDED", "payment_method": {"type": "QR_CODE"}}}`,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND",
+			token: testCallbackToken,
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND_WRAPPED",
+			token: testCallbackToken,
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil, fmt.Errorf("q.GetPaymentMethodCustomerForUpdate.err: %w", pgx.ErrNoRows))
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "ERR_UPDATE_NOT_PERSISTED",
+			token: testCallbackToken,
+			body:  paymentSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("connection is already closed"))
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			usecaseCtrl := gomock.NewController(t)
+			defer usecaseCtrl.Finish()
+			usecase := wkmock.NewMockUsecase(usecaseCtrl)
+			tc.stubs(usecase)
+
+			e := echo.New()
+			h := New(logger.NewLogger(), testCfg, validator.New(), usecase, testMetrics)
+			h.MapRoutes(e)
+
+			req := httptest.NewRequest(http.MethodPost, testWebhookPath, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(XWebhookID, tc.tname)
+			if tc.token != "" {
+				req.Header.Set(XCallbackToken, tc.token)
+			}
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.statusCode, rec.Code)
+		})
+	}
+}
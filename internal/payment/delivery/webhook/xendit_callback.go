@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	eventPaymentMethod  = "payment_method."
+	eventPayment        = "payment."
+	eventPaymentRequest = "payment_request."
+	eventRefund         = "refund."
+)
+
+// xenditCallback also covers the invoice callbacks, they carry no event and hold the invoice at the top level.
+type xenditCallback struct {
+	Event      string              `json:"event"`
+	BusinessID string              `json:"business_id"`
+	Created    *time.Time          `json:"created"`
+	Data       *xenditCallbackData `json:"data"`
+
+	ID             string           `json:"id"`
+	ExternalID     string           `json:"external_id"`
+	Status         string           `json:"status"`
+	PaidAmount     *decimal.Decimal `json:"paid_amount"`
+	PaidAt         *time.Time       `json:"paid_at"`
+	Updated        *time.Time       `json:"updated"`
+	PaymentChannel string           `json:"payment_channel"`
+	PaymentID      string           `json:"payment_id"`
+}
+
+// xenditCallbackData covers both shapes xendit sends, the payment method object itself
+// for payment_method.* events, and the payment / payment request object holding
+// the payment method for payment.* and payment_request.* events.
+type xenditCallbackData struct {
+	ID               string                       `json:"id"`
+	PaymentRequestID string                       `json:"payment_request_id"`
+	BusinessID       string                       `json:"business_id"`
+	CustomerID       string                       `json:"customer_id"`
+	Type             string                       `json:"type"`
+	Reusability      string                       `json:"reusability"`
+	Amount           *decimal.Decimal             `json:"amount"`
+	CapturedAmount   *decimal.Decimal             `json:"captured_amount"`
+	Status           string                       `json:"status"`
+	FailureCode      *string                      `json:"failure_code"`
+	Updated          *time.Time                   `json:"updated"`
+	PaymentMethod    *xenditCallbackData          `json:"payment_method"`
+	Ewallet          *xenditCallbackChannelDetail `json:"ewallet"`
+	QrCode           *xenditCallbackChannelDetail `json:"qr_code"`
+	VirtualAccount   *xenditCallbackChannelDetail `json:"virtual_account"`
+	DirectDebit      *xenditCallbackChannelDetail `json:"direct_debit"`
+	OverTheCounter   *xenditCallbackChannelDetail `json:"over_the_counter"`
+}
+
+type xenditCallbackChannelDetail struct {
+	ChannelCode string `json:"channel_code"`
+}
+
+func (c *xenditCallback) toUpdatePaymentRequest() (*models.UpdatePaymentRequest, bool) {
+	if c.Data == nil {
+		return nil, false
+	}
+
+	var paymentMethod *xenditCallbackData
+
+	switch {
+	case strings.HasPrefix(c.Event, eventPaymentMethod):
+		paymentMethod = c.Data
+	case strings.HasPrefix(c.Event, eventPayment), strings.HasPrefix(c.Event, eventPaymentRequest):
+		paymentMethod = c.Data.PaymentMethod
+	default:
+		return nil, false
+	}
+
+	if paymentMethod == nil {
+		return nil, false
+	}
+
+	res := &models.UpdatePaymentRequest{
+		PaymentEvent:       c.Event,
+		PaymentType:        paymentMethod.Type,
+		PaymentCustomerId:  firstNonEmpty(c.Data.CustomerID, paymentMethod.CustomerID),
+		PaymentMethodId:    paymentMethod.ID,
+		PaymentBusinessId:  firstNonEmpty(c.Data.BusinessID, paymentMethod.BusinessID, c.BusinessID),
+		PaymentChannel:     paymentMethod.channelCode(),
+		UpdatedAt:          c.Data.Updated,
+		PaymentStatus:      c.Data.Status,
+		PaymentFailureCode: c.Data.FailureCode,
+	}
+
+	// the payment made to the payment method, a MULTIPLE_USE one receives many of them.
+	if paymentMethod != c.Data {
+		res.PaymentId = c.Data.ID
+		res.PaymentRequestId = c.Data.PaymentRequestID
+		res.PaymentReusability = paymentMethod.Reusability
+		res.PaymentAmount = c.Data.Amount
+		res.CapturedAmount = c.Data.CapturedAmount
+	}
+
+	// every direct debit charge shares the linked payment method, the charges are stored by their payment request.
+	if paymentMethod != c.Data && paymentMethod.Type == payment.METHODE_TYPE_DIRECT_DEBIT {
+		res.PaymentMethodId = firstNonEmpty(c.Data.PaymentRequestID, c.Data.ID)
+	}
+
+	if res.UpdatedAt == nil {
+		res.UpdatedAt = c.Created
+	}
+
+	return res, true
+}
+
+func (c *xenditCallback) toUpdateRefundRequest() (*models.UpdateRefundRequest, bool) {
+	if c.Data == nil || !strings.HasPrefix(c.Event, eventRefund) {
+		return nil, false
+	}
+
+	res := &models.UpdateRefundRequest{
+		RefundEvent:       c.Event,
+		RefundId:          c.Data.ID,
+		RefundStatus:      c.Data.Status,
+		RefundFailureCode: c.Data.FailureCode,
+		UpdatedAt:         c.Data.Updated,
+	}
+
+	if res.UpdatedAt == nil {
+		res.UpdatedAt = c.Created
+	}
+
+	return res, true
+}
+
+func (c *xenditCallback) toUpdateInvoiceRequest() (*models.UpdateInvoiceRequest, bool) {
+	if c.Event != "" || c.ExternalID == "" || !payment.IsInvoiceStatus(c.Status) {
+		return nil, false
+	}
+
+	res := &models.UpdateInvoiceRequest{
+		InvoiceId:     c.ID,
+		InvoiceStatus: c.Status,
+		PaymentId:     c.PaymentID,
+		PaymentAmount: c.PaidAmount,
+		UpdatedAt:     c.Updated,
+		PaidAt:        c.PaidAt,
+	}
+
+	if c.PaymentChannel != "" {
+		res.PaymentChannel = gateway.XenditInvoicePaymentChannel(c.PaymentChannel)
+	}
+
+	if res.UpdatedAt == nil {
+		res.UpdatedAt = c.Created
+	}
+
+	return res, true
+}
+
+func (d *xenditCallbackData) channelCode() string {
+	switch {
+	case d.Ewallet != nil:
+		return d.Ewallet.ChannelCode
+	case d.QrCode != nil:
+		return d.QrCode.ChannelCode
+	case d.VirtualAccount != nil:
+		return d.VirtualAccount.ChannelCode
+	case d.DirectDebit != nil:
+		return d.DirectDebit.ChannelCode
+	case d.OverTheCounter != nil:
+		return d.OverTheCounter.ChannelCode
+	default:
+		return ""
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
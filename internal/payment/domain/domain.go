@@ -14,19 +14,70 @@ type ProducerWorker interface {
 	OnConfigUpdate(key string, config *config.App)
 
 	PaymentStatusUpdated(ctx context.Context, task *models.PaymentStatusUpdatedTask) error
+	RefundStatusUpdated(ctx context.Context, task *models.RefundStatusUpdatedTask) error
+	InvoicePaid(ctx context.Context, task *models.InvoicePaidTask) error
+	ReplayDeadLetter(ctx context.Context, task *models.ReplayDeadLetterTask) error
 }
 
 type Usecase interface {
 	OnConfigUpdate(key string, config *config.App)
 
 	Create(ctx context.Context, arg *models.CreatePaymentRequest) (*pb.CreatePaymentResponse, error)
-	Update(ctx context.Context, arg *models.UpdatePaymentRequest) error
+	Update(ctx context.Context, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error)
 	GetByID(ctx context.Context, arg *models.GetByIDPaymentRequest) (*pb.GetByIDPaymentResponse, error)
+	GetByReferenceID(ctx context.Context, arg *models.GetByReferenceIDPaymentRequest) (*pb.GetByReferenceIDPaymentResponse, error)
+	ListPayments(ctx context.Context, arg *models.ListPaymentsRequest) (*pb.ListPaymentsResponse, error)
+	Cancel(ctx context.Context, arg *models.CancelPaymentRequest) (*pb.CancelPaymentResponse, error)
+	Capture(ctx context.Context, arg *models.CapturePaymentRequest) (*pb.CapturePaymentResponse, error)
+	Void(ctx context.Context, arg *models.VoidPaymentRequest) (*pb.VoidPaymentResponse, error)
+
+	LinkDirectDebit(ctx context.Context, arg *models.LinkDirectDebitRequest) (*pb.LinkDirectDebitResponse, error)
+	ValidateDirectDebitLink(ctx context.Context, arg *models.ValidateDirectDebitLinkRequest) (*pb.ValidateDirectDebitLinkResponse, error)
+
+	CreateInvoice(ctx context.Context, arg *models.CreateInvoiceRequest) (*pb.CreateInvoiceResponse, error)
+	UpdateInvoice(ctx context.Context, arg *models.UpdateInvoiceRequest) error
+
+	Refund(ctx context.Context, arg *models.RefundPaymentRequest) (*pb.RefundPaymentResponse, error)
+	UpdateRefund(ctx context.Context, arg *models.UpdateRefundRequest) error
+
+	GetLedgerBalances(ctx context.Context, arg *models.GetLedgerBalancesRequest) (*pb.GetLedgerBalancesResponse, error)
+	ListPaymentLedgerEntries(ctx context.Context, arg *models.ListPaymentLedgerEntriesRequest) (*pb.ListPaymentLedgerEntriesResponse, error)
+	GetPaymentTimeline(ctx context.Context, arg *models.GetPaymentTimelineRequest) (*pb.GetPaymentTimelineResponse, error)
+	GetCustomer(ctx context.Context, arg *models.GetCustomerRequest) (*pb.Customer, error)
+
+	ListReconciliationDiscrepancies(ctx context.Context, arg *models.ListReconciliationDiscrepanciesRequest) (*pb.ListReconciliationDiscrepanciesResponse, error)
+
+	QuarantineDeadLetter(ctx context.Context, arg *models.QuarantineDeadLetterRequest) error
+	ListDeadLetterMessages(ctx context.Context, arg *models.ListDeadLetterMessagesRequest) (*pb.ListDeadLetterMessagesResponse, error)
+	GetDeadLetterMessage(ctx context.Context, arg *models.GetDeadLetterMessageRequest) (*pb.GetDeadLetterMessageResponse, error)
+	ReplayDeadLetterMessage(ctx context.Context, arg *models.ReplayDeadLetterMessageRequest) (*pb.ReplayDeadLetterMessageResponse, error)
 
 	GetAvailableChannel(ctx context.Context, arg *models.GetPaymentChannelRequest) (*pb.GetPaymentChannelResponse, error)
 	GetAvailableChannels(ctx context.Context, arg *models.GetPaymentChannelsRequest) (*pb.GetPaymentChannelsResponse, error)
 }
 
+type ExpirySweeper interface {
+	OnConfigUpdate(key string, config *config.App)
+
+	Run(ctx context.Context)
+	Sweep(ctx context.Context) error
+	Prune(ctx context.Context) error
+}
+
+type Reconciler interface {
+	OnConfigUpdate(key string, config *config.App)
+
+	Run(ctx context.Context)
+	Reconcile(ctx context.Context) error
+}
+
+type OutboxRelay interface {
+	OnConfigUpdate(key string, config *config.App)
+
+	Run(ctx context.Context)
+	Relay(ctx context.Context) error
+}
+
 type Worker interface {
 	OnConfigUpdate(key string, config *config.App)
 }
@@ -0,0 +1,804 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+)
+
+const (
+	FakeProviderBusinessID = "fake-business"
+	// FakeProviderDirectDebitOTP is the only OTP the fake gateway accepts when validating a direct debit link.
+	FakeProviderDirectDebitOTP = "123456"
+)
+
+var fakeProviderChannels = map[string][]string{
+	payment.METHODE_TYPE_EWALLET:          {"OVO", "DANA", "LINKAJA", "SHOPEEPAY", "ASTRAPAY", "GCASH", "GRABPAY", "PAYMAYA", "TRUEMONEY", "MOMO", "ZALOPAY", "TOUCHNGO"},
+	payment.METHODE_TYPE_QR_CODE:          {"QRIS", "PROMPTPAY"},
+	payment.METHODE_TYPE_VIRTUAL_ACCOUNT:  {"BCA", "BNI", "BRI", "BSI", "CIMB", "MANDIRI", "PERMATA"},
+	payment.METHODE_TYPE_OVER_THE_COUNTER: {"ALFAMART", "INDOMARET", "7ELEVEN", "CEBUANA"},
+	payment.METHODE_TYPE_CARD:             {"CARDS"},
+	payment.METHODE_TYPE_DIRECT_DEBIT:     {"BCA_ONEKLIK", "BPI", "UBP", "RCBC"},
+}
+
+// FakeProvider is an in-memory PaymentProvider, it never leaves the process
+// which makes it suitable for tests and local development.
+type FakeProvider struct {
+	mu        sync.Mutex
+	customers map[string]*Customer
+	payments  map[string]*Payment
+	requests  map[string]string
+	refunds   map[string]*Refund
+	invoices  map[string]*Invoice
+	splits    map[string]*SplitRule
+	reported  []*TransactionReportRow
+	err       error
+	// idempotent holds the payment created under each idempotency key.
+	idempotent map[string]string
+	// holdCapture leaves the next capture PENDING, it is then settled like the payment webhook would.
+	holdCapture bool
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		customers:  make(map[string]*Customer),
+		payments:   make(map[string]*Payment),
+		requests:   make(map[string]string),
+		refunds:    make(map[string]*Refund),
+		invoices:   make(map[string]*Invoice),
+		splits:     make(map[string]*SplitRule),
+		idempotent: make(map[string]string),
+	}
+}
+
+// FailNext makes the next call to the provider return err.
+func (f *FakeProvider) FailNext(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+// HoldNextCapture makes the next capture stay PENDING, SetPaymentStatus settles it afterwards.
+func (f *FakeProvider) HoldNextCapture() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.holdCapture = true
+}
+
+// SetPaymentStatus simulates the gateway moving a payment into another status.
+func (f *FakeProvider) SetPaymentStatus(id string, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.payments[id]
+	if !ok {
+		return fmt.Errorf("fake provider: payment %s not found", id)
+	}
+
+	res.Status = status
+	res.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// SetInvoiceStatus simulates the customer paying an invoice or the gateway expiring it.
+func (f *FakeProvider) SetInvoiceStatus(id string, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.invoices[id]
+	if !ok {
+		return fmt.Errorf("fake provider: invoice %s not found", id)
+	}
+
+	res.Status = status
+	res.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// AddTransactionReportRow adds a row to every transaction report which follows, it simulates a
+// transaction the gateway processed without the service ever recording it.
+func (f *FakeProvider) AddTransactionReportRow(row *TransactionReportRow) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := *row
+	f.reported = append(f.reported, &res)
+}
+
+func (f *FakeProvider) CreateCustomerPayment(ctx context.Context, arg *CreateCustomerPaymentParams) (*Customer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	id, err := f.newID("cust")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Customer{
+		ID:          id,
+		ReferenceID: arg.ReferenceID,
+		Name:        arg.CustomerName,
+		Email:       arg.CustomerEmail,
+		PhoneNumber: "+" + arg.CustomerNumber,
+	}
+	f.customers[id] = res
+
+	return copyCustomer(res), nil
+}
+
+func (f *FakeProvider) GetCustomerPaymentByID(ctx context.Context, arg string) (*Customer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.customers[arg]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: customer %s not found", arg)
+	}
+
+	return copyCustomer(res), nil
+}
+
+func (f *FakeProvider) CreateEwalletPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	return f.createPayment(payment.METHODE_TYPE_EWALLET, arg)
+}
+
+func (f *FakeProvider) GetEwalletPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	id, ok := f.requests[arg]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payment request %s not found", arg)
+	}
+
+	return copyPayment(f.payments[id]), nil
+}
+
+func (f *FakeProvider) CreateQrCodePayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	return f.createPayment(payment.METHODE_TYPE_QR_CODE, arg)
+}
+
+func (f *FakeProvider) GetQrCodePaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	return f.getPayment(arg)
+}
+
+func (f *FakeProvider) CreateVirtualAccountBankPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	return f.createPayment(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, arg)
+}
+
+func (f *FakeProvider) GetVirtualAccountBankPaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	return f.getPayment(arg)
+}
+
+func (f *FakeProvider) CreateOverTheCounterPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	return f.createPayment(payment.METHODE_TYPE_OVER_THE_COUNTER, arg)
+}
+
+func (f *FakeProvider) GetOverTheCounterPaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	return f.getPayment(arg)
+}
+
+func (f *FakeProvider) CreateCardPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	return f.createPayment(payment.METHODE_TYPE_CARD, arg)
+}
+
+func (f *FakeProvider) GetCardPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	return f.GetEwalletPaymentRequestByID(ctx, arg)
+}
+
+func (f *FakeProvider) LinkDirectDebit(ctx context.Context, arg *LinkDirectDebitParams) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(fakeProviderChannels[payment.METHODE_TYPE_DIRECT_DEBIT], arg.ChannelCode) {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode)
+	}
+
+	id, err := f.newID("pm")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Payment{
+		ID:          id,
+		ReferenceID: arg.ReferenceID,
+		BusinessID:  FakeProviderBusinessID,
+		CustomerID:  arg.CustomerPaymentID,
+		Type:        payment.METHODE_TYPE_DIRECT_DEBIT,
+		Status:      payment.STATUS_REQUIRES_ACTION,
+		Reusability: payment.USAGE_TYPE_MULTIPLE_USE,
+		Channel:     arg.ChannelCode,
+		Description: arg.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	f.payments[id] = res
+
+	return copyPayment(res), nil
+}
+
+// ValidateDirectDebitLink activates a link when the OTP is FakeProviderDirectDebitOTP.
+func (f *FakeProvider) ValidateDirectDebitLink(ctx context.Context, arg *ValidateDirectDebitLinkParams) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.payments[arg.PaymentMethodID]
+	if !ok || res.Type != payment.METHODE_TYPE_DIRECT_DEBIT {
+		return nil, fmt.Errorf("fake provider: direct debit link %s not found", arg.PaymentMethodID)
+	}
+
+	if res.Status != payment.STATUS_REQUIRES_ACTION {
+		return nil, fmt.Errorf("fake provider: direct debit link %s can not be validated from status %s", arg.PaymentMethodID, res.Status)
+	}
+
+	if arg.OTPCode != FakeProviderDirectDebitOTP {
+		return nil, unierror.ErrInvalidOTPCode
+	}
+
+	res.Status = payment.STATUS_ACTIVE
+	res.UpdatedAt = time.Now()
+
+	return copyPayment(res), nil
+}
+
+func (f *FakeProvider) CreateDirectDebitPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if res, ok := f.idempotentPayment(arg); ok {
+		return res, nil
+	}
+
+	linked, ok := f.payments[arg.LinkedPaymentMethodID]
+	if !ok || linked.Type != payment.METHODE_TYPE_DIRECT_DEBIT || linked.Status != payment.STATUS_ACTIVE {
+		return nil, fmt.Errorf("fake provider: direct debit link %s is not active", arg.LinkedPaymentMethodID)
+	}
+
+	if err := f.checkSplitRule(arg); err != nil {
+		return nil, err
+	}
+
+	id, err := f.newID("pr")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Payment{
+		ID:                    id,
+		RequestID:             id,
+		ReferenceID:           arg.ReferenceID,
+		BusinessID:            FakeProviderBusinessID,
+		CustomerID:            arg.CustomerPaymentID,
+		Type:                  payment.METHODE_TYPE_DIRECT_DEBIT,
+		Status:                payment.STATUS_PENDING,
+		Reusability:           payment.USAGE_TYPE_ONE_TIME_USE,
+		Channel:               linked.Channel,
+		Amount:                arg.Amount,
+		Currency:              arg.Currency,
+		LinkedPaymentMethodID: linked.ID,
+		Description:           arg.Description,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	f.payments[id] = res
+	f.requests[id] = id
+	f.storeIdempotentPayment(arg, res)
+
+	return copyPayment(res), nil
+}
+
+func (f *FakeProvider) GetDirectDebitPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	return f.GetEwalletPaymentRequestByID(ctx, arg)
+}
+
+// CapturePayment captures a payment the fake gateway moved to AWAITING_CAPTURE, see SetPaymentStatus.
+func (f *FakeProvider) CapturePayment(ctx context.Context, arg *CapturePaymentParams) (*Capture, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	id, ok := f.requests[arg.PaymentRequestID]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payment request %s not found", arg.PaymentRequestID)
+	}
+
+	res := f.payments[id]
+	if res.Status != payment.STATUS_AWAITING_CAPTURE {
+		return nil, fmt.Errorf("fake provider: payment %s can not be captured from status %s", id, res.Status)
+	}
+
+	if !arg.Amount.IsPositive() || arg.Amount.GreaterThan(res.Amount) {
+		return nil, fmt.Errorf("fake provider: invalid capture amount %v", arg.Amount)
+	}
+
+	captureID, err := f.newID("capt")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	status := payment.CAPTURE_STATUS_SUCCEEDED
+	if f.holdCapture {
+		status = payment.CAPTURE_STATUS_PENDING
+		f.holdCapture = false
+	} else {
+		res.Status = payment.STATUS_SUCCEEDED
+		res.UpdatedAt = now
+	}
+
+	return &Capture{
+		ID:               captureID,
+		PaymentRequestID: arg.PaymentRequestID,
+		Status:           status,
+		AuthorizedAmount: res.Amount,
+		CapturedAmount:   arg.Amount,
+		Currency:         res.Currency,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+func (f *FakeProvider) VoidPayment(ctx context.Context, arg *VoidPaymentParams) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.payments[arg.PaymentMethodID]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payment %s not found", arg.PaymentMethodID)
+	}
+
+	if res.Status != payment.STATUS_AWAITING_CAPTURE {
+		return nil, fmt.Errorf("fake provider: payment %s can not be voided from status %s", arg.PaymentMethodID, res.Status)
+	}
+
+	res.Status = payment.STATUS_VOIDED
+	res.UpdatedAt = time.Now()
+
+	return copyPayment(res), nil
+}
+
+func (f *FakeProvider) ExpirePayment(ctx context.Context, arg string) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.payments[arg]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payment %s not found", arg)
+	}
+
+	if !payment.IsOutstandingStatus(res.Status) {
+		return nil, fmt.Errorf("fake provider: payment %s can not be expired from status %s", arg, res.Status)
+	}
+
+	res.Status = payment.STATUS_EXPIRED
+	res.UpdatedAt = time.Now()
+
+	return copyPayment(res), nil
+}
+
+func (f *FakeProvider) CreateRefund(ctx context.Context, arg *CreateRefundParams) (*Refund, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if !arg.Amount.IsPositive() {
+		return nil, fmt.Errorf("fake provider: invalid refund amount %v", arg.Amount)
+	}
+
+	id, err := f.newID("rfd")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Refund{
+		ID:               id,
+		PaymentRequestID: arg.PaymentRequestID,
+		ReferenceID:      arg.ReferenceID,
+		Status:           payment.REFUND_STATUS_PENDING,
+		Amount:           arg.Amount,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	f.refunds[id] = res
+
+	return copyRefund(res), nil
+}
+
+func (f *FakeProvider) CreateSplitRule(ctx context.Context, arg *CreateSplitRuleParams) (*SplitRule, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if len(arg.Routes) == 0 {
+		return nil, fmt.Errorf("fake provider: split rule %s has no routes", arg.Name)
+	}
+
+	routes := make([]*SplitRoute, 0, len(arg.Routes))
+	for _, route := range arg.Routes {
+		if route.DestinationAccountID == "" || !route.Amount.IsPositive() {
+			return nil, fmt.Errorf("fake provider: invalid split route %+v", route)
+		}
+
+		res := *route
+		routes = append(routes, &res)
+	}
+
+	id, err := f.newID("splitru")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SplitRule{
+		ID:        id,
+		Name:      arg.Name,
+		Routes:    routes,
+		CreatedAt: time.Now(),
+	}
+	f.splits[id] = res
+
+	return copySplitRule(res), nil
+}
+
+func (f *FakeProvider) CreateInvoice(ctx context.Context, arg *CreateInvoiceParams) (*Invoice, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	for _, channel := range arg.AllowedChannels {
+		if !isFakeProviderChannel(channel) {
+			return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, channel)
+		}
+	}
+
+	id, err := f.newID("inv")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Invoice{
+		ID:              id,
+		ReferenceID:     arg.ReferenceID,
+		BusinessID:      FakeProviderBusinessID,
+		CustomerID:      arg.CustomerPaymentID,
+		Status:          payment.INVOICE_STATUS_PENDING,
+		Amount:          arg.Amount,
+		Currency:        arg.Currency,
+		AllowedChannels: slices.Clone(arg.AllowedChannels),
+		URL:             fmt.Sprintf("https://fake.gateway/invoice/%s", id),
+		Description:     arg.Description,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		ExpiresAt:       arg.Expiry,
+	}
+	f.invoices[id] = res
+
+	return copyInvoice(res), nil
+}
+
+func (f *FakeProvider) GetInvoiceByID(ctx context.Context, arg string) (*Invoice, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.invoices[arg]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: invoice %s not found", arg)
+	}
+
+	return copyInvoice(res), nil
+}
+
+// GetTransactionReport reports the succeeded payments last updated within the period along with the
+// rows added through AddTransactionReportRow.
+func (f *FakeProvider) GetTransactionReport(ctx context.Context, arg *GetTransactionReportParams) (*TransactionReport, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	id, err := f.newID("report")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TransactionReport{ID: id}
+	for _, pm := range f.payments {
+		if pm.Status != payment.STATUS_SUCCEEDED || pm.UpdatedAt.Before(arg.From) || !pm.UpdatedAt.Before(arg.To) {
+			continue
+		}
+
+		if arg.Currency != "" && pm.Currency != arg.Currency {
+			continue
+		}
+
+		res.Rows = append(res.Rows, &TransactionReportRow{
+			PaymentMethodID:  pm.ID,
+			PaymentRequestID: pm.RequestID,
+			ReferenceID:      pm.ReferenceID,
+			Status:           pm.Status,
+			Amount:           pm.Amount,
+			Currency:         pm.Currency,
+			CreatedAt:        pm.CreatedAt,
+		})
+	}
+
+	slices.SortFunc(res.Rows, func(a, b *TransactionReportRow) int {
+		return strings.Compare(a.PaymentMethodID, b.PaymentMethodID)
+	})
+
+	for _, row := range f.reported {
+		rowCopy := *row
+		res.Rows = append(res.Rows, &rowCopy)
+	}
+
+	return res, nil
+}
+
+func (f *FakeProvider) createPayment(typ string, arg *CreatePaymentParams) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if res, ok := f.idempotentPayment(arg); ok {
+		return res, nil
+	}
+
+	if !slices.Contains(fakeProviderChannels[typ], arg.ChannelCode) {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode)
+	}
+
+	if err := f.checkSplitRule(arg); err != nil {
+		return nil, err
+	}
+
+	id, err := f.newID("pm")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Payment{
+		ID:          id,
+		ReferenceID: arg.ReferenceID,
+		BusinessID:  FakeProviderBusinessID,
+		CustomerID:  arg.CustomerPaymentID,
+		Type:        typ,
+		Status:      payment.STATUS_ACTIVE,
+		Reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+		Channel:     arg.ChannelCode,
+		Amount:      arg.Amount,
+		Currency:    arg.Currency,
+		Description: arg.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ExpiresAt:   arg.Expiry,
+	}
+
+	switch typ {
+	case payment.METHODE_TYPE_EWALLET:
+		requestID, err := f.newID("pr")
+		if err != nil {
+			return nil, err
+		}
+
+		res.RequestID = requestID
+		res.Status = payment.STATUS_PENDING
+		if arg.ChannelCode != "OVO" {
+			res.URL = fmt.Sprintf("https://fake.gateway/ewallet/%s", requestID)
+		}
+		f.requests[requestID] = id
+	case payment.METHODE_TYPE_CARD:
+		if arg.CardToken == "" {
+			return nil, unierror.ErrCardTokenRequired
+		}
+
+		requestID, err := f.newID("pr")
+		if err != nil {
+			return nil, err
+		}
+
+		// the card token is the payment method, the customer still has to pass 3DS.
+		res.ID = arg.CardToken
+		res.RequestID = requestID
+		res.Status = payment.STATUS_REQUIRES_ACTION
+		res.CaptureMethod = arg.CaptureMethod
+		res.URL = fmt.Sprintf("https://fake.gateway/3ds/%s", requestID)
+		f.requests[requestID] = res.ID
+	case payment.METHODE_TYPE_QR_CODE:
+		res.QrCode = helper.RandomString(64)
+		if arg.Reusability == payment.USAGE_TYPE_MULTIPLE_USE {
+			res.Reusability = arg.Reusability
+		}
+	case payment.METHODE_TYPE_VIRTUAL_ACCOUNT:
+		res.VirtualAccountNumber = helper.RandomStringInt(16)
+		if arg.Reusability == payment.USAGE_TYPE_MULTIPLE_USE {
+			res.Reusability = arg.Reusability
+		}
+	case payment.METHODE_TYPE_OVER_THE_COUNTER:
+		res.PaymentCode = strings.ToUpper(helper.RandomString(12))
+	}
+
+	f.payments[res.ID] = res
+	f.storeIdempotentPayment(arg, res)
+
+	return copyPayment(res), nil
+}
+
+func (f *FakeProvider) getPayment(id string) (*Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.payments[id]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payment %s not found", id)
+	}
+
+	return copyPayment(res), nil
+}
+
+// checkSplitRule rejects a split rule which was never created or is not applied to a sub-account,
+// a split rule moves funds out of the balance of the sub-account the payment is collected on behalf of.
+func (f *FakeProvider) checkSplitRule(arg *CreatePaymentParams) error {
+	if arg.SplitRuleID == "" {
+		return nil
+	}
+
+	if arg.ForUserID == "" {
+		return fmt.Errorf("fake provider: split rule %s without a sub-account", arg.SplitRuleID)
+	}
+
+	if _, ok := f.splits[arg.SplitRuleID]; !ok {
+		return fmt.Errorf("fake provider: split rule %s not found", arg.SplitRuleID)
+	}
+
+	return nil
+}
+
+// idempotentPayment is the payment an earlier call with the same idempotency key created, the gateway hands
+// it back instead of creating another one.
+func (f *FakeProvider) idempotentPayment(arg *CreatePaymentParams) (*Payment, bool) {
+	if arg.IdempotencyKey == "" {
+		return nil, false
+	}
+
+	id, ok := f.idempotent[arg.IdempotencyKey]
+	if !ok {
+		return nil, false
+	}
+
+	return copyPayment(f.payments[id]), true
+}
+
+func (f *FakeProvider) storeIdempotentPayment(arg *CreatePaymentParams, res *Payment) {
+	if arg.IdempotencyKey != "" {
+		f.idempotent[arg.IdempotencyKey] = res.ID
+	}
+}
+
+func (f *FakeProvider) takeErr() error {
+	err := f.err
+	f.err = nil
+
+	return err
+}
+
+func (f *FakeProvider) newID(prefix string) (string, error) {
+	id, err := helper.GenerateULID()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, id.String()), nil
+}
+
+func copyCustomer(arg *Customer) *Customer {
+	res := *arg
+	return &res
+}
+
+func copyPayment(arg *Payment) *Payment {
+	res := *arg
+	return &res
+}
+
+func copyRefund(arg *Refund) *Refund {
+	res := *arg
+	return &res
+}
+
+func copyInvoice(arg *Invoice) *Invoice {
+	res := *arg
+	res.AllowedChannels = slices.Clone(arg.AllowedChannels)
+	return &res
+}
+
+func copySplitRule(arg *SplitRule) *SplitRule {
+	res := *arg
+	res.Routes = make([]*SplitRoute, 0, len(arg.Routes))
+	for _, route := range arg.Routes {
+		routeCopy := *route
+		res.Routes = append(res.Routes, &routeCopy)
+	}
+	return &res
+}
+
+func isFakeProviderChannel(channel string) bool {
+	for _, channels := range fakeProviderChannels {
+		if slices.Contains(channels, channel) {
+			return true
+		}
+	}
+
+	return false
+}
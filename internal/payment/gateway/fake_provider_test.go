@@ -0,0 +1,469 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProviderCreateCustomerPayment(t *testing.T) {
+	provider := NewFakeProvider()
+
+	arg := CreateCustomerPaymentParams{
+		CustomerName:   helper.RandomString(28),
+		CustomerNumber: helper.RandomStringInt(12),
+		ReferenceID:    helper.RandomString(26),
+	}
+
+	res, err := provider.CreateCustomerPayment(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ID)
+	require.Equal(t, arg.CustomerName, res.Name)
+
+	got, err := provider.GetCustomerPaymentByID(context.TODO(), res.ID)
+	require.NoError(t, err)
+	require.Equal(t, res, got)
+}
+
+func TestFakeProviderCreatePayment(t *testing.T) {
+	testCases := []struct {
+		tname   string
+		typ     string
+		channel string
+		create  func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error)
+		get     func(provider *FakeProvider, res *Payment) (*Payment, error)
+	}{
+		{
+			tname:   "EWALLET",
+			typ:     payment.METHODE_TYPE_EWALLET,
+			channel: "DANA",
+			create: func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error) {
+				return provider.CreateEwalletPayment(context.TODO(), arg)
+			},
+			get: func(provider *FakeProvider, res *Payment) (*Payment, error) {
+				return provider.GetEwalletPaymentRequestByID(context.TODO(), res.RequestID)
+			},
+		},
+		{
+			tname:   "QR_CODE",
+			typ:     payment.METHODE_TYPE_QR_CODE,
+			channel: "QRIS",
+			create: func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error) {
+				return provider.CreateQrCodePayment(context.TODO(), arg)
+			},
+			get: func(provider *FakeProvider, res *Payment) (*Payment, error) {
+				return provider.GetQrCodePaymentByID(context.TODO(), res.ID)
+			},
+		},
+		{
+			tname:   "VIRTUAL_ACCOUNT",
+			typ:     payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+			channel: "BCA",
+			create: func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error) {
+				return provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+			},
+			get: func(provider *FakeProvider, res *Payment) (*Payment, error) {
+				return provider.GetVirtualAccountBankPaymentByID(context.TODO(), res.ID)
+			},
+		},
+		{
+			tname:   "OVER_THE_COUNTER",
+			typ:     payment.METHODE_TYPE_OVER_THE_COUNTER,
+			channel: "ALFAMART",
+			create: func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error) {
+				res, err := provider.CreateOverTheCounterPayment(context.TODO(), arg)
+				if err == nil && res.PaymentCode == "" {
+					return nil, errors.New("over the counter payment without a payment code")
+				}
+
+				return res, err
+			},
+			get: func(provider *FakeProvider, res *Payment) (*Payment, error) {
+				return provider.GetOverTheCounterPaymentByID(context.TODO(), res.ID)
+			},
+		},
+		{
+			tname:   "CARD",
+			typ:     payment.METHODE_TYPE_CARD,
+			channel: "CARDS",
+			create: func(provider *FakeProvider, arg *CreatePaymentParams) (*Payment, error) {
+				return provider.CreateCardPayment(context.TODO(), arg)
+			},
+			get: func(provider *FakeProvider, res *Payment) (*Payment, error) {
+				return provider.GetCardPaymentRequestByID(context.TODO(), res.RequestID)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			provider := NewFakeProvider()
+
+			arg := &CreatePaymentParams{
+				CustomerPaymentID: helper.RandomString(26),
+				ReferenceID:       helper.RandomString(26),
+				Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+				Expiry:            time.Now().Add(72 * time.Hour),
+				ChannelCode:       tc.channel,
+				CardToken:         "pm-" + helper.RandomString(26),
+			}
+
+			res, err := tc.create(provider, arg)
+			require.NoError(t, err)
+			require.NotEmpty(t, res.ID)
+			require.Equal(t, tc.typ, res.Type)
+			require.Equal(t, arg.ChannelCode, res.Channel)
+			require.Equal(t, arg.CustomerPaymentID, res.CustomerID)
+
+			require.NoError(t, provider.SetPaymentStatus(res.ID, payment.STATUS_SUCCEEDED))
+
+			got, err := tc.get(provider, res)
+			require.NoError(t, err)
+			require.Equal(t, payment.STATUS_SUCCEEDED, got.Status)
+
+			arg.ChannelCode = helper.RandomString(8)
+			_, err = tc.create(provider, arg)
+			require.True(t, errors.Is(err, unierror.ErrUnsupportedPaymentChannel))
+
+			provider.FailNext(errors.New("gateway unavailable"))
+			_, err = tc.get(provider, res)
+			require.Error(t, err)
+
+			_, err = tc.get(provider, res)
+			require.NoError(t, err)
+
+			// a retry under the idempotency key of the first call gets its payment back.
+			arg.ChannelCode = tc.channel
+			arg.IdempotencyKey = helper.RandomString(26)
+			first, err := tc.create(provider, arg)
+			require.NoError(t, err)
+
+			retried, err := tc.create(provider, arg)
+			require.NoError(t, err)
+			require.Equal(t, first.ID, retried.ID)
+			require.Equal(t, first.RequestID, retried.RequestID)
+		})
+	}
+}
+
+func TestFakeProviderCreateRefund(t *testing.T) {
+	provider := NewFakeProvider()
+
+	arg := CreateRefundParams{
+		PaymentMethodID:  helper.RandomString(26),
+		PaymentRequestID: helper.RandomString(26),
+		ReferenceID:      helper.RandomString(26),
+		Amount:           decimal.NewFromInt(helper.RandomInt(100, 20000)),
+		Reason:           payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+	}
+
+	res, err := provider.CreateRefund(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ID)
+	require.Equal(t, arg.ReferenceID, res.ReferenceID)
+	require.Equal(t, payment.REFUND_STATUS_PENDING, res.Status)
+	require.True(t, arg.Amount.Equal(res.Amount))
+
+	provider.FailNext(errors.New("gateway unavailable"))
+	_, err = provider.CreateRefund(context.TODO(), &arg)
+	require.Error(t, err)
+
+	arg.Amount = decimal.Zero
+	_, err = provider.CreateRefund(context.TODO(), &arg)
+	require.Error(t, err)
+}
+
+func TestFakeProviderExpirePayment(t *testing.T) {
+	provider := NewFakeProvider()
+
+	res, err := provider.CreateVirtualAccountBankPayment(context.TODO(), &CreatePaymentParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "BNI",
+	})
+	require.NoError(t, err)
+
+	expired, err := provider.ExpirePayment(context.TODO(), res.ID)
+	require.NoError(t, err)
+	require.Equal(t, res.ID, expired.ID)
+	require.Equal(t, payment.STATUS_EXPIRED, expired.Status)
+
+	got, err := provider.GetVirtualAccountBankPaymentByID(context.TODO(), res.ID)
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_EXPIRED, got.Status)
+
+	_, err = provider.ExpirePayment(context.TODO(), res.ID)
+	require.Error(t, err)
+
+	_, err = provider.ExpirePayment(context.TODO(), helper.RandomString(26))
+	require.Error(t, err)
+}
+
+func TestFakeProviderCaptureAndVoidPayment(t *testing.T) {
+	provider := NewFakeProvider()
+
+	createCard := func() *Payment {
+		res, err := provider.CreateCardPayment(context.TODO(), &CreatePaymentParams{
+			CustomerPaymentID: helper.RandomString(26),
+			ReferenceID:       helper.RandomString(26),
+			Amount:            decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+			Currency:          payment.DEFAULT_CURRENCY,
+			Expiry:            time.Now().Add(72 * time.Hour),
+			ChannelCode:       "CARDS",
+			CardToken:         "pm-" + helper.RandomString(26),
+			CaptureMethod:     payment.CAPTURE_METHOD_MANUAL,
+		})
+		require.NoError(t, err)
+		require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.Status)
+		require.Equal(t, payment.CAPTURE_METHOD_MANUAL, res.CaptureMethod)
+		require.NotEmpty(t, res.URL)
+
+		return res
+	}
+
+	captured := createCard()
+	captureArg := &CapturePaymentParams{
+		PaymentRequestID: captured.RequestID,
+		ReferenceID:      captured.ReferenceID,
+		Amount:           captured.Amount.Sub(decimal.NewFromInt(1)),
+	}
+
+	// 3DS has not been completed yet.
+	_, err := provider.CapturePayment(context.TODO(), captureArg)
+	require.Error(t, err)
+
+	require.NoError(t, provider.SetPaymentStatus(captured.ID, payment.STATUS_AWAITING_CAPTURE))
+
+	capture, err := provider.CapturePayment(context.TODO(), captureArg)
+	require.NoError(t, err)
+	require.Equal(t, payment.CAPTURE_STATUS_SUCCEEDED, capture.Status)
+	require.True(t, captureArg.Amount.Equal(capture.CapturedAmount))
+	require.True(t, captured.Amount.Equal(capture.AuthorizedAmount))
+
+	_, err = provider.VoidPayment(context.TODO(), &VoidPaymentParams{PaymentMethodID: captured.ID, PaymentRequestID: captured.RequestID})
+	require.Error(t, err)
+
+	voided := createCard()
+	require.NoError(t, provider.SetPaymentStatus(voided.ID, payment.STATUS_AWAITING_CAPTURE))
+
+	res, err := provider.VoidPayment(context.TODO(), &VoidPaymentParams{PaymentMethodID: voided.ID, PaymentRequestID: voided.RequestID})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_VOIDED, res.Status)
+
+	_, err = provider.CapturePayment(context.TODO(), &CapturePaymentParams{
+		PaymentRequestID: voided.RequestID,
+		Amount:           voided.Amount,
+	})
+	require.Error(t, err)
+}
+
+func TestFakeProviderDirectDebitLinkAndCharge(t *testing.T) {
+	provider := NewFakeProvider()
+	customerPaymentID := helper.RandomString(26)
+
+	link, err := provider.LinkDirectDebit(context.TODO(), &LinkDirectDebitParams{
+		CustomerPaymentID: customerPaymentID,
+		ReferenceID:       helper.RandomString(26),
+		Country:           payment.COUNTRY_ID,
+		ChannelCode:       "BCA_ONEKLIK",
+		MobileNumber:      "+6281234567890",
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_REQUIRES_ACTION, link.Status)
+	require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, link.Reusability)
+
+	chargeArg := &CreatePaymentParams{
+		CustomerPaymentID:     customerPaymentID,
+		ReferenceID:           helper.RandomString(26),
+		Amount:                decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+		Currency:              payment.DEFAULT_CURRENCY,
+		LinkedPaymentMethodID: link.ID,
+	}
+
+	// the link has not been validated yet.
+	_, err = provider.CreateDirectDebitPayment(context.TODO(), chargeArg)
+	require.Error(t, err)
+
+	_, err = provider.ValidateDirectDebitLink(context.TODO(), &ValidateDirectDebitLinkParams{PaymentMethodID: link.ID, OTPCode: "000000"})
+	require.ErrorIs(t, err, unierror.ErrInvalidOTPCode)
+
+	validated, err := provider.ValidateDirectDebitLink(context.TODO(), &ValidateDirectDebitLinkParams{PaymentMethodID: link.ID, OTPCode: FakeProviderDirectDebitOTP})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_ACTIVE, validated.Status)
+
+	charge, err := provider.CreateDirectDebitPayment(context.TODO(), chargeArg)
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_PENDING, charge.Status)
+	require.Equal(t, link.ID, charge.LinkedPaymentMethodID)
+	require.Equal(t, link.Channel, charge.Channel)
+	require.NotEqual(t, link.ID, charge.ID)
+
+	res, err := provider.GetDirectDebitPaymentRequestByID(context.TODO(), charge.RequestID)
+	require.NoError(t, err)
+	require.Equal(t, charge.ID, res.ID)
+
+	_, err = provider.LinkDirectDebit(context.TODO(), &LinkDirectDebitParams{ChannelCode: "OVO"})
+	require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentChannel)
+}
+
+func TestFakeProviderReusablePayment(t *testing.T) {
+	provider := NewFakeProvider()
+
+	arg := &CreatePaymentParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "BCA",
+		Reusability:       payment.USAGE_TYPE_MULTIPLE_USE,
+	}
+
+	res, err := provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, res.Reusability)
+
+	arg.ChannelCode = "QRIS"
+	res, err = provider.CreateQrCodePayment(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, res.Reusability)
+
+	arg.ChannelCode = "DANA"
+	res, err = provider.CreateEwalletPayment(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, payment.USAGE_TYPE_ONE_TIME_USE, res.Reusability)
+}
+
+func TestFakeProviderCreateInvoice(t *testing.T) {
+	provider := NewFakeProvider()
+
+	arg := &CreateInvoiceParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Currency:          payment.CURRENCY_IDR,
+		Expiry:            time.Now().Add(72 * time.Hour),
+		AllowedChannels:   []string{"BCA", "OVO", "CARDS"},
+	}
+
+	res, err := provider.CreateInvoice(context.TODO(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ID)
+	require.NotEmpty(t, res.URL)
+	require.Equal(t, payment.INVOICE_STATUS_PENDING, res.Status)
+	require.Equal(t, arg.AllowedChannels, res.AllowedChannels)
+
+	provider.SetInvoiceStatus(res.ID, payment.INVOICE_STATUS_PAID)
+
+	res, err = provider.GetInvoiceByID(context.TODO(), res.ID)
+	require.NoError(t, err)
+	require.Equal(t, payment.INVOICE_STATUS_PAID, res.Status)
+
+	arg.AllowedChannels = []string{"UNKNOWN"}
+	_, err = provider.CreateInvoice(context.TODO(), arg)
+	require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentChannel)
+}
+
+func TestFakeProviderSplitPayment(t *testing.T) {
+	provider := NewFakeProvider()
+
+	rule, err := provider.CreateSplitRule(context.TODO(), &CreateSplitRuleParams{
+		Name: helper.RandomString(12),
+		Routes: []*SplitRoute{
+			{
+				DestinationAccountID: helper.RandomString(24),
+				Amount:               decimal.NewFromInt(2500),
+				Currency:             payment.CURRENCY_IDR,
+				ReferenceID:          helper.RandomString(12),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rule.ID)
+	require.Len(t, rule.Routes, 1)
+
+	arg := &CreatePaymentParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+		Currency:          payment.CURRENCY_IDR,
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "BCA",
+		ForUserID:         helper.RandomString(24),
+		SplitRuleID:       rule.ID,
+	}
+
+	_, err = provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.NoError(t, err)
+
+	arg.ForUserID = ""
+	_, err = provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.Error(t, err)
+
+	arg.ForUserID = helper.RandomString(24)
+	arg.SplitRuleID = helper.RandomString(24)
+	_, err = provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.Error(t, err)
+
+	_, err = provider.CreateSplitRule(context.TODO(), &CreateSplitRuleParams{Name: helper.RandomString(12)})
+	require.Error(t, err)
+}
+
+func TestFakeProviderTransactionReport(t *testing.T) {
+	provider := NewFakeProvider()
+
+	from := time.Now().Add(-time.Minute)
+
+	arg := &CreatePaymentParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+		Currency:          payment.CURRENCY_IDR,
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "BCA",
+	}
+
+	succeeded, err := provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.NoError(t, err)
+	require.NoError(t, provider.SetPaymentStatus(succeeded.ID, payment.STATUS_SUCCEEDED))
+
+	arg.ReferenceID = helper.RandomString(26)
+	_, err = provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	require.NoError(t, err)
+
+	extra := &TransactionReportRow{
+		ReferenceID: helper.RandomString(26),
+		Status:      payment.STATUS_SUCCEEDED,
+		Amount:      decimal.NewFromInt(5000),
+		Currency:    payment.CURRENCY_IDR,
+	}
+	provider.AddTransactionReportRow(extra)
+
+	report, err := provider.GetTransactionReport(context.TODO(), &GetTransactionReportParams{From: from, To: time.Now().Add(time.Minute)})
+	require.NoError(t, err)
+	require.NotEmpty(t, report.ID)
+	require.Len(t, report.Rows, 2)
+	require.Equal(t, succeeded.ID, report.Rows[0].PaymentMethodID)
+	require.Equal(t, succeeded.ReferenceID, report.Rows[0].ReferenceID)
+	require.True(t, succeeded.Amount.Equal(report.Rows[0].Amount))
+	require.Equal(t, payment.STATUS_SUCCEEDED, report.Rows[0].Status)
+	require.Equal(t, extra, report.Rows[1])
+
+	report, err = provider.GetTransactionReport(context.TODO(), &GetTransactionReportParams{From: from.Add(-time.Hour), To: from})
+	require.NoError(t, err)
+	require.Len(t, report.Rows, 1)
+
+	provider.FailNext(errors.New("gateway unavailable"))
+	_, err = provider.GetTransactionReport(context.TODO(), &GetTransactionReportParams{From: from, To: time.Now()})
+	require.Error(t, err)
+}
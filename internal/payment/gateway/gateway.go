@@ -0,0 +1,288 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PaymentProvider is the provider-neutral contract every payment gateway has to satisfy.
+// Implementations translate these params and results from / into their own SDK types,
+// so neither the usecase nor the repository layer has to know which gateway is behind it.
+type PaymentProvider interface {
+	CreateCustomerPayment(ctx context.Context, arg *CreateCustomerPaymentParams) (*Customer, error)
+	GetCustomerPaymentByID(ctx context.Context, arg string) (*Customer, error)
+
+	CreateEwalletPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetEwalletPaymentRequestByID(ctx context.Context, arg string) (*Payment, error)
+
+	CreateQrCodePayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetQrCodePaymentByID(ctx context.Context, arg string) (*Payment, error)
+
+	CreateVirtualAccountBankPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetVirtualAccountBankPaymentByID(ctx context.Context, arg string) (*Payment, error)
+
+	// CreateOverTheCounterPayment issues a payment code the customer pays with at a retail outlet.
+	CreateOverTheCounterPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetOverTheCounterPaymentByID(ctx context.Context, arg string) (*Payment, error)
+
+	// CreateCardPayment charges a card tokenized on the client, the payment stays in REQUIRES_ACTION
+	// until the customer completes 3DS through the returned URL.
+	CreateCardPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetCardPaymentRequestByID(ctx context.Context, arg string) (*Payment, error)
+
+	// LinkDirectDebit links a customer bank account as a MULTIPLE_USE payment method, the link stays
+	// in REQUIRES_ACTION until the OTP the bank sent to the customer is validated.
+	LinkDirectDebit(ctx context.Context, arg *LinkDirectDebitParams) (*Payment, error)
+	ValidateDirectDebitLink(ctx context.Context, arg *ValidateDirectDebitLinkParams) (*Payment, error)
+	// CreateDirectDebitPayment charges a linked direct debit payment method without redirecting the customer.
+	CreateDirectDebitPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
+	GetDirectDebitPaymentRequestByID(ctx context.Context, arg string) (*Payment, error)
+
+	// CapturePayment captures an authorized payment, an amount below the authorized one captures it partially.
+	CapturePayment(ctx context.Context, arg *CapturePaymentParams) (*Capture, error)
+	// VoidPayment releases an authorized payment which has not been captured.
+	VoidPayment(ctx context.Context, arg *VoidPaymentParams) (*Payment, error)
+
+	// ExpirePayment deactivates an outstanding payment method so it can no longer be paid.
+	ExpirePayment(ctx context.Context, arg string) (*Payment, error)
+
+	CreateRefund(ctx context.Context, arg *CreateRefundParams) (*Refund, error)
+
+	// CreateSplitRule creates the routes a marketplace payment is split with, the split rule id is then
+	// passed along with the payment which is collected on behalf of a sub-account.
+	CreateSplitRule(ctx context.Context, arg *CreateSplitRuleParams) (*SplitRule, error)
+
+	// CreateInvoice creates a hosted checkout page the customer pays on through any of the allowed channels.
+	CreateInvoice(ctx context.Context, arg *CreateInvoiceParams) (*Invoice, error)
+	GetInvoiceByID(ctx context.Context, arg string) (*Invoice, error)
+
+	// GetTransactionReport generates the report of the transactions of a period, it returns once the
+	// gateway has the report ready or ctx is done.
+	GetTransactionReport(ctx context.Context, arg *GetTransactionReportParams) (*TransactionReport, error)
+}
+
+type CreateCustomerPaymentParams struct {
+	CustomerName   string `json:"customerName"`
+	CustomerNumber string `json:"customerNumber"`
+	CustomerUID    string `json:"customerUID"`
+	CustomerEmail  string `json:"customerEmail"`
+	ReferenceID    string `json:"referenceID"`
+}
+
+type CreatePaymentParams struct {
+	CustomerName      string          `json:"customerName"`
+	CustomerPaymentID string          `json:"customerPaymentID"`
+	CustomerNumber    string          `json:"customerNumber"`
+	Description       string          `json:"description"`
+	ReferenceID       string          `json:"referenceID"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Country           string          `json:"country"`
+	Expiry            time.Time       `json:"expiry"`
+	ChannelCode       string          `json:"channelCode"`
+	SuccessReturnURL  string          `json:"successReturnURL"`
+	FailureReturnURL  string          `json:"failureReturnURL"`
+	// CardToken is the payment method id the client tokenized the card into, for CARD payments only.
+	CardToken     string `json:"cardToken"`
+	CaptureMethod string `json:"captureMethod"`
+	// LinkedPaymentMethodID is the linked payment method to charge, for DIRECT_DEBIT payments only.
+	LinkedPaymentMethodID string `json:"linkedPaymentMethodID"`
+	// Reusability is MULTIPLE_USE for a VIRTUAL_ACCOUNT or QR_CODE which keeps receiving payments,
+	// empty creates a ONE_TIME_USE one.
+	Reusability string `json:"reusability"`
+	// ForUserID is the marketplace sub-account the payment is collected on behalf of, empty collects it
+	// on the platform account.
+	ForUserID string `json:"forUserID"`
+	// SplitRuleID routes parts of the payment to other accounts, it requires ForUserID.
+	SplitRuleID string `json:"splitRuleID"`
+	// IdempotencyKey is the idempotency key of the create payment request, the gateway creates a single
+	// payment for it however many times the request is retried.
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+type CreateSplitRuleParams struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Routes      []*SplitRoute `json:"routes"`
+}
+
+// SplitRoute moves a flat amount of a payment to the destination account.
+type SplitRoute struct {
+	DestinationAccountID string          `json:"destinationAccountID"`
+	Amount               decimal.Decimal `json:"amount"`
+	Currency             string          `json:"currency"`
+	ReferenceID          string          `json:"referenceID"`
+}
+
+type LinkDirectDebitParams struct {
+	CustomerPaymentID string `json:"customerPaymentID"`
+	ReferenceID       string `json:"referenceID"`
+	Description       string `json:"description"`
+	Country           string `json:"country"`
+	ChannelCode       string `json:"channelCode"`
+	SuccessReturnURL  string `json:"successReturnURL"`
+	FailureReturnURL  string `json:"failureReturnURL"`
+	// the account details below are the ones the channel asks for, e.g. BRI asks for all of them.
+	MobileNumber string `json:"mobileNumber"`
+	CardLastFour string `json:"cardLastFour"`
+	CardExpiry   string `json:"cardExpiry"`
+	Email        string `json:"email"`
+}
+
+type ValidateDirectDebitLinkParams struct {
+	PaymentMethodID string `json:"paymentMethodID"`
+	OTPCode         string `json:"otpCode"`
+}
+
+type CapturePaymentParams struct {
+	PaymentRequestID string          `json:"paymentRequestID"`
+	ReferenceID      string          `json:"referenceID"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+}
+
+type VoidPaymentParams struct {
+	PaymentMethodID  string `json:"paymentMethodID"`
+	PaymentRequestID string `json:"paymentRequestID"`
+}
+
+type CreateRefundParams struct {
+	PaymentMethodID  string          `json:"paymentMethodID"`
+	PaymentRequestID string          `json:"paymentRequestID"`
+	ReferenceID      string          `json:"referenceID"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+	Reason           string          `json:"reason"`
+}
+
+type CreateInvoiceParams struct {
+	CustomerName      string          `json:"customerName"`
+	CustomerPaymentID string          `json:"customerPaymentID"`
+	CustomerNumber    string          `json:"customerNumber"`
+	Description       string          `json:"description"`
+	ReferenceID       string          `json:"referenceID"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Expiry            time.Time       `json:"expiry"`
+	// AllowedChannels are the channel codes the customer can choose from on the checkout page.
+	AllowedChannels  []string `json:"allowedChannels"`
+	SuccessReturnURL string   `json:"successReturnURL"`
+	FailureReturnURL string   `json:"failureReturnURL"`
+}
+
+// Customer is the gateway side representation of a customer.
+type Customer struct {
+	ID          string `json:"id"`
+	ReferenceID string `json:"referenceID"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// Payment is the gateway side representation of a payment method / payment request.
+// Type, Status and Reusability carry the values defined in internal/pkg/payment.
+// A charge of a linked payment method has the payment request id as ID, since the linked
+// payment method is shared by every charge, and the linked one as LinkedPaymentMethodID.
+type Payment struct {
+	ID                    string          `json:"id"`
+	RequestID             string          `json:"requestID"`
+	ReferenceID           string          `json:"referenceID"`
+	BusinessID            string          `json:"businessID"`
+	CustomerID            string          `json:"customerID"`
+	Type                  string          `json:"type"`
+	Status                string          `json:"status"`
+	Reusability           string          `json:"reusability"`
+	Channel               string          `json:"channel"`
+	Amount                decimal.Decimal `json:"amount"`
+	Currency              string          `json:"currency"`
+	CaptureMethod         string          `json:"captureMethod"`
+	LinkedPaymentMethodID string          `json:"linkedPaymentMethodID"`
+	QrCode                string          `json:"qrCode"`
+	VirtualAccountNumber  string          `json:"virtualAccountNumber"`
+	PaymentCode           string          `json:"paymentCode"`
+	URL                   string          `json:"url"`
+	Description           string          `json:"description"`
+	FailureCode           string          `json:"failureCode"`
+	CreatedAt             time.Time       `json:"createdAt"`
+	UpdatedAt             time.Time       `json:"updatedAt"`
+	ExpiresAt             time.Time       `json:"expiresAt"`
+}
+
+// Capture is the gateway side representation of a capture, Status carries the
+// CAPTURE_STATUS_* values defined in internal/pkg/payment.
+type Capture struct {
+	ID               string          `json:"id"`
+	PaymentRequestID string          `json:"paymentRequestID"`
+	Status           string          `json:"status"`
+	AuthorizedAmount decimal.Decimal `json:"authorizedAmount"`
+	CapturedAmount   decimal.Decimal `json:"capturedAmount"`
+	Currency         string          `json:"currency"`
+	FailureCode      string          `json:"failureCode"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
+}
+
+// Refund is the gateway side representation of a refund, Status carries the
+// REFUND_STATUS_* values defined in internal/pkg/payment.
+type Refund struct {
+	ID               string          `json:"id"`
+	PaymentRequestID string          `json:"paymentRequestID"`
+	ReferenceID      string          `json:"referenceID"`
+	Status           string          `json:"status"`
+	Amount           decimal.Decimal `json:"amount"`
+	FailureCode      string          `json:"failureCode"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
+}
+
+// SplitRule is the gateway side representation of a split rule.
+type SplitRule struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Routes    []*SplitRoute `json:"routes"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+type GetTransactionReportParams struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Currency string    `json:"currency"`
+}
+
+// TransactionReport is a report of the transactions the gateway processed in a period.
+type TransactionReport struct {
+	ID   string                  `json:"id"`
+	Rows []*TransactionReportRow `json:"rows"`
+}
+
+// TransactionReportRow is a transaction as the gateway reported it, Status carries the
+// STATUS_* values defined in internal/pkg/payment.
+type TransactionReportRow struct {
+	PaymentMethodID  string          `json:"paymentMethodID"`
+	PaymentRequestID string          `json:"paymentRequestID"`
+	ReferenceID      string          `json:"referenceID"`
+	Status           string          `json:"status"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+	CreatedAt        time.Time       `json:"createdAt"`
+}
+
+// Invoice is the gateway side representation of a hosted checkout page, Status carries the
+// INVOICE_STATUS_* values defined in internal/pkg/payment.
+type Invoice struct {
+	ID              string          `json:"id"`
+	ReferenceID     string          `json:"referenceID"`
+	BusinessID      string          `json:"businessID"`
+	CustomerID      string          `json:"customerID"`
+	Status          string          `json:"status"`
+	Amount          decimal.Decimal `json:"amount"`
+	Currency        string          `json:"currency"`
+	AllowedChannels []string        `json:"allowedChannels"`
+	URL             string          `json:"url"`
+	Description     string          `json:"description"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+	ExpiresAt       time.Time       `json:"expiresAt"`
+}
@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/xendit/xendit-go/v5"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+)
+
+var (
+	testProvider PaymentProvider
+	cfg          *config.App
+	tlog         logger.Logger
+	xenditClient *xendit.APIClient
+)
+
+func TestMain(m *testing.M) {
+	logger := logger.NewLogger()
+	cm := config.NewManager(logger, 15*time.Second)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Println("Error getting current directory:", err)
+		return
+	}
+
+	cfgs, err := cm.Bootstrap(fmt.Sprintf("%v/%s", findModuleRoot(cwd), "etcd-config.yaml"))
+	if err != nil {
+		logger.Debug(err)
+		return
+	}
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	cfg = cfgs
+
+	tlog = logger
+
+	xenditClient = xendit.NewClient(cfg.Services.Internal.PaymentGatewayKeys.Development)
+
+	testProvider = NewXenditProviderImpl(logger, cfg, xenditClient)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,27 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	id := helper.RandomString(12)
+	provider := NewFakeProvider()
+
+	registry := NewRegistry()
+	registry.Register(id, provider)
+
+	res, err := registry.Get(id)
+	require.NoError(t, err)
+	require.Equal(t, res, provider)
+
+	res, err = registry.Get(helper.RandomString(13))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, unierror.ErrPaymentProviderNotRegistered))
+	require.Empty(t, res)
+}
@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+)
+
+var errReportColumnsMissing = errors.New("report has no amount, status or payment id column")
+
+// transactionReportColumns are the header names a column of a transaction report is recognised by,
+// headers are compared in lower case with spaces and dashes as underscores.
+var transactionReportColumns = map[string][]string{
+	"payment_method_id":  {"payment_method_id", "product_id"},
+	"payment_request_id": {"payment_request_id"},
+	"reference_id":       {"reference_id", "reference", "payment_reference_id"},
+	"status":             {"status", "payment_status"},
+	"amount":             {"amount", "payment_amount"},
+	"currency":           {"currency"},
+	"created_at":         {"created_at", "created", "created_date", "transaction_date"},
+}
+
+var transactionReportTimeLayouts = []string{time.RFC3339, constants.TZ, time.DateTime, time.DateOnly}
+
+// ParseTransactionReport reads the rows of a CSV transaction report, the one the gateway generates
+// as well as a file dropped by hand as long as its header names the columns.
+func ParseTransactionReport(r io.Reader) ([]*TransactionReportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reader.Read.header.err: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(name)))
+		for column, aliases := range transactionReportColumns {
+			if _, ok := columns[column]; ok {
+				continue
+			}
+
+			for _, alias := range aliases {
+				if name == alias {
+					columns[column] = i
+				}
+			}
+		}
+	}
+
+	_, hasAmount := columns["amount"]
+	_, hasStatus := columns["status"]
+	_, hasMethodID := columns["payment_method_id"]
+	_, hasRequestID := columns["payment_request_id"]
+	_, hasReferenceID := columns["reference_id"]
+	if !hasAmount || !hasStatus || (!hasMethodID && !hasRequestID && !hasReferenceID) {
+		return nil, errReportColumnsMissing
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	var res []*TransactionReportRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reader.Read.err: line %d: %v", line, err)
+		}
+
+		amount, err := decimal.NewFromString(strings.ReplaceAll(value(record, "amount"), ",", ""))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount: %v", line, err)
+		}
+
+		row := &TransactionReportRow{
+			PaymentMethodID:  value(record, "payment_method_id"),
+			PaymentRequestID: value(record, "payment_request_id"),
+			ReferenceID:      value(record, "reference_id"),
+			Status:           transactionReportStatus(value(record, "status")),
+			Amount:           amount,
+			Currency:         strings.ToUpper(value(record, "currency")),
+		}
+
+		if row.PaymentMethodID == "" && row.PaymentRequestID == "" && row.ReferenceID == "" {
+			return nil, fmt.Errorf("line %d: row has no payment id", line)
+		}
+
+		if createdAt := value(record, "created_at"); createdAt != "" {
+			for _, layout := range transactionReportTimeLayouts {
+				if t, err := time.Parse(layout, createdAt); err == nil {
+					row.CreatedAt = t
+					break
+				}
+			}
+		}
+
+		res = append(res, row)
+	}
+}
+
+// transactionReportStatus maps the status of a report row onto the status of a payment, a report
+// names a paid transaction after its settlement rather than after the payment.
+func transactionReportStatus(status string) string {
+	switch status = strings.ToUpper(status); status {
+	case "SUCCESS", "COMPLETED", "PAID", "SETTLED", "SETTLING":
+		return payment.STATUS_SUCCEEDED
+	default:
+		return status
+	}
+}
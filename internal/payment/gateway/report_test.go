@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransactionReport(t *testing.T) {
+	testCases := []struct {
+		tname         string
+		report        string
+		checkResponse func(t *testing.T, res []*TransactionReportRow, err error)
+	}{
+		{
+			tname: "OK",
+			report: "Product ID,Payment Request ID,Reference,Status,Amount,Currency,Created Date\n" +
+				"pm-1,pr-1,ref-1,SETTLED,\"10,000.50\",idr,2026-01-01T10:00:00Z\n" +
+				"pm-2,,ref-2,FAILED,2500,IDR,2026-01-02\n",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+
+				require.Equal(t, "pm-1", res[0].PaymentMethodID)
+				require.Equal(t, "pr-1", res[0].PaymentRequestID)
+				require.Equal(t, "ref-1", res[0].ReferenceID)
+				require.Equal(t, payment.STATUS_SUCCEEDED, res[0].Status)
+				require.True(t, decimal.RequireFromString("10000.50").Equal(res[0].Amount))
+				require.Equal(t, payment.CURRENCY_IDR, res[0].Currency)
+				require.True(t, time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).Equal(res[0].CreatedAt))
+
+				require.Empty(t, res[1].PaymentRequestID)
+				require.Equal(t, payment.STATUS_FAILED, res[1].Status)
+				require.True(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC).Equal(res[1].CreatedAt))
+			},
+		},
+		{
+			tname:  "OK_UNDATED",
+			report: "reference_id,payment_status,payment_amount\nref-1,PAID,100\n",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.Equal(t, payment.STATUS_SUCCEEDED, res[0].Status)
+				require.True(t, res[0].CreatedAt.IsZero())
+			},
+		},
+		{
+			tname:  "ERR_COLUMNS_MISSING",
+			report: "reference_id,amount\nref-1,100\n",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.ErrorIs(t, err, errReportColumnsMissing)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:  "ERR_INVALID_AMOUNT",
+			report: "reference_id,status,amount\nref-1,PAID,abc\n",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:  "ERR_ROW_WITHOUT_ID",
+			report: "reference_id,status,amount\n,PAID,100\n",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:  "ERR_EMPTY",
+			report: "",
+			checkResponse: func(t *testing.T, res []*TransactionReportRow, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			res, err := ParseTransactionReport(strings.NewReader(tc.report))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
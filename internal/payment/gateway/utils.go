@@ -0,0 +1,38 @@
+package gateway
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+func findModuleRoot(dir string) string {
+	for {
+		_, err := os.Stat(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return ""
+}
+
+func errorResponse(
+	span opentracing.Span,
+	err error,
+	fullError error,
+	returnedFormat string,
+	loggedFormat string,
+) error {
+	tracing.TraceWithError(span, fmt.Errorf("%s: %v", loggedFormat, fullError))
+	return fmt.Errorf("%s: %v", returnedFormat, err)
+}
@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/shopspring/decimal"
+	"github.com/xendit/xendit-go/v5"
+	"github.com/xendit/xendit-go/v5/payment_method"
+)
+
+type XenditProviderImpl struct {
+	log          logger.Logger
+	cfg          *config.App
+	xenditClient *xendit.APIClient
+}
+
+func NewXenditProviderImpl(log logger.Logger, cfg *config.App, xenditClient *xendit.APIClient) *XenditProviderImpl {
+	return &XenditProviderImpl{
+		log:          log.WithPrefix(fmt.Sprintf("%s-%s", "payment-gateway-provider", constants.Repository)),
+		cfg:          cfg,
+		xenditClient: newXenPlatformClient(xenditClient),
+	}
+}
+
+func xenditPaymentMethodToGateway(resp *payment_method.PaymentMethod) *Payment {
+	res := &Payment{
+		ID:          resp.Id,
+		ReferenceID: resp.GetReferenceId(),
+		BusinessID:  resp.GetBusinessId(),
+		CustomerID:  resp.GetCustomerId(),
+		Type:        resp.GetType().String(),
+		Status:      resp.GetStatus().String(),
+		Reusability: resp.GetReusability().String(),
+		Description: resp.GetDescription(),
+		FailureCode: resp.GetFailureCode(),
+		CreatedAt:   resp.GetCreated(),
+		UpdatedAt:   resp.GetUpdated(),
+	}
+
+	if qrCode, ok := resp.GetQrCodeOk(); ok && qrCode != nil {
+		if channelCode := qrCode.ChannelCode.Get(); channelCode != nil {
+			res.Channel = channelCode.String()
+		}
+		if amount := qrCode.Amount.Get(); amount != nil {
+			res.Amount = decimal.NewFromFloat(*amount)
+		}
+		res.Currency = qrCode.GetCurrency()
+		if channelProperties := qrCode.ChannelProperties.Get(); channelProperties != nil {
+			res.QrCode = channelProperties.GetQrString()
+			if expiresAt := channelProperties.ExpiresAt; expiresAt != nil {
+				res.ExpiresAt = *expiresAt
+			}
+		}
+	}
+
+	if directDebit, ok := resp.GetDirectDebitOk(); ok && directDebit != nil {
+		res.Channel = directDebit.ChannelCode.String()
+	}
+
+	if virtualAccount, ok := resp.GetVirtualAccountOk(); ok && virtualAccount != nil {
+		res.Channel = virtualAccount.ChannelCode.String()
+		if amount := virtualAccount.Amount.Get(); amount != nil {
+			res.Amount = decimal.NewFromFloat(*amount)
+		}
+		res.Currency = virtualAccount.GetCurrency()
+		res.VirtualAccountNumber = virtualAccount.ChannelProperties.GetVirtualAccountNumber()
+		if expiresAt := virtualAccount.ChannelProperties.ExpiresAt; expiresAt != nil {
+			res.ExpiresAt = *expiresAt
+		}
+	}
+
+	if overTheCounter, ok := resp.GetOverTheCounterOk(); ok && overTheCounter != nil {
+		res.Channel = overTheCounter.ChannelCode.String()
+		if amount := overTheCounter.Amount.Get(); amount != nil {
+			res.Amount = decimal.NewFromFloat(*amount)
+		}
+		res.Currency = overTheCounter.GetCurrency()
+		res.PaymentCode = overTheCounter.ChannelProperties.GetPaymentCode()
+		if expiresAt := overTheCounter.ChannelProperties.ExpiresAt; expiresAt != nil {
+			res.ExpiresAt = *expiresAt
+		}
+	}
+
+	return res
+}
+
+func xenditReusability(reusability string) payment_method.PaymentMethodReusability {
+	if reusability == payment.USAGE_TYPE_MULTIPLE_USE {
+		return payment_method.PAYMENTMETHODREUSABILITY_MULTIPLE_USE
+	}
+
+	return payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE
+}
+
+const xenditBaseURL = "https://api.xendit.co"
+
+// callAPI sends a request for an endpoint the sdk has no call for, it goes through the client to keep
+// its authentication and the headers of the sub-account the context is for.
+func (p *XenditProviderImpl) callAPI(ctx context.Context, method string, path string, body any, out any) error {
+	baseURL, err := p.xenditClient.GetConfig().ServerURLWithContext(ctx, "")
+	if err != nil || baseURL == "" {
+		baseURL = xenditBaseURL
+	}
+
+	req, err := p.xenditClient.PrepareRequest(
+		ctx,
+		baseURL+path,
+		method,
+		body,
+		map[string]string{"Content-Type": "application/json", "Accept": "application/json"},
+		url.Values{},
+		url.Values{},
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("p.xenditClient.PrepareRequest.err: %v", err)
+	}
+
+	resp, err := p.xenditClient.CallAPI(req)
+	if err != nil {
+		return fmt.Errorf("p.xenditClient.CallAPI.err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("io.ReadAll.err: %v", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: %s", resp.Status, string(respBody))
+	}
+
+	if err := serializer.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("serializer.Unmarshal.err: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+	"github.com/xendit/xendit-go/v5/payment_request"
+)
+
+func (p *XenditProviderImpl) CreateCardPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateCardPayment")
+	defer span.Finish()
+
+	ctx = withPaymentHeaders(ctx, arg)
+
+	currency, err := payment_request.NewPaymentRequestCurrencyFromValue(arg.Currency)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedCurrency, arg.Currency))
+	}
+
+	captureMethod, err := payment_request.NewPaymentRequestCaptureMethodFromValue(arg.CaptureMethod)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedCaptureMethod, arg.CaptureMethod))
+	}
+
+	// the card token is a payment method the client created through the gateway tokenization,
+	// the card details never reach this service.
+	paymentRequestParameters := *payment_request.NewPaymentRequestParameters(*currency)
+	paymentRequestParameters.CustomerId = *payment_request.NewNullableString(&arg.CustomerPaymentID)
+	paymentRequestParameters.PaymentMethodId = &arg.CardToken
+	paymentRequestParameters.Description = *payment_request.NewNullableString(&arg.Description)
+	paymentRequestParameters.ReferenceId = &arg.ReferenceID
+	paymentRequestParameters.SetCaptureMethod(*captureMethod)
+	paymentRequestParameters.SetChannelProperties(payment_request.PaymentRequestParametersChannelProperties{
+		SuccessReturnUrl: &arg.SuccessReturnURL,
+		FailureReturnUrl: &arg.FailureReturnURL,
+	})
+	amount := arg.Amount.InexactFloat64()
+	paymentRequestParameters.Amount = &amount
+
+	idempotencyKey, err := paymentRequestIdempotencyKey(arg)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	resp, _, errs := p.xenditClient.PaymentRequestApi.CreatePaymentRequest(ctx).
+		IdempotencyKey(idempotencyKey).
+		PaymentRequestParameters(paymentRequestParameters).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create card payment request",
+			"p.xenditClient.PaymentRequestApi.CreatePaymentRequest.err",
+		)
+	}
+
+	res, err := xenditCardPaymentRequestToGateway(resp, arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	if res.Status == payment.STATUS_REQUIRES_ACTION {
+		action, err := findUrlType(resp.GetActions(), "WEB")
+		if err != nil {
+			return nil, tracing.TraceWithError(span, err)
+		}
+
+		res.URL = action.GetUrl()
+	}
+	res.ExpiresAt = arg.Expiry
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) GetCardPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetCardPaymentRequestByID")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentRequestApi.GetPaymentRequestByID(ctx, arg).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(err.Error()),
+			errors.New(string(fullErr)),
+			"unable to get card payment request id",
+			"p.xenditClient.PaymentRequestApi.GetPaymentRequestByID.err",
+		)
+	}
+
+	res, errs := xenditCardPaymentRequestToGateway(resp, "")
+	if errs != nil {
+		return nil, tracing.TraceWithError(span, errs)
+	}
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) CapturePayment(ctx context.Context, arg *CapturePaymentParams) (*Capture, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CapturePayment")
+	defer span.Finish()
+
+	captureParameters := *payment_request.NewCaptureParameters(arg.Amount.InexactFloat64())
+	captureParameters.ReferenceId = *payment_request.NewNullableString(&arg.ReferenceID)
+
+	resp, _, err := p.xenditClient.PaymentRequestApi.CapturePaymentRequest(ctx, arg.PaymentRequestID).
+		CaptureParameters(captureParameters).
+		Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(err.Error()),
+			errors.New(string(fullErr)),
+			"unable to capture payment request",
+			"p.xenditClient.PaymentRequestApi.CapturePaymentRequest.err",
+		)
+	}
+
+	res := &Capture{
+		ID:               resp.GetId(),
+		PaymentRequestID: resp.GetPaymentRequestId(),
+		Status:           resp.GetStatus(),
+		AuthorizedAmount: decimal.NewFromFloat(resp.GetAuthorizedAmount()),
+		CapturedAmount:   decimal.NewFromFloat(resp.GetCapturedAmount()),
+		Currency:         resp.GetCurrency(),
+		FailureCode:      resp.GetFailureCode(),
+	}
+
+	if created, err := time.Parse(constants.TZ, resp.GetCreated()); err == nil {
+		res.CreatedAt = created
+	}
+
+	if updated, err := time.Parse(constants.TZ, resp.GetUpdated()); err == nil {
+		res.UpdatedAt = updated
+	}
+
+	return res, nil
+}
+
+// VoidPayment expires the card payment method, the SDK has no reversal endpoint for payment requests
+// so the authorization can no longer be captured and lapses at the issuer.
+func (p *XenditProviderImpl) VoidPayment(ctx context.Context, arg *VoidPaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.VoidPayment")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentMethodApi.ExpirePaymentMethod(ctx, arg.PaymentMethodID).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			err,
+			errors.New(string(fullErr)),
+			"unable to void card payment method",
+			"p.xenditClient.PaymentMethodApi.ExpirePaymentMethod.err",
+		)
+	}
+
+	res := xenditPaymentMethodToGateway(resp)
+	res.RequestID = arg.PaymentRequestID
+	res.Status = payment.STATUS_VOIDED
+
+	return res, nil
+}
+
+// xenditCardPaymentRequestToGateway reports the payment request status, which is the one
+// moving through REQUIRES_ACTION and AWAITING_CAPTURE for cards.
+func xenditCardPaymentRequestToGateway(resp *payment_request.PaymentRequest, channelCode string) (*Payment, error) {
+	res, err := xenditPaymentRequestToGateway(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Status = string(resp.Status)
+	res.CaptureMethod = string(resp.GetCaptureMethod())
+	if channelCode != "" {
+		res.Channel = channelCode
+	}
+
+	return res, nil
+}
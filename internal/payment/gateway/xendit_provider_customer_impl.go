@@ -1,4 +1,4 @@
-package repository
+package gateway
 
 import (
 	"context"
@@ -12,16 +12,8 @@ import (
 	"github.com/xendit/xendit-go/v5/customer"
 )
 
-type CreateCustomerPaymentParams struct {
-	CustomerName   string `json:"customerName"`
-	CustomerNumber string `json:"customerNumber"`
-	CustomerUID    string `json:"customerUID"`
-	CustomerEmail  string `json:"customerEmail"`
-	ReferenceID    string `json:"referenceID"`
-}
-
-func (p *PaymentProviderImpl) CreateCustomerPayment(ctx context.Context, arg *CreateCustomerPaymentParams) (*customer.Customer, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.CreateCustomerPayment")
+func (p *XenditProviderImpl) CreateCustomerPayment(ctx context.Context, arg *CreateCustomerPaymentParams) (*Customer, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateCustomerPayment")
 	defer span.Finish()
 	number := "+" + arg.CustomerNumber
 
@@ -54,11 +46,11 @@ func (p *PaymentProviderImpl) CreateCustomerPayment(ctx context.Context, arg *Cr
 		)
 	}
 
-	return resp, nil
+	return xenditCustomerToGateway(resp), nil
 }
 
-func (p *PaymentProviderImpl) GetCustomerPaymentByID(ctx context.Context, arg string) (*customer.Customer, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.GetCustomerPaymentByID")
+func (p *XenditProviderImpl) GetCustomerPaymentByID(ctx context.Context, arg string) (*Customer, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetCustomerPaymentByID")
 	defer span.Finish()
 
 	resp, _, err := p.xenditClient.CustomerApi.GetCustomer(ctx, arg).Execute()
@@ -73,5 +65,20 @@ func (p *PaymentProviderImpl) GetCustomerPaymentByID(ctx context.Context, arg st
 		)
 	}
 
-	return resp, nil
+	return xenditCustomerToGateway(resp), nil
+}
+
+func xenditCustomerToGateway(resp *customer.Customer) *Customer {
+	res := &Customer{
+		ID:          resp.Id,
+		ReferenceID: resp.GetReferenceId(),
+		Email:       resp.GetEmail(),
+		PhoneNumber: resp.GetPhoneNumber(),
+	}
+
+	if individualDetail := resp.GetIndividualDetail(); individualDetail.GivenNames != nil {
+		res.Name = *individualDetail.GivenNames
+	}
+
+	return res
 }
@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoCreateCustomerPayment(t *testing.T) {
+	createRandomPaymentProviderCustomer(t)
+}
+
+func TestRepoGetCustomerPaymentByID(t *testing.T) {
+	customer := createRandomPaymentProviderCustomer(t)
+
+	res, err := testProvider.GetCustomerPaymentByID(context.TODO(), customer.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.Equal(t, customer.ID, res.ID)
+	require.Equal(t, customer.Name, res.Name)
+	require.Equal(t, customer.PhoneNumber, res.PhoneNumber)
+}
+
+func createRandomPaymentProviderCustomer(t *testing.T) *Customer {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	referenceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, referenceID)
+
+	arg := CreateCustomerPaymentParams{
+		CustomerName:   helper.RandomString(28),
+		CustomerNumber: helper.RandomStringInt(12),
+		CustomerUID:    ulid.String(),
+		ReferenceID:    referenceID.String(),
+	}
+
+	res, err := testProvider.CreateCustomerPayment(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.NotEmpty(t, res.ID)
+	require.Equal(t, arg.CustomerName, res.Name)
+	require.Equal(t, arg.ReferenceID, res.ReferenceID)
+	require.NotEmpty(t, res.PhoneNumber)
+
+	return res
+}
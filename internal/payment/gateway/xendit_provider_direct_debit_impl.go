@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/xendit/xendit-go/v5/payment_method"
+	"github.com/xendit/xendit-go/v5/payment_request"
+)
+
+func (p *XenditProviderImpl) LinkDirectDebit(ctx context.Context, arg *LinkDirectDebitParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.LinkDirectDebit")
+	defer span.Finish()
+
+	channelCode, err := payment_method.NewDirectDebitChannelCodeFromValue(arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode))
+	}
+
+	paymentMethodParameters := *payment_method.NewPaymentMethodParameters(
+		payment_method.PaymentMethodType(payment_method.PAYMENTMETHODTYPE_DIRECT_DEBIT),
+		payment_method.PAYMENTMETHODREUSABILITY_MULTIPLE_USE,
+	)
+	paymentMethodParameters.CustomerId = *payment_method.NewNullableString(&arg.CustomerPaymentID)
+	paymentMethodParameters.Country = *payment_method.NewNullableString(&arg.Country)
+	paymentMethodParameters.Description = *payment_method.NewNullableString(&arg.Description)
+	paymentMethodParameters.ReferenceId = &arg.ReferenceID
+
+	channelProperties := payment_method.NewDirectDebitChannelProperties()
+	channelProperties.SuccessReturnUrl = &arg.SuccessReturnURL
+	channelProperties.FailureReturnUrl = *payment_method.NewNullableString(&arg.FailureReturnURL)
+	channelProperties.MobileNumber = nullableString(arg.MobileNumber)
+	channelProperties.CardLastFour = nullableString(arg.CardLastFour)
+	channelProperties.CardExpiry = nullableString(arg.CardExpiry)
+	channelProperties.Email = nullableString(arg.Email)
+
+	paymentMethodParameters.SetDirectDebit(*payment_method.NewDirectDebitParameters(
+		*channelCode,
+		*payment_method.NewNullableDirectDebitChannelProperties(channelProperties),
+	))
+
+	resp, _, errs := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
+		PaymentMethodParameters(paymentMethodParameters).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to link direct debit",
+			"p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
+		)
+	}
+
+	res := xenditPaymentMethodToGateway(resp)
+
+	// banks which do not send an OTP ask the customer to authorize the link on their own page.
+	for _, action := range resp.GetActions() {
+		if action.GetUrlType() == "WEB" {
+			res.URL = action.GetUrl()
+			break
+		}
+	}
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) ValidateDirectDebitLink(ctx context.Context, arg *ValidateDirectDebitLinkParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.ValidateDirectDebitLink")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentMethodApi.AuthPaymentMethod(ctx, arg.PaymentMethodID).
+		PaymentMethodAuthParameters(*payment_method.NewPaymentMethodAuthParameters(arg.OTPCode)).
+		Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			err,
+			errors.New(string(fullErr)),
+			"unable to validate direct debit link",
+			"p.xenditClient.PaymentMethodApi.AuthPaymentMethod.err",
+		)
+	}
+
+	return xenditPaymentMethodToGateway(resp), nil
+}
+
+func (p *XenditProviderImpl) CreateDirectDebitPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateDirectDebitPayment")
+	defer span.Finish()
+
+	ctx = withPaymentHeaders(ctx, arg)
+
+	currency, err := payment_request.NewPaymentRequestCurrencyFromValue(arg.Currency)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedCurrency, arg.Currency))
+	}
+
+	paymentRequestParameters := *payment_request.NewPaymentRequestParameters(*currency)
+	paymentRequestParameters.CustomerId = *payment_request.NewNullableString(&arg.CustomerPaymentID)
+	paymentRequestParameters.PaymentMethodId = &arg.LinkedPaymentMethodID
+	paymentRequestParameters.Description = *payment_request.NewNullableString(&arg.Description)
+	paymentRequestParameters.ReferenceId = &arg.ReferenceID
+	amount := arg.Amount.InexactFloat64()
+	paymentRequestParameters.Amount = &amount
+
+	idempotencyKey, err := paymentRequestIdempotencyKey(arg)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	resp, _, errs := p.xenditClient.PaymentRequestApi.CreatePaymentRequest(ctx).
+		IdempotencyKey(idempotencyKey).
+		PaymentRequestParameters(paymentRequestParameters).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create direct debit payment request",
+			"p.xenditClient.PaymentRequestApi.CreatePaymentRequest.err",
+		)
+	}
+
+	res, err := xenditDirectDebitPaymentRequestToGateway(resp)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) GetDirectDebitPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetDirectDebitPaymentRequestByID")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentRequestApi.GetPaymentRequestByID(ctx, arg).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(err.Error()),
+			errors.New(string(fullErr)),
+			"unable to get direct debit payment request id",
+			"p.xenditClient.PaymentRequestApi.GetPaymentRequestByID.err",
+		)
+	}
+
+	res, errs := xenditDirectDebitPaymentRequestToGateway(resp)
+	if errs != nil {
+		return nil, tracing.TraceWithError(span, errs)
+	}
+
+	return res, nil
+}
+
+// xenditDirectDebitPaymentRequestToGateway keys the charge by its payment request, every charge
+// of a linked account shares the same payment method.
+func xenditDirectDebitPaymentRequestToGateway(resp *payment_request.PaymentRequest) (*Payment, error) {
+	res, err := xenditPaymentRequestToGateway(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	res.LinkedPaymentMethodID = res.ID
+	res.ID = resp.Id
+	res.Status = string(resp.Status)
+	res.Reusability = payment.USAGE_TYPE_ONE_TIME_USE
+
+	if directDebit := resp.PaymentMethod.DirectDebit.Get(); directDebit != nil {
+		res.Channel = string(directDebit.GetChannelCode())
+	}
+
+	return res, nil
+}
+
+func nullableString(value string) payment_method.NullableString {
+	if value == "" {
+		return *payment_method.NewNullableString(nil)
+	}
+
+	return *payment_method.NewNullableString(&value)
+}
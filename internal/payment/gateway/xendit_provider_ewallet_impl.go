@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/shopspring/decimal"
+	"github.com/xendit/xendit-go/v5/payment_method"
+	"github.com/xendit/xendit-go/v5/payment_request"
+)
+
+func (p *XenditProviderImpl) CreateEwalletPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateEwalletPayment")
+	defer span.Finish()
+
+	ctx = withPaymentHeaders(ctx, arg)
+
+	channelCode, err := payment_method.NewEWalletChannelCodeFromValue(arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode))
+	}
+
+	currency, err := payment_request.NewPaymentRequestCurrencyFromValue(arg.Currency)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedCurrency, arg.Currency))
+	}
+
+	paymentMethod, err := p.createEwalletMethod(ctx, span, *channelCode, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentRequestParameters := *payment_request.NewPaymentRequestParameters(*currency)
+	paymentRequestParameters.CustomerId = *payment_request.NewNullableString(&arg.CustomerPaymentID)
+	paymentRequestParameters.PaymentMethodId = &paymentMethod.Id
+	paymentRequestParameters.Description = *payment_request.NewNullableString(&arg.Description)
+	paymentRequestParameters.ReferenceId = &arg.ReferenceID
+	amount := arg.Amount.InexactFloat64()
+	paymentRequestParameters.Amount = &amount
+
+	idempotencyKey, err := paymentRequestIdempotencyKey(arg)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	resp, _, errs := p.xenditClient.PaymentRequestApi.CreatePaymentRequest(ctx).
+		IdempotencyKey(idempotencyKey).
+		PaymentRequestParameters(paymentRequestParameters).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create payment request",
+			"p.xenditClient.PaymentRequestApi.CreatePaymentRequest.err",
+		)
+	}
+
+	res, err := xenditPaymentRequestToGateway(resp)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	res.URL, err = getURl(res.Channel, resp.GetActions())
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+	res.ExpiresAt = arg.Expiry
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) GetEwalletPaymentRequestByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetEwalletPaymentRequestByID")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentRequestApi.GetPaymentRequestByID(ctx, arg).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(err.Error()),
+			errors.New(string(fullErr)),
+			"unable to get payment request id",
+			"p.xenditClient.PaymentRequestApi.GetPaymentRequestByID.err",
+		)
+	}
+
+	res, errs := xenditPaymentRequestToGateway(resp)
+	if errs != nil {
+		return nil, tracing.TraceWithError(span, errs)
+	}
+
+	// the payment request status is the one telling whether the customer has paid,
+	// the payment method status only tells whether the method is still usable.
+	res.Status = string(resp.Status)
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) createEwalletMethod(
+	ctx context.Context,
+	span opentracing.Span,
+	channelCode payment_method.EWalletChannelCode,
+	arg *CreatePaymentParams,
+) (*payment_method.PaymentMethod, error) {
+	paymentMethodParameters := *payment_method.NewPaymentMethodParameters(
+		payment_method.PaymentMethodType(payment_method.PAYMENTMETHODTYPE_EWALLET),
+		payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE,
+	)
+	paymentMethodParameters.CustomerId = *payment_method.NewNullableString(&arg.CustomerPaymentID)
+	paymentMethodParameters.Country = *payment_method.NewNullableString(&arg.Country)
+	paymentMethodParameters.ReferenceId = &arg.ReferenceID
+
+	eWalletArg := *payment_method.NewEWalletParameters(channelCode)
+	eWalletArg.Account = payment_method.NewEWalletAccountWithDefaults()
+	eWalletArg.Account.Name = *payment_method.NewNullableString(&arg.CustomerName)
+
+	eWalletArg.ChannelProperties = &payment_method.EWalletChannelProperties{
+		SuccessReturnUrl: &arg.SuccessReturnURL,
+		FailureReturnUrl: &arg.FailureReturnURL,
+	}
+	eWalletArg.ChannelProperties.MobileNumber = &arg.CustomerNumber
+
+	paymentMethodParameters.SetEwallet(eWalletArg)
+
+	resp, _, err := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
+		PaymentMethodParameters(paymentMethodParameters).
+		Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(err.Error()),
+			errors.New(string(fullErr)),
+			"unable to create payment method",
+			"p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
+		)
+	}
+	span.LogFields(log.Object("create_payment_resp", resp))
+
+	return resp, nil
+}
+
+func xenditPaymentRequestToGateway(resp *payment_request.PaymentRequest) (*Payment, error) {
+	paymentCreated, err := time.Parse(constants.TZ, resp.GetCreated())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Payment{
+		ID:          resp.PaymentMethod.Id,
+		RequestID:   resp.Id,
+		ReferenceID: resp.PaymentMethod.GetReferenceId(),
+		BusinessID:  resp.GetBusinessId(),
+		CustomerID:  resp.GetCustomerId(),
+		Type:        resp.PaymentMethod.Type.String(),
+		Status:      resp.PaymentMethod.Status.String(),
+		Reusability: resp.PaymentMethod.Reusability.String(),
+		Amount:      decimal.NewFromFloat(resp.GetAmount()),
+		Currency:    string(resp.GetCurrency()),
+		Description: resp.GetDescription(),
+		FailureCode: resp.GetFailureCode(),
+		CreatedAt:   paymentCreated,
+	}
+
+	if ewallet := resp.PaymentMethod.Ewallet.Get(); ewallet != nil {
+		res.Channel = string(ewallet.GetChannelCode())
+	}
+
+	if updated, err := time.Parse(constants.TZ, resp.GetUpdated()); err == nil {
+		res.UpdatedAt = updated
+	}
+
+	return res, nil
+}
+
+func getURl(channelType string, actions []payment_request.PaymentRequestAction) (string, error) {
+	switch channelType {
+	case string(payment_method.EWALLETCHANNELCODE_SHOPEEPAY):
+		action, err := findUrlType(actions, "DEEPLINK")
+		if err != nil {
+			return "", err
+		}
+
+		return action.GetUrl(), nil
+	case string(payment_method.EWALLETCHANNELCODE_OVO):
+		return "", nil
+	default:
+		action, err := findUrlType(actions, "MOBILE")
+		if err != nil {
+			return "", err
+		}
+
+		return action.GetUrl(), nil
+	}
+}
+
+func findUrlType(actions []payment_request.PaymentRequestAction, urlType string) (*payment_request.PaymentRequestAction, error) {
+	for _, action := range actions {
+		if action.UrlType == urlType {
+			return &action, nil
+		}
+	}
+	return nil, errors.New("no type found in ewallet action response")
+}
@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/xendit/xendit-go/v5/payment_method"
+)
+
+func TestRepoCreatePaymentEwalletOVO(t *testing.T) {
+	createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_OVO)
+}
+
+func TestRepoCreatePaymentEwalletAstraPay(t *testing.T) {
+	createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_ASTRAPAY)
+}
+
+func TestRepoCreatePaymentEwalletLinkAja(t *testing.T) {
+	createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_LINKAJA)
+}
+
+func TestRepoCreatePaymentEwalletShopeePay(t *testing.T) {
+	createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_SHOPEEPAY)
+}
+
+func TestRepoCreatePaymentEwalletDANA(t *testing.T) {
+	createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_DANA)
+}
+
+func TestRepoGetPaymentEwalletByRequestID(t *testing.T) {
+	ewallet := createRandomPaymentEwallet(t, payment_method.EWALLETCHANNELCODE_DANA)
+
+	res, err := testProvider.GetEwalletPaymentRequestByID(context.TODO(), ewallet.RequestID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.Equal(t, res.ID, ewallet.ID)
+	require.Equal(t, res.RequestID, ewallet.RequestID)
+	require.Equal(t, res.Channel, ewallet.Channel)
+	require.True(t, res.Amount.Equal(ewallet.Amount))
+}
+
+func TestRepoCreatePaymentEwalletUnsupportedChannel(t *testing.T) {
+	customer := createRandomPaymentProviderCustomer(t)
+
+	arg := CreatePaymentParams{
+		CustomerName:      customer.Name,
+		CustomerPaymentID: customer.ID,
+		CustomerNumber:    customer.PhoneNumber,
+		Amount:            decimal.NewFromInt(helper.RandomInt(100000, 200000)),
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       helper.RandomString(8),
+	}
+
+	res, err := testProvider.CreateEwalletPayment(context.TODO(), &arg)
+	require.Error(t, err)
+	require.Empty(t, res)
+}
+
+func createRandomPaymentEwallet(t *testing.T, channelCode payment_method.EWalletChannelCode) *Payment {
+	customer := createRandomPaymentProviderCustomer(t)
+
+	referenceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, referenceID)
+
+	arg := CreatePaymentParams{
+		CustomerName:      customer.Name,
+		CustomerPaymentID: customer.ID,
+		CustomerNumber:    customer.PhoneNumber,
+		Description:       helper.RandomString(100),
+		ReferenceID:       referenceID.String(),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100000, 200000)),
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       string(channelCode),
+		SuccessReturnURL:  helper.RandomUrl(),
+		FailureReturnURL:  helper.RandomUrl(),
+	}
+
+	res, err := testProvider.CreateEwalletPayment(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.NotEmpty(t, res.ID)
+	require.NotEmpty(t, res.RequestID)
+
+	if channelCode != payment_method.EWALLETCHANNELCODE_OVO {
+		require.NotEmpty(t, res.URL)
+	}
+
+	amount, _ := res.Amount.Float64()
+	require.Equal(t, res.Channel, arg.ChannelCode)
+	require.Equal(t, res.ReferenceID, referenceID.String())
+	require.Equal(t, amount, arg.Amount)
+	require.Equal(t, res.CustomerID, customer.ID)
+	require.Equal(t, res.Reusability, payment.USAGE_TYPE_ONE_TIME_USE)
+	require.Equal(t, res.Type, payment.METHODE_TYPE_EWALLET)
+	require.Equal(t, res.ExpiresAt, arg.Expiry)
+
+	return res
+}
@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (p *XenditProviderImpl) ExpirePayment(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.ExpirePayment")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentMethodApi.ExpirePaymentMethod(ctx, arg).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			err,
+			errors.New(string(fullErr)),
+			"unable to expire payment method",
+			"p.xenditClient.PaymentMethodApi.ExpirePaymentMethod.err",
+		)
+	}
+
+	return xenditPaymentMethodToGateway(resp), nil
+}
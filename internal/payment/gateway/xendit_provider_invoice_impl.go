@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+	"github.com/xendit/xendit-go/v5/invoice"
+)
+
+// xenditInvoiceChannels holds the channels whose invoice payment method differs from their channel code.
+var xenditInvoiceChannels = map[string]string{
+	"CARDS": "CREDIT_CARD",
+}
+
+func (p *XenditProviderImpl) CreateInvoice(ctx context.Context, arg *CreateInvoiceParams) (*Invoice, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateInvoice")
+	defer span.Finish()
+
+	currency, err := invoice.NewInvoiceCurrencyFromValue(arg.Currency)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedCurrency, arg.Currency))
+	}
+
+	createInvoiceRequest := *invoice.NewCreateInvoiceRequest(arg.ReferenceID, arg.Amount.InexactFloat64())
+	createInvoiceRequest.Description = &arg.Description
+	currencyCode := string(*currency)
+	createInvoiceRequest.Currency = &currencyCode
+	invoiceDuration := strconv.FormatInt(int64(time.Until(arg.Expiry).Seconds()), 10)
+	createInvoiceRequest.InvoiceDuration = &invoiceDuration
+	createInvoiceRequest.SuccessRedirectUrl = &arg.SuccessReturnURL
+	createInvoiceRequest.FailureRedirectUrl = &arg.FailureReturnURL
+
+	createInvoiceRequest.PaymentMethods = make([]string, 0, len(arg.AllowedChannels))
+	for _, channel := range arg.AllowedChannels {
+		createInvoiceRequest.PaymentMethods = append(createInvoiceRequest.PaymentMethods, xenditInvoiceChannel(channel))
+	}
+
+	customer := *invoice.NewCustomerObject()
+	customer.Id = *invoice.NewNullableString(&arg.CustomerPaymentID)
+	customer.GivenNames = *invoice.NewNullableString(&arg.CustomerName)
+	phoneNumber := "+" + arg.CustomerNumber
+	customer.PhoneNumber = *invoice.NewNullableString(&phoneNumber)
+	createInvoiceRequest.Customer = &customer
+
+	resp, _, errs := p.xenditClient.InvoiceApi.CreateInvoice(ctx).
+		CreateInvoiceRequest(createInvoiceRequest).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create invoice",
+			"p.xenditClient.InvoiceApi.CreateInvoice.err",
+		)
+	}
+
+	res := xenditInvoiceToGateway(resp)
+	res.CustomerID = arg.CustomerPaymentID
+	res.AllowedChannels = arg.AllowedChannels
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) GetInvoiceByID(ctx context.Context, arg string) (*Invoice, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetInvoiceByID")
+	defer span.Finish()
+
+	resp, _, errs := p.xenditClient.InvoiceApi.GetInvoiceById(ctx, arg).Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to get invoice by id",
+			"p.xenditClient.InvoiceApi.GetInvoiceById.err",
+		)
+	}
+
+	return xenditInvoiceToGateway(resp), nil
+}
+
+func xenditInvoiceToGateway(resp *invoice.Invoice) *Invoice {
+	res := &Invoice{
+		ID:          resp.GetId(),
+		ReferenceID: resp.GetExternalId(),
+		BusinessID:  resp.GetUserId(),
+		Status:      resp.GetStatus().String(),
+		Amount:      decimal.NewFromFloat(resp.GetAmount()),
+		URL:         resp.GetInvoiceUrl(),
+		Description: resp.GetDescription(),
+		CreatedAt:   resp.GetCreated(),
+		UpdatedAt:   resp.GetUpdated(),
+		ExpiresAt:   resp.GetExpiryDate(),
+	}
+
+	if currency, ok := resp.GetCurrencyOk(); ok && currency != nil {
+		res.Currency = currency.String()
+	}
+
+	for _, bank := range resp.GetAvailableBanks() {
+		res.AllowedChannels = append(res.AllowedChannels, bank.BankCode.String())
+	}
+
+	for _, ewallet := range resp.GetAvailableEwallets() {
+		res.AllowedChannels = append(res.AllowedChannels, ewallet.EwalletType.String())
+	}
+
+	for _, qrCode := range resp.GetAvailableQrCodes() {
+		res.AllowedChannels = append(res.AllowedChannels, qrCode.QrCodeType.String())
+	}
+
+	for _, retailOutlet := range resp.GetAvailableRetailOutlets() {
+		res.AllowedChannels = append(res.AllowedChannels, retailOutlet.RetailOutletName.String())
+	}
+
+	return res
+}
+
+func xenditInvoiceChannel(channel string) string {
+	if code, ok := xenditInvoiceChannels[channel]; ok {
+		return code
+	}
+
+	return channel
+}
+
+// XenditInvoicePaymentChannel maps the payment channel of an invoice callback back to its channel code.
+func XenditInvoicePaymentChannel(code string) string {
+	for channel, invoiceCode := range xenditInvoiceChannels {
+		if invoiceCode == code {
+			return channel
+		}
+	}
+
+	return code
+}
@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/xendit/xendit-go/v5/payment_method"
+)
+
+func (p *XenditProviderImpl) CreateOverTheCounterPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateOverTheCounterPayment")
+	defer span.Finish()
+
+	ctx = withPaymentHeaders(ctx, arg)
+
+	channelCode, err := payment_method.NewOverTheCounterChannelCodeFromValue(arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode))
+	}
+
+	paymentMethodParameters := *payment_method.NewPaymentMethodParameters(
+		payment_method.PaymentMethodType(payment_method.PAYMENTMETHODTYPE_OVER_THE_COUNTER),
+		payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE,
+	)
+	paymentMethodParameters.CustomerId = *payment_method.NewNullableString(&arg.CustomerPaymentID)
+	paymentMethodParameters.Country = *payment_method.NewNullableString(&arg.Country)
+	paymentMethodParameters.Description = *payment_method.NewNullableString(&arg.Description)
+	paymentMethodParameters.ReferenceId = &arg.ReferenceID
+
+	method := payment_method.OverTheCounterParameters{
+		ChannelCode:       *channelCode,
+		ChannelProperties: *payment_method.NewOverTheCounterChannelPropertiesWithDefaults(),
+	}
+	method.ChannelProperties.CustomerName = arg.CustomerName
+	method.ChannelProperties.ExpiresAt = &arg.Expiry
+	amount := arg.Amount.InexactFloat64()
+	method.Amount = *payment_method.NewNullableFloat64(&amount)
+	method.Currency = &arg.Currency
+
+	paymentMethodParameters.SetOverTheCounter(method)
+
+	resp, _, errs := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
+		PaymentMethodParameters(paymentMethodParameters).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create payment",
+			"p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
+		)
+	}
+
+	res := xenditPaymentMethodToGateway(resp)
+	if res.Currency == "" {
+		res.Currency = arg.Currency
+	}
+
+	return res, nil
+}
+
+func (p *XenditProviderImpl) GetOverTheCounterPaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetOverTheCounterPaymentByID")
+	defer span.Finish()
+
+	resp, _, err := p.xenditClient.PaymentMethodApi.GetPaymentMethodByID(ctx, arg).Execute()
+	if err != nil {
+		fullErr, _ := serializer.Marshal(err.FullError())
+		return nil, errorResponse(
+			span,
+			err,
+			errors.New(string(fullErr)),
+			"unable to get payment method",
+			"p.xenditClient.PaymentMethodApi.GetPaymentMethodByID",
+		)
+	}
+
+	return xenditPaymentMethodToGateway(resp), nil
+}
@@ -1,68 +1,72 @@
-package repository
+package gateway
 
 import (
 	"context"
 	"errors"
-	"time"
+	"fmt"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
 	"github.com/opentracing/opentracing-go"
 	"github.com/xendit/xendit-go/v5/payment_method"
 )
 
-type CreateQrCodePaymentParams struct {
-	CustomerName      string    `json:"customerName"`
-	CustomerPaymentID string    `json:"customerPaymentID"`
-	CustomerNumber    string    `json:"customerNumber"`
-	Description       string    `json:"description"`
-	ReferenceID       string    `json:"referenceID"`
-	Amount            float64   `json:"amount"`
-	Expiry            time.Time `json:"expiry"`
-	ChannelCode       payment_method.QRCodeChannelCode
-	SuccessReturnURL  string `json:"successReturnURL"`
-	FailureReturnURL  string `json:"failureReturnURL"`
-}
-
-func (p *PaymentProviderImpl) CreateQrCodePayment(ctx context.Context, arg *CreateQrCodePaymentParams) (*payment_method.PaymentMethod, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.CreateQrcodePayment")
+func (p *XenditProviderImpl) CreateQrCodePayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateQrcodePayment")
 	defer span.Finish()
 
+	ctx = withPaymentHeaders(ctx, arg)
+
+	channelCode, err := payment_method.NewQRCodeChannelCodeFromValue(arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode))
+	}
+
 	paymentMethodParameters := *payment_method.NewPaymentMethodParameters(
 		payment_method.PaymentMethodType(payment_method.PAYMENTMETHODTYPE_QR_CODE),
-		payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE,
+		xenditReusability(arg.Reusability),
 	)
 	paymentMethodParameters.CustomerId = *payment_method.NewNullableString(&arg.CustomerPaymentID)
-	paymentMethodParameters.Country = *payment_method.NewNullableString(&ID_COUNTRY)
+	paymentMethodParameters.Country = *payment_method.NewNullableString(&arg.Country)
 	paymentMethodParameters.Description = *payment_method.NewNullableString(&arg.Description)
 	paymentMethodParameters.ReferenceId = &arg.ReferenceID
 
 	method := payment_method.QRCodeParameters{
-		ChannelCode:       *payment_method.NewNullableQRCodeChannelCode(&arg.ChannelCode),
+		ChannelCode:       *payment_method.NewNullableQRCodeChannelCode(channelCode),
 		ChannelProperties: *payment_method.NewNullableQRCodeChannelProperties(payment_method.NewQRCodeChannelProperties()),
 	}
-	method.Amount = *payment_method.NewNullableFloat64(&arg.Amount)
+	amount := arg.Amount.InexactFloat64()
+	method.Amount = *payment_method.NewNullableFloat64(&amount)
+	method.Currency = &arg.Currency
 
 	paymentMethodParameters.SetQrCode(method)
 
-	resp, _, err := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
+	resp, _, errs := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
 		PaymentMethodParameters(paymentMethodParameters).
 		Execute()
-	if err != nil {
-		fullErr, _ := serializer.Marshal(err.FullError())
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
 		return nil, errorResponse(
 			span,
-			errors.New(err.Error()),
+			errors.New(errs.Error()),
 			errors.New(string(fullErr)),
 			"unable to create payment method",
-			" p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
+			"p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
 		)
 	}
 
-	return resp, nil
+	res := xenditPaymentMethodToGateway(resp)
+	res.ExpiresAt = arg.Expiry
+	if res.Currency == "" {
+		res.Currency = arg.Currency
+	}
+
+	return res, nil
 }
 
-func (p *PaymentProviderImpl) GetQrCodePaymentByID(ctx context.Context, arg string) (*payment_method.PaymentMethod, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.GetQrcodePaymentMethodByID")
+func (p *XenditProviderImpl) GetQrCodePaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetQrcodePaymentMethodByID")
 	defer span.Finish()
 
 	resp, _, err := p.xenditClient.PaymentMethodApi.GetPaymentMethodByID(ctx, arg).Execute()
@@ -77,5 +81,5 @@ func (p *PaymentProviderImpl) GetQrCodePaymentByID(ctx context.Context, arg stri
 		)
 	}
 
-	return resp, nil
+	return xenditPaymentMethodToGateway(resp), nil
 }
@@ -0,0 +1,69 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/xendit/xendit-go/v5/payment_method"
+)
+
+func TestRepoCreatePaymentQrcodeQRIS(t *testing.T) {
+	createRandomPaymentQrCode(t, payment_method.QRCODECHANNELCODE_QRIS)
+}
+
+func TestRepoGetPaymentQrCodeByID(t *testing.T) {
+	qrcode := createRandomPaymentQrCode(t, payment_method.QRCODECHANNELCODE_QRIS)
+
+	res, err := testProvider.GetQrCodePaymentByID(context.TODO(), qrcode.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.NotEmpty(t, res.ID)
+	require.Equal(t, res.Channel, qrcode.Channel)
+	require.True(t, res.Amount.Equal(qrcode.Amount))
+	require.Equal(t, res.CustomerID, qrcode.CustomerID)
+	require.Equal(t, res.Reusability, payment.USAGE_TYPE_ONE_TIME_USE)
+	require.Equal(t, res.Type, payment.METHODE_TYPE_QR_CODE)
+}
+
+func createRandomPaymentQrCode(t *testing.T, channelCode payment_method.QRCodeChannelCode) *Payment {
+	customer := createRandomPaymentProviderCustomer(t)
+
+	referenceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, referenceID)
+
+	arg := CreatePaymentParams{
+		CustomerName:      customer.Name,
+		CustomerPaymentID: customer.ID,
+		CustomerNumber:    customer.PhoneNumber,
+		Description:       helper.RandomString(100),
+		ReferenceID:       referenceID.String(),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100000, 200000)),
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       string(channelCode),
+		SuccessReturnURL:  helper.RandomUrl(),
+		FailureReturnURL:  helper.RandomUrl(),
+	}
+
+	res, err := testProvider.CreateQrCodePayment(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	amount, _ := res.Amount.Float64()
+	require.NotEmpty(t, res.ID)
+	require.NotEmpty(t, res.QrCode)
+	require.Equal(t, res.Channel, arg.ChannelCode)
+	require.Equal(t, amount, arg.Amount)
+	require.Equal(t, res.CustomerID, customer.ID)
+	require.Equal(t, res.Reusability, payment.USAGE_TYPE_ONE_TIME_USE)
+	require.Equal(t, res.Type, payment.METHODE_TYPE_QR_CODE)
+	require.Equal(t, res.ReferenceID, arg.ReferenceID)
+
+	return res
+}
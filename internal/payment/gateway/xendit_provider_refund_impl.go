@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+	"github.com/xendit/xendit-go/v5/refund"
+)
+
+func (p *XenditProviderImpl) CreateRefund(ctx context.Context, arg *CreateRefundParams) (*Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateRefund")
+	defer span.Finish()
+
+	// xendit refunds are issued against a payment request, payments without one can not be refunded through the api.
+	if arg.PaymentRequestID == "" {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s has no payment request", unierror.ErrPaymentNotRefundable, arg.PaymentMethodID))
+	}
+
+	createRefund := *refund.NewCreateRefund()
+	createRefund.PaymentRequestId = &arg.PaymentRequestID
+	createRefund.ReferenceId = &arg.ReferenceID
+	amount := arg.Amount.InexactFloat64()
+	createRefund.Amount = &amount
+	createRefund.Currency = &arg.Currency
+	createRefund.Reason = &arg.Reason
+
+	resp, _, errs := p.xenditClient.RefundApi.CreateRefund(ctx).
+		IdempotencyKey(fmt.Sprintf("refund-%s", arg.ReferenceID)).
+		CreateRefund(createRefund).
+		Execute()
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
+		return nil, errorResponse(
+			span,
+			errors.New(errs.Error()),
+			errors.New(string(fullErr)),
+			"unable to create refund",
+			"p.xenditClient.RefundApi.CreateRefund.err",
+		)
+	}
+
+	return xenditRefundToGateway(resp), nil
+}
+
+// xenditRefundToGateway maps the refund response, the sdk model does not expose the refund status
+// so a freshly created refund is reported as pending until the refund callback settles it.
+func xenditRefundToGateway(resp *refund.Refund) *Refund {
+	res := &Refund{
+		ID:               resp.GetId(),
+		PaymentRequestID: resp.GetPaymentRequestId(),
+		ReferenceID:      resp.GetReferenceId(),
+		Status:           payment.REFUND_STATUS_PENDING,
+		Amount:           decimal.NewFromFloat(resp.GetAmount()),
+		FailureCode:      resp.GetFailureCode(),
+	}
+
+	if created, err := time.Parse(constants.TZ, resp.GetCreated()); err == nil {
+		res.CreatedAt = created
+	}
+
+	if updated, err := time.Parse(constants.TZ, resp.GetUpdated()); err == nil {
+		res.UpdatedAt = updated
+	}
+
+	return res
+}
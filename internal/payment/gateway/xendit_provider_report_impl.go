@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	xenditReportStatusCompleted = "COMPLETED"
+	xenditReportStatusFailed    = "FAILED"
+	// xenditReportPollInterval is how often a report being generated is checked on.
+	xenditReportPollInterval = 5 * time.Second
+)
+
+type xenditReportFilter struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type xenditReportRequest struct {
+	Type     string              `json:"type"`
+	Filter   *xenditReportFilter `json:"filter"`
+	Format   string              `json:"format"`
+	Currency string              `json:"currency,omitempty"`
+}
+
+type xenditReport struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	URL    string `json:"url"`
+}
+
+// GetTransactionReport asks the report api for a CSV of the transactions of the period, waits until it
+// is generated and downloads it. The download url is signed so it is fetched without the client.
+func (p *XenditProviderImpl) GetTransactionReport(ctx context.Context, arg *GetTransactionReportParams) (*TransactionReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetTransactionReport")
+	defer span.Finish()
+
+	var report xenditReport
+	err := p.callAPI(ctx, http.MethodPost, "/reports", &xenditReportRequest{
+		Type: "TRANSACTIONS",
+		Filter: &xenditReportFilter{
+			From: arg.From.UTC().Format(constants.TZ),
+			To:   arg.To.UTC().Format(constants.TZ),
+		},
+		Format:   "CSV",
+		Currency: arg.Currency,
+	}, &report)
+	if err != nil {
+		return nil, errorResponse(span, err, err, "unable to generate transaction report", "p.callAPI.create.err")
+	}
+
+	ticker := time.NewTicker(xenditReportPollInterval)
+	defer ticker.Stop()
+
+	for report.Status != xenditReportStatusCompleted {
+		if report.Status == xenditReportStatusFailed {
+			err := fmt.Errorf("report %s failed", report.ID)
+			return nil, errorResponse(span, err, err, "unable to generate transaction report", "report.status")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errorResponse(span, ctx.Err(), ctx.Err(), "unable to generate transaction report", "ctx.Done")
+		case <-ticker.C:
+		}
+
+		if err := p.callAPI(ctx, http.MethodGet, "/reports/"+report.ID, nil, &report); err != nil {
+			return nil, errorResponse(span, err, err, "unable to get transaction report", "p.callAPI.get.err")
+		}
+	}
+
+	if report.URL == "" {
+		err := fmt.Errorf("report %s has no url", report.ID)
+		return nil, errorResponse(span, err, err, "unable to download transaction report", "report.url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, report.URL, nil)
+	if err != nil {
+		return nil, errorResponse(span, err, err, "unable to download transaction report", "http.NewRequestWithContext.err")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errorResponse(span, err, err, "unable to download transaction report", "http.DefaultClient.Do.err")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := errors.New(resp.Status)
+		return nil, errorResponse(span, err, err, "unable to download transaction report", "resp.status")
+	}
+
+	rows, err := ParseTransactionReport(resp.Body)
+	if err != nil {
+		return nil, errorResponse(span, err, err, "unable to read transaction report", "ParseTransactionReport.err")
+	}
+
+	return &TransactionReport{ID: report.ID, Rows: rows}, nil
+}
@@ -0,0 +1,76 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+type xenditSplitRoute struct {
+	FlatAmount           float64 `json:"flat_amount"`
+	Currency             string  `json:"currency"`
+	DestinationAccountID string  `json:"destination_account_id"`
+	ReferenceID          string  `json:"reference_id"`
+}
+
+type xenditSplitRule struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Routes      []*xenditSplitRoute `json:"routes"`
+	Created     string              `json:"created,omitempty"`
+}
+
+// CreateSplitRule creates the split rule on the platform account, the payment sdk has no call for it.
+func (p *XenditProviderImpl) CreateSplitRule(ctx context.Context, arg *CreateSplitRuleParams) (*SplitRule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateSplitRule")
+	defer span.Finish()
+
+	body := &xenditSplitRule{
+		Name:        arg.Name,
+		Description: arg.Description,
+		Routes:      make([]*xenditSplitRoute, 0, len(arg.Routes)),
+	}
+	for _, route := range arg.Routes {
+		body.Routes = append(body.Routes, &xenditSplitRoute{
+			FlatAmount:           route.Amount.InexactFloat64(),
+			Currency:             route.Currency,
+			DestinationAccountID: route.DestinationAccountID,
+			ReferenceID:          route.ReferenceID,
+		})
+	}
+
+	var data xenditSplitRule
+	if err := p.callAPI(ctx, http.MethodPost, "/split_rules", body, &data); err != nil {
+		return nil, errorResponse(span, err, err, "unable to create split rule", "p.callAPI.err")
+	}
+
+	return xenditSplitRuleToGateway(&data), nil
+}
+
+func xenditSplitRuleToGateway(resp *xenditSplitRule) *SplitRule {
+	res := &SplitRule{
+		ID:     resp.ID,
+		Name:   resp.Name,
+		Routes: make([]*SplitRoute, 0, len(resp.Routes)),
+	}
+
+	for _, route := range resp.Routes {
+		res.Routes = append(res.Routes, &SplitRoute{
+			DestinationAccountID: route.DestinationAccountID,
+			Amount:               decimal.NewFromFloat(route.FlatAmount),
+			Currency:             route.Currency,
+			ReferenceID:          route.ReferenceID,
+		})
+	}
+
+	if created, err := time.Parse(constants.TZ, resp.Created); err == nil {
+		res.CreatedAt = created
+	}
+
+	return res
+}
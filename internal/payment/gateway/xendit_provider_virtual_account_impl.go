@@ -1,69 +1,73 @@
-package repository
+package gateway
 
 import (
 	"context"
 	"errors"
-	"time"
+	"fmt"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/serializer"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
 	"github.com/opentracing/opentracing-go"
 	"github.com/xendit/xendit-go/v5/payment_method"
 )
 
-type CreateVirtualAccountBankPaymentParams struct {
-	CustomerName      string    `json:"customerName"`
-	CustomerPaymentID string    `json:"customerPaymentID"`
-	CustomerNumber    string    `json:"customerNumber"`
-	Description       string    `json:"description"`
-	ReferenceID       string    `json:"referenceID"`
-	Amount            float64   `json:"amount"`
-	Expiry            time.Time `json:"expiry"`
-	ChannelCode       payment_method.VirtualAccountChannelCode
-	SuccessReturnURL  string `json:"successReturnURL"`
-	FailureReturnURL  string `json:"failureReturnURL"`
-}
-
-func (p *PaymentProviderImpl) CreateVirtualAccountBankPayment(ctx context.Context, arg *CreateVirtualAccountBankPaymentParams) (*payment_method.PaymentMethod, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.CreateVirtualAccountBankPayment")
+func (p *XenditProviderImpl) CreateVirtualAccountBankPayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.CreateVirtualAccountBankPayment")
 	defer span.Finish()
 
+	ctx = withPaymentHeaders(ctx, arg)
+
+	channelCode, err := payment_method.NewVirtualAccountChannelCodeFromValue(arg.ChannelCode)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, arg.ChannelCode))
+	}
+
 	paymentMethodParameters := *payment_method.NewPaymentMethodParameters(
 		payment_method.PaymentMethodType(payment_method.PAYMENTMETHODTYPE_VIRTUAL_ACCOUNT),
-		payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE,
+		xenditReusability(arg.Reusability),
 	)
 	paymentMethodParameters.CustomerId = *payment_method.NewNullableString(&arg.CustomerPaymentID)
+	paymentMethodParameters.Country = *payment_method.NewNullableString(&arg.Country)
 	paymentMethodParameters.Description = *payment_method.NewNullableString(&arg.Description)
 	paymentMethodParameters.ReferenceId = &arg.ReferenceID
 
 	method := payment_method.VirtualAccountParameters{
-		ChannelCode:       arg.ChannelCode,
+		ChannelCode:       *channelCode,
 		ChannelProperties: *payment_method.NewVirtualAccountChannelPropertiesWithDefaults(),
 	}
 	method.ChannelProperties.CustomerName = &arg.CustomerName
 	method.ChannelProperties.ExpiresAt = &arg.Expiry
-	method.Amount = *payment_method.NewNullableFloat64(&arg.Amount)
+	amount := arg.Amount.InexactFloat64()
+	method.Amount = *payment_method.NewNullableFloat64(&amount)
+	method.Currency = &arg.Currency
 
 	paymentMethodParameters.SetVirtualAccount(method)
 
-	resp, _, err := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
+	resp, _, errs := p.xenditClient.PaymentMethodApi.CreatePaymentMethod(ctx).
 		PaymentMethodParameters(paymentMethodParameters).
 		Execute()
-	if err != nil {
-		fullErr, _ := serializer.Marshal(err.FullError())
+	if errs != nil {
+		fullErr, _ := serializer.Marshal(errs.FullError())
 		return nil, errorResponse(
 			span,
-			errors.New(err.Error()),
+			errors.New(errs.Error()),
 			errors.New(string(fullErr)),
 			"unable to create payment",
 			"p.xenditClient.PaymentMethodApi.CreatePaymentMethod.err",
 		)
 	}
 
-	return resp, nil
+	res := xenditPaymentMethodToGateway(resp)
+	if res.Currency == "" {
+		res.Currency = arg.Currency
+	}
+
+	return res, nil
 }
 
-func (p *PaymentProviderImpl) GetVirtualAccountBankPaymentByID(ctx context.Context, arg string) (*payment_method.PaymentMethod, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PaymentProviderImpl.CreateVirtualAccountBankPayment")
+func (p *XenditProviderImpl) GetVirtualAccountBankPaymentByID(ctx context.Context, arg string) (*Payment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "XenditProviderImpl.GetVirtualAccountBankPaymentByID")
 	defer span.Finish()
 
 	resp, _, err := p.xenditClient.PaymentMethodApi.GetPaymentMethodByID(ctx, arg).Execute()
@@ -78,5 +82,5 @@ func (p *PaymentProviderImpl) GetVirtualAccountBankPaymentByID(ctx context.Conte
 		)
 	}
 
-	return resp, nil
+	return xenditPaymentMethodToGateway(resp), nil
 }
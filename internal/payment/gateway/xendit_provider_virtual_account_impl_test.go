@@ -1,4 +1,4 @@
-package repository
+package gateway
 
 import (
 	"context"
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/xendit/xendit-go/v5/payment_method"
 )
@@ -38,37 +40,51 @@ func TestRepoCreatePaymentVritualAccountBankPERMATA(t *testing.T) {
 	createRandomPaymentVirtualAccountBank(t, payment_method.VIRTUALACCOUNTCHANNELCODE_PERMATA)
 }
 
-func createRandomPaymentVirtualAccountBank(t *testing.T, channelCode payment_method.VirtualAccountChannelCode) *payment_method.PaymentMethod {
+func TestRepoGetPaymentVirtualAccountBankByID(t *testing.T) {
+	va := createRandomPaymentVirtualAccountBank(t, payment_method.VIRTUALACCOUNTCHANNELCODE_BNI)
+
+	res, err := testProvider.GetVirtualAccountBankPaymentByID(context.TODO(), va.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.Equal(t, res.ID, va.ID)
+	require.Equal(t, res.VirtualAccountNumber, va.VirtualAccountNumber)
+	require.Equal(t, res.Channel, va.Channel)
+}
+
+func createRandomPaymentVirtualAccountBank(t *testing.T, channelCode payment_method.VirtualAccountChannelCode) *Payment {
 	customer := createRandomPaymentProviderCustomer(t)
 
 	referenceID, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, referenceID)
 
-	arg := CreateVirtualAccountBankPaymentParams{
-		CustomerName:      *customer.GetIndividualDetail().GivenNames,
-		CustomerPaymentID: customer.Id,
-		CustomerNumber:    customer.GetMobileNumber(),
+	arg := CreatePaymentParams{
+		CustomerName:      customer.Name,
+		CustomerPaymentID: customer.ID,
+		CustomerNumber:    customer.PhoneNumber,
 		Description:       helper.RandomString(100),
 		ReferenceID:       referenceID.String(),
-		Amount:            float64(helper.RandomInt(100000, 200000)),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100000, 200000)),
 		Expiry:            time.Now().Add(24 * 3 * time.Hour),
-		ChannelCode:       channelCode,
+		ChannelCode:       string(channelCode),
 		SuccessReturnURL:  helper.RandomUrl(),
 		FailureReturnURL:  helper.RandomUrl(),
 	}
 
-	res, err := testStore.CreateVirtualAccountBankPayment(context.TODO(), &arg)
+	res, err := testProvider.CreateVirtualAccountBankPayment(context.TODO(), &arg)
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
 
-	require.NotEmpty(t, res.Id)
-	require.Equal(t, res.GetVirtualAccount().ChannelCode.String(), string(channelCode))
-	require.Equal(t, res.GetVirtualAccount().Amount.Get(), &arg.Amount)
-	require.Equal(t, res.GetCustomerId(), customer.Id)
-	require.Equal(t, res.GetReferenceId(), arg.ReferenceID)
-	require.Equal(t, string(res.GetReusability()), string(payment_method.PAYMENTMETHODREUSABILITY_ONE_TIME_USE))
-	require.Equal(t, string(res.GetType()), string(payment_method.PAYMENTMETHODTYPE_VIRTUAL_ACCOUNT))
+	amount, _ := res.Amount.Float64()
+	require.NotEmpty(t, res.ID)
+	require.NotEmpty(t, res.VirtualAccountNumber)
+	require.Equal(t, res.Channel, arg.ChannelCode)
+	require.Equal(t, amount, arg.Amount)
+	require.Equal(t, res.CustomerID, customer.ID)
+	require.Equal(t, res.ReferenceID, arg.ReferenceID)
+	require.Equal(t, res.Reusability, payment.USAGE_TYPE_ONE_TIME_USE)
+	require.Equal(t, res.Type, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
 
 	return res
 }
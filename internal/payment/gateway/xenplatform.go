@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/xendit/xendit-go/v5"
+	"github.com/xendit/xendit-go/v5/common"
+	"github.com/xendit/xendit-go/v5/customer"
+	"github.com/xendit/xendit-go/v5/invoice"
+	"github.com/xendit/xendit-go/v5/payment_method"
+	"github.com/xendit/xendit-go/v5/payment_request"
+	"github.com/xendit/xendit-go/v5/refund"
+)
+
+const (
+	xenPlatformForUserIDHeader   = "for-user-id"
+	xenPlatformSplitRuleIDHeader = "with-split-rule"
+	idempotencyKeyHeader         = "idempotency-key"
+)
+
+type xenPlatformKey struct{}
+
+type xenPlatformHeaders struct {
+	forUserID      string
+	splitRuleID    string
+	idempotencyKey string
+}
+
+// ForUser makes the gateway calls made with the returned context act on behalf of the marketplace
+// sub-account, a payment collected for a sub-account can only be expired, captured or refunded through it.
+// An empty forUserID acts on the platform account.
+func ForUser(ctx context.Context, forUserID string) context.Context {
+	headers, _ := ctx.Value(xenPlatformKey{}).(xenPlatformHeaders)
+	headers.forUserID = forUserID
+
+	return context.WithValue(ctx, xenPlatformKey{}, headers)
+}
+
+func withSplitRule(ctx context.Context, splitRuleID string) context.Context {
+	headers, _ := ctx.Value(xenPlatformKey{}).(xenPlatformHeaders)
+	headers.splitRuleID = splitRuleID
+
+	return context.WithValue(ctx, xenPlatformKey{}, headers)
+}
+
+func withIdempotencyKey(ctx context.Context, idempotencyKey string) context.Context {
+	headers, _ := ctx.Value(xenPlatformKey{}).(xenPlatformHeaders)
+	headers.idempotencyKey = idempotencyKey
+
+	return context.WithValue(ctx, xenPlatformKey{}, headers)
+}
+
+// withPaymentHeaders carries the sub-account, split rule and idempotency key of a payment over to the
+// calls creating it.
+func withPaymentHeaders(ctx context.Context, arg *CreatePaymentParams) context.Context {
+	ctx = withSplitRule(ForUser(ctx, arg.ForUserID), arg.SplitRuleID)
+	if arg.IdempotencyKey == "" {
+		return withIdempotencyKey(ctx, "")
+	}
+
+	return withIdempotencyKey(ctx, fmt.Sprintf("pm-%s", arg.IdempotencyKey))
+}
+
+// paymentRequestIdempotencyKey is the idempotency key a payment request is created with, the one of the
+// create payment request when it has one so that a retry can never have the gateway charge twice.
+func paymentRequestIdempotencyKey(arg *CreatePaymentParams) (string, error) {
+	if arg.IdempotencyKey != "" {
+		return fmt.Sprintf("pr-%s", arg.IdempotencyKey), nil
+	}
+
+	requestKey, err := helper.GenerateULID()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("pr-%s", requestKey.String()), nil
+}
+
+// xenPlatformClient adds the xenPlatform headers of the request context to every request,
+// the sdk only takes for-user-id on some calls and has no with-split-rule at all.
+type xenPlatformClient struct {
+	*xendit.APIClient
+}
+
+func (c *xenPlatformClient) PrepareRequest(
+	ctx context.Context,
+	path string, method string,
+	postBody interface{},
+	headerParams map[string]string,
+	queryParams url.Values,
+	formParams url.Values,
+	formFiles []common.FormFile,
+) (*http.Request, error) {
+	if headers, ok := ctx.Value(xenPlatformKey{}).(xenPlatformHeaders); ok {
+		if headers.forUserID != "" {
+			headerParams[xenPlatformForUserIDHeader] = headers.forUserID
+		}
+
+		if headers.splitRuleID != "" {
+			headerParams[xenPlatformSplitRuleIDHeader] = headers.splitRuleID
+		}
+
+		// the sdk only takes an idempotency key on some calls, the one it was given is kept.
+		if _, ok := headerParams[idempotencyKeyHeader]; !ok && headers.idempotencyKey != "" {
+			headerParams[idempotencyKeyHeader] = headers.idempotencyKey
+		}
+	}
+
+	return c.APIClient.PrepareRequest(ctx, path, method, postBody, headerParams, queryParams, formParams, formFiles)
+}
+
+// newXenPlatformClient returns a copy of the client whose payment apis send the xenPlatform headers,
+// the client itself is left untouched.
+func newXenPlatformClient(client *xendit.APIClient) *xendit.APIClient {
+	res := *client
+	platformClient := &xenPlatformClient{APIClient: client}
+
+	res.CustomerApi = customer.NewCustomerApi(platformClient)
+	res.PaymentMethodApi = payment_method.NewPaymentMethodApi(platformClient)
+	res.PaymentRequestApi = payment_request.NewPaymentRequestApi(platformClient)
+	res.RefundApi = refund.NewRefundApi(platformClient)
+	res.InvoiceApi = invoice.NewInvoiceApi(platformClient)
+
+	return &res
+}
@@ -1,8 +1,11 @@
 package mapper
 
 import (
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,6 +33,8 @@ func PaymentChannelToDto(paymentChannel *repository.PaymentChannel) *pb.PaymentC
 		IsTaxPercentage:    paymentChannel.IsTaxPercentage,
 		IsActive:           paymentChannel.IsActive,
 		IsAvailable:        paymentChannel.IsAvailable,
+		Currency:           paymentChannel.Currency,
+		Country:            paymentChannel.Country,
 	}
 }
 
@@ -42,8 +47,21 @@ func PaymentChannelsToDto(args []*repository.PaymentChannel) []*pb.PaymentChanne
 	return list
 }
 
+func PaymentPriceToDto(arg *models.PaymentPrice) *pb.PaymentPrice {
+	return &pb.PaymentPrice{
+		BaseAmount:  arg.BaseAmount.InexactFloat64(),
+		FeeAmount:   arg.FeeAmount.InexactFloat64(),
+		TotalAmount: arg.TotalAmount.InexactFloat64(),
+		FeeBearer:   arg.FeeBearer,
+
+		BaseAmountMinor:  payment.ToMinorUnits(arg.BaseAmount, arg.Currency),
+		FeeAmountMinor:   payment.ToMinorUnits(arg.FeeAmount, arg.Currency),
+		TotalAmountMinor: payment.ToMinorUnits(arg.TotalAmount, arg.Currency),
+		Currency:         arg.Currency,
+	}
+}
+
 func PaymentToDto(arg *repository.PaymentMethod) *pb.PaymentMethod {
-	amount, _ := arg.PaymentAmount.Float64()
 	return &pb.PaymentMethod{
 		Uid:                         arg.Uid,
 		PaymentMethodId:             arg.PaymentMethodID,
@@ -55,10 +73,20 @@ func PaymentToDto(arg *repository.PaymentMethod) *pb.PaymentMethod {
 		PaymentStatus:               arg.PaymentStatus,
 		PaymentReusability:          arg.PaymentReusability,
 		PaymentChannel:              arg.PaymentChannel,
-		PaymentAmount:               amount,
+		PaymentAmount:               arg.PaymentAmount.InexactFloat64(),
+		PaymentAmountMinor:          payment.ToMinorUnits(arg.PaymentAmount, arg.Currency),
+		Currency:                    arg.Currency,
+		CaptureMethod:               arg.PaymentCaptureMethod,
+		CapturedAmount:              arg.PaymentCapturedAmount.InexactFloat64(),
+		CapturedAmountMinor:         payment.ToMinorUnits(arg.PaymentCapturedAmount, arg.Currency),
+		LinkedPaymentMethodId:       &arg.PaymentLinkedMethodID.String,
+		PaymentCode:                 &arg.PaymentCode.String,
+		PaymentParentUid:            &arg.PaymentParentUid.String,
+		PaymentInvoiceUid:           &arg.PaymentInvoiceUid.String,
 		PaymentQrCode:               &arg.PaymentQrCode.String,
 		PaymentVirtualAccountNumber: &arg.PaymentVirtualAccountNumber.String,
 		PaymentUrl:                  &arg.PaymentUrl.String,
+		PaymentForUserId:            &arg.PaymentForUserID.String,
 		PaymentDescription:          arg.PaymentDescription,
 		CreatedAt:                   timestamppb.New(arg.CreatedAt.Time),
 		UpdatedAt:                   timestamppb.New(arg.UpdatedAt.Time),
@@ -66,3 +94,263 @@ func PaymentToDto(arg *repository.PaymentMethod) *pb.PaymentMethod {
 		PaidAt:                      timestamppb.New(arg.PaidAt.Time),
 	}
 }
+
+func PaymentsToDto(args []*repository.PaymentMethod) []*pb.PaymentMethod {
+	list := make([]*pb.PaymentMethod, 0, len(args))
+	for _, pm := range args {
+		list = append(list, PaymentToDto(pm))
+	}
+
+	return list
+}
+
+func PaymentSplitToDto(arg *repository.PaymentSplit) *pb.PaymentSplit {
+	return &pb.PaymentSplit{
+		Uid:                  arg.Uid,
+		SplitType:            arg.SplitType,
+		DestinationAccountId: arg.DestinationAccountID,
+		SplitAmount:          arg.SplitAmount.InexactFloat64(),
+		SplitAmountMinor:     payment.ToMinorUnits(arg.SplitAmount, arg.Currency),
+		Currency:             arg.Currency,
+		SplitReferenceId:     &arg.SplitReferenceID.String,
+		SplitRuleId:          &arg.SplitRuleID.String,
+	}
+}
+
+func PaymentSplitsToDto(args []*repository.PaymentSplit) []*pb.PaymentSplit {
+	list := make([]*pb.PaymentSplit, 0, len(args))
+	for _, split := range args {
+		list = append(list, PaymentSplitToDto(split))
+	}
+
+	return list
+}
+
+func PaginationTimeRangeToDto(arg *helper.PaginationTimeRangeResponse, nextCursor *string) *pb.PaginationTimeRange {
+	return &pb.PaginationTimeRange{
+		TotalCount: arg.TotalCount,
+		TotalPages: arg.TotalPages,
+		Page:       arg.Page,
+		Size:       arg.Size,
+		From:       arg.From,
+		To:         arg.To,
+		HasMore:    arg.HasMore,
+		NextCursor: nextCursor,
+	}
+}
+
+func RefundToDto(arg *repository.Refund) *pb.Refund {
+	return &pb.Refund{
+		Uid:               arg.Uid,
+		RefundId:          &arg.RefundID.String,
+		PaymentMethodId:   arg.PaymentMethodID,
+		RefundReferenceId: arg.RefundReferenceID,
+		RefundStatus:      arg.RefundStatus,
+		RefundAmount:      arg.RefundAmount.InexactFloat64(),
+		RefundAmountMinor: payment.ToMinorUnits(arg.RefundAmount, arg.Currency),
+		Currency:          arg.Currency,
+		RefundReason:      arg.RefundReason,
+		RefundFailureCode: &arg.RefundFailureCode.String,
+		CreatedAt:         timestamppb.New(arg.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(arg.UpdatedAt.Time),
+	}
+}
+
+func InvoiceToDto(arg *repository.Invoice) *pb.Invoice {
+	return &pb.Invoice{
+		Uid:                    arg.Uid,
+		InvoiceId:              arg.InvoiceID,
+		InvoiceReferenceId:     arg.InvoiceReferenceID,
+		PaymentCustomerId:      arg.PaymentCustomerID,
+		InvoiceStatus:          arg.InvoiceStatus,
+		InvoiceAmount:          arg.InvoiceAmount.InexactFloat64(),
+		InvoiceAmountMinor:     payment.ToMinorUnits(arg.InvoiceAmount, arg.Currency),
+		Currency:               arg.Currency,
+		InvoiceAllowedChannels: arg.InvoiceAllowedChannels,
+		InvoiceUrl:             arg.InvoiceUrl,
+		InvoiceDescription:     arg.InvoiceDescription,
+		CreatedAt:              timestamppb.New(arg.CreatedAt.Time),
+		UpdatedAt:              timestamppb.New(arg.UpdatedAt.Time),
+		ExpiresAt:              timestamppb.New(arg.ExpiresAt.Time),
+		PaidAt:                 timestamppb.New(arg.PaidAt.Time),
+	}
+}
+
+func LedgerAccountBalanceToDto(arg *repository.ListLedgerAccountBalancesRow) *pb.LedgerAccountBalance {
+	balance := payment.LedgerBalance(arg.AccountType, arg.DebitAmount, arg.CreditAmount)
+
+	return &pb.LedgerAccountBalance{
+		Uid:               arg.Uid,
+		AccountCode:       arg.AccountCode,
+		AccountOwner:      arg.AccountOwner,
+		AccountType:       arg.AccountType,
+		Currency:          arg.Currency,
+		DebitAmount:       arg.DebitAmount.InexactFloat64(),
+		DebitAmountMinor:  payment.ToMinorUnits(arg.DebitAmount, arg.Currency),
+		CreditAmount:      arg.CreditAmount.InexactFloat64(),
+		CreditAmountMinor: payment.ToMinorUnits(arg.CreditAmount, arg.Currency),
+		Balance:           balance.InexactFloat64(),
+		BalanceMinor:      payment.ToMinorUnits(balance, arg.Currency),
+	}
+}
+
+func LedgerAccountBalancesToDto(args []*repository.ListLedgerAccountBalancesRow) []*pb.LedgerAccountBalance {
+	list := make([]*pb.LedgerAccountBalance, 0, len(args))
+	for _, balance := range args {
+		list = append(list, LedgerAccountBalanceToDto(balance))
+	}
+
+	return list
+}
+
+func LedgerPostingToDto(arg *repository.ListLedgerPostingsByPaymentMethodUidRow) *pb.LedgerPosting {
+	return &pb.LedgerPosting{
+		Uid:          arg.Uid,
+		AccountCode:  arg.AccountCode,
+		AccountOwner: arg.AccountOwner,
+		AccountType:  arg.AccountType,
+		Direction:    arg.Direction,
+		Amount:       arg.Amount.InexactFloat64(),
+		AmountMinor:  payment.ToMinorUnits(arg.Amount, arg.Currency),
+		Currency:     arg.Currency,
+	}
+}
+
+// LedgerEntriesToDto groups the postings of a payment under the entries they belong to.
+func LedgerEntriesToDto(entries []*repository.LedgerEntry, postings []*repository.ListLedgerPostingsByPaymentMethodUidRow) []*pb.LedgerEntry {
+	list := make([]*pb.LedgerEntry, 0, len(entries))
+	byUid := make(map[string]*pb.LedgerEntry, len(entries))
+	for _, entry := range entries {
+		dto := &pb.LedgerEntry{
+			Uid:              entry.Uid,
+			EntryType:        entry.EntryType,
+			Currency:         entry.Currency,
+			EntryDescription: entry.EntryDescription,
+			Postings:         []*pb.LedgerPosting{},
+			CreatedAt:        timestamppb.New(entry.CreatedAt.Time),
+		}
+
+		if entry.RefundUid.Valid {
+			dto.RefundUid = &entry.RefundUid.String
+		}
+
+		byUid[entry.Uid] = dto
+		list = append(list, dto)
+	}
+
+	for _, posting := range postings {
+		if entry, ok := byUid[posting.EntryUid]; ok {
+			entry.Postings = append(entry.Postings, LedgerPostingToDto(posting))
+		}
+	}
+
+	return list
+}
+
+func PaymentStatusChangeToDto(arg *repository.PaymentStatusHistory) *pb.PaymentStatusChange {
+	res := &pb.PaymentStatusChange{
+		Uid:            arg.Uid,
+		PreviousStatus: arg.PreviousStatus,
+		NewStatus:      arg.NewStatus,
+		PaymentEvent:   arg.PaymentEvent,
+		CreatedAt:      timestamppb.New(arg.CreatedAt.Time),
+	}
+
+	if arg.FailureCode.Valid {
+		res.FailureCode = &arg.FailureCode.String
+	}
+
+	if arg.EventID.Valid {
+		res.EventId = &arg.EventID.String
+	}
+
+	if arg.GatewayUpdatedAt.Valid {
+		res.GatewayUpdatedAt = timestamppb.New(arg.GatewayUpdatedAt.Time)
+	}
+
+	return res
+}
+
+func PaymentStatusHistoryToDto(args []*repository.PaymentStatusHistory) []*pb.PaymentStatusChange {
+	list := make([]*pb.PaymentStatusChange, 0, len(args))
+	for _, change := range args {
+		list = append(list, PaymentStatusChangeToDto(change))
+	}
+
+	return list
+}
+
+func ReconciliationDiscrepancyToDto(arg *repository.ReconciliationDiscrepancy) *pb.ReconciliationDiscrepancy {
+	return &pb.ReconciliationDiscrepancy{
+		Uid:                 arg.Uid,
+		DiscrepancyType:     arg.DiscrepancyType,
+		PaymentMethodUid:    &arg.PaymentMethodUid.String,
+		PaymentMethodId:     &arg.PaymentMethodID.String,
+		PaymentRequestId:    &arg.PaymentRequestID.String,
+		PaymentReferenceId:  &arg.PaymentReferenceID.String,
+		ExpectedAmount:      arg.ExpectedAmount.InexactFloat64(),
+		ExpectedAmountMinor: payment.ToMinorUnits(arg.ExpectedAmount, arg.Currency),
+		ReportedAmount:      arg.ReportedAmount.InexactFloat64(),
+		ReportedAmountMinor: payment.ToMinorUnits(arg.ReportedAmount, arg.Currency),
+		ExpectedStatus:      &arg.ExpectedStatus.String,
+		ReportedStatus:      &arg.ReportedStatus.String,
+		Currency:            arg.Currency,
+		ReportSource:        arg.ReportSource,
+		ReportReference:     arg.ReportReference,
+		DetectedAt:          timestamppb.New(arg.DetectedAt.Time),
+		LastDetectedAt:      timestamppb.New(arg.LastDetectedAt.Time),
+	}
+}
+
+func ReconciliationDiscrepanciesToDto(args []*repository.ReconciliationDiscrepancy) []*pb.ReconciliationDiscrepancy {
+	list := make([]*pb.ReconciliationDiscrepancy, 0, len(args))
+	for _, discrepancy := range args {
+		list = append(list, ReconciliationDiscrepancyToDto(discrepancy))
+	}
+
+	return list
+}
+
+func KafkaHeadersToDto(args []*models.KafkaHeader) []*pb.KafkaHeader {
+	list := make([]*pb.KafkaHeader, 0, len(args))
+	for _, header := range args {
+		list = append(list, &pb.KafkaHeader{
+			Key:   header.Key,
+			Value: header.Value,
+		})
+	}
+
+	return list
+}
+
+// DeadLetterMessageToDto maps a quarantined message, headers are the ones it was stored with.
+func DeadLetterMessageToDto(arg *repository.DeadLetterMessage, headers []*models.KafkaHeader) *pb.DeadLetterMessage {
+	res := &pb.DeadLetterMessage{
+		Uid:                 arg.Uid,
+		SourceTopic:         arg.SourceTopic,
+		SourcePartition:     arg.SourcePartition,
+		SourceOffset:        arg.SourceOffset,
+		DeadLetterTopic:     arg.DeadLetterTopic,
+		DeadLetterPartition: arg.DeadLetterPartition,
+		DeadLetterOffset:    arg.DeadLetterOffset,
+		Payload:             arg.Payload,
+		Headers:             KafkaHeadersToDto(headers),
+		ErrorMessage:        arg.ErrorMessage,
+		Attempts:            arg.Attempts,
+		FirstSeenAt:         timestamppb.New(arg.FirstSeenAt.Time),
+		DeadLetteredAt:      timestamppb.New(arg.DeadLetteredAt.Time),
+		DeadLetterStatus:    arg.DeadLetterStatus,
+		ReplayCount:         arg.ReplayCount,
+	}
+
+	if len(arg.MessageKey) > 0 {
+		messageKey := string(arg.MessageKey)
+		res.MessageKey = &messageKey
+	}
+
+	if arg.ReplayedAt.Valid {
+		res.ReplayedAt = timestamppb.New(arg.ReplayedAt.Time)
+	}
+
+	return res
+}
@@ -1,45 +1,267 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
 )
 
 type PaymentStatusUpdatedTask struct {
+	PaymentMethod *repository.PaymentMethod  `json:"payment_method"`
+	Splits        []*repository.PaymentSplit `json:"splits,omitempty"`
+}
+
+type RefundStatusUpdatedTask struct {
+	Refund *repository.Refund `json:"refund"`
+}
+
+// ReplayDeadLetterTask publishes a quarantined message back onto its source topic with the headers it was
+// first published with.
+type ReplayDeadLetterTask struct {
+	DeadLetterMessage *repository.DeadLetterMessage `json:"dead_letter_message"`
+	Headers           []*KafkaHeader                `json:"headers"`
+}
+
+type InvoicePaidTask struct {
+	Invoice       *repository.Invoice       `json:"invoice"`
 	PaymentMethod *repository.PaymentMethod `json:"payment_method"`
 }
 
+// PaymentPrice is what a payment costs through a payment channel, TotalAmount is the amount charged to the customer.
+type PaymentPrice struct {
+	BaseAmount  decimal.Decimal `json:"base_amount"`
+	FeeAmount   decimal.Decimal `json:"fee_amount"`
+	TotalAmount decimal.Decimal `json:"total_amount"`
+	FeeBearer   string          `json:"fee_bearer"`
+	Currency    string          `json:"currency"`
+}
+
 type CreatePaymentRequest struct {
+	CustomerUid             *string         `json:"customer_uid,omitempty"`
+	CustomerName            string          `json:"customer_name" validate:"required,gt=0"`
+	CustomerPhoneNumber     string          `json:"customer_phone_number" validate:"required,lte=17"`
+	PaymentDescription      string          `json:"payment_description" validate:"required,gt=0"`
+	PaymentReferenceId      string          `json:"payment_reference_id" validate:"required,gt=0"`
+	PaymentAmount           decimal.Decimal `json:"payment_amount"`
+	Currency                string          `json:"currency" validate:"required,len=3"`
+	Country                 string          `json:"country" validate:"required,len=2"`
+	PaymentType             string          `json:"payment_type" validate:"required,gt=0"`
+	PaymentChannel          string          `json:"payment_channel" validate:"required,gt=0"`
+	ExpiryHour              int64           `json:"expiry_hour" validate:"required,gte=72"`
+	PaymentSuccessReturnUrl string          `json:"payment_success_return_url" validate:"required,gt=0"`
+	PaymentFailureReturnUrl string          `json:"payment_failure_return_url" validate:"required,gt=0"`
+	XIdempotencyKey         string          `json:"x_idempotency_key" validate:"required,gt=0"`
+	CardToken               *string         `json:"card_token,omitempty" validate:"omitempty,gt=0"`
+	CaptureMethod           string          `json:"capture_method" validate:"required,gt=0"`
+	LinkedPaymentMethodId   *string         `json:"linked_payment_method_id,omitempty" validate:"omitempty,gt=0"`
+	PaymentReusability      string          `json:"payment_reusability" validate:"required,gt=0"`
+	// ForUserId is the xenPlatform sub-account the payment is collected on behalf of.
+	ForUserId   *string             `json:"for_user_id,omitempty" validate:"omitempty,gt=0"`
+	PlatformFee *decimal.Decimal    `json:"platform_fee,omitempty"`
+	SplitRules  []*PaymentSplitRule `json:"split_rules,omitempty"`
+}
+
+// PaymentSplitRule routes part of a payment to another account, either a percentage of the amount
+// or a flat amount in the currency of the payment.
+type PaymentSplitRule struct {
+	DestinationAccountId string           `json:"destination_account_id"`
+	PercentAmount        *decimal.Decimal `json:"percent_amount,omitempty"`
+	FlatAmount           *decimal.Decimal `json:"flat_amount,omitempty"`
+	ReferenceId          *string          `json:"reference_id,omitempty"`
+}
+
+func NewCreatePaymentRequestParams(arg *pb.CreatePaymentRequest) *CreatePaymentRequest {
+	currency := currencyOrDefault(arg.Currency)
+
+	country := payment.CurrencyCountry(currency)
+	if arg.Country != nil {
+		country = arg.GetCountry()
+	}
+
+	// the minor units are exact, the double field is only kept for clients which have not moved yet.
+	amount := decimal.NewFromFloat(arg.GetPaymentAmount())
+	if arg.PaymentAmountMinor != nil {
+		amount = payment.FromMinorUnits(arg.GetPaymentAmountMinor(), currency)
+	}
+
+	captureMethod := payment.CAPTURE_METHOD_AUTOMATIC
+	if arg.CaptureMethod != nil {
+		captureMethod = arg.GetCaptureMethod()
+	}
+
+	reusability := payment.USAGE_TYPE_ONE_TIME_USE
+	if arg.PaymentReusability != nil {
+		reusability = arg.GetPaymentReusability()
+	}
+
+	var platformFee *decimal.Decimal
+	if arg.PlatformFeeMinor != nil {
+		fee := payment.FromMinorUnits(arg.GetPlatformFeeMinor(), currency)
+		platformFee = &fee
+	} else if arg.PlatformFee != nil {
+		fee := decimal.NewFromFloat(arg.GetPlatformFee())
+		platformFee = &fee
+	}
+
+	var splitRules []*PaymentSplitRule
+	for _, rule := range arg.GetSplitRules() {
+		splitRules = append(splitRules, newPaymentSplitRule(rule, currency))
+	}
+
+	return &CreatePaymentRequest{
+		CustomerUid:             arg.CustomerUid,
+		CustomerName:            arg.GetCustomerName(),
+		CustomerPhoneNumber:     arg.GetCustomerPhoneNumber(),
+		PaymentDescription:      arg.GetPaymentDescription(),
+		PaymentReferenceId:      arg.GetPaymentReferenceId(),
+		PaymentAmount:           amount,
+		Currency:                currency,
+		Country:                 country,
+		PaymentType:             arg.GetPaymentType(),
+		PaymentChannel:          arg.GetPaymentChannel(),
+		ExpiryHour:              arg.GetExpiryHour(),
+		PaymentSuccessReturnUrl: arg.GetPaymentSuccessReturnUrl(),
+		PaymentFailureReturnUrl: arg.GetPaymentFailureReturnUrl(),
+		XIdempotencyKey:         arg.GetXIdempotencyKey(),
+		CardToken:               arg.CardToken,
+		CaptureMethod:           captureMethod,
+		LinkedPaymentMethodId:   arg.LinkedPaymentMethodId,
+		PaymentReusability:      reusability,
+		ForUserId:               arg.ForUserId,
+		PlatformFee:             platformFee,
+		SplitRules:              splitRules,
+	}
+}
+
+func newPaymentSplitRule(arg *pb.PaymentSplitRule, currency string) *PaymentSplitRule {
+	res := &PaymentSplitRule{
+		DestinationAccountId: arg.GetDestinationAccountId(),
+		ReferenceId:          arg.ReferenceId,
+	}
+
+	if arg.PercentAmount != nil {
+		percent := decimal.NewFromFloat(arg.GetPercentAmount())
+		res.PercentAmount = &percent
+	}
+
+	if arg.FlatAmountMinor != nil {
+		flat := payment.FromMinorUnits(arg.GetFlatAmountMinor(), currency)
+		res.FlatAmount = &flat
+	} else if arg.FlatAmount != nil {
+		flat := decimal.NewFromFloat(arg.GetFlatAmount())
+		res.FlatAmount = &flat
+	}
+
+	return res
+}
+
+type LinkDirectDebitRequest struct {
 	CustomerUid             *string `json:"customer_uid,omitempty"`
 	CustomerName            string  `json:"customer_name" validate:"required,gt=0"`
 	CustomerPhoneNumber     string  `json:"customer_phone_number" validate:"required,lte=17"`
-	PaymentDescription      string  `json:"payment_description" validate:"required,gt=0"`
 	PaymentReferenceId      string  `json:"payment_reference_id" validate:"required,gt=0"`
-	PaymentAmount           float64 `json:"payment_amount" validate:"required,gte=100"`
-	PaymentType             string  `json:"payment_type" validate:"required,gt=0"`
+	PaymentDescription      string  `json:"payment_description" validate:"required,gt=0"`
 	PaymentChannel          string  `json:"payment_channel" validate:"required,gt=0"`
-	ExpiryHour              int64   `json:"expiry_hour" validate:"required,gte=72"`
 	PaymentSuccessReturnUrl string  `json:"payment_success_return_url" validate:"required,gt=0"`
 	PaymentFailureReturnUrl string  `json:"payment_failure_return_url" validate:"required,gt=0"`
-	XIdempotencyKey         string  `json:"x_idempotency_key" validate:"required,gt=0"`
+	Currency                string  `json:"currency" validate:"required,len=3"`
+	Country                 string  `json:"country" validate:"required,len=2"`
+	AccountMobileNumber     string  `json:"account_mobile_number,omitempty"`
+	AccountCardLastFour     string  `json:"account_card_last_four,omitempty" validate:"omitempty,len=4"`
+	AccountCardExpiry       string  `json:"account_card_expiry,omitempty" validate:"omitempty,len=5"`
+	AccountEmail            string  `json:"account_email,omitempty" validate:"omitempty,email"`
 }
 
-func NewCreatePaymentRequestParams(arg *pb.CreatePaymentRequest) *CreatePaymentRequest {
-	return &CreatePaymentRequest{
+func NewLinkDirectDebitRequestParams(arg *pb.LinkDirectDebitRequest) *LinkDirectDebitRequest {
+	currency := currencyOrDefault(arg.Currency)
+
+	country := payment.CurrencyCountry(currency)
+	if arg.Country != nil {
+		country = arg.GetCountry()
+	}
+
+	return &LinkDirectDebitRequest{
 		CustomerUid:             arg.CustomerUid,
 		CustomerName:            arg.GetCustomerName(),
 		CustomerPhoneNumber:     arg.GetCustomerPhoneNumber(),
-		PaymentDescription:      arg.GetPaymentDescription(),
 		PaymentReferenceId:      arg.GetPaymentReferenceId(),
-		PaymentAmount:           arg.GetPaymentAmount(),
-		PaymentType:             arg.GetPaymentType(),
+		PaymentDescription:      arg.GetPaymentDescription(),
 		PaymentChannel:          arg.GetPaymentChannel(),
-		ExpiryHour:              arg.GetExpiryHour(),
 		PaymentSuccessReturnUrl: arg.GetPaymentSuccessReturnUrl(),
 		PaymentFailureReturnUrl: arg.GetPaymentFailureReturnUrl(),
-		XIdempotencyKey:         arg.GetXIdempotencyKey(),
+		Currency:                currency,
+		Country:                 country,
+		AccountMobileNumber:     arg.GetAccountMobileNumber(),
+		AccountCardLastFour:     arg.GetAccountCardLastFour(),
+		AccountCardExpiry:       arg.GetAccountCardExpiry(),
+		AccountEmail:            arg.GetAccountEmail(),
+	}
+}
+
+type CreateInvoiceRequest struct {
+	CustomerUid             *string         `json:"customer_uid,omitempty"`
+	CustomerName            string          `json:"customer_name" validate:"required,gt=0"`
+	CustomerPhoneNumber     string          `json:"customer_phone_number" validate:"required,lte=17"`
+	InvoiceDescription      string          `json:"invoice_description" validate:"required,gt=0"`
+	InvoiceReferenceId      string          `json:"invoice_reference_id" validate:"required,gt=0"`
+	InvoiceAmount           decimal.Decimal `json:"invoice_amount"`
+	Currency                string          `json:"currency" validate:"required,len=3"`
+	InvoiceAllowedChannels  []string        `json:"invoice_allowed_channels" validate:"required,gt=0,dive,gt=0"`
+	ExpiryHour              int64           `json:"expiry_hour" validate:"required,gte=72"`
+	InvoiceSuccessReturnUrl string          `json:"invoice_success_return_url" validate:"required,gt=0"`
+	InvoiceFailureReturnUrl string          `json:"invoice_failure_return_url" validate:"required,gt=0"`
+}
+
+func NewCreateInvoiceRequestParams(arg *pb.CreateInvoiceRequest) *CreateInvoiceRequest {
+	currency := currencyOrDefault(arg.Currency)
+
+	amount := decimal.NewFromFloat(arg.GetInvoiceAmount())
+	if arg.InvoiceAmountMinor != nil {
+		amount = payment.FromMinorUnits(arg.GetInvoiceAmountMinor(), currency)
+	}
+
+	return &CreateInvoiceRequest{
+		CustomerUid:             arg.CustomerUid,
+		CustomerName:            arg.GetCustomerName(),
+		CustomerPhoneNumber:     arg.GetCustomerPhoneNumber(),
+		InvoiceDescription:      arg.GetInvoiceDescription(),
+		InvoiceReferenceId:      arg.GetInvoiceReferenceId(),
+		InvoiceAmount:           amount,
+		Currency:                currency,
+		InvoiceAllowedChannels:  arg.GetInvoiceAllowedChannels(),
+		ExpiryHour:              arg.GetExpiryHour(),
+		InvoiceSuccessReturnUrl: arg.GetInvoiceSuccessReturnUrl(),
+		InvoiceFailureReturnUrl: arg.GetInvoiceFailureReturnUrl(),
+	}
+}
+
+// UpdateInvoiceRequest is an invoice callback, PaymentId, PaymentChannel, PaymentAmount and PaidAt
+// describe the payment made through the checkout page and are only known once the invoice is paid.
+type UpdateInvoiceRequest struct {
+	InvoiceId      string           `json:"invoice_id" validate:"required,gt=0"`
+	InvoiceStatus  string           `json:"invoice_status" validate:"required,gt=0"`
+	PaymentId      string           `json:"payment_id,omitempty"`
+	PaymentChannel string           `json:"payment_channel,omitempty"`
+	PaymentAmount  *decimal.Decimal `json:"payment_amount,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+	PaidAt         *time.Time       `json:"paid_at,omitempty"`
+}
+
+type ValidateDirectDebitLinkRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string `json:"payment_method_id" validate:"required,gt=0"`
+	OtpCode           string `json:"otp_code" validate:"required,gt=0"`
+}
+
+func NewValidateDirectDebitLinkRequestParams(arg *pb.ValidateDirectDebitLinkRequest) *ValidateDirectDebitLinkRequest {
+	return &ValidateDirectDebitLinkRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+		OtpCode:           arg.GetOtpCode(),
 	}
 }
 
@@ -53,9 +275,37 @@ type UpdatePaymentRequest struct {
 	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 	PaymentStatus      string     `json:"payment_status" validate:"required,gt=0"`
 	PaymentFailureCode *string    `json:"payment_failure_code,omitempty"`
+	// PaymentId, PaymentRequestId, PaymentReusability and PaymentAmount describe the payment made to
+	// the payment method, they are only known for payment events.
+	PaymentId          string           `json:"payment_id,omitempty"`
+	PaymentRequestId   string           `json:"payment_request_id,omitempty"`
+	PaymentReusability string           `json:"payment_reusability,omitempty"`
+	PaymentAmount      *decimal.Decimal `json:"payment_amount,omitempty"`
+	// CapturedAmount is the amount collected of a manually captured payment, set once its capture settles.
+	CapturedAmount *decimal.Decimal `json:"captured_amount,omitempty"`
+	// EventId is the kafka topic/partition/offset or the webhook id the update was delivered with.
+	EventId string `json:"event_id,omitempty"`
+}
+
+// UpdatePaymentResult tells the caller whether a status update was applied, Rejection is one of the
+// payment.STATUS_UPDATE_REJECTED_ reasons when the update was skipped.
+type UpdatePaymentResult struct {
+	Rejection string `json:"rejection,omitempty"`
 }
 
 func NewUpdatePaymentRequestParams(arg *pb.UpdatePaymentRequest) *UpdatePaymentRequest {
+	var amount *decimal.Decimal
+	if arg.PaymentAmount != nil {
+		value := decimal.NewFromFloat(arg.GetPaymentAmount())
+		amount = &value
+	}
+
+	var capturedAmount *decimal.Decimal
+	if arg.CapturedAmount != nil {
+		value := decimal.NewFromFloat(arg.GetCapturedAmount())
+		capturedAmount = &value
+	}
+
 	updatedAt := arg.GetUpdatedAt().AsTime()
 	return &UpdatePaymentRequest{
 		PaymentEvent:       arg.GetPaymentEvent(),
@@ -67,6 +317,11 @@ func NewUpdatePaymentRequestParams(arg *pb.UpdatePaymentRequest) *UpdatePaymentR
 		UpdatedAt:          &updatedAt,
 		PaymentStatus:      arg.GetPaymentStatus(),
 		PaymentFailureCode: arg.PaymentFailureCode,
+		PaymentId:          arg.GetPaymentId(),
+		PaymentRequestId:   arg.GetPaymentRequestId(),
+		PaymentReusability: arg.GetPaymentReusability(),
+		PaymentAmount:      amount,
+		CapturedAmount:     capturedAmount,
 	}
 }
 
@@ -82,10 +337,105 @@ func NewGetByIDPaymentRequestParams(arg *pb.GetByIDPaymentRequest) *GetByIDPayme
 	}
 }
 
+type GetByReferenceIDPaymentRequest struct {
+	PaymentReferenceId string `json:"payment_reference_id" validate:"required,gt=0"`
+}
+
+func NewGetByReferenceIDPaymentRequestParams(arg *pb.GetByReferenceIDPaymentRequest) *GetByReferenceIDPaymentRequest {
+	return &GetByReferenceIDPaymentRequest{
+		PaymentReferenceId: arg.GetPaymentReferenceId(),
+	}
+}
+
+type ListPaymentsRequest struct {
+	PaymentCustomerId  *string    `json:"payment_customer_id,omitempty" validate:"omitempty,gt=0"`
+	PaymentStatus      *string    `json:"payment_status,omitempty" validate:"omitempty,gt=0"`
+	PaymentType        *string    `json:"payment_type,omitempty" validate:"omitempty,gt=0"`
+	PaymentChannel     *string    `json:"payment_channel,omitempty" validate:"omitempty,gt=0"`
+	PaymentReferenceId *string    `json:"payment_reference_id,omitempty" validate:"omitempty,gt=0"`
+	CreatedFrom        *time.Time `json:"created_from,omitempty"`
+	CreatedTo          *time.Time `json:"created_to,omitempty"`
+	Size               int64      `json:"size" validate:"gte=0,lte=100"`
+	Cursor             *string    `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+}
+
+func NewListPaymentsRequestParams(arg *pb.ListPaymentsRequest) *ListPaymentsRequest {
+	res := &ListPaymentsRequest{
+		PaymentCustomerId:  arg.PaymentCustomerId,
+		PaymentStatus:      arg.PaymentStatus,
+		PaymentType:        arg.PaymentType,
+		PaymentChannel:     arg.PaymentChannel,
+		PaymentReferenceId: arg.PaymentReferenceId,
+		Size:               arg.GetSize(),
+		Cursor:             arg.Cursor,
+	}
+
+	if arg.CreatedFrom != nil {
+		createdFrom := arg.GetCreatedFrom().AsTime()
+		res.CreatedFrom = &createdFrom
+	}
+
+	if arg.CreatedTo != nil {
+		createdTo := arg.GetCreatedTo().AsTime()
+		res.CreatedTo = &createdTo
+	}
+
+	return res
+}
+
+type CancelPaymentRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string `json:"payment_method_id" validate:"required,gt=0"`
+}
+
+func NewCancelPaymentRequestParams(arg *pb.CancelPaymentRequest) *CancelPaymentRequest {
+	return &CancelPaymentRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+	}
+}
+
+type CapturePaymentRequest struct {
+	PaymentCustomerId  string           `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId    string           `json:"payment_method_id" validate:"required,gt=0"`
+	CaptureAmount      *decimal.Decimal `json:"capture_amount,omitempty"`
+	CaptureAmountMinor *int64           `json:"capture_amount_minor,omitempty"`
+}
+
+func NewCapturePaymentRequestParams(arg *pb.CapturePaymentRequest) *CapturePaymentRequest {
+	res := &CapturePaymentRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+	}
+
+	switch {
+	case arg.CaptureAmountMinor != nil:
+		res.CaptureAmountMinor = arg.CaptureAmountMinor
+	case arg.CaptureAmount != nil:
+		amount := decimal.NewFromFloat(arg.GetCaptureAmount())
+		res.CaptureAmount = &amount
+	}
+
+	return res
+}
+
+type VoidPaymentRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string `json:"payment_method_id" validate:"required,gt=0"`
+}
+
+func NewVoidPaymentRequestParams(arg *pb.VoidPaymentRequest) *VoidPaymentRequest {
+	return &VoidPaymentRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+	}
+}
+
 type GetPaymentChannelRequest struct {
-	Amount             float64 `json:"amount" validate:"required,gte=100"`
+	Amount             float64 `json:"amount" validate:"required,gt=0"`
 	PaymentChannelName string  `json:"payment_channel_name" validate:"required,gt=0"`
 	PaymentChannelType string  `json:"payment_channel_type" validate:"required,gt=0"`
+	Currency           string  `json:"currency" validate:"required,len=3"`
 }
 
 func NewGetPaymentChannelRequestParams(arg *pb.GetPaymentChannelRequest) *GetPaymentChannelRequest {
@@ -93,15 +443,222 @@ func NewGetPaymentChannelRequestParams(arg *pb.GetPaymentChannelRequest) *GetPay
 		Amount:             arg.GetAmount(),
 		PaymentChannelName: arg.GetPaymentChannelName(),
 		PaymentChannelType: arg.GetPaymentChannelType(),
+		Currency:           currencyOrDefault(arg.Currency),
 	}
 }
 
 type GetPaymentChannelsRequest struct {
-	Amount float64 `json:"amount" validate:"required,gte=100"`
+	Amount   float64 `json:"amount" validate:"required,gt=0"`
+	Currency string  `json:"currency" validate:"required,len=3"`
 }
 
 func NewGetPaymentChannelsRequestParams(arg *pb.GetPaymentChannelsRequest) *GetPaymentChannelsRequest {
 	return &GetPaymentChannelsRequest{
-		Amount: arg.GetAmount(),
+		Amount:   arg.GetAmount(),
+		Currency: currencyOrDefault(arg.Currency),
+	}
+}
+
+func currencyOrDefault(currency *string) string {
+	if currency == nil {
+		return payment.DEFAULT_CURRENCY
+	}
+
+	return *currency
+}
+
+type RefundPaymentRequest struct {
+	PaymentCustomerId string           `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string           `json:"payment_method_id" validate:"required,gt=0"`
+	RefundAmount      *decimal.Decimal `json:"refund_amount,omitempty"`
+	RefundAmountMinor *int64           `json:"refund_amount_minor,omitempty"`
+	RefundReason      string           `json:"refund_reason" validate:"required,gt=0"`
+}
+
+func NewRefundPaymentRequestParams(arg *pb.RefundPaymentRequest) *RefundPaymentRequest {
+	res := &RefundPaymentRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+		RefundReason:      arg.GetRefundReason(),
+	}
+
+	switch {
+	case arg.RefundAmountMinor != nil:
+		// minor units are only known once the currency of the payment is.
+		res.RefundAmountMinor = arg.RefundAmountMinor
+	case arg.RefundAmount != nil:
+		amount := decimal.NewFromFloat(arg.GetRefundAmount())
+		res.RefundAmount = &amount
+	}
+
+	return res
+}
+
+type UpdateRefundRequest struct {
+	RefundEvent       string     `json:"refund_event,omitempty"`
+	RefundId          string     `json:"refund_id" validate:"required,gt=0"`
+	RefundStatus      string     `json:"refund_status" validate:"required,gt=0"`
+	RefundFailureCode *string    `json:"refund_failure_code,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+type GetLedgerBalancesRequest struct {
+	Currency     *string `json:"currency,omitempty" validate:"omitempty,gt=0"`
+	AccountCode  *string `json:"account_code,omitempty" validate:"omitempty,gt=0"`
+	AccountOwner *string `json:"account_owner,omitempty" validate:"omitempty,gt=0"`
+}
+
+func NewGetLedgerBalancesRequestParams(arg *pb.GetLedgerBalancesRequest) *GetLedgerBalancesRequest {
+	return &GetLedgerBalancesRequest{
+		Currency:     arg.Currency,
+		AccountCode:  arg.AccountCode,
+		AccountOwner: arg.AccountOwner,
+	}
+}
+
+// GetCustomerRequest looks a customer up by the id the payment gateway assigned to it.
+type GetCustomerRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+}
+
+type ListPaymentLedgerEntriesRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string `json:"payment_method_id" validate:"required,gt=0"`
+}
+
+func NewListPaymentLedgerEntriesRequestParams(arg *pb.ListPaymentLedgerEntriesRequest) *ListPaymentLedgerEntriesRequest {
+	return &ListPaymentLedgerEntriesRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+	}
+}
+
+type GetPaymentTimelineRequest struct {
+	PaymentCustomerId string `json:"payment_customer_id" validate:"required,gt=0"`
+	PaymentMethodId   string `json:"payment_method_id" validate:"required,gt=0"`
+}
+
+func NewGetPaymentTimelineRequestParams(arg *pb.GetPaymentTimelineRequest) *GetPaymentTimelineRequest {
+	return &GetPaymentTimelineRequest{
+		PaymentCustomerId: arg.GetPaymentCustomerId(),
+		PaymentMethodId:   arg.GetPaymentMethodId(),
+	}
+}
+
+type ListReconciliationDiscrepanciesRequest struct {
+	DiscrepancyType *string    `json:"discrepancy_type,omitempty" validate:"omitempty,gt=0"`
+	PaymentMethodId *string    `json:"payment_method_id,omitempty" validate:"omitempty,gt=0"`
+	DetectedFrom    *time.Time `json:"detected_from,omitempty"`
+	DetectedTo      *time.Time `json:"detected_to,omitempty"`
+	Size            int64      `json:"size" validate:"gte=0,lte=100"`
+	Cursor          *string    `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+}
+
+func NewListReconciliationDiscrepanciesRequestParams(arg *pb.ListReconciliationDiscrepanciesRequest) *ListReconciliationDiscrepanciesRequest {
+	res := &ListReconciliationDiscrepanciesRequest{
+		DiscrepancyType: arg.DiscrepancyType,
+		PaymentMethodId: arg.PaymentMethodId,
+		Size:            arg.GetSize(),
+		Cursor:          arg.Cursor,
+	}
+
+	if arg.DetectedFrom != nil {
+		detectedFrom := arg.GetDetectedFrom().AsTime()
+		res.DetectedFrom = &detectedFrom
+	}
+
+	if arg.DetectedTo != nil {
+		detectedTo := arg.GetDetectedTo().AsTime()
+		res.DetectedTo = &detectedTo
+	}
+
+	return res
+}
+
+// KafkaHeader is a header of a quarantined message, the value is kept as text.
+type KafkaHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DecodeKafkaHeaders reads the headers a quarantined message was stored with.
+func DecodeKafkaHeaders(arg []byte) ([]*KafkaHeader, error) {
+	var res []*KafkaHeader
+	if len(arg) == 0 {
+		return res, nil
+	}
+
+	if err := json.Unmarshal(arg, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// QuarantineDeadLetterRequest is a message read from a dead-letter topic, the source fields locate it on
+// the topic it was first published to.
+type QuarantineDeadLetterRequest struct {
+	SourceTopic         string         `json:"source_topic" validate:"required,gt=0"`
+	SourcePartition     int32          `json:"source_partition" validate:"gte=0"`
+	SourceOffset        int64          `json:"source_offset" validate:"gte=0"`
+	DeadLetterTopic     string         `json:"dead_letter_topic" validate:"required,gt=0"`
+	DeadLetterPartition int32          `json:"dead_letter_partition" validate:"gte=0"`
+	DeadLetterOffset    int64          `json:"dead_letter_offset" validate:"gte=0"`
+	MessageKey          []byte         `json:"message_key,omitempty"`
+	Payload             []byte         `json:"payload"`
+	Headers             []*KafkaHeader `json:"headers,omitempty"`
+	ErrorMessage        string         `json:"error_message"`
+	Attempts            int32          `json:"attempts" validate:"gte=0"`
+	FirstSeenAt         time.Time      `json:"first_seen_at"`
+	DeadLetteredAt      time.Time      `json:"dead_lettered_at"`
+}
+
+type ListDeadLetterMessagesRequest struct {
+	SourceTopic      *string    `json:"source_topic,omitempty" validate:"omitempty,gt=0"`
+	DeadLetterStatus *string    `json:"dead_letter_status,omitempty" validate:"omitempty,gt=0"`
+	DeadLetteredFrom *time.Time `json:"dead_lettered_from,omitempty"`
+	DeadLetteredTo   *time.Time `json:"dead_lettered_to,omitempty"`
+	Size             int64      `json:"size" validate:"gte=0,lte=100"`
+	Cursor           *string    `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+}
+
+func NewListDeadLetterMessagesRequestParams(arg *pb.ListDeadLetterMessagesRequest) *ListDeadLetterMessagesRequest {
+	res := &ListDeadLetterMessagesRequest{
+		SourceTopic:      arg.SourceTopic,
+		DeadLetterStatus: arg.DeadLetterStatus,
+		Size:             arg.GetSize(),
+		Cursor:           arg.Cursor,
+	}
+
+	if arg.DeadLetteredFrom != nil {
+		deadLetteredFrom := arg.GetDeadLetteredFrom().AsTime()
+		res.DeadLetteredFrom = &deadLetteredFrom
+	}
+
+	if arg.DeadLetteredTo != nil {
+		deadLetteredTo := arg.GetDeadLetteredTo().AsTime()
+		res.DeadLetteredTo = &deadLetteredTo
+	}
+
+	return res
+}
+
+type GetDeadLetterMessageRequest struct {
+	Uid string `json:"uid" validate:"required,gt=0"`
+}
+
+func NewGetDeadLetterMessageRequestParams(arg *pb.GetDeadLetterMessageRequest) *GetDeadLetterMessageRequest {
+	return &GetDeadLetterMessageRequest{
+		Uid: arg.GetUid(),
+	}
+}
+
+type ReplayDeadLetterMessageRequest struct {
+	Uid string `json:"uid" validate:"required,gt=0"`
+}
+
+func NewReplayDeadLetterMessageRequestParams(arg *pb.ReplayDeadLetterMessageRequest) *ReplayDeadLetterMessageRequest {
+	return &ReplayDeadLetterMessageRequest{
+		Uid: arg.GetUid(),
 	}
 }
@@ -0,0 +1,274 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: dead_letter_message_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countDeadLetterMessages = `-- name: CountDeadLetterMessages :one
+SELECT COUNT(*) FROM dead_letter_message
+WHERE
+    ($1::varchar IS NULL OR source_topic = $1)
+AND
+    ($2::varchar IS NULL OR dead_letter_status = $2)
+AND
+    dead_lettered_at >= $3
+AND
+    dead_lettered_at < $4
+`
+
+type CountDeadLetterMessagesParams struct {
+	SourceTopic      pgtype.Text        `json:"source_topic"`
+	DeadLetterStatus pgtype.Text        `json:"dead_letter_status"`
+	DeadLetteredFrom pgtype.Timestamptz `json:"dead_lettered_from"`
+	DeadLetteredTo   pgtype.Timestamptz `json:"dead_lettered_to"`
+}
+
+func (q *Queries) CountDeadLetterMessages(ctx context.Context, arg *CountDeadLetterMessagesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDeadLetterMessages,
+		arg.SourceTopic,
+		arg.DeadLetterStatus,
+		arg.DeadLetteredFrom,
+		arg.DeadLetteredTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDeadLetterMessage = `-- name: CreateDeadLetterMessage :one
+INSERT INTO dead_letter_message (
+    uid,
+    source_topic,
+    source_partition,
+    source_offset,
+    dead_letter_topic,
+    dead_letter_partition,
+    dead_letter_offset,
+    message_key,
+    payload,
+    headers,
+    error_message,
+    attempts,
+    first_seen_at,
+    dead_lettered_at,
+    dead_letter_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+) ON CONFLICT (dead_letter_topic, dead_letter_partition, dead_letter_offset) DO NOTHING
+RETURNING uid, source_topic, source_partition, source_offset, dead_letter_topic, dead_letter_partition, dead_letter_offset, message_key, payload, headers, error_message, attempts, first_seen_at, dead_lettered_at, dead_letter_status, replay_count, replayed_at, created_at
+`
+
+type CreateDeadLetterMessageParams struct {
+	Uid                 string             `json:"uid"`
+	SourceTopic         string             `json:"source_topic"`
+	SourcePartition     int32              `json:"source_partition"`
+	SourceOffset        int64              `json:"source_offset"`
+	DeadLetterTopic     string             `json:"dead_letter_topic"`
+	DeadLetterPartition int32              `json:"dead_letter_partition"`
+	DeadLetterOffset    int64              `json:"dead_letter_offset"`
+	MessageKey          []byte             `json:"message_key"`
+	Payload             []byte             `json:"payload"`
+	Headers             []byte             `json:"headers"`
+	ErrorMessage        string             `json:"error_message"`
+	Attempts            int32              `json:"attempts"`
+	FirstSeenAt         pgtype.Timestamptz `json:"first_seen_at"`
+	DeadLetteredAt      pgtype.Timestamptz `json:"dead_lettered_at"`
+	DeadLetterStatus    string             `json:"dead_letter_status"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateDeadLetterMessage(ctx context.Context, arg *CreateDeadLetterMessageParams) (*DeadLetterMessage, error) {
+	row := q.db.QueryRow(ctx, createDeadLetterMessage,
+		arg.Uid,
+		arg.SourceTopic,
+		arg.SourcePartition,
+		arg.SourceOffset,
+		arg.DeadLetterTopic,
+		arg.DeadLetterPartition,
+		arg.DeadLetterOffset,
+		arg.MessageKey,
+		arg.Payload,
+		arg.Headers,
+		arg.ErrorMessage,
+		arg.Attempts,
+		arg.FirstSeenAt,
+		arg.DeadLetteredAt,
+		arg.DeadLetterStatus,
+		arg.CreatedAt,
+	)
+	var i DeadLetterMessage
+	err := row.Scan(
+		&i.Uid,
+		&i.SourceTopic,
+		&i.SourcePartition,
+		&i.SourceOffset,
+		&i.DeadLetterTopic,
+		&i.DeadLetterPartition,
+		&i.DeadLetterOffset,
+		&i.MessageKey,
+		&i.Payload,
+		&i.Headers,
+		&i.ErrorMessage,
+		&i.Attempts,
+		&i.FirstSeenAt,
+		&i.DeadLetteredAt,
+		&i.DeadLetterStatus,
+		&i.ReplayCount,
+		&i.ReplayedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getDeadLetterMessage = `-- name: GetDeadLetterMessage :one
+SELECT uid, source_topic, source_partition, source_offset, dead_letter_topic, dead_letter_partition, dead_letter_offset, message_key, payload, headers, error_message, attempts, first_seen_at, dead_lettered_at, dead_letter_status, replay_count, replayed_at, created_at FROM dead_letter_message WHERE uid = $1 LIMIT 1
+`
+
+func (q *Queries) GetDeadLetterMessage(ctx context.Context, uid string) (*DeadLetterMessage, error) {
+	row := q.db.QueryRow(ctx, getDeadLetterMessage, uid)
+	var i DeadLetterMessage
+	err := row.Scan(
+		&i.Uid,
+		&i.SourceTopic,
+		&i.SourcePartition,
+		&i.SourceOffset,
+		&i.DeadLetterTopic,
+		&i.DeadLetterPartition,
+		&i.DeadLetterOffset,
+		&i.MessageKey,
+		&i.Payload,
+		&i.Headers,
+		&i.ErrorMessage,
+		&i.Attempts,
+		&i.FirstSeenAt,
+		&i.DeadLetteredAt,
+		&i.DeadLetterStatus,
+		&i.ReplayCount,
+		&i.ReplayedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listDeadLetterMessages = `-- name: ListDeadLetterMessages :many
+SELECT uid, source_topic, source_partition, source_offset, dead_letter_topic, dead_letter_partition, dead_letter_offset, message_key, payload, headers, error_message, attempts, first_seen_at, dead_lettered_at, dead_letter_status, replay_count, replayed_at, created_at FROM dead_letter_message
+WHERE
+    ($1::varchar IS NULL OR source_topic = $1)
+AND
+    ($2::varchar IS NULL OR dead_letter_status = $2)
+AND
+    dead_lettered_at >= $3
+AND
+    dead_lettered_at < $4
+AND
+    (dead_lettered_at, uid) < ($5::timestamptz, $6::varchar)
+ORDER BY dead_lettered_at DESC, uid DESC
+LIMIT $7
+`
+
+type ListDeadLetterMessagesParams struct {
+	SourceTopic          pgtype.Text        `json:"source_topic"`
+	DeadLetterStatus     pgtype.Text        `json:"dead_letter_status"`
+	DeadLetteredFrom     pgtype.Timestamptz `json:"dead_lettered_from"`
+	DeadLetteredTo       pgtype.Timestamptz `json:"dead_lettered_to"`
+	BeforeDeadLetteredAt pgtype.Timestamptz `json:"before_dead_lettered_at"`
+	BeforeUid            string             `json:"before_uid"`
+	PageSize             int32              `json:"page_size"`
+}
+
+func (q *Queries) ListDeadLetterMessages(ctx context.Context, arg *ListDeadLetterMessagesParams) ([]*DeadLetterMessage, error) {
+	rows, err := q.db.Query(ctx, listDeadLetterMessages,
+		arg.SourceTopic,
+		arg.DeadLetterStatus,
+		arg.DeadLetteredFrom,
+		arg.DeadLetteredTo,
+		arg.BeforeDeadLetteredAt,
+		arg.BeforeUid,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*DeadLetterMessage{}
+	for rows.Next() {
+		var i DeadLetterMessage
+		if err := rows.Scan(
+			&i.Uid,
+			&i.SourceTopic,
+			&i.SourcePartition,
+			&i.SourceOffset,
+			&i.DeadLetterTopic,
+			&i.DeadLetterPartition,
+			&i.DeadLetterOffset,
+			&i.MessageKey,
+			&i.Payload,
+			&i.Headers,
+			&i.ErrorMessage,
+			&i.Attempts,
+			&i.FirstSeenAt,
+			&i.DeadLetteredAt,
+			&i.DeadLetterStatus,
+			&i.ReplayCount,
+			&i.ReplayedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markDeadLetterMessageReplayed = `-- name: MarkDeadLetterMessageReplayed :one
+UPDATE dead_letter_message
+SET
+    dead_letter_status = $1::varchar,
+    replay_count = replay_count + 1,
+    replayed_at = $2::timestamptz
+WHERE uid = $3::varchar
+RETURNING uid, source_topic, source_partition, source_offset, dead_letter_topic, dead_letter_partition, dead_letter_offset, message_key, payload, headers, error_message, attempts, first_seen_at, dead_lettered_at, dead_letter_status, replay_count, replayed_at, created_at
+`
+
+type MarkDeadLetterMessageReplayedParams struct {
+	DeadLetterStatus string             `json:"dead_letter_status"`
+	ReplayedAt       pgtype.Timestamptz `json:"replayed_at"`
+	Uid              string             `json:"uid"`
+}
+
+func (q *Queries) MarkDeadLetterMessageReplayed(ctx context.Context, arg *MarkDeadLetterMessageReplayedParams) (*DeadLetterMessage, error) {
+	row := q.db.QueryRow(ctx, markDeadLetterMessageReplayed, arg.DeadLetterStatus, arg.ReplayedAt, arg.Uid)
+	var i DeadLetterMessage
+	err := row.Scan(
+		&i.Uid,
+		&i.SourceTopic,
+		&i.SourcePartition,
+		&i.SourceOffset,
+		&i.DeadLetterTopic,
+		&i.DeadLetterPartition,
+		&i.DeadLetterOffset,
+		&i.MessageKey,
+		&i.Payload,
+		&i.Headers,
+		&i.ErrorMessage,
+		&i.Attempts,
+		&i.FirstSeenAt,
+		&i.DeadLetteredAt,
+		&i.DeadLetterStatus,
+		&i.ReplayCount,
+		&i.ReplayedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_DEAD_LETTER_MESSAGE(t *testing.T) {
+	arg := createRandomDeadLetterMessageParams(t)
+
+	msg, err := testStore.CreateDeadLetterMessage(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Uid, msg.Uid)
+	require.Equal(t, arg.Payload, msg.Payload)
+	require.JSONEq(t, string(arg.Headers), string(msg.Headers))
+	require.Equal(t, payment.DEAD_LETTER_STATUS_QUARANTINED, msg.DeadLetterStatus)
+	require.Zero(t, msg.ReplayCount)
+	require.False(t, msg.ReplayedAt.Valid)
+
+	// the same dead-letter offset read again is not quarantined twice.
+	duplicate := *arg
+	duplicate.Uid = helper.RandomString(26)
+	_, err = testStore.CreateDeadLetterMessage(context.TODO(), &duplicate)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	found, err := testStore.GetDeadLetterMessage(context.TODO(), msg.Uid)
+	require.NoError(t, err)
+	require.Equal(t, msg.Uid, found.Uid)
+
+	sourceTopic := pgtype.Text{String: arg.SourceTopic, Valid: true}
+	list, err := testStore.ListDeadLetterMessages(context.TODO(), &ListDeadLetterMessagesParams{
+		SourceTopic:          sourceTopic,
+		DeadLetteredFrom:     pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		DeadLetteredTo:       pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		BeforeDeadLetteredAt: pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true},
+		PageSize:             10,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, msg.Uid, list[0].Uid)
+
+	count, err := testStore.CountDeadLetterMessages(context.TODO(), &CountDeadLetterMessagesParams{
+		SourceTopic:      sourceTopic,
+		DeadLetteredFrom: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		DeadLetteredTo:   pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	for i := 1; i <= 2; i++ {
+		replayed, err := testStore.MarkDeadLetterMessageReplayed(context.TODO(), &MarkDeadLetterMessageReplayedParams{
+			DeadLetterStatus: payment.DEAD_LETTER_STATUS_REPLAYED,
+			ReplayedAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			Uid:              msg.Uid,
+		})
+		require.NoError(t, err)
+		require.Equal(t, payment.DEAD_LETTER_STATUS_REPLAYED, replayed.DeadLetterStatus)
+		require.Equal(t, int32(i), replayed.ReplayCount)
+		require.True(t, replayed.ReplayedAt.Valid)
+	}
+}
+
+func createRandomDeadLetterMessageParams(t *testing.T) *CreateDeadLetterMessageParams {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	return &CreateDeadLetterMessageParams{
+		Uid:                 ulid.String(),
+		SourceTopic:         helper.RandomString(12),
+		SourcePartition:     0,
+		SourceOffset:        helper.RandomInt(1, 1000),
+		DeadLetterTopic:     helper.RandomString(12),
+		DeadLetterPartition: 0,
+		DeadLetterOffset:    helper.RandomInt(1, 1000),
+		MessageKey:          []byte(ulid.String()),
+		Payload:             []byte(helper.RandomString(64)),
+		Headers:             []byte(`[{"key":"x-attempts","value":"4"}]`),
+		ErrorMessage:        "m.usecase.Update.err",
+		Attempts:            4,
+		FirstSeenAt:         pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+		DeadLetteredAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		DeadLetterStatus:    payment.DEAD_LETTER_STATUS_QUARANTINED,
+		CreatedAt:           pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+}
@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: idempotency_key_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :one
+UPDATE idempotency_key
+SET
+    idempotency_status = $1::varchar,
+    response_payload = $2::bytea,
+    completed_at = $3::timestamptz
+WHERE idempotency_key = $4::varchar
+    AND fencing_token = $5::bigint
+RETURNING idempotency_key, request_fingerprint, idempotency_status, fencing_token, response_payload, created_at, completed_at, reserved_until
+`
+
+type CompleteIdempotencyKeyParams struct {
+	IdempotencyStatus string             `json:"idempotency_status"`
+	ResponsePayload   []byte             `json:"response_payload"`
+	CompletedAt       pgtype.Timestamptz `json:"completed_at"`
+	IdempotencyKey    string             `json:"idempotency_key"`
+	FencingToken      int64              `json:"fencing_token"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg *CompleteIdempotencyKeyParams) (*IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, completeIdempotencyKey,
+		arg.IdempotencyStatus,
+		arg.ResponsePayload,
+		arg.CompletedAt,
+		arg.IdempotencyKey,
+		arg.FencingToken,
+	)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.IdempotencyKey,
+		&i.RequestFingerprint,
+		&i.IdempotencyStatus,
+		&i.FencingToken,
+		&i.ResponsePayload,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ReservedUntil,
+	)
+	return &i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT idempotency_key, request_fingerprint, idempotency_status, fencing_token, response_payload, created_at, completed_at, reserved_until FROM idempotency_key
+WHERE idempotency_key = $1 LIMIT 1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, idempotencyKey string) (*IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, idempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.IdempotencyKey,
+		&i.RequestFingerprint,
+		&i.IdempotencyStatus,
+		&i.FencingToken,
+		&i.ResponsePayload,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ReservedUntil,
+	)
+	return &i, err
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :one
+INSERT INTO idempotency_key (
+    idempotency_key,
+    request_fingerprint,
+    idempotency_status,
+    fencing_token,
+    created_at,
+    reserved_until
+) VALUES (
+    $1, $2, $3, 1, $4, $5
+)
+ON CONFLICT (idempotency_key) DO UPDATE
+SET
+    fencing_token = idempotency_key.fencing_token + 1,
+    reserved_until = EXCLUDED.reserved_until
+WHERE idempotency_key.idempotency_status = EXCLUDED.idempotency_status
+    AND idempotency_key.request_fingerprint = EXCLUDED.request_fingerprint
+    AND idempotency_key.reserved_until < now()
+RETURNING idempotency_key, request_fingerprint, idempotency_status, fencing_token, response_payload, created_at, completed_at, reserved_until
+`
+
+type ReserveIdempotencyKeyParams struct {
+	IdempotencyKey     string             `json:"idempotency_key"`
+	RequestFingerprint string             `json:"request_fingerprint"`
+	IdempotencyStatus  string             `json:"idempotency_status"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	ReservedUntil      pgtype.Timestamptz `json:"reserved_until"`
+}
+
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg *ReserveIdempotencyKeyParams) (*IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, reserveIdempotencyKey,
+		arg.IdempotencyKey,
+		arg.RequestFingerprint,
+		arg.IdempotencyStatus,
+		arg.CreatedAt,
+		arg.ReservedUntil,
+	)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.IdempotencyKey,
+		&i.RequestFingerprint,
+		&i.IdempotencyStatus,
+		&i.FencingToken,
+		&i.ResponsePayload,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ReservedUntil,
+	)
+	return &i, err
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_IDEMPOTENCY_KEY(t *testing.T) {
+	// the lease of the first holder has already run out.
+	arg := &ReserveIdempotencyKeyParams{
+		IdempotencyKey:     helper.RandomString(26),
+		RequestFingerprint: helper.RandomString(64),
+		IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_IN_PROGRESS,
+		CreatedAt:          pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		ReservedUntil:      pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+	}
+
+	reserved, err := testStore.ReserveIdempotencyKey(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.RequestFingerprint, reserved.RequestFingerprint)
+	require.Equal(t, int64(1), reserved.FencingToken)
+
+	// another body under the same key never takes the reservation over.
+	otherBody := *arg
+	otherBody.RequestFingerprint = helper.RandomString(64)
+	_, err = testStore.ReserveIdempotencyKey(context.TODO(), &otherBody)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	// a stale reservation is taken over with the next fencing token.
+	leased := *arg
+	leased.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	leased.ReservedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+	reserved, err = testStore.ReserveIdempotencyKey(context.TODO(), &leased)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), reserved.FencingToken)
+	require.True(t, reserved.ReservedUntil.Time.After(time.Now()))
+
+	// a reservation whose lease still runs is never taken over, even once redis forgot it.
+	_, err = testStore.ReserveIdempotencyKey(context.TODO(), &leased)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	// the request fenced off by the takeover can no longer complete the key.
+	complete := &CompleteIdempotencyKeyParams{
+		IdempotencyStatus: payment.IDEMPOTENCY_STATUS_COMPLETED,
+		ResponsePayload:   []byte(helper.RandomString(64)),
+		CompletedAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		IdempotencyKey:    arg.IdempotencyKey,
+		FencingToken:      1,
+	}
+	_, err = testStore.CompleteIdempotencyKey(context.TODO(), complete)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	complete.FencingToken = 2
+	completed, err := testStore.CompleteIdempotencyKey(context.TODO(), complete)
+	require.NoError(t, err)
+	require.Equal(t, payment.IDEMPOTENCY_STATUS_COMPLETED, completed.IdempotencyStatus)
+	require.Equal(t, complete.ResponsePayload, completed.ResponsePayload)
+
+	// a completed key is only replayed, it is never reserved again.
+	_, err = testStore.ReserveIdempotencyKey(context.TODO(), arg)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	found, err := testStore.GetIdempotencyKey(context.TODO(), arg.IdempotencyKey)
+	require.NoError(t, err)
+	require.Equal(t, payment.IDEMPOTENCY_STATUS_COMPLETED, found.IdempotencyStatus)
+	require.True(t, found.CompletedAt.Valid)
+}
@@ -0,0 +1,254 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: invoice_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createInvoice = `-- name: CreateInvoice :one
+INSERT INTO invoice (
+    uid,
+    invoice_id,
+    invoice_reference_id,
+    invoice_business_id,
+    payment_customer_id,
+    invoice_status,
+    invoice_amount,
+    currency,
+    invoice_allowed_channels,
+    invoice_url,
+    invoice_description,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING uid, invoice_id, invoice_reference_id, invoice_business_id, payment_customer_id, invoice_status, invoice_amount, currency, invoice_allowed_channels, invoice_url, invoice_description, created_at, updated_at, expires_at, paid_at
+`
+
+type CreateInvoiceParams struct {
+	Uid                    string             `json:"uid"`
+	InvoiceID              string             `json:"invoice_id"`
+	InvoiceReferenceID     string             `json:"invoice_reference_id"`
+	InvoiceBusinessID      string             `json:"invoice_business_id"`
+	PaymentCustomerID      string             `json:"payment_customer_id"`
+	InvoiceStatus          string             `json:"invoice_status"`
+	InvoiceAmount          decimal.Decimal    `json:"invoice_amount"`
+	Currency               string             `json:"currency"`
+	InvoiceAllowedChannels []string           `json:"invoice_allowed_channels"`
+	InvoiceUrl             string             `json:"invoice_url"`
+	InvoiceDescription     string             `json:"invoice_description"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt              pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateInvoice(ctx context.Context, arg *CreateInvoiceParams) (*Invoice, error) {
+	row := q.db.QueryRow(ctx, createInvoice,
+		arg.Uid,
+		arg.InvoiceID,
+		arg.InvoiceReferenceID,
+		arg.InvoiceBusinessID,
+		arg.PaymentCustomerID,
+		arg.InvoiceStatus,
+		arg.InvoiceAmount,
+		arg.Currency,
+		arg.InvoiceAllowedChannels,
+		arg.InvoiceUrl,
+		arg.InvoiceDescription,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.Uid,
+		&i.InvoiceID,
+		&i.InvoiceReferenceID,
+		&i.InvoiceBusinessID,
+		&i.PaymentCustomerID,
+		&i.InvoiceStatus,
+		&i.InvoiceAmount,
+		&i.Currency,
+		&i.InvoiceAllowedChannels,
+		&i.InvoiceUrl,
+		&i.InvoiceDescription,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+	)
+	return &i, err
+}
+
+const getInvoice = `-- name: GetInvoice :one
+SELECT uid, invoice_id, invoice_reference_id, invoice_business_id, payment_customer_id, invoice_status, invoice_amount, currency, invoice_allowed_channels, invoice_url, invoice_description, created_at, updated_at, expires_at, paid_at FROM invoice WHERE uid = $1 LIMIT 1
+`
+
+func (q *Queries) GetInvoice(ctx context.Context, uid string) (*Invoice, error) {
+	row := q.db.QueryRow(ctx, getInvoice, uid)
+	var i Invoice
+	err := row.Scan(
+		&i.Uid,
+		&i.InvoiceID,
+		&i.InvoiceReferenceID,
+		&i.InvoiceBusinessID,
+		&i.PaymentCustomerID,
+		&i.InvoiceStatus,
+		&i.InvoiceAmount,
+		&i.Currency,
+		&i.InvoiceAllowedChannels,
+		&i.InvoiceUrl,
+		&i.InvoiceDescription,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+	)
+	return &i, err
+}
+
+const getInvoiceByInvoiceID = `-- name: GetInvoiceByInvoiceID :one
+SELECT uid, invoice_id, invoice_reference_id, invoice_business_id, payment_customer_id, invoice_status, invoice_amount, currency, invoice_allowed_channels, invoice_url, invoice_description, created_at, updated_at, expires_at, paid_at FROM invoice WHERE invoice_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetInvoiceByInvoiceID(ctx context.Context, invoiceID string) (*Invoice, error) {
+	row := q.db.QueryRow(ctx, getInvoiceByInvoiceID, invoiceID)
+	var i Invoice
+	err := row.Scan(
+		&i.Uid,
+		&i.InvoiceID,
+		&i.InvoiceReferenceID,
+		&i.InvoiceBusinessID,
+		&i.PaymentCustomerID,
+		&i.InvoiceStatus,
+		&i.InvoiceAmount,
+		&i.Currency,
+		&i.InvoiceAllowedChannels,
+		&i.InvoiceUrl,
+		&i.InvoiceDescription,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+	)
+	return &i, err
+}
+
+const getInvoiceByInvoiceIDForUpdate = `-- name: GetInvoiceByInvoiceIDForUpdate :one
+SELECT uid, invoice_id, invoice_reference_id, invoice_business_id, payment_customer_id, invoice_status, invoice_amount, currency, invoice_allowed_channels, invoice_url, invoice_description, created_at, updated_at, expires_at, paid_at FROM invoice WHERE invoice_id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetInvoiceByInvoiceIDForUpdate(ctx context.Context, invoiceID string) (*Invoice, error) {
+	row := q.db.QueryRow(ctx, getInvoiceByInvoiceIDForUpdate, invoiceID)
+	var i Invoice
+	err := row.Scan(
+		&i.Uid,
+		&i.InvoiceID,
+		&i.InvoiceReferenceID,
+		&i.InvoiceBusinessID,
+		&i.PaymentCustomerID,
+		&i.InvoiceStatus,
+		&i.InvoiceAmount,
+		&i.Currency,
+		&i.InvoiceAllowedChannels,
+		&i.InvoiceUrl,
+		&i.InvoiceDescription,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+	)
+	return &i, err
+}
+
+const getPaymentMethodByInvoiceUid = `-- name: GetPaymentMethodByInvoiceUid :one
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_invoice_uid = $1 LIMIT 1
+`
+
+func (q *Queries) GetPaymentMethodByInvoiceUid(ctx context.Context, paymentInvoiceUid pgtype.Text) (*PaymentMethod, error) {
+	row := q.db.QueryRow(ctx, getPaymentMethodByInvoiceUid, paymentInvoiceUid)
+	var i PaymentMethod
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.PaymentBusinessID,
+		&i.PaymentCustomerID,
+		&i.PaymentType,
+		&i.PaymentStatus,
+		&i.PaymentReusability,
+		&i.PaymentChannel,
+		&i.PaymentAmount,
+		&i.PaymentQrCode,
+		&i.PaymentVirtualAccountNumber,
+		&i.PaymentUrl,
+		&i.PaymentDescription,
+		&i.PaymentFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
+	)
+	return &i, err
+}
+
+const updateInvoice = `-- name: UpdateInvoice :one
+UPDATE invoice
+SET
+    invoice_status = COALESCE($1, invoice_status),
+    updated_at = COALESCE($2, updated_at),
+    paid_at = COALESCE($3, paid_at)
+WHERE
+    uid = $4
+RETURNING uid, invoice_id, invoice_reference_id, invoice_business_id, payment_customer_id, invoice_status, invoice_amount, currency, invoice_allowed_channels, invoice_url, invoice_description, created_at, updated_at, expires_at, paid_at
+`
+
+type UpdateInvoiceParams struct {
+	InvoiceStatus pgtype.Text        `json:"invoice_status"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	PaidAt        pgtype.Timestamptz `json:"paid_at"`
+	Uid           string             `json:"uid"`
+}
+
+func (q *Queries) UpdateInvoice(ctx context.Context, arg *UpdateInvoiceParams) (*Invoice, error) {
+	row := q.db.QueryRow(ctx, updateInvoice,
+		arg.InvoiceStatus,
+		arg.UpdatedAt,
+		arg.PaidAt,
+		arg.Uid,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.Uid,
+		&i.InvoiceID,
+		&i.InvoiceReferenceID,
+		&i.InvoiceBusinessID,
+		&i.PaymentCustomerID,
+		&i.InvoiceStatus,
+		&i.InvoiceAmount,
+		&i.Currency,
+		&i.InvoiceAllowedChannels,
+		&i.InvoiceUrl,
+		&i.InvoiceDescription,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+	)
+	return &i, err
+}
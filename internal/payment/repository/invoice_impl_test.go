@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_CREATE_INVOICE(t *testing.T) {
+	createRandomInvoice(t, createRandomPaymentChannel(t))
+}
+
+func Test_REPO_GET_INVOICE_BY_INVOICE_ID(t *testing.T) {
+	invoice := createRandomInvoice(t, createRandomPaymentChannel(t))
+
+	res, err := testStore.GetInvoiceByInvoiceID(context.TODO(), invoice.InvoiceID)
+	require.NoError(t, err)
+	require.Equal(t, invoice.Uid, res.Uid)
+	require.Equal(t, invoice.InvoiceAllowedChannels, res.InvoiceAllowedChannels)
+
+	res, err = testStore.GetInvoice(context.TODO(), invoice.Uid)
+	require.NoError(t, err)
+	require.Equal(t, invoice.InvoiceID, res.InvoiceID)
+
+	_, err = testStore.GetInvoiceByInvoiceID(context.TODO(), helper.RandomString(26))
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func Test_REPO_UPDATE_INVOICE(t *testing.T) {
+	invoice := createRandomInvoice(t, createRandomPaymentChannel(t))
+
+	updatedAt := time.Now()
+	res, err := testStore.UpdateInvoice(context.TODO(), &UpdateInvoiceParams{
+		Uid: invoice.Uid,
+		InvoiceStatus: pgtype.Text{
+			String: payment.INVOICE_STATUS_EXPIRED,
+			Valid:  true,
+		},
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  updatedAt,
+			Valid: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.INVOICE_STATUS_EXPIRED, res.InvoiceStatus)
+	require.WithinDuration(t, updatedAt, res.UpdatedAt.Time, time.Second)
+	require.Equal(t, invoice.InvoiceAmount.String(), res.InvoiceAmount.String())
+}
+
+func createRandomInvoice(t *testing.T, channels ...*PaymentChannel) *Invoice {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid.String())
+
+	customer := createRandomCustomer(t)
+
+	allowedChannels := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		allowedChannels = append(allowedChannels, channel.Pcname)
+	}
+
+	arg := CreateInvoiceParams{
+		Uid:                    ulid.String(),
+		InvoiceID:              helper.RandomString(26),
+		InvoiceReferenceID:     helper.RandomString(26),
+		InvoiceBusinessID:      helper.RandomString(26),
+		PaymentCustomerID:      customer.PaymentCustomerID,
+		InvoiceStatus:          payment.INVOICE_STATUS_PENDING,
+		InvoiceAmount:          decimal.NewFromInt(helper.RandomInt(5000, 500000)),
+		Currency:               payment.DEFAULT_CURRENCY,
+		InvoiceAllowedChannels: allowedChannels,
+		InvoiceUrl:             helper.RandomUrl(),
+		InvoiceDescription:     helper.RandomString(100),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Now().Add(24 * 3 * time.Hour),
+			Valid: true,
+		},
+	}
+
+	res, err := testStore.CreateInvoice(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.Equal(t, arg.Uid, res.Uid)
+	require.Equal(t, arg.InvoiceID, res.InvoiceID)
+	require.Equal(t, arg.PaymentCustomerID, res.PaymentCustomerID)
+	require.Equal(t, arg.InvoiceStatus, res.InvoiceStatus)
+	require.Equal(t, arg.InvoiceAmount.String(), res.InvoiceAmount.String())
+	require.Equal(t, arg.InvoiceAllowedChannels, res.InvoiceAllowedChannels)
+
+	return res
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+type ledgerAccountKey struct {
+	code  string
+	owner string
+}
+
+// ledgerTransfer moves an amount from the credited account to the debited one. An entry is only ever
+// made of transfers, each one posting the same amount on both sides, so every entry balances.
+type ledgerTransfer struct {
+	debit  ledgerAccountKey
+	credit ledgerAccountKey
+	amount decimal.Decimal
+}
+
+var (
+	gatewayClearingAccount    = ledgerAccountKey{code: payment.LEDGER_ACCOUNT_GATEWAY_CLEARING}
+	merchantPayableAccount    = ledgerAccountKey{code: payment.LEDGER_ACCOUNT_MERCHANT_PAYABLE}
+	platformFeeRevenueAccount = ledgerAccountKey{code: payment.LEDGER_ACCOUNT_PLATFORM_FEE_REVENUE}
+	gatewayFeeExpenseAccount  = ledgerAccountKey{code: payment.LEDGER_ACCOUNT_GATEWAY_FEE_EXPENSE}
+)
+
+func subAccountPayableAccount(owner string) ledgerAccountKey {
+	return ledgerAccountKey{code: payment.LEDGER_ACCOUNT_SUB_ACCOUNT_PAYABLE, owner: owner}
+}
+
+// payableAccount is the account a payment is owed to, the sub-account it was collected for if any.
+func payableAccount(pm *PaymentMethod) ledgerAccountKey {
+	if pm.PaymentForUserID.Valid && pm.PaymentForUserID.String != "" {
+		return subAccountPayableAccount(pm.PaymentForUserID.String)
+	}
+
+	return merchantPayableAccount
+}
+
+// bookPayment books a succeeded payment: the amount collected at the gateway is owed to the legs of its
+// split, or to the account it was collected for. The channel fee the payment was priced with is booked on
+// its own entry, a sub-account bears the fee of its payments while the merchant books it as an expense.
+func (r *Store) bookPayment(ctx context.Context, q *Queries, pm *PaymentMethod) error {
+	amount := pm.PaymentAmount
+	if pm.PaymentCapturedAmount.IsPositive() {
+		amount = pm.PaymentCapturedAmount
+	}
+
+	// a manual capture may succeed before its captured amount is known, it is booked once the amount comes in.
+	if pm.PaymentCaptureMethod == payment.CAPTURE_METHOD_MANUAL && !pm.PaymentCapturedAmount.IsPositive() {
+		return nil
+	}
+
+	transfers, err := r.paymentTransfers(ctx, q, pm, amount)
+	if err != nil {
+		return err
+	}
+
+	err = r.bookLedgerEntry(ctx, q, &CreateLedgerEntryParams{
+		IdempotencyKey:   helper.StringBuilder(payment.LEDGER_ENTRY_TYPE_PAYMENT, ":", pm.Uid),
+		EntryType:        payment.LEDGER_ENTRY_TYPE_PAYMENT,
+		PaymentMethodUid: pm.Uid,
+		Currency:         pm.Currency,
+		EntryDescription: fmt.Sprintf("payment %s succeeded", pm.PaymentMethodID),
+		CreatedAt:        pm.UpdatedAt,
+	}, transfers)
+	if err != nil {
+		return err
+	}
+
+	feeAccount := gatewayFeeExpenseAccount
+	if pm.PaymentForUserID.Valid && pm.PaymentForUserID.String != "" {
+		feeAccount = subAccountPayableAccount(pm.PaymentForUserID.String)
+	}
+
+	return r.bookLedgerEntry(ctx, q, &CreateLedgerEntryParams{
+		IdempotencyKey:   helper.StringBuilder(payment.LEDGER_ENTRY_TYPE_FEE, ":", pm.Uid),
+		EntryType:        payment.LEDGER_ENTRY_TYPE_FEE,
+		PaymentMethodUid: pm.Uid,
+		Currency:         pm.Currency,
+		EntryDescription: fmt.Sprintf("channel fee of payment %s", pm.PaymentMethodID),
+		CreatedAt:        pm.UpdatedAt,
+	}, []*ledgerTransfer{{debit: feeAccount, credit: gatewayClearingAccount, amount: pm.PaymentFeeAmount}})
+}
+
+func (r *Store) paymentTransfers(ctx context.Context, q *Queries, pm *PaymentMethod, amount decimal.Decimal) ([]*ledgerTransfer, error) {
+	if !pm.PaymentForUserID.Valid {
+		return []*ledgerTransfer{{debit: gatewayClearingAccount, credit: merchantPayableAccount, amount: amount}}, nil
+	}
+
+	splits, err := q.ListPaymentSplitsByPaymentMethodUid(ctx, pm.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("q.ListPaymentSplitsByPaymentMethodUid.err: %w", err)
+	}
+
+	// the remainder is whatever the other legs leave of the amount actually collected.
+	remainder := amount
+	transfers := make([]*ledgerTransfer, 0, len(splits)+1)
+	for _, split := range splits {
+		switch split.SplitType {
+		case payment.SPLIT_TYPE_PLATFORM_FEE:
+			transfers = append(transfers, &ledgerTransfer{debit: gatewayClearingAccount, credit: platformFeeRevenueAccount, amount: split.SplitAmount})
+		case payment.SPLIT_TYPE_ROUTE:
+			transfers = append(transfers, &ledgerTransfer{debit: gatewayClearingAccount, credit: subAccountPayableAccount(split.DestinationAccountID), amount: split.SplitAmount})
+		default:
+			continue
+		}
+
+		remainder = remainder.Sub(split.SplitAmount)
+	}
+
+	if remainder.IsNegative() {
+		return nil, fmt.Errorf("split of payment %s exceeds the amount collected by %s", pm.PaymentMethodID, remainder.Neg())
+	}
+
+	transfers = append(transfers, &ledgerTransfer{debit: gatewayClearingAccount, credit: payableAccount(pm), amount: remainder})
+
+	return transfers, nil
+}
+
+// bookRefund books a succeeded refund, it is paid back out of the account the payment is owed to.
+func (r *Store) bookRefund(ctx context.Context, q *Queries, pm *PaymentMethod, refund *Refund) error {
+	return r.bookLedgerEntry(ctx, q, &CreateLedgerEntryParams{
+		IdempotencyKey:   helper.StringBuilder(payment.LEDGER_ENTRY_TYPE_REFUND, ":", refund.Uid),
+		EntryType:        payment.LEDGER_ENTRY_TYPE_REFUND,
+		PaymentMethodUid: pm.Uid,
+		RefundUid:        pgtype.Text{String: refund.Uid, Valid: true},
+		Currency:         refund.Currency,
+		EntryDescription: fmt.Sprintf("refund %s of payment %s succeeded", refund.RefundReferenceID, pm.PaymentMethodID),
+		CreatedAt:        refund.UpdatedAt,
+	}, []*ledgerTransfer{{debit: payableAccount(pm), credit: gatewayClearingAccount, amount: refund.RefundAmount}})
+}
+
+// bookLedgerEntry writes an entry with the postings of its transfers, an entry which has already been
+// booked under the same idempotency key is left as it is.
+func (r *Store) bookLedgerEntry(ctx context.Context, q *Queries, entry *CreateLedgerEntryParams, transfers []*ledgerTransfer) error {
+	postings := make([]*ledgerTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		if transfer.amount.IsPositive() {
+			postings = append(postings, transfer)
+		}
+	}
+
+	if len(postings) == 0 {
+		return nil
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return err
+	}
+
+	entry.Uid = uid.String()
+	if !entry.CreatedAt.Valid || entry.CreatedAt.Time.IsZero() {
+		entry.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+
+	res, err := q.CreateLedgerEntry(ctx, entry)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("q.CreateLedgerEntry.err: %w", err)
+	}
+
+	accounts := make(map[ledgerAccountKey]*LedgerAccount)
+	for _, transfer := range postings {
+		if err := r.createLedgerPosting(ctx, q, res, accounts, transfer.debit, payment.LEDGER_DIRECTION_DEBIT, transfer.amount); err != nil {
+			return err
+		}
+
+		if err := r.createLedgerPosting(ctx, q, res, accounts, transfer.credit, payment.LEDGER_DIRECTION_CREDIT, transfer.amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Store) createLedgerPosting(
+	ctx context.Context,
+	q *Queries,
+	entry *LedgerEntry,
+	accounts map[ledgerAccountKey]*LedgerAccount,
+	key ledgerAccountKey,
+	direction string,
+	amount decimal.Decimal,
+) error {
+	account, ok := accounts[key]
+	if !ok {
+		uid, err := helper.GenerateULID()
+		if err != nil {
+			return err
+		}
+
+		account, err = q.UpsertLedgerAccount(ctx, &UpsertLedgerAccountParams{
+			Uid:          uid.String(),
+			AccountCode:  key.code,
+			AccountOwner: key.owner,
+			AccountType:  payment.LedgerAccountType(key.code),
+			Currency:     entry.Currency,
+			CreatedAt:    entry.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("q.UpsertLedgerAccount.err: %w", err)
+		}
+
+		accounts[key] = account
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateLedgerPosting(ctx, &CreateLedgerPostingParams{
+		Uid:        uid.String(),
+		EntryUid:   entry.Uid,
+		AccountUid: account.Uid,
+		Direction:  direction,
+		Amount:     amount,
+		Currency:   entry.Currency,
+		CreatedAt:  entry.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("q.CreateLedgerPosting.err: %w", err)
+	}
+
+	return nil
+}
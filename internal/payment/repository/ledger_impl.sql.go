@@ -0,0 +1,308 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: ledger_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createLedgerEntry = `-- name: CreateLedgerEntry :one
+INSERT INTO ledger_entry (
+    uid,
+    idempotency_key,
+    entry_type,
+    payment_method_uid,
+    refund_uid,
+    currency,
+    entry_description,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) ON CONFLICT (idempotency_key) DO NOTHING
+RETURNING uid, idempotency_key, entry_type, payment_method_uid, refund_uid, currency, entry_description, created_at
+`
+
+type CreateLedgerEntryParams struct {
+	Uid              string             `json:"uid"`
+	IdempotencyKey   string             `json:"idempotency_key"`
+	EntryType        string             `json:"entry_type"`
+	PaymentMethodUid string             `json:"payment_method_uid"`
+	RefundUid        pgtype.Text        `json:"refund_uid"`
+	Currency         string             `json:"currency"`
+	EntryDescription string             `json:"entry_description"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateLedgerEntry(ctx context.Context, arg *CreateLedgerEntryParams) (*LedgerEntry, error) {
+	row := q.db.QueryRow(ctx, createLedgerEntry,
+		arg.Uid,
+		arg.IdempotencyKey,
+		arg.EntryType,
+		arg.PaymentMethodUid,
+		arg.RefundUid,
+		arg.Currency,
+		arg.EntryDescription,
+		arg.CreatedAt,
+	)
+	var i LedgerEntry
+	err := row.Scan(
+		&i.Uid,
+		&i.IdempotencyKey,
+		&i.EntryType,
+		&i.PaymentMethodUid,
+		&i.RefundUid,
+		&i.Currency,
+		&i.EntryDescription,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const createLedgerPosting = `-- name: CreateLedgerPosting :one
+INSERT INTO ledger_posting (
+    uid,
+    entry_uid,
+    account_uid,
+    direction,
+    amount,
+    currency,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING uid, entry_uid, account_uid, direction, amount, currency, created_at
+`
+
+type CreateLedgerPostingParams struct {
+	Uid        string             `json:"uid"`
+	EntryUid   string             `json:"entry_uid"`
+	AccountUid string             `json:"account_uid"`
+	Direction  string             `json:"direction"`
+	Amount     decimal.Decimal    `json:"amount"`
+	Currency   string             `json:"currency"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateLedgerPosting(ctx context.Context, arg *CreateLedgerPostingParams) (*LedgerPosting, error) {
+	row := q.db.QueryRow(ctx, createLedgerPosting,
+		arg.Uid,
+		arg.EntryUid,
+		arg.AccountUid,
+		arg.Direction,
+		arg.Amount,
+		arg.Currency,
+		arg.CreatedAt,
+	)
+	var i LedgerPosting
+	err := row.Scan(
+		&i.Uid,
+		&i.EntryUid,
+		&i.AccountUid,
+		&i.Direction,
+		&i.Amount,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listLedgerAccountBalances = `-- name: ListLedgerAccountBalances :many
+SELECT
+    ledger_account.uid, ledger_account.account_code, ledger_account.account_owner, ledger_account.account_type, ledger_account.currency, ledger_account.created_at,
+    COALESCE(SUM(ledger_posting.amount) FILTER (WHERE ledger_posting.direction = 'DEBIT'), 0)::numeric AS debit_amount,
+    COALESCE(SUM(ledger_posting.amount) FILTER (WHERE ledger_posting.direction = 'CREDIT'), 0)::numeric AS credit_amount
+FROM ledger_account
+LEFT JOIN ledger_posting ON ledger_posting.account_uid = ledger_account.uid
+WHERE
+    ($1::varchar IS NULL OR ledger_account.currency = $1) AND
+    ($2::varchar IS NULL OR ledger_account.account_code = $2) AND
+    ($3::varchar IS NULL OR ledger_account.account_owner = $3)
+GROUP BY ledger_account.uid
+ORDER BY ledger_account.account_code ASC, ledger_account.account_owner ASC, ledger_account.currency ASC
+`
+
+type ListLedgerAccountBalancesParams struct {
+	Currency     pgtype.Text `json:"currency"`
+	AccountCode  pgtype.Text `json:"account_code"`
+	AccountOwner pgtype.Text `json:"account_owner"`
+}
+
+type ListLedgerAccountBalancesRow struct {
+	Uid          string             `json:"uid"`
+	AccountCode  string             `json:"account_code"`
+	AccountOwner string             `json:"account_owner"`
+	AccountType  string             `json:"account_type"`
+	Currency     string             `json:"currency"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	DebitAmount  decimal.Decimal    `json:"debit_amount"`
+	CreditAmount decimal.Decimal    `json:"credit_amount"`
+}
+
+func (q *Queries) ListLedgerAccountBalances(ctx context.Context, arg *ListLedgerAccountBalancesParams) ([]*ListLedgerAccountBalancesRow, error) {
+	rows, err := q.db.Query(ctx, listLedgerAccountBalances, arg.Currency, arg.AccountCode, arg.AccountOwner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListLedgerAccountBalancesRow{}
+	for rows.Next() {
+		var i ListLedgerAccountBalancesRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.AccountCode,
+			&i.AccountOwner,
+			&i.AccountType,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.DebitAmount,
+			&i.CreditAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLedgerEntriesByPaymentMethodUid = `-- name: ListLedgerEntriesByPaymentMethodUid :many
+SELECT uid, idempotency_key, entry_type, payment_method_uid, refund_uid, currency, entry_description, created_at FROM ledger_entry WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC
+`
+
+func (q *Queries) ListLedgerEntriesByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*LedgerEntry, error) {
+	rows, err := q.db.Query(ctx, listLedgerEntriesByPaymentMethodUid, paymentMethodUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*LedgerEntry{}
+	for rows.Next() {
+		var i LedgerEntry
+		if err := rows.Scan(
+			&i.Uid,
+			&i.IdempotencyKey,
+			&i.EntryType,
+			&i.PaymentMethodUid,
+			&i.RefundUid,
+			&i.Currency,
+			&i.EntryDescription,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLedgerPostingsByPaymentMethodUid = `-- name: ListLedgerPostingsByPaymentMethodUid :many
+SELECT
+    ledger_posting.uid, ledger_posting.entry_uid, ledger_posting.account_uid, ledger_posting.direction, ledger_posting.amount, ledger_posting.currency, ledger_posting.created_at,
+    ledger_account.account_code,
+    ledger_account.account_owner,
+    ledger_account.account_type
+FROM ledger_posting
+JOIN ledger_entry ON ledger_entry.uid = ledger_posting.entry_uid
+JOIN ledger_account ON ledger_account.uid = ledger_posting.account_uid
+WHERE ledger_entry.payment_method_uid = $1
+ORDER BY ledger_posting.created_at ASC, ledger_posting.uid ASC
+`
+
+type ListLedgerPostingsByPaymentMethodUidRow struct {
+	Uid          string             `json:"uid"`
+	EntryUid     string             `json:"entry_uid"`
+	AccountUid   string             `json:"account_uid"`
+	Direction    string             `json:"direction"`
+	Amount       decimal.Decimal    `json:"amount"`
+	Currency     string             `json:"currency"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	AccountCode  string             `json:"account_code"`
+	AccountOwner string             `json:"account_owner"`
+	AccountType  string             `json:"account_type"`
+}
+
+func (q *Queries) ListLedgerPostingsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*ListLedgerPostingsByPaymentMethodUidRow, error) {
+	rows, err := q.db.Query(ctx, listLedgerPostingsByPaymentMethodUid, paymentMethodUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListLedgerPostingsByPaymentMethodUidRow{}
+	for rows.Next() {
+		var i ListLedgerPostingsByPaymentMethodUidRow
+		if err := rows.Scan(
+			&i.Uid,
+			&i.EntryUid,
+			&i.AccountUid,
+			&i.Direction,
+			&i.Amount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.AccountCode,
+			&i.AccountOwner,
+			&i.AccountType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertLedgerAccount = `-- name: UpsertLedgerAccount :one
+INSERT INTO ledger_account (
+    uid,
+    account_code,
+    account_owner,
+    account_type,
+    currency,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (account_code, account_owner, currency) DO UPDATE SET
+    account_code = EXCLUDED.account_code
+RETURNING uid, account_code, account_owner, account_type, currency, created_at
+`
+
+type UpsertLedgerAccountParams struct {
+	Uid          string             `json:"uid"`
+	AccountCode  string             `json:"account_code"`
+	AccountOwner string             `json:"account_owner"`
+	AccountType  string             `json:"account_type"`
+	Currency     string             `json:"currency"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) UpsertLedgerAccount(ctx context.Context, arg *UpsertLedgerAccountParams) (*LedgerAccount, error) {
+	row := q.db.QueryRow(ctx, upsertLedgerAccount,
+		arg.Uid,
+		arg.AccountCode,
+		arg.AccountOwner,
+		arg.AccountType,
+		arg.Currency,
+		arg.CreatedAt,
+	)
+	var i LedgerAccount
+	err := row.Scan(
+		&i.Uid,
+		&i.AccountCode,
+		&i.AccountOwner,
+		&i.AccountType,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_LEDGER_BOOK_PAYMENT(t *testing.T) {
+	pm := createRandomActivePaymentMethod(t)
+
+	arg := UpdatePaymentMethodCustomerParams{
+		PaymentStatus: pgtype.Text{
+			String: payment.STATUS_SUCCEEDED,
+			Valid:  true,
+		},
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+	}
+
+	_, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{UpdateParams: arg})
+	require.NoError(t, err)
+
+	// a redelivered callback books nothing twice.
+	_, err = testStore.UpdateTx(context.TODO(), &UpdateTxParams{UpdateParams: arg})
+	require.NoError(t, err)
+
+	entries, err := testStore.ListLedgerEntriesByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, payment.LEDGER_ENTRY_TYPE_PAYMENT, entries[0].EntryType)
+
+	postings, err := testStore.ListLedgerPostingsByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, postings, 2)
+
+	debit, credit := decimal.Zero, decimal.Zero
+	for _, posting := range postings {
+		require.Equal(t, entries[0].Uid, posting.EntryUid)
+
+		switch posting.Direction {
+		case payment.LEDGER_DIRECTION_DEBIT:
+			require.Equal(t, payment.LEDGER_ACCOUNT_GATEWAY_CLEARING, posting.AccountCode)
+			debit = debit.Add(posting.Amount)
+		case payment.LEDGER_DIRECTION_CREDIT:
+			require.Equal(t, payment.LEDGER_ACCOUNT_MERCHANT_PAYABLE, posting.AccountCode)
+			credit = credit.Add(posting.Amount)
+		}
+	}
+
+	require.Equal(t, pm.PaymentAmount.String(), debit.String())
+	require.True(t, debit.Equal(credit))
+}
+
+func Test_REPO_LEDGER_ACCOUNT_BALANCES(t *testing.T) {
+	pm := createRandomActivePaymentMethod(t)
+
+	_, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{UpdateParams: UpdatePaymentMethodCustomerParams{
+		PaymentStatus: pgtype.Text{
+			String: payment.STATUS_SUCCEEDED,
+			Valid:  true,
+		},
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+	}})
+	require.NoError(t, err)
+
+	balances, err := testStore.ListLedgerAccountBalances(context.TODO(), &ListLedgerAccountBalancesParams{
+		Currency: pgtype.Text{
+			String: pm.Currency,
+			Valid:  true,
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, balances)
+
+	// every entry balances, so all the accounts together do too.
+	debit, credit := decimal.Zero, decimal.Zero
+	for _, balance := range balances {
+		require.Equal(t, pm.Currency, balance.Currency)
+		debit = debit.Add(balance.DebitAmount)
+		credit = credit.Add(balance.CreditAmount)
+	}
+
+	require.True(t, debit.Equal(credit))
+}
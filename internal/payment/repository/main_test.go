@@ -11,7 +11,6 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/xendit/xendit-go/v5"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/config"
 	postgres "github.com/handysuherman/clean-arch-payment-service/internal/pkg/databases/postgresql"
@@ -21,12 +20,11 @@ import (
 )
 
 var (
-	testStore    Repository
-	cfg          *config.App
-	tlog         logger.Logger
-	pqConn       *pgxpool.Pool
-	xenditClient *xendit.APIClient
-	rConn        redis.UniversalClient
+	testStore Repository
+	cfg       *config.App
+	tlog      logger.Logger
+	pqConn    *pgxpool.Pool
+	rConn     redis.UniversalClient
 )
 
 func TestMain(m *testing.M) {
@@ -102,8 +100,6 @@ func TestMain(m *testing.M) {
 	redisConn, _ := redisDb.NewUniversalRedisClient(ctx, &redisOpt)
 	rConn = redisConn
 
-	xenditClient = xendit.NewClient(cfg.Services.Internal.PaymentGatewayKeys.Development)
-
-	testStore = NewStore(logger, cfg, pgxConn, redisConn, xenditClient)
+	testStore = NewStore(logger, cfg, pgxConn, redisConn)
 	os.Exit(m.Run())
 }
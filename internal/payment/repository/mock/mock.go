@@ -13,15 +13,13 @@ import (
 	context "context"
 	reflect "reflect"
 
-	pgxpool "github.com/jackc/pgx/v5/pgxpool"
-	redis "github.com/redis/go-redis/v9"
-	decimal "github.com/shopspring/decimal"
 	config "github.com/handysuherman/clean-arch-payment-service/internal/config"
 	repository "github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	pb "github.com/handysuherman/clean-arch-payment-service/internal/pb"
-	customer "github.com/xendit/xendit-go/v5/customer"
-	payment_method "github.com/xendit/xendit-go/v5/payment_method"
-	payment_request "github.com/xendit/xendit-go/v5/payment_request"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+	redis "github.com/redis/go-redis/v9"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -48,34 +46,155 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateCustomer mocks base method.
-func (m *MockRepository) CreateCustomer(ctx context.Context, arg *repository.CreateCustomerParams) (*repository.Customer, error) {
+// AcquireCreatePaymentLock mocks base method.
+func (m *MockRepository) AcquireCreatePaymentLock(ctx context.Context, key string) (string, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomer", ctx, arg)
-	ret0, _ := ret[0].(*repository.Customer)
+	ret := m.ctrl.Call(m, "AcquireCreatePaymentLock", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AcquireCreatePaymentLock indicates an expected call of AcquireCreatePaymentLock.
+func (mr *MockRepositoryMockRecorder) AcquireCreatePaymentLock(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireCreatePaymentLock", reflect.TypeOf((*MockRepository)(nil).AcquireCreatePaymentLock), ctx, key)
+}
+
+// AcquireExpirySweeperLock mocks base method.
+func (m *MockRepository) AcquireExpirySweeperLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireExpirySweeperLock", ctx, token)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateCustomer indicates an expected call of CreateCustomer.
-func (mr *MockRepositoryMockRecorder) CreateCustomer(ctx, arg any) *gomock.Call {
+// AcquireExpirySweeperLock indicates an expected call of AcquireExpirySweeperLock.
+func (mr *MockRepositoryMockRecorder) AcquireExpirySweeperLock(ctx, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockRepository)(nil).CreateCustomer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireExpirySweeperLock", reflect.TypeOf((*MockRepository)(nil).AcquireExpirySweeperLock), ctx, token)
+}
+
+// AcquireOutboxRelayLock mocks base method.
+func (m *MockRepository) AcquireOutboxRelayLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireOutboxRelayLock", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireOutboxRelayLock indicates an expected call of AcquireOutboxRelayLock.
+func (mr *MockRepositoryMockRecorder) AcquireOutboxRelayLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireOutboxRelayLock", reflect.TypeOf((*MockRepository)(nil).AcquireOutboxRelayLock), ctx, token)
+}
+
+// AcquireReconciliationLock mocks base method.
+func (m *MockRepository) AcquireReconciliationLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireReconciliationLock", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireReconciliationLock indicates an expected call of AcquireReconciliationLock.
+func (mr *MockRepositoryMockRecorder) AcquireReconciliationLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireReconciliationLock", reflect.TypeOf((*MockRepository)(nil).AcquireReconciliationLock), ctx, token)
+}
+
+// CompleteIdempotencyKey mocks base method.
+func (m *MockRepository) CompleteIdempotencyKey(ctx context.Context, arg *repository.CompleteIdempotencyKeyParams) (*repository.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", ctx, arg)
+	ret0, _ := ret[0].(*repository.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteIdempotencyKey indicates an expected call of CompleteIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) CompleteIdempotencyKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).CompleteIdempotencyKey), ctx, arg)
+}
+
+// CountDeadLetterMessages mocks base method.
+func (m *MockRepository) CountDeadLetterMessages(ctx context.Context, arg *repository.CountDeadLetterMessagesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeadLetterMessages", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeadLetterMessages indicates an expected call of CountDeadLetterMessages.
+func (mr *MockRepositoryMockRecorder) CountDeadLetterMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeadLetterMessages", reflect.TypeOf((*MockRepository)(nil).CountDeadLetterMessages), ctx, arg)
+}
+
+// CountPaymentMethods mocks base method.
+func (m *MockRepository) CountPaymentMethods(ctx context.Context, arg *repository.CountPaymentMethodsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPaymentMethods", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPaymentMethods indicates an expected call of CountPaymentMethods.
+func (mr *MockRepositoryMockRecorder) CountPaymentMethods(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPaymentMethods", reflect.TypeOf((*MockRepository)(nil).CountPaymentMethods), ctx, arg)
 }
 
-// CreateCustomerPayment mocks base method.
-func (m *MockRepository) CreateCustomerPayment(ctx context.Context, arg *repository.CreateCustomerPaymentParams) (*customer.Customer, error) {
+// CountPaymentMethodsByReferenceIDAndStatuses mocks base method.
+func (m *MockRepository) CountPaymentMethodsByReferenceIDAndStatuses(ctx context.Context, arg *repository.CountPaymentMethodsByReferenceIDAndStatusesParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomerPayment", ctx, arg)
-	ret0, _ := ret[0].(*customer.Customer)
+	ret := m.ctrl.Call(m, "CountPaymentMethodsByReferenceIDAndStatuses", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateCustomerPayment indicates an expected call of CreateCustomerPayment.
-func (mr *MockRepositoryMockRecorder) CreateCustomerPayment(ctx, arg any) *gomock.Call {
+// CountPaymentMethodsByReferenceIDAndStatuses indicates an expected call of CountPaymentMethodsByReferenceIDAndStatuses.
+func (mr *MockRepositoryMockRecorder) CountPaymentMethodsByReferenceIDAndStatuses(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerPayment", reflect.TypeOf((*MockRepository)(nil).CreateCustomerPayment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPaymentMethodsByReferenceIDAndStatuses", reflect.TypeOf((*MockRepository)(nil).CountPaymentMethodsByReferenceIDAndStatuses), ctx, arg)
+}
+
+// CountReconciliationDiscrepancies mocks base method.
+func (m *MockRepository) CountReconciliationDiscrepancies(ctx context.Context, arg *repository.CountReconciliationDiscrepanciesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReconciliationDiscrepancies", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReconciliationDiscrepancies indicates an expected call of CountReconciliationDiscrepancies.
+func (mr *MockRepositoryMockRecorder) CountReconciliationDiscrepancies(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReconciliationDiscrepancies", reflect.TypeOf((*MockRepository)(nil).CountReconciliationDiscrepancies), ctx, arg)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockRepository) CreateCustomer(ctx context.Context, arg *repository.CreateCustomerParams) (*repository.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, arg)
+	ret0, _ := ret[0].(*repository.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockRepositoryMockRecorder) CreateCustomer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockRepository)(nil).CreateCustomer), ctx, arg)
 }
 
 // CreateCustomerTx mocks base method.
@@ -93,34 +212,79 @@ func (mr *MockRepositoryMockRecorder) CreateCustomerTx(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerTx", reflect.TypeOf((*MockRepository)(nil).CreateCustomerTx), ctx, arg)
 }
 
-// CreateEwalletPayment mocks base method.
-func (m *MockRepository) CreateEwalletPayment(ctx context.Context, arg *repository.CreateEwalletPaymentParams) (*payment_request.PaymentRequest, error) {
+// CreateDeadLetterMessage mocks base method.
+func (m *MockRepository) CreateDeadLetterMessage(ctx context.Context, arg *repository.CreateDeadLetterMessageParams) (*repository.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterMessage", ctx, arg)
+	ret0, _ := ret[0].(*repository.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadLetterMessage indicates an expected call of CreateDeadLetterMessage.
+func (mr *MockRepositoryMockRecorder) CreateDeadLetterMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterMessage", reflect.TypeOf((*MockRepository)(nil).CreateDeadLetterMessage), ctx, arg)
+}
+
+// CreateInvoice mocks base method.
+func (m *MockRepository) CreateInvoice(ctx context.Context, arg *repository.CreateInvoiceParams) (*repository.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", ctx, arg)
+	ret0, _ := ret[0].(*repository.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockRepositoryMockRecorder) CreateInvoice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockRepository)(nil).CreateInvoice), ctx, arg)
+}
+
+// CreateLedgerEntry mocks base method.
+func (m *MockRepository) CreateLedgerEntry(ctx context.Context, arg *repository.CreateLedgerEntryParams) (*repository.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerEntry", ctx, arg)
+	ret0, _ := ret[0].(*repository.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLedgerEntry indicates an expected call of CreateLedgerEntry.
+func (mr *MockRepositoryMockRecorder) CreateLedgerEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerEntry", reflect.TypeOf((*MockRepository)(nil).CreateLedgerEntry), ctx, arg)
+}
+
+// CreateLedgerPosting mocks base method.
+func (m *MockRepository) CreateLedgerPosting(ctx context.Context, arg *repository.CreateLedgerPostingParams) (*repository.LedgerPosting, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEwalletPayment", ctx, arg)
-	ret0, _ := ret[0].(*payment_request.PaymentRequest)
+	ret := m.ctrl.Call(m, "CreateLedgerPosting", ctx, arg)
+	ret0, _ := ret[0].(*repository.LedgerPosting)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEwalletPayment indicates an expected call of CreateEwalletPayment.
-func (mr *MockRepositoryMockRecorder) CreateEwalletPayment(ctx, arg any) *gomock.Call {
+// CreateLedgerPosting indicates an expected call of CreateLedgerPosting.
+func (mr *MockRepositoryMockRecorder) CreateLedgerPosting(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEwalletPayment", reflect.TypeOf((*MockRepository)(nil).CreateEwalletPayment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerPosting", reflect.TypeOf((*MockRepository)(nil).CreateLedgerPosting), ctx, arg)
 }
 
-// CreateEwalletPaymentTx mocks base method.
-func (m *MockRepository) CreateEwalletPaymentTx(ctx context.Context, arg *repository.CreateEwalletPaymentTxParams) (repository.CreateEwalletPaymentTxResult, error) {
+// CreateOutboxMessage mocks base method.
+func (m *MockRepository) CreateOutboxMessage(ctx context.Context, arg *repository.CreateOutboxMessageParams) (*repository.Outbox, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEwalletPaymentTx", ctx, arg)
-	ret0, _ := ret[0].(repository.CreateEwalletPaymentTxResult)
+	ret := m.ctrl.Call(m, "CreateOutboxMessage", ctx, arg)
+	ret0, _ := ret[0].(*repository.Outbox)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEwalletPaymentTx indicates an expected call of CreateEwalletPaymentTx.
-func (mr *MockRepositoryMockRecorder) CreateEwalletPaymentTx(ctx, arg any) *gomock.Call {
+// CreateOutboxMessage indicates an expected call of CreateOutboxMessage.
+func (mr *MockRepositoryMockRecorder) CreateOutboxMessage(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEwalletPaymentTx", reflect.TypeOf((*MockRepository)(nil).CreateEwalletPaymentTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxMessage", reflect.TypeOf((*MockRepository)(nil).CreateOutboxMessage), ctx, arg)
 }
 
 // CreatePaymentChannel mocks base method.
@@ -168,6 +332,21 @@ func (mr *MockRepositoryMockRecorder) CreatePaymentReusability(ctx, prname any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentReusability", reflect.TypeOf((*MockRepository)(nil).CreatePaymentReusability), ctx, prname)
 }
 
+// CreatePaymentSplit mocks base method.
+func (m *MockRepository) CreatePaymentSplit(ctx context.Context, arg *repository.CreatePaymentSplitParams) (*repository.PaymentSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentSplit", ctx, arg)
+	ret0, _ := ret[0].(*repository.PaymentSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentSplit indicates an expected call of CreatePaymentSplit.
+func (mr *MockRepositoryMockRecorder) CreatePaymentSplit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentSplit", reflect.TypeOf((*MockRepository)(nil).CreatePaymentSplit), ctx, arg)
+}
+
 // CreatePaymentStatus mocks base method.
 func (m *MockRepository) CreatePaymentStatus(ctx context.Context, psname string) (string, error) {
 	m.ctrl.T.Helper()
@@ -183,79 +362,94 @@ func (mr *MockRepositoryMockRecorder) CreatePaymentStatus(ctx, psname any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentStatus", reflect.TypeOf((*MockRepository)(nil).CreatePaymentStatus), ctx, psname)
 }
 
-// CreatePaymentType mocks base method.
-func (m *MockRepository) CreatePaymentType(ctx context.Context, ptname string) (string, error) {
+// CreatePaymentStatusHistory mocks base method.
+func (m *MockRepository) CreatePaymentStatusHistory(ctx context.Context, arg *repository.CreatePaymentStatusHistoryParams) (*repository.PaymentStatusHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePaymentType", ctx, ptname)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreatePaymentStatusHistory", ctx, arg)
+	ret0, _ := ret[0].(*repository.PaymentStatusHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePaymentType indicates an expected call of CreatePaymentType.
-func (mr *MockRepositoryMockRecorder) CreatePaymentType(ctx, ptname any) *gomock.Call {
+// CreatePaymentStatusHistory indicates an expected call of CreatePaymentStatusHistory.
+func (mr *MockRepositoryMockRecorder) CreatePaymentStatusHistory(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentType", reflect.TypeOf((*MockRepository)(nil).CreatePaymentType), ctx, ptname)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentStatusHistory", reflect.TypeOf((*MockRepository)(nil).CreatePaymentStatusHistory), ctx, arg)
 }
 
-// CreateQrCodePayment mocks base method.
-func (m *MockRepository) CreateQrCodePayment(ctx context.Context, arg *repository.CreateQrCodePaymentParams) (*payment_method.PaymentMethod, error) {
+// CreatePaymentTx mocks base method.
+func (m *MockRepository) CreatePaymentTx(ctx context.Context, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateQrCodePayment", ctx, arg)
-	ret0, _ := ret[0].(*payment_method.PaymentMethod)
+	ret := m.ctrl.Call(m, "CreatePaymentTx", ctx, arg)
+	ret0, _ := ret[0].(repository.CreatePaymentTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateQrCodePayment indicates an expected call of CreateQrCodePayment.
-func (mr *MockRepositoryMockRecorder) CreateQrCodePayment(ctx, arg any) *gomock.Call {
+// CreatePaymentTx indicates an expected call of CreatePaymentTx.
+func (mr *MockRepositoryMockRecorder) CreatePaymentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentTx", reflect.TypeOf((*MockRepository)(nil).CreatePaymentTx), ctx, arg)
+}
+
+// CreatePaymentType mocks base method.
+func (m *MockRepository) CreatePaymentType(ctx context.Context, ptname string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentType", ctx, ptname)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentType indicates an expected call of CreatePaymentType.
+func (mr *MockRepositoryMockRecorder) CreatePaymentType(ctx, ptname any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQrCodePayment", reflect.TypeOf((*MockRepository)(nil).CreateQrCodePayment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentType", reflect.TypeOf((*MockRepository)(nil).CreatePaymentType), ctx, ptname)
 }
 
-// CreateQrCodePaymentTx mocks base method.
-func (m *MockRepository) CreateQrCodePaymentTx(ctx context.Context, arg *repository.CreateQrCodePaymentTxParams) (repository.CreateQrCodePaymentTxResult, error) {
+// CreateProcessedEvent mocks base method.
+func (m *MockRepository) CreateProcessedEvent(ctx context.Context, arg *repository.CreateProcessedEventParams) (*repository.ProcessedEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateQrCodePaymentTx", ctx, arg)
-	ret0, _ := ret[0].(repository.CreateQrCodePaymentTxResult)
+	ret := m.ctrl.Call(m, "CreateProcessedEvent", ctx, arg)
+	ret0, _ := ret[0].(*repository.ProcessedEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateQrCodePaymentTx indicates an expected call of CreateQrCodePaymentTx.
-func (mr *MockRepositoryMockRecorder) CreateQrCodePaymentTx(ctx, arg any) *gomock.Call {
+// CreateProcessedEvent indicates an expected call of CreateProcessedEvent.
+func (mr *MockRepositoryMockRecorder) CreateProcessedEvent(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQrCodePaymentTx", reflect.TypeOf((*MockRepository)(nil).CreateQrCodePaymentTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProcessedEvent", reflect.TypeOf((*MockRepository)(nil).CreateProcessedEvent), ctx, arg)
 }
 
-// CreateVirtualAccountBankPayment mocks base method.
-func (m *MockRepository) CreateVirtualAccountBankPayment(ctx context.Context, arg *repository.CreateVirtualAccountBankPaymentParams) (*payment_method.PaymentMethod, error) {
+// CreateRefund mocks base method.
+func (m *MockRepository) CreateRefund(ctx context.Context, arg *repository.CreateRefundParams) (*repository.Refund, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVirtualAccountBankPayment", ctx, arg)
-	ret0, _ := ret[0].(*payment_method.PaymentMethod)
+	ret := m.ctrl.Call(m, "CreateRefund", ctx, arg)
+	ret0, _ := ret[0].(*repository.Refund)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVirtualAccountBankPayment indicates an expected call of CreateVirtualAccountBankPayment.
-func (mr *MockRepositoryMockRecorder) CreateVirtualAccountBankPayment(ctx, arg any) *gomock.Call {
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockRepositoryMockRecorder) CreateRefund(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualAccountBankPayment", reflect.TypeOf((*MockRepository)(nil).CreateVirtualAccountBankPayment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockRepository)(nil).CreateRefund), ctx, arg)
 }
 
-// CreateVirtualAccountBankPaymentTx mocks base method.
-func (m *MockRepository) CreateVirtualAccountBankPaymentTx(ctx context.Context, arg *repository.CreateVirtualAccountBankPaymentTxParams) (repository.CreateVirtualAccountBankPaymentTxResult, error) {
+// CreateRefundTx mocks base method.
+func (m *MockRepository) CreateRefundTx(ctx context.Context, arg *repository.CreateRefundTxParams) (repository.CreateRefundTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVirtualAccountBankPaymentTx", ctx, arg)
-	ret0, _ := ret[0].(repository.CreateVirtualAccountBankPaymentTxResult)
+	ret := m.ctrl.Call(m, "CreateRefundTx", ctx, arg)
+	ret0, _ := ret[0].(repository.CreateRefundTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVirtualAccountBankPaymentTx indicates an expected call of CreateVirtualAccountBankPaymentTx.
-func (mr *MockRepositoryMockRecorder) CreateVirtualAccountBankPaymentTx(ctx, arg any) *gomock.Call {
+// CreateRefundTx indicates an expected call of CreateRefundTx.
+func (mr *MockRepositoryMockRecorder) CreateRefundTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualAccountBankPaymentTx", reflect.TypeOf((*MockRepository)(nil).CreateVirtualAccountBankPaymentTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefundTx", reflect.TypeOf((*MockRepository)(nil).CreateRefundTx), ctx, arg)
 }
 
 // DeleteCache mocks base method.
@@ -271,15 +465,15 @@ func (mr *MockRepositoryMockRecorder) DeleteCache(ctx, paymentCustomerID, paymen
 }
 
 // DeleteCreatePaymentIdempotencyKey mocks base method.
-func (m *MockRepository) DeleteCreatePaymentIdempotencyKey(ctx context.Context, key string) {
+func (m *MockRepository) DeleteCreatePaymentIdempotencyKey(ctx context.Context, key, fingerprint string) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "DeleteCreatePaymentIdempotencyKey", ctx, key)
+	m.ctrl.Call(m, "DeleteCreatePaymentIdempotencyKey", ctx, key, fingerprint)
 }
 
 // DeleteCreatePaymentIdempotencyKey indicates an expected call of DeleteCreatePaymentIdempotencyKey.
-func (mr *MockRepositoryMockRecorder) DeleteCreatePaymentIdempotencyKey(ctx, key any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DeleteCreatePaymentIdempotencyKey(ctx, key, fingerprint any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).DeleteCreatePaymentIdempotencyKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).DeleteCreatePaymentIdempotencyKey), ctx, key, fingerprint)
 }
 
 // DeleteCustomerCache mocks base method.
@@ -294,6 +488,21 @@ func (mr *MockRepositoryMockRecorder) DeleteCustomerCache(ctx, key any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerCache", reflect.TypeOf((*MockRepository)(nil).DeleteCustomerCache), ctx, key)
 }
 
+// DeleteProcessedEventsBefore mocks base method.
+func (m *MockRepository) DeleteProcessedEventsBefore(ctx context.Context, processedBefore pgtype.Timestamptz) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessedEventsBefore", ctx, processedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProcessedEventsBefore indicates an expected call of DeleteProcessedEventsBefore.
+func (mr *MockRepositoryMockRecorder) DeleteProcessedEventsBefore(ctx, processedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessedEventsBefore", reflect.TypeOf((*MockRepository)(nil).DeleteProcessedEventsBefore), ctx, processedBefore)
+}
+
 // GetAvailablePaymentChannel mocks base method.
 func (m *MockRepository) GetAvailablePaymentChannel(ctx context.Context, arg *repository.GetAvailablePaymentChannelParams) (*repository.PaymentChannel, error) {
 	m.ctrl.T.Helper()
@@ -310,18 +519,18 @@ func (mr *MockRepositoryMockRecorder) GetAvailablePaymentChannel(ctx, arg any) *
 }
 
 // GetAvailablePaymentChannels mocks base method.
-func (m *MockRepository) GetAvailablePaymentChannels(ctx context.Context, minAmount decimal.Decimal) ([]*repository.PaymentChannel, error) {
+func (m *MockRepository) GetAvailablePaymentChannels(ctx context.Context, arg *repository.GetAvailablePaymentChannelsParams) ([]*repository.PaymentChannel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailablePaymentChannels", ctx, minAmount)
+	ret := m.ctrl.Call(m, "GetAvailablePaymentChannels", ctx, arg)
 	ret0, _ := ret[0].([]*repository.PaymentChannel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailablePaymentChannels indicates an expected call of GetAvailablePaymentChannels.
-func (mr *MockRepositoryMockRecorder) GetAvailablePaymentChannels(ctx, minAmount any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetAvailablePaymentChannels(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePaymentChannels", reflect.TypeOf((*MockRepository)(nil).GetAvailablePaymentChannels), ctx, minAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailablePaymentChannels", reflect.TypeOf((*MockRepository)(nil).GetAvailablePaymentChannels), ctx, arg)
 }
 
 // GetCache mocks base method.
@@ -339,19 +548,34 @@ func (mr *MockRepositoryMockRecorder) GetCache(ctx, paymentCustomerID, paymentMe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCache", reflect.TypeOf((*MockRepository)(nil).GetCache), ctx, paymentCustomerID, paymentMethodID)
 }
 
+// GetChildPaymentMethod mocks base method.
+func (m *MockRepository) GetChildPaymentMethod(ctx context.Context, arg *repository.GetChildPaymentMethodParams) (*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildPaymentMethod", ctx, arg)
+	ret0, _ := ret[0].(*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildPaymentMethod indicates an expected call of GetChildPaymentMethod.
+func (mr *MockRepositoryMockRecorder) GetChildPaymentMethod(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildPaymentMethod", reflect.TypeOf((*MockRepository)(nil).GetChildPaymentMethod), ctx, arg)
+}
+
 // GetCreatePaymentIdempotencyKey mocks base method.
-func (m *MockRepository) GetCreatePaymentIdempotencyKey(ctx context.Context, key string) (*pb.CreatePaymentResponse, error) {
+func (m *MockRepository) GetCreatePaymentIdempotencyKey(ctx context.Context, key, fingerprint string) (*pb.CreatePaymentResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCreatePaymentIdempotencyKey", ctx, key)
+	ret := m.ctrl.Call(m, "GetCreatePaymentIdempotencyKey", ctx, key, fingerprint)
 	ret0, _ := ret[0].(*pb.CreatePaymentResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCreatePaymentIdempotencyKey indicates an expected call of GetCreatePaymentIdempotencyKey.
-func (mr *MockRepositoryMockRecorder) GetCreatePaymentIdempotencyKey(ctx, key any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetCreatePaymentIdempotencyKey(ctx, key, fingerprint any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetCreatePaymentIdempotencyKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetCreatePaymentIdempotencyKey), ctx, key, fingerprint)
 }
 
 // GetCustomerByCustomerAppID mocks base method.
@@ -399,34 +623,94 @@ func (mr *MockRepositoryMockRecorder) GetCustomerCache(ctx, key any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerCache", reflect.TypeOf((*MockRepository)(nil).GetCustomerCache), ctx, key)
 }
 
-// GetCustomerPaymentByID mocks base method.
-func (m *MockRepository) GetCustomerPaymentByID(ctx context.Context, arg string) (*customer.Customer, error) {
+// GetDeadLetterMessage mocks base method.
+func (m *MockRepository) GetDeadLetterMessage(ctx context.Context, uid string) (*repository.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessage", ctx, uid)
+	ret0, _ := ret[0].(*repository.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessage indicates an expected call of GetDeadLetterMessage.
+func (mr *MockRepositoryMockRecorder) GetDeadLetterMessage(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessage", reflect.TypeOf((*MockRepository)(nil).GetDeadLetterMessage), ctx, uid)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockRepository) GetIdempotencyKey(ctx context.Context, idempotencyKey string) (*repository.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*repository.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) GetIdempotencyKey(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetIdempotencyKey), ctx, idempotencyKey)
+}
+
+// GetInvoice mocks base method.
+func (m *MockRepository) GetInvoice(ctx context.Context, uid string) (*repository.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, uid)
+	ret0, _ := ret[0].(*repository.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockRepositoryMockRecorder) GetInvoice(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockRepository)(nil).GetInvoice), ctx, uid)
+}
+
+// GetInvoiceByInvoiceID mocks base method.
+func (m *MockRepository) GetInvoiceByInvoiceID(ctx context.Context, invoiceID string) (*repository.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceByInvoiceID", ctx, invoiceID)
+	ret0, _ := ret[0].(*repository.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceByInvoiceID indicates an expected call of GetInvoiceByInvoiceID.
+func (mr *MockRepositoryMockRecorder) GetInvoiceByInvoiceID(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByInvoiceID", reflect.TypeOf((*MockRepository)(nil).GetInvoiceByInvoiceID), ctx, invoiceID)
+}
+
+// GetInvoiceByInvoiceIDForUpdate mocks base method.
+func (m *MockRepository) GetInvoiceByInvoiceIDForUpdate(ctx context.Context, invoiceID string) (*repository.Invoice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerPaymentByID", ctx, arg)
-	ret0, _ := ret[0].(*customer.Customer)
+	ret := m.ctrl.Call(m, "GetInvoiceByInvoiceIDForUpdate", ctx, invoiceID)
+	ret0, _ := ret[0].(*repository.Invoice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomerPaymentByID indicates an expected call of GetCustomerPaymentByID.
-func (mr *MockRepositoryMockRecorder) GetCustomerPaymentByID(ctx, arg any) *gomock.Call {
+// GetInvoiceByInvoiceIDForUpdate indicates an expected call of GetInvoiceByInvoiceIDForUpdate.
+func (mr *MockRepositoryMockRecorder) GetInvoiceByInvoiceIDForUpdate(ctx, invoiceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerPaymentByID", reflect.TypeOf((*MockRepository)(nil).GetCustomerPaymentByID), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByInvoiceIDForUpdate", reflect.TypeOf((*MockRepository)(nil).GetInvoiceByInvoiceIDForUpdate), ctx, invoiceID)
 }
 
-// GetEwalletPaymentRequestByID mocks base method.
-func (m *MockRepository) GetEwalletPaymentRequestByID(ctx context.Context, arg string) (*payment_request.PaymentRequest, error) {
+// GetOutboxLag mocks base method.
+func (m *MockRepository) GetOutboxLag(ctx context.Context, outboxStatus string) (*repository.GetOutboxLagRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEwalletPaymentRequestByID", ctx, arg)
-	ret0, _ := ret[0].(*payment_request.PaymentRequest)
+	ret := m.ctrl.Call(m, "GetOutboxLag", ctx, outboxStatus)
+	ret0, _ := ret[0].(*repository.GetOutboxLagRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEwalletPaymentRequestByID indicates an expected call of GetEwalletPaymentRequestByID.
-func (mr *MockRepositoryMockRecorder) GetEwalletPaymentRequestByID(ctx, arg any) *gomock.Call {
+// GetOutboxLag indicates an expected call of GetOutboxLag.
+func (mr *MockRepositoryMockRecorder) GetOutboxLag(ctx, outboxStatus any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEwalletPaymentRequestByID", reflect.TypeOf((*MockRepository)(nil).GetEwalletPaymentRequestByID), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxLag", reflect.TypeOf((*MockRepository)(nil).GetOutboxLag), ctx, outboxStatus)
 }
 
 // GetPaymentChannelByID mocks base method.
@@ -444,19 +728,34 @@ func (mr *MockRepositoryMockRecorder) GetPaymentChannelByID(ctx, uid any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentChannelByID", reflect.TypeOf((*MockRepository)(nil).GetPaymentChannelByID), ctx, uid)
 }
 
-// GetPaymentChannelByName mocks base method.
-func (m *MockRepository) GetPaymentChannelByName(ctx context.Context, pcname string) (*repository.PaymentChannel, error) {
+// GetPaymentChannelByNameAndCurrency mocks base method.
+func (m *MockRepository) GetPaymentChannelByNameAndCurrency(ctx context.Context, arg *repository.GetPaymentChannelByNameAndCurrencyParams) (*repository.PaymentChannel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaymentChannelByName", ctx, pcname)
+	ret := m.ctrl.Call(m, "GetPaymentChannelByNameAndCurrency", ctx, arg)
 	ret0, _ := ret[0].(*repository.PaymentChannel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPaymentChannelByName indicates an expected call of GetPaymentChannelByName.
-func (mr *MockRepositoryMockRecorder) GetPaymentChannelByName(ctx, pcname any) *gomock.Call {
+// GetPaymentChannelByNameAndCurrency indicates an expected call of GetPaymentChannelByNameAndCurrency.
+func (mr *MockRepositoryMockRecorder) GetPaymentChannelByNameAndCurrency(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentChannelByNameAndCurrency", reflect.TypeOf((*MockRepository)(nil).GetPaymentChannelByNameAndCurrency), ctx, arg)
+}
+
+// GetPaymentMethodByInvoiceUid mocks base method.
+func (m *MockRepository) GetPaymentMethodByInvoiceUid(ctx context.Context, paymentInvoiceUid pgtype.Text) (*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethodByInvoiceUid", ctx, paymentInvoiceUid)
+	ret0, _ := ret[0].(*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethodByInvoiceUid indicates an expected call of GetPaymentMethodByInvoiceUid.
+func (mr *MockRepositoryMockRecorder) GetPaymentMethodByInvoiceUid(ctx, paymentInvoiceUid any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentChannelByName", reflect.TypeOf((*MockRepository)(nil).GetPaymentChannelByName), ctx, pcname)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodByInvoiceUid", reflect.TypeOf((*MockRepository)(nil).GetPaymentMethodByInvoiceUid), ctx, paymentInvoiceUid)
 }
 
 // GetPaymentMethodByPaymentMethodID mocks base method.
@@ -474,6 +773,21 @@ func (mr *MockRepositoryMockRecorder) GetPaymentMethodByPaymentMethodID(ctx, pay
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodByPaymentMethodID", reflect.TypeOf((*MockRepository)(nil).GetPaymentMethodByPaymentMethodID), ctx, paymentMethodID)
 }
 
+// GetPaymentMethodByPaymentRequestID mocks base method.
+func (m *MockRepository) GetPaymentMethodByPaymentRequestID(ctx context.Context, paymentRequestID pgtype.Text) (*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethodByPaymentRequestID", ctx, paymentRequestID)
+	ret0, _ := ret[0].(*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethodByPaymentRequestID indicates an expected call of GetPaymentMethodByPaymentRequestID.
+func (mr *MockRepositoryMockRecorder) GetPaymentMethodByPaymentRequestID(ctx, paymentRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodByPaymentRequestID", reflect.TypeOf((*MockRepository)(nil).GetPaymentMethodByPaymentRequestID), ctx, paymentRequestID)
+}
+
 // GetPaymentMethodByReferenceID mocks base method.
 func (m *MockRepository) GetPaymentMethodByReferenceID(ctx context.Context, paymentReferenceID string) (*repository.PaymentMethod, error) {
 	m.ctrl.T.Helper()
@@ -504,6 +818,21 @@ func (mr *MockRepositoryMockRecorder) GetPaymentMethodCustomer(ctx, arg any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodCustomer", reflect.TypeOf((*MockRepository)(nil).GetPaymentMethodCustomer), ctx, arg)
 }
 
+// GetPaymentMethodCustomerForUpdate mocks base method.
+func (m *MockRepository) GetPaymentMethodCustomerForUpdate(ctx context.Context, arg *repository.GetPaymentMethodCustomerForUpdateParams) (*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentMethodCustomerForUpdate", ctx, arg)
+	ret0, _ := ret[0].(*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentMethodCustomerForUpdate indicates an expected call of GetPaymentMethodCustomerForUpdate.
+func (mr *MockRepositoryMockRecorder) GetPaymentMethodCustomerForUpdate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentMethodCustomerForUpdate", reflect.TypeOf((*MockRepository)(nil).GetPaymentMethodCustomerForUpdate), ctx, arg)
+}
+
 // GetPaymentReusabilityByName mocks base method.
 func (m *MockRepository) GetPaymentReusabilityByName(ctx context.Context, prname string) (string, error) {
 	m.ctrl.T.Helper()
@@ -549,34 +878,316 @@ func (mr *MockRepositoryMockRecorder) GetPaymentTypeByName(ctx, ptname any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentTypeByName", reflect.TypeOf((*MockRepository)(nil).GetPaymentTypeByName), ctx, ptname)
 }
 
-// GetQrCodePaymentByID mocks base method.
-func (m *MockRepository) GetQrCodePaymentByID(ctx context.Context, arg string) (*payment_method.PaymentMethod, error) {
+// GetProcessedEvent mocks base method.
+func (m *MockRepository) GetProcessedEvent(ctx context.Context, eventID string) (*repository.ProcessedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessedEvent", ctx, eventID)
+	ret0, _ := ret[0].(*repository.ProcessedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessedEvent indicates an expected call of GetProcessedEvent.
+func (mr *MockRepositoryMockRecorder) GetProcessedEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessedEvent", reflect.TypeOf((*MockRepository)(nil).GetProcessedEvent), ctx, eventID)
+}
+
+// GetRefund mocks base method.
+func (m *MockRepository) GetRefund(ctx context.Context, uid string) (*repository.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefund", ctx, uid)
+	ret0, _ := ret[0].(*repository.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefund indicates an expected call of GetRefund.
+func (mr *MockRepositoryMockRecorder) GetRefund(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefund", reflect.TypeOf((*MockRepository)(nil).GetRefund), ctx, uid)
+}
+
+// GetRefundByRefundID mocks base method.
+func (m *MockRepository) GetRefundByRefundID(ctx context.Context, refundID pgtype.Text) (*repository.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefundByRefundID", ctx, refundID)
+	ret0, _ := ret[0].(*repository.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundByRefundID indicates an expected call of GetRefundByRefundID.
+func (mr *MockRepositoryMockRecorder) GetRefundByRefundID(ctx, refundID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundByRefundID", reflect.TypeOf((*MockRepository)(nil).GetRefundByRefundID), ctx, refundID)
+}
+
+// GetRefundedAmount mocks base method.
+func (m *MockRepository) GetRefundedAmount(ctx context.Context, paymentMethodUid string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefundedAmount", ctx, paymentMethodUid)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundedAmount indicates an expected call of GetRefundedAmount.
+func (mr *MockRepositoryMockRecorder) GetRefundedAmount(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundedAmount", reflect.TypeOf((*MockRepository)(nil).GetRefundedAmount), ctx, paymentMethodUid)
+}
+
+// ListDeadLetterMessages mocks base method.
+func (m *MockRepository) ListDeadLetterMessages(ctx context.Context, arg *repository.ListDeadLetterMessagesParams) ([]*repository.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterMessages", ctx, arg)
+	ret0, _ := ret[0].([]*repository.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterMessages indicates an expected call of ListDeadLetterMessages.
+func (mr *MockRepositoryMockRecorder) ListDeadLetterMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterMessages", reflect.TypeOf((*MockRepository)(nil).ListDeadLetterMessages), ctx, arg)
+}
+
+// ListLedgerAccountBalances mocks base method.
+func (m *MockRepository) ListLedgerAccountBalances(ctx context.Context, arg *repository.ListLedgerAccountBalancesParams) ([]*repository.ListLedgerAccountBalancesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerAccountBalances", ctx, arg)
+	ret0, _ := ret[0].([]*repository.ListLedgerAccountBalancesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerAccountBalances indicates an expected call of ListLedgerAccountBalances.
+func (mr *MockRepositoryMockRecorder) ListLedgerAccountBalances(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerAccountBalances", reflect.TypeOf((*MockRepository)(nil).ListLedgerAccountBalances), ctx, arg)
+}
+
+// ListLedgerEntriesByPaymentMethodUid mocks base method.
+func (m *MockRepository) ListLedgerEntriesByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*repository.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerEntriesByPaymentMethodUid", ctx, paymentMethodUid)
+	ret0, _ := ret[0].([]*repository.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerEntriesByPaymentMethodUid indicates an expected call of ListLedgerEntriesByPaymentMethodUid.
+func (mr *MockRepositoryMockRecorder) ListLedgerEntriesByPaymentMethodUid(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerEntriesByPaymentMethodUid", reflect.TypeOf((*MockRepository)(nil).ListLedgerEntriesByPaymentMethodUid), ctx, paymentMethodUid)
+}
+
+// ListLedgerPostingsByPaymentMethodUid mocks base method.
+func (m *MockRepository) ListLedgerPostingsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*repository.ListLedgerPostingsByPaymentMethodUidRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerPostingsByPaymentMethodUid", ctx, paymentMethodUid)
+	ret0, _ := ret[0].([]*repository.ListLedgerPostingsByPaymentMethodUidRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerPostingsByPaymentMethodUid indicates an expected call of ListLedgerPostingsByPaymentMethodUid.
+func (mr *MockRepositoryMockRecorder) ListLedgerPostingsByPaymentMethodUid(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerPostingsByPaymentMethodUid", reflect.TypeOf((*MockRepository)(nil).ListLedgerPostingsByPaymentMethodUid), ctx, paymentMethodUid)
+}
+
+// ListOverduePaymentMethods mocks base method.
+func (m *MockRepository) ListOverduePaymentMethods(ctx context.Context, arg *repository.ListOverduePaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverduePaymentMethods", ctx, arg)
+	ret0, _ := ret[0].([]*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverduePaymentMethods indicates an expected call of ListOverduePaymentMethods.
+func (mr *MockRepositoryMockRecorder) ListOverduePaymentMethods(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverduePaymentMethods", reflect.TypeOf((*MockRepository)(nil).ListOverduePaymentMethods), ctx, arg)
+}
+
+// ListPaymentMethods mocks base method.
+func (m *MockRepository) ListPaymentMethods(ctx context.Context, arg *repository.ListPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentMethods", ctx, arg)
+	ret0, _ := ret[0].([]*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentMethods indicates an expected call of ListPaymentMethods.
+func (mr *MockRepositoryMockRecorder) ListPaymentMethods(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentMethods", reflect.TypeOf((*MockRepository)(nil).ListPaymentMethods), ctx, arg)
+}
+
+// ListPaymentMethodsByReferenceID mocks base method.
+func (m *MockRepository) ListPaymentMethodsByReferenceID(ctx context.Context, paymentReferenceID string) ([]*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentMethodsByReferenceID", ctx, paymentReferenceID)
+	ret0, _ := ret[0].([]*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentMethodsByReferenceID indicates an expected call of ListPaymentMethodsByReferenceID.
+func (mr *MockRepositoryMockRecorder) ListPaymentMethodsByReferenceID(ctx, paymentReferenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentMethodsByReferenceID", reflect.TypeOf((*MockRepository)(nil).ListPaymentMethodsByReferenceID), ctx, paymentReferenceID)
+}
+
+// ListPaymentSplitsByPaymentMethodUid mocks base method.
+func (m *MockRepository) ListPaymentSplitsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*repository.PaymentSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentSplitsByPaymentMethodUid", ctx, paymentMethodUid)
+	ret0, _ := ret[0].([]*repository.PaymentSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentSplitsByPaymentMethodUid indicates an expected call of ListPaymentSplitsByPaymentMethodUid.
+func (mr *MockRepositoryMockRecorder) ListPaymentSplitsByPaymentMethodUid(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentSplitsByPaymentMethodUid", reflect.TypeOf((*MockRepository)(nil).ListPaymentSplitsByPaymentMethodUid), ctx, paymentMethodUid)
+}
+
+// ListPaymentStatusHistoryByPaymentMethodUid mocks base method.
+func (m *MockRepository) ListPaymentStatusHistoryByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*repository.PaymentStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentStatusHistoryByPaymentMethodUid", ctx, paymentMethodUid)
+	ret0, _ := ret[0].([]*repository.PaymentStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentStatusHistoryByPaymentMethodUid indicates an expected call of ListPaymentStatusHistoryByPaymentMethodUid.
+func (mr *MockRepositoryMockRecorder) ListPaymentStatusHistoryByPaymentMethodUid(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentStatusHistoryByPaymentMethodUid", reflect.TypeOf((*MockRepository)(nil).ListPaymentStatusHistoryByPaymentMethodUid), ctx, paymentMethodUid)
+}
+
+// ListPendingOutboxMessages mocks base method.
+func (m *MockRepository) ListPendingOutboxMessages(ctx context.Context, arg *repository.ListPendingOutboxMessagesParams) ([]*repository.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboxMessages", ctx, arg)
+	ret0, _ := ret[0].([]*repository.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboxMessages indicates an expected call of ListPendingOutboxMessages.
+func (mr *MockRepositoryMockRecorder) ListPendingOutboxMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboxMessages", reflect.TypeOf((*MockRepository)(nil).ListPendingOutboxMessages), ctx, arg)
+}
+
+// ListReconciliationDiscrepancies mocks base method.
+func (m *MockRepository) ListReconciliationDiscrepancies(ctx context.Context, arg *repository.ListReconciliationDiscrepanciesParams) ([]*repository.ReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliationDiscrepancies", ctx, arg)
+	ret0, _ := ret[0].([]*repository.ReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliationDiscrepancies indicates an expected call of ListReconciliationDiscrepancies.
+func (mr *MockRepositoryMockRecorder) ListReconciliationDiscrepancies(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliationDiscrepancies", reflect.TypeOf((*MockRepository)(nil).ListReconciliationDiscrepancies), ctx, arg)
+}
+
+// ListRefundsByPaymentMethodUid mocks base method.
+func (m *MockRepository) ListRefundsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*repository.Refund, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQrCodePaymentByID", ctx, arg)
-	ret0, _ := ret[0].(*payment_method.PaymentMethod)
+	ret := m.ctrl.Call(m, "ListRefundsByPaymentMethodUid", ctx, paymentMethodUid)
+	ret0, _ := ret[0].([]*repository.Refund)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetQrCodePaymentByID indicates an expected call of GetQrCodePaymentByID.
-func (mr *MockRepositoryMockRecorder) GetQrCodePaymentByID(ctx, arg any) *gomock.Call {
+// ListRefundsByPaymentMethodUid indicates an expected call of ListRefundsByPaymentMethodUid.
+func (mr *MockRepositoryMockRecorder) ListRefundsByPaymentMethodUid(ctx, paymentMethodUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefundsByPaymentMethodUid", reflect.TypeOf((*MockRepository)(nil).ListRefundsByPaymentMethodUid), ctx, paymentMethodUid)
+}
+
+// ListSucceededPaymentMethods mocks base method.
+func (m *MockRepository) ListSucceededPaymentMethods(ctx context.Context, arg *repository.ListSucceededPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSucceededPaymentMethods", ctx, arg)
+	ret0, _ := ret[0].([]*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSucceededPaymentMethods indicates an expected call of ListSucceededPaymentMethods.
+func (mr *MockRepositoryMockRecorder) ListSucceededPaymentMethods(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSucceededPaymentMethods", reflect.TypeOf((*MockRepository)(nil).ListSucceededPaymentMethods), ctx, arg)
+}
+
+// LockPaymentReferenceID mocks base method.
+func (m *MockRepository) LockPaymentReferenceID(ctx context.Context, paymentReferenceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPaymentReferenceID", ctx, paymentReferenceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPaymentReferenceID indicates an expected call of LockPaymentReferenceID.
+func (mr *MockRepositoryMockRecorder) LockPaymentReferenceID(ctx, paymentReferenceID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQrCodePaymentByID", reflect.TypeOf((*MockRepository)(nil).GetQrCodePaymentByID), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPaymentReferenceID", reflect.TypeOf((*MockRepository)(nil).LockPaymentReferenceID), ctx, paymentReferenceID)
 }
 
-// GetVirtualAccountBankPaymentByID mocks base method.
-func (m *MockRepository) GetVirtualAccountBankPaymentByID(ctx context.Context, arg string) (*payment_method.PaymentMethod, error) {
+// MarkDeadLetterMessageReplayed mocks base method.
+func (m *MockRepository) MarkDeadLetterMessageReplayed(ctx context.Context, arg *repository.MarkDeadLetterMessageReplayedParams) (*repository.DeadLetterMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVirtualAccountBankPaymentByID", ctx, arg)
-	ret0, _ := ret[0].(*payment_method.PaymentMethod)
+	ret := m.ctrl.Call(m, "MarkDeadLetterMessageReplayed", ctx, arg)
+	ret0, _ := ret[0].(*repository.DeadLetterMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVirtualAccountBankPaymentByID indicates an expected call of GetVirtualAccountBankPaymentByID.
-func (mr *MockRepositoryMockRecorder) GetVirtualAccountBankPaymentByID(ctx, arg any) *gomock.Call {
+// MarkDeadLetterMessageReplayed indicates an expected call of MarkDeadLetterMessageReplayed.
+func (mr *MockRepositoryMockRecorder) MarkDeadLetterMessageReplayed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeadLetterMessageReplayed", reflect.TypeOf((*MockRepository)(nil).MarkDeadLetterMessageReplayed), ctx, arg)
+}
+
+// MarkOutboxMessageFailed mocks base method.
+func (m *MockRepository) MarkOutboxMessageFailed(ctx context.Context, arg *repository.MarkOutboxMessageFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageFailed indicates an expected call of MarkOutboxMessageFailed.
+func (mr *MockRepositoryMockRecorder) MarkOutboxMessageFailed(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualAccountBankPaymentByID", reflect.TypeOf((*MockRepository)(nil).GetVirtualAccountBankPaymentByID), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageFailed", reflect.TypeOf((*MockRepository)(nil).MarkOutboxMessageFailed), ctx, arg)
+}
+
+// MarkOutboxMessageSent mocks base method.
+func (m *MockRepository) MarkOutboxMessageSent(ctx context.Context, arg *repository.MarkOutboxMessageSentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessageSent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessageSent indicates an expected call of MarkOutboxMessageSent.
+func (mr *MockRepositoryMockRecorder) MarkOutboxMessageSent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessageSent", reflect.TypeOf((*MockRepository)(nil).MarkOutboxMessageSent), ctx, arg)
 }
 
 // OnConfigUpdate mocks base method.
@@ -628,15 +1239,15 @@ func (mr *MockRepositoryMockRecorder) PutCache(ctx, arg any) *gomock.Call {
 }
 
 // PutCreatePaymentIdempotencyKey mocks base method.
-func (m *MockRepository) PutCreatePaymentIdempotencyKey(ctx context.Context, key string, arg *pb.CreatePaymentResponse) {
+func (m *MockRepository) PutCreatePaymentIdempotencyKey(ctx context.Context, key, fingerprint string, arg *pb.CreatePaymentResponse) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PutCreatePaymentIdempotencyKey", ctx, key, arg)
+	m.ctrl.Call(m, "PutCreatePaymentIdempotencyKey", ctx, key, fingerprint, arg)
 }
 
 // PutCreatePaymentIdempotencyKey indicates an expected call of PutCreatePaymentIdempotencyKey.
-func (mr *MockRepositoryMockRecorder) PutCreatePaymentIdempotencyKey(ctx, key, arg any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) PutCreatePaymentIdempotencyKey(ctx, key, fingerprint, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).PutCreatePaymentIdempotencyKey), ctx, key, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCreatePaymentIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).PutCreatePaymentIdempotencyKey), ctx, key, fingerprint, arg)
 }
 
 // PutCustomerCache mocks base method.
@@ -651,6 +1262,174 @@ func (mr *MockRepositoryMockRecorder) PutCustomerCache(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCustomerCache", reflect.TypeOf((*MockRepository)(nil).PutCustomerCache), ctx, arg)
 }
 
+// RecordChildPaymentTx mocks base method.
+func (m *MockRepository) RecordChildPaymentTx(ctx context.Context, arg *repository.RecordChildPaymentTxParams) (repository.RecordChildPaymentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordChildPaymentTx", ctx, arg)
+	ret0, _ := ret[0].(repository.RecordChildPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordChildPaymentTx indicates an expected call of RecordChildPaymentTx.
+func (mr *MockRepositoryMockRecorder) RecordChildPaymentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildPaymentTx", reflect.TypeOf((*MockRepository)(nil).RecordChildPaymentTx), ctx, arg)
+}
+
+// RefreshExpirySweeperLock mocks base method.
+func (m *MockRepository) RefreshExpirySweeperLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshExpirySweeperLock", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshExpirySweeperLock indicates an expected call of RefreshExpirySweeperLock.
+func (mr *MockRepositoryMockRecorder) RefreshExpirySweeperLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshExpirySweeperLock", reflect.TypeOf((*MockRepository)(nil).RefreshExpirySweeperLock), ctx, token)
+}
+
+// RefreshOutboxRelayLock mocks base method.
+func (m *MockRepository) RefreshOutboxRelayLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshOutboxRelayLock", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshOutboxRelayLock indicates an expected call of RefreshOutboxRelayLock.
+func (mr *MockRepositoryMockRecorder) RefreshOutboxRelayLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshOutboxRelayLock", reflect.TypeOf((*MockRepository)(nil).RefreshOutboxRelayLock), ctx, token)
+}
+
+// RefreshReconciliationLock mocks base method.
+func (m *MockRepository) RefreshReconciliationLock(ctx context.Context, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshReconciliationLock", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshReconciliationLock indicates an expected call of RefreshReconciliationLock.
+func (mr *MockRepositoryMockRecorder) RefreshReconciliationLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshReconciliationLock", reflect.TypeOf((*MockRepository)(nil).RefreshReconciliationLock), ctx, token)
+}
+
+// ReleaseCreatePaymentLock mocks base method.
+func (m *MockRepository) ReleaseCreatePaymentLock(ctx context.Context, key, ticket string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseCreatePaymentLock", ctx, key, ticket)
+}
+
+// ReleaseCreatePaymentLock indicates an expected call of ReleaseCreatePaymentLock.
+func (mr *MockRepositoryMockRecorder) ReleaseCreatePaymentLock(ctx, key, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCreatePaymentLock", reflect.TypeOf((*MockRepository)(nil).ReleaseCreatePaymentLock), ctx, key, ticket)
+}
+
+// ReleaseExpirySweeperLock mocks base method.
+func (m *MockRepository) ReleaseExpirySweeperLock(ctx context.Context, token string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseExpirySweeperLock", ctx, token)
+}
+
+// ReleaseExpirySweeperLock indicates an expected call of ReleaseExpirySweeperLock.
+func (mr *MockRepositoryMockRecorder) ReleaseExpirySweeperLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpirySweeperLock", reflect.TypeOf((*MockRepository)(nil).ReleaseExpirySweeperLock), ctx, token)
+}
+
+// ReleaseOutboxRelayLock mocks base method.
+func (m *MockRepository) ReleaseOutboxRelayLock(ctx context.Context, token string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseOutboxRelayLock", ctx, token)
+}
+
+// ReleaseOutboxRelayLock indicates an expected call of ReleaseOutboxRelayLock.
+func (mr *MockRepositoryMockRecorder) ReleaseOutboxRelayLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutboxRelayLock", reflect.TypeOf((*MockRepository)(nil).ReleaseOutboxRelayLock), ctx, token)
+}
+
+// ReleaseReconciliationLock mocks base method.
+func (m *MockRepository) ReleaseReconciliationLock(ctx context.Context, token string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseReconciliationLock", ctx, token)
+}
+
+// ReleaseReconciliationLock indicates an expected call of ReleaseReconciliationLock.
+func (mr *MockRepositoryMockRecorder) ReleaseReconciliationLock(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReconciliationLock", reflect.TypeOf((*MockRepository)(nil).ReleaseReconciliationLock), ctx, token)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockRepository) ReserveIdempotencyKey(ctx context.Context, arg *repository.ReserveIdempotencyKeyParams) (*repository.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, arg)
+	ret0, _ := ret[0].(*repository.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) ReserveIdempotencyKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).ReserveIdempotencyKey), ctx, arg)
+}
+
+// UpdateInvoice mocks base method.
+func (m *MockRepository) UpdateInvoice(ctx context.Context, arg *repository.UpdateInvoiceParams) (*repository.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInvoice", ctx, arg)
+	ret0, _ := ret[0].(*repository.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInvoice indicates an expected call of UpdateInvoice.
+func (mr *MockRepositoryMockRecorder) UpdateInvoice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInvoice", reflect.TypeOf((*MockRepository)(nil).UpdateInvoice), ctx, arg)
+}
+
+// UpdateInvoiceTx mocks base method.
+func (m *MockRepository) UpdateInvoiceTx(ctx context.Context, arg *repository.UpdateInvoiceTxParams) (repository.UpdateInvoiceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInvoiceTx", ctx, arg)
+	ret0, _ := ret[0].(repository.UpdateInvoiceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInvoiceTx indicates an expected call of UpdateInvoiceTx.
+func (mr *MockRepositoryMockRecorder) UpdateInvoiceTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInvoiceTx", reflect.TypeOf((*MockRepository)(nil).UpdateInvoiceTx), ctx, arg)
+}
+
+// UpdatePaymentMethodCapturedAmount mocks base method.
+func (m *MockRepository) UpdatePaymentMethodCapturedAmount(ctx context.Context, arg *repository.UpdatePaymentMethodCapturedAmountParams) (*repository.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentMethodCapturedAmount", ctx, arg)
+	ret0, _ := ret[0].(*repository.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentMethodCapturedAmount indicates an expected call of UpdatePaymentMethodCapturedAmount.
+func (mr *MockRepositoryMockRecorder) UpdatePaymentMethodCapturedAmount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentMethodCapturedAmount", reflect.TypeOf((*MockRepository)(nil).UpdatePaymentMethodCapturedAmount), ctx, arg)
+}
+
 // UpdatePaymentMethodCustomer mocks base method.
 func (m *MockRepository) UpdatePaymentMethodCustomer(ctx context.Context, arg *repository.UpdatePaymentMethodCustomerParams) (*repository.PaymentMethod, error) {
 	m.ctrl.T.Helper()
@@ -666,6 +1445,36 @@ func (mr *MockRepositoryMockRecorder) UpdatePaymentMethodCustomer(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentMethodCustomer", reflect.TypeOf((*MockRepository)(nil).UpdatePaymentMethodCustomer), ctx, arg)
 }
 
+// UpdateRefund mocks base method.
+func (m *MockRepository) UpdateRefund(ctx context.Context, arg *repository.UpdateRefundParams) (*repository.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefund", ctx, arg)
+	ret0, _ := ret[0].(*repository.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRefund indicates an expected call of UpdateRefund.
+func (mr *MockRepositoryMockRecorder) UpdateRefund(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefund", reflect.TypeOf((*MockRepository)(nil).UpdateRefund), ctx, arg)
+}
+
+// UpdateRefundTx mocks base method.
+func (m *MockRepository) UpdateRefundTx(ctx context.Context, arg *repository.UpdateRefundTxParams) (repository.UpdateRefundTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefundTx", ctx, arg)
+	ret0, _ := ret[0].(repository.UpdateRefundTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRefundTx indicates an expected call of UpdateRefundTx.
+func (mr *MockRepositoryMockRecorder) UpdateRefundTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefundTx", reflect.TypeOf((*MockRepository)(nil).UpdateRefundTx), ctx, arg)
+}
+
 // UpdateTx mocks base method.
 func (m *MockRepository) UpdateTx(ctx context.Context, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
 	m.ctrl.T.Helper()
@@ -680,3 +1489,33 @@ func (mr *MockRepositoryMockRecorder) UpdateTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTx", reflect.TypeOf((*MockRepository)(nil).UpdateTx), ctx, arg)
 }
+
+// UpsertLedgerAccount mocks base method.
+func (m *MockRepository) UpsertLedgerAccount(ctx context.Context, arg *repository.UpsertLedgerAccountParams) (*repository.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLedgerAccount", ctx, arg)
+	ret0, _ := ret[0].(*repository.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertLedgerAccount indicates an expected call of UpsertLedgerAccount.
+func (mr *MockRepositoryMockRecorder) UpsertLedgerAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLedgerAccount", reflect.TypeOf((*MockRepository)(nil).UpsertLedgerAccount), ctx, arg)
+}
+
+// UpsertReconciliationDiscrepancy mocks base method.
+func (m *MockRepository) UpsertReconciliationDiscrepancy(ctx context.Context, arg *repository.UpsertReconciliationDiscrepancyParams) (*repository.ReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReconciliationDiscrepancy", ctx, arg)
+	ret0, _ := ret[0].(*repository.ReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReconciliationDiscrepancy indicates an expected call of UpsertReconciliationDiscrepancy.
+func (mr *MockRepositoryMockRecorder) UpsertReconciliationDiscrepancy(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReconciliationDiscrepancy", reflect.TypeOf((*MockRepository)(nil).UpsertReconciliationDiscrepancy), ctx, arg)
+}
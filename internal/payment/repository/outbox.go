@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PaymentStatusUpdatedEvent is the payload of a payment_status_updated outbox message, the payment as the
+// transaction left it together with its split breakdown.
+type PaymentStatusUpdatedEvent struct {
+	PaymentMethod *PaymentMethod  `json:"payment_method"`
+	Splits        []*PaymentSplit `json:"splits"`
+}
+
+// enqueuePaymentStatusUpdated writes the status of a payment to the outbox, it is written in the same
+// transaction as the change so the event is published if and only if the change is committed.
+func (r *Store) enqueuePaymentStatusUpdated(ctx context.Context, q *Queries, pm *PaymentMethod) error {
+	event := PaymentStatusUpdatedEvent{PaymentMethod: pm}
+
+	if pm.PaymentForUserID.Valid {
+		splits, err := q.ListPaymentSplitsByPaymentMethodUid(ctx, pm.Uid)
+		if err != nil {
+			return fmt.Errorf("q.ListPaymentSplitsByPaymentMethodUid.err: %w", err)
+		}
+
+		event.Splits = splits
+	}
+
+	payload, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateOutboxMessage(ctx, &CreateOutboxMessageParams{
+		Uid:          uid.String(),
+		AggregateUid: pm.Uid,
+		EventType:    payment.OUTBOX_EVENT_PAYMENT_STATUS_UPDATED,
+		Payload:      payload,
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("q.CreateOutboxMessage.err: %w", err)
+	}
+
+	return nil
+}
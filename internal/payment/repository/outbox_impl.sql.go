@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: outbox_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createOutboxMessage = `-- name: CreateOutboxMessage :one
+INSERT INTO outbox (
+    uid,
+    aggregate_uid,
+    event_type,
+    payload,
+    outbox_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING uid, sequence, aggregate_uid, event_type, payload, outbox_status, attempts, last_error, created_at, sent_at
+`
+
+type CreateOutboxMessageParams struct {
+	Uid          string             `json:"uid"`
+	AggregateUid string             `json:"aggregate_uid"`
+	EventType    string             `json:"event_type"`
+	Payload      []byte             `json:"payload"`
+	OutboxStatus string             `json:"outbox_status"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateOutboxMessage(ctx context.Context, arg *CreateOutboxMessageParams) (*Outbox, error) {
+	row := q.db.QueryRow(ctx, createOutboxMessage,
+		arg.Uid,
+		arg.AggregateUid,
+		arg.EventType,
+		arg.Payload,
+		arg.OutboxStatus,
+		arg.CreatedAt,
+	)
+	var i Outbox
+	err := row.Scan(
+		&i.Uid,
+		&i.Sequence,
+		&i.AggregateUid,
+		&i.EventType,
+		&i.Payload,
+		&i.OutboxStatus,
+		&i.Attempts,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.SentAt,
+	)
+	return &i, err
+}
+
+const getOutboxLag = `-- name: GetOutboxLag :one
+SELECT
+    COUNT(*)::bigint AS pending_count,
+    COALESCE(MIN(created_at), now())::timestamptz AS oldest_created_at
+FROM outbox
+WHERE outbox_status = $1::varchar
+`
+
+type GetOutboxLagRow struct {
+	PendingCount    int64              `json:"pending_count"`
+	OldestCreatedAt pgtype.Timestamptz `json:"oldest_created_at"`
+}
+
+func (q *Queries) GetOutboxLag(ctx context.Context, outboxStatus string) (*GetOutboxLagRow, error) {
+	row := q.db.QueryRow(ctx, getOutboxLag, outboxStatus)
+	var i GetOutboxLagRow
+	err := row.Scan(&i.PendingCount, &i.OldestCreatedAt)
+	return &i, err
+}
+
+const listPendingOutboxMessages = `-- name: ListPendingOutboxMessages :many
+SELECT uid, sequence, aggregate_uid, event_type, payload, outbox_status, attempts, last_error, created_at, sent_at FROM outbox
+WHERE outbox_status = $1::varchar
+ORDER BY sequence ASC
+LIMIT $2::int
+`
+
+type ListPendingOutboxMessagesParams struct {
+	OutboxStatus string `json:"outbox_status"`
+	BatchSize    int32  `json:"batch_size"`
+}
+
+func (q *Queries) ListPendingOutboxMessages(ctx context.Context, arg *ListPendingOutboxMessagesParams) ([]*Outbox, error) {
+	rows, err := q.db.Query(ctx, listPendingOutboxMessages, arg.OutboxStatus, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Outbox{}
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.Uid,
+			&i.Sequence,
+			&i.AggregateUid,
+			&i.EventType,
+			&i.Payload,
+			&i.OutboxStatus,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxMessageFailed = `-- name: MarkOutboxMessageFailed :exec
+UPDATE outbox
+SET
+    outbox_status = $1::varchar,
+    attempts = attempts + 1,
+    last_error = $2::varchar
+WHERE uid = $3::varchar
+`
+
+type MarkOutboxMessageFailedParams struct {
+	OutboxStatus string `json:"outbox_status"`
+	LastError    string `json:"last_error"`
+	Uid          string `json:"uid"`
+}
+
+func (q *Queries) MarkOutboxMessageFailed(ctx context.Context, arg *MarkOutboxMessageFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxMessageFailed, arg.OutboxStatus, arg.LastError, arg.Uid)
+	return err
+}
+
+const markOutboxMessageSent = `-- name: MarkOutboxMessageSent :exec
+UPDATE outbox
+SET
+    outbox_status = $1::varchar,
+    last_error = NULL,
+    sent_at = $2::timestamptz
+WHERE uid = $3::varchar
+`
+
+type MarkOutboxMessageSentParams struct {
+	OutboxStatus string             `json:"outbox_status"`
+	SentAt       pgtype.Timestamptz `json:"sent_at"`
+	Uid          string             `json:"uid"`
+}
+
+func (q *Queries) MarkOutboxMessageSent(ctx context.Context, arg *MarkOutboxMessageSentParams) error {
+	_, err := q.db.Exec(ctx, markOutboxMessageSent, arg.OutboxStatus, arg.SentAt, arg.Uid)
+	return err
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_OUTBOX(t *testing.T) {
+	pm := createRandomActivePaymentMethod(t)
+
+	for _, status := range []string{payment.STATUS_PENDING, payment.STATUS_SUCCEEDED, payment.STATUS_SUCCEEDED} {
+		_, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+			UpdateParams: UpdatePaymentMethodCustomerParams{
+				PaymentStatus:     pgtype.Text{String: status, Valid: true},
+				UpdatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				PaymentMethodID:   pm.PaymentMethodID,
+				PaymentCustomerID: pm.PaymentCustomerID,
+			},
+			PaymentEvent: "payment." + status,
+		})
+		require.NoError(t, err)
+	}
+
+	pending, err := testStore.ListPendingOutboxMessages(context.TODO(), &ListPendingOutboxMessagesParams{
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		BatchSize:    1000,
+	})
+	require.NoError(t, err)
+
+	// the redelivered SUCCEEDED event changes nothing, only the two status changes are written.
+	var list []*Outbox
+	for _, msg := range pending {
+		if msg.AggregateUid == pm.Uid {
+			list = append(list, msg)
+		}
+	}
+	require.Len(t, list, 2)
+	require.Less(t, list[0].Sequence, list[1].Sequence)
+
+	for i, status := range []string{payment.STATUS_PENDING, payment.STATUS_SUCCEEDED} {
+		require.Equal(t, payment.OUTBOX_EVENT_PAYMENT_STATUS_UPDATED, list[i].EventType)
+
+		var event PaymentStatusUpdatedEvent
+		require.NoError(t, json.Unmarshal(list[i].Payload, &event))
+		require.Equal(t, pm.Uid, event.PaymentMethod.Uid)
+		require.Equal(t, status, event.PaymentMethod.PaymentStatus)
+	}
+
+	err = testStore.MarkOutboxMessageFailed(context.TODO(), &MarkOutboxMessageFailedParams{
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		LastError:    "broker unavailable",
+		Uid:          list[0].Uid,
+	})
+	require.NoError(t, err)
+
+	lag, err := testStore.GetOutboxLag(context.TODO(), payment.OUTBOX_STATUS_PENDING)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, lag.PendingCount, int64(2))
+	require.False(t, lag.OldestCreatedAt.Time.After(list[0].CreatedAt.Time))
+
+	// a parked message is no longer listed with the pending ones.
+	err = testStore.MarkOutboxMessageFailed(context.TODO(), &MarkOutboxMessageFailedParams{
+		OutboxStatus: payment.OUTBOX_STATUS_FAILED,
+		LastError:    "unsupported outbox event type",
+		Uid:          list[1].Uid,
+	})
+	require.NoError(t, err)
+
+	pending, err = testStore.ListPendingOutboxMessages(context.TODO(), &ListPendingOutboxMessagesParams{
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		BatchSize:    1000,
+	})
+	require.NoError(t, err)
+
+	for _, msg := range pending {
+		require.NotEqual(t, list[1].Uid, msg.Uid)
+	}
+
+	for _, msg := range list {
+		err = testStore.MarkOutboxMessageSent(context.TODO(), &MarkOutboxMessageSentParams{
+			OutboxStatus: payment.OUTBOX_STATUS_SENT,
+			SentAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			Uid:          msg.Uid,
+		})
+		require.NoError(t, err)
+	}
+
+	pending, err = testStore.ListPendingOutboxMessages(context.TODO(), &ListPendingOutboxMessagesParams{
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		BatchSize:    1000,
+	})
+	require.NoError(t, err)
+
+	for _, msg := range pending {
+		require.NotEqual(t, pm.Uid, msg.AggregateUid)
+	}
+}
@@ -19,10 +19,12 @@ INSERT INTO payment_channel (
     min_amount,
     max_amount,
     tax,
-    is_tax_percentage
+    is_tax_percentage,
+    currency,
+    country
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
-) RETURNING uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available, currency, country
 `
 
 type CreatePaymentChannelParams struct {
@@ -33,6 +35,8 @@ type CreatePaymentChannelParams struct {
 	MaxAmount       decimal.Decimal `json:"max_amount"`
 	Tax             decimal.Decimal `json:"tax"`
 	IsTaxPercentage bool            `json:"is_tax_percentage"`
+	Currency        string          `json:"currency"`
+	Country         string          `json:"country"`
 }
 
 func (q *Queries) CreatePaymentChannel(ctx context.Context, arg *CreatePaymentChannelParams) (*PaymentChannel, error) {
@@ -44,6 +48,8 @@ func (q *Queries) CreatePaymentChannel(ctx context.Context, arg *CreatePaymentCh
 		arg.MaxAmount,
 		arg.Tax,
 		arg.IsTaxPercentage,
+		arg.Currency,
+		arg.Country,
 	)
 	var i PaymentChannel
 	err := row.Scan(
@@ -57,22 +63,30 @@ func (q *Queries) CreatePaymentChannel(ctx context.Context, arg *CreatePaymentCh
 		&i.IsTaxPercentage,
 		&i.IsActive,
 		&i.IsAvailable,
+		&i.Currency,
+		&i.Country,
 	)
 	return &i, err
 }
 
 const getAvailablePaymentChannel = `-- name: GetAvailablePaymentChannel :one
-SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available FROM payment_channel WHERE pc_type = $1 AND pcname = $2 AND $3 > min_amount AND $3 < max_amount AND is_active = true AND is_available = true LIMIT 1
+SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available, currency, country FROM payment_channel WHERE pc_type = $1 AND pcname = $2 AND $3 > min_amount AND $3 < max_amount AND currency = $4 AND is_active = true AND is_available = true LIMIT 1
 `
 
 type GetAvailablePaymentChannelParams struct {
 	PcType    string          `json:"pc_type"`
 	Pcname    string          `json:"pcname"`
 	MinAmount decimal.Decimal `json:"min_amount"`
+	Currency  string          `json:"currency"`
 }
 
 func (q *Queries) GetAvailablePaymentChannel(ctx context.Context, arg *GetAvailablePaymentChannelParams) (*PaymentChannel, error) {
-	row := q.db.QueryRow(ctx, getAvailablePaymentChannel, arg.PcType, arg.Pcname, arg.MinAmount)
+	row := q.db.QueryRow(ctx, getAvailablePaymentChannel,
+		arg.PcType,
+		arg.Pcname,
+		arg.MinAmount,
+		arg.Currency,
+	)
 	var i PaymentChannel
 	err := row.Scan(
 		&i.Uid,
@@ -85,16 +99,23 @@ func (q *Queries) GetAvailablePaymentChannel(ctx context.Context, arg *GetAvaila
 		&i.IsTaxPercentage,
 		&i.IsActive,
 		&i.IsAvailable,
+		&i.Currency,
+		&i.Country,
 	)
 	return &i, err
 }
 
 const getAvailablePaymentChannels = `-- name: GetAvailablePaymentChannels :many
-SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available FROM payment_channel WHERE  $1 > min_amount  AND $1 < max_amount AND is_active = true AND is_available = true
+SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available, currency, country FROM payment_channel WHERE currency = $1 AND $2 > min_amount AND $2 < max_amount AND is_active = true AND is_available = true
 `
 
-func (q *Queries) GetAvailablePaymentChannels(ctx context.Context, minAmount decimal.Decimal) ([]*PaymentChannel, error) {
-	rows, err := q.db.Query(ctx, getAvailablePaymentChannels, minAmount)
+type GetAvailablePaymentChannelsParams struct {
+	Currency  string          `json:"currency"`
+	MinAmount decimal.Decimal `json:"min_amount"`
+}
+
+func (q *Queries) GetAvailablePaymentChannels(ctx context.Context, arg *GetAvailablePaymentChannelsParams) ([]*PaymentChannel, error) {
+	rows, err := q.db.Query(ctx, getAvailablePaymentChannels, arg.Currency, arg.MinAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +134,8 @@ func (q *Queries) GetAvailablePaymentChannels(ctx context.Context, minAmount dec
 			&i.IsTaxPercentage,
 			&i.IsActive,
 			&i.IsAvailable,
+			&i.Currency,
+			&i.Country,
 		); err != nil {
 			return nil, err
 		}
@@ -125,7 +148,7 @@ func (q *Queries) GetAvailablePaymentChannels(ctx context.Context, minAmount dec
 }
 
 const getPaymentChannelByID = `-- name: GetPaymentChannelByID :one
-SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available FROM payment_channel WHERE uid = $1 LIMIT 1
+SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available, currency, country FROM payment_channel WHERE uid = $1 LIMIT 1
 `
 
 func (q *Queries) GetPaymentChannelByID(ctx context.Context, uid string) (*PaymentChannel, error) {
@@ -142,16 +165,23 @@ func (q *Queries) GetPaymentChannelByID(ctx context.Context, uid string) (*Payme
 		&i.IsTaxPercentage,
 		&i.IsActive,
 		&i.IsAvailable,
+		&i.Currency,
+		&i.Country,
 	)
 	return &i, err
 }
 
-const getPaymentChannelByName = `-- name: GetPaymentChannelByName :one
-SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available FROM payment_channel WHERE pcname = $1 LIMIT 1
+const getPaymentChannelByNameAndCurrency = `-- name: GetPaymentChannelByNameAndCurrency :one
+SELECT uid, pcname, pc_type, logo_src, min_amount, max_amount, tax, is_tax_percentage, is_active, is_available, currency, country FROM payment_channel WHERE pcname = $1 AND currency = $2 LIMIT 1
 `
 
-func (q *Queries) GetPaymentChannelByName(ctx context.Context, pcname string) (*PaymentChannel, error) {
-	row := q.db.QueryRow(ctx, getPaymentChannelByName, pcname)
+type GetPaymentChannelByNameAndCurrencyParams struct {
+	Pcname   string `json:"pcname"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetPaymentChannelByNameAndCurrency(ctx context.Context, arg *GetPaymentChannelByNameAndCurrencyParams) (*PaymentChannel, error) {
+	row := q.db.QueryRow(ctx, getPaymentChannelByNameAndCurrency, arg.Pcname, arg.Currency)
 	var i PaymentChannel
 	err := row.Scan(
 		&i.Uid,
@@ -164,6 +194,8 @@ func (q *Queries) GetPaymentChannelByName(ctx context.Context, pcname string) (*
 		&i.IsTaxPercentage,
 		&i.IsActive,
 		&i.IsAvailable,
+		&i.Currency,
+		&i.Country,
 	)
 	return &i, err
 }
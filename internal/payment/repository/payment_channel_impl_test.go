@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
@@ -28,10 +29,13 @@ func TestRepoGetPaymentChannelByID(t *testing.T) {
 	require.Equal(t, pc.IsTaxPercentage, res.IsTaxPercentage)
 }
 
-func TestRepoGetPaymentChannelByName(t *testing.T) {
+func TestRepoGetPaymentChannelByNameAndCurrency(t *testing.T) {
 	pc := createRandomPaymentChannel(t)
 
-	res, err := testStore.GetPaymentChannelByName(context.TODO(), pc.Pcname)
+	res, err := testStore.GetPaymentChannelByNameAndCurrency(context.TODO(), &GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   pc.Pcname,
+		Currency: pc.Currency,
+	})
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
 
@@ -41,18 +45,44 @@ func TestRepoGetPaymentChannelByName(t *testing.T) {
 	require.Equal(t, pc.MaxAmount.String(), res.MaxAmount.String())
 	require.Equal(t, pc.Tax.String(), res.Tax.String())
 	require.Equal(t, pc.IsTaxPercentage, res.IsTaxPercentage)
+	require.Equal(t, pc.Currency, res.Currency)
+	require.Equal(t, pc.Country, res.Country)
 }
 
 func TestRepoGetAvailableChannels(t *testing.T) {
 	pc := createRandomPaymentChannel(t)
 
-	res, err := testStore.GetAvailablePaymentChannels(context.TODO(), decimal.NewFromInt(helper.RandomInt(int64(pc.MinAmount.Round(0).IntPart()), int64(pc.MaxAmount.Round(0).IntPart()))))
+	res, err := testStore.GetAvailablePaymentChannels(context.TODO(), &GetAvailablePaymentChannelsParams{
+		Currency:  payment.DEFAULT_CURRENCY,
+		MinAmount: decimal.NewFromInt(helper.RandomInt(int64(pc.MinAmount.Round(0).IntPart()), int64(pc.MaxAmount.Round(0).IntPart()))),
+	})
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
+
+	for _, v := range res {
+		require.Equal(t, payment.DEFAULT_CURRENCY, v.Currency)
+	}
+}
+
+func TestRepoGetAvailableChannelsOtherCurrency(t *testing.T) {
+	res, err := testStore.GetAvailablePaymentChannels(context.TODO(), &GetAvailablePaymentChannelsParams{
+		Currency:  payment.CURRENCY_PHP,
+		MinAmount: decimal.NewFromInt(500),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	for _, v := range res {
+		require.Equal(t, payment.CURRENCY_PHP, v.Currency)
+		require.Equal(t, payment.COUNTRY_PH, v.Country)
+	}
 }
 
 func TestRepoGetAvailableChannel(t *testing.T) {
-	pc, err := testStore.GetPaymentChannelByName(context.TODO(), "BCA")
+	pc, err := testStore.GetPaymentChannelByNameAndCurrency(context.TODO(), &GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   "BCA",
+		Currency: payment.DEFAULT_CURRENCY,
+	})
 	require.NoError(t, err)
 	require.NotEmpty(t, pc)
 
@@ -60,6 +90,7 @@ func TestRepoGetAvailableChannel(t *testing.T) {
 		PcType:    pc.PcType,
 		Pcname:    pc.Pcname,
 		MinAmount: decimal.NewFromInt(helper.RandomInt(int64(pc.MinAmount.Round(0).IntPart()), int64(pc.MaxAmount.Round(0).IntPart()))),
+		Currency:  pc.Currency,
 	}
 
 	res, err := testStore.GetAvailablePaymentChannel(context.TODO(), &arg)
@@ -89,6 +120,8 @@ func createRandomPaymentChannel(t *testing.T) *PaymentChannel {
 		MaxAmount:       decimal.NewFromInt(helper.RandomInt(100001, 200000)),
 		Tax:             decimal.NewFromInt(helper.RandomInt(1000, 4000)),
 		IsTaxPercentage: false,
+		Currency:        payment.DEFAULT_CURRENCY,
+		Country:         payment.COUNTRY_ID,
 	}
 
 	res, err := testStore.CreatePaymentChannel(context.TODO(), &arg)
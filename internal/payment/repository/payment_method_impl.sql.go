@@ -12,6 +12,69 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const countPaymentMethods = `-- name: CountPaymentMethods :one
+SELECT COUNT(*) FROM payment_method
+WHERE
+    ($1::varchar IS NULL OR payment_customer_id = $1)
+AND
+    ($2::varchar IS NULL OR payment_status = $2)
+AND
+    ($3::varchar IS NULL OR payment_type = $3)
+AND
+    ($4::varchar IS NULL OR payment_channel = $4)
+AND
+    ($5::varchar IS NULL OR payment_reference_id = $5)
+AND
+    created_at >= $6
+AND
+    created_at <= $7
+`
+
+type CountPaymentMethodsParams struct {
+	PaymentCustomerID  pgtype.Text        `json:"payment_customer_id"`
+	PaymentStatus      pgtype.Text        `json:"payment_status"`
+	PaymentType        pgtype.Text        `json:"payment_type"`
+	PaymentChannel     pgtype.Text        `json:"payment_channel"`
+	PaymentReferenceID pgtype.Text        `json:"payment_reference_id"`
+	CreatedFrom        pgtype.Timestamptz `json:"created_from"`
+	CreatedTo          pgtype.Timestamptz `json:"created_to"`
+}
+
+func (q *Queries) CountPaymentMethods(ctx context.Context, arg *CountPaymentMethodsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPaymentMethods,
+		arg.PaymentCustomerID,
+		arg.PaymentStatus,
+		arg.PaymentType,
+		arg.PaymentChannel,
+		arg.PaymentReferenceID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPaymentMethodsByReferenceIDAndStatuses = `-- name: CountPaymentMethodsByReferenceIDAndStatuses :one
+SELECT COUNT(*) FROM payment_method
+WHERE
+    payment_reference_id = $1
+AND
+    payment_status = ANY($2::varchar[])
+`
+
+type CountPaymentMethodsByReferenceIDAndStatusesParams struct {
+	PaymentReferenceID string   `json:"payment_reference_id"`
+	PaymentStatuses    []string `json:"payment_statuses"`
+}
+
+func (q *Queries) CountPaymentMethodsByReferenceIDAndStatuses(ctx context.Context, arg *CountPaymentMethodsByReferenceIDAndStatusesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPaymentMethodsByReferenceIDAndStatuses, arg.PaymentReferenceID, arg.PaymentStatuses)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPaymentMethod = `-- name: CreatePaymentMethod :one
 INSERT INTO payment_method (
     uid,
@@ -30,10 +93,18 @@ INSERT INTO payment_method (
     payment_url,
     payment_description,
     created_at,
-    expires_at
+    expires_at,
+    currency,
+    payment_capture_method,
+    payment_linked_method_id,
+    payment_code,
+    payment_parent_uid,
+    payment_invoice_uid,
+    payment_for_user_id,
+    payment_fee_amount
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
-) RETURNING uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25
+) RETURNING uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount
 `
 
 type CreatePaymentMethodParams struct {
@@ -54,6 +125,14 @@ type CreatePaymentMethodParams struct {
 	PaymentDescription          string             `json:"payment_description"`
 	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
 	ExpiresAt                   pgtype.Timestamptz `json:"expires_at"`
+	Currency                    string             `json:"currency"`
+	PaymentCaptureMethod        string             `json:"payment_capture_method"`
+	PaymentLinkedMethodID       pgtype.Text        `json:"payment_linked_method_id"`
+	PaymentCode                 pgtype.Text        `json:"payment_code"`
+	PaymentParentUid            pgtype.Text        `json:"payment_parent_uid"`
+	PaymentInvoiceUid           pgtype.Text        `json:"payment_invoice_uid"`
+	PaymentForUserID            pgtype.Text        `json:"payment_for_user_id"`
+	PaymentFeeAmount            decimal.Decimal    `json:"payment_fee_amount"`
 }
 
 func (q *Queries) CreatePaymentMethod(ctx context.Context, arg *CreatePaymentMethodParams) (*PaymentMethod, error) {
@@ -75,7 +154,61 @@ func (q *Queries) CreatePaymentMethod(ctx context.Context, arg *CreatePaymentMet
 		arg.PaymentDescription,
 		arg.CreatedAt,
 		arg.ExpiresAt,
+		arg.Currency,
+		arg.PaymentCaptureMethod,
+		arg.PaymentLinkedMethodID,
+		arg.PaymentCode,
+		arg.PaymentParentUid,
+		arg.PaymentInvoiceUid,
+		arg.PaymentForUserID,
+		arg.PaymentFeeAmount,
+	)
+	var i PaymentMethod
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.PaymentBusinessID,
+		&i.PaymentCustomerID,
+		&i.PaymentType,
+		&i.PaymentStatus,
+		&i.PaymentReusability,
+		&i.PaymentChannel,
+		&i.PaymentAmount,
+		&i.PaymentQrCode,
+		&i.PaymentVirtualAccountNumber,
+		&i.PaymentUrl,
+		&i.PaymentDescription,
+		&i.PaymentFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
+	return &i, err
+}
+
+const getChildPaymentMethod = `-- name: GetChildPaymentMethod :one
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_parent_uid = $1 AND payment_method_id = $2 LIMIT 1
+`
+
+type GetChildPaymentMethodParams struct {
+	PaymentParentUid pgtype.Text `json:"payment_parent_uid"`
+	PaymentMethodID  string      `json:"payment_method_id"`
+}
+
+func (q *Queries) GetChildPaymentMethod(ctx context.Context, arg *GetChildPaymentMethodParams) (*PaymentMethod, error) {
+	row := q.db.QueryRow(ctx, getChildPaymentMethod, arg.PaymentParentUid, arg.PaymentMethodID)
 	var i PaymentMethod
 	err := row.Scan(
 		&i.Uid,
@@ -98,12 +231,21 @@ func (q *Queries) CreatePaymentMethod(ctx context.Context, arg *CreatePaymentMet
 		&i.UpdatedAt,
 		&i.ExpiresAt,
 		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
 	return &i, err
 }
 
 const getPaymentMethodByPaymentMethodID = `-- name: GetPaymentMethodByPaymentMethodID :one
-SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at FROM payment_method WHERE payment_method_id = $1 LIMIT 1
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_method_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetPaymentMethodByPaymentMethodID(ctx context.Context, paymentMethodID string) (*PaymentMethod, error) {
@@ -130,12 +272,62 @@ func (q *Queries) GetPaymentMethodByPaymentMethodID(ctx context.Context, payment
 		&i.UpdatedAt,
 		&i.ExpiresAt,
 		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
+	)
+	return &i, err
+}
+
+const getPaymentMethodByPaymentRequestID = `-- name: GetPaymentMethodByPaymentRequestID :one
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_request_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetPaymentMethodByPaymentRequestID(ctx context.Context, paymentRequestID pgtype.Text) (*PaymentMethod, error) {
+	row := q.db.QueryRow(ctx, getPaymentMethodByPaymentRequestID, paymentRequestID)
+	var i PaymentMethod
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.PaymentBusinessID,
+		&i.PaymentCustomerID,
+		&i.PaymentType,
+		&i.PaymentStatus,
+		&i.PaymentReusability,
+		&i.PaymentChannel,
+		&i.PaymentAmount,
+		&i.PaymentQrCode,
+		&i.PaymentVirtualAccountNumber,
+		&i.PaymentUrl,
+		&i.PaymentDescription,
+		&i.PaymentFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
 	return &i, err
 }
 
 const getPaymentMethodByReferenceID = `-- name: GetPaymentMethodByReferenceID :one
-SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at FROM payment_method WHERE payment_reference_id = $1 LIMIT 1
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_reference_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetPaymentMethodByReferenceID(ctx context.Context, paymentReferenceID string) (*PaymentMethod, error) {
@@ -162,12 +354,21 @@ func (q *Queries) GetPaymentMethodByReferenceID(ctx context.Context, paymentRefe
 		&i.UpdatedAt,
 		&i.ExpiresAt,
 		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
 	return &i, err
 }
 
 const getPaymentMethodCustomer = `-- name: GetPaymentMethodCustomer :one
-SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at FROM payment_method WHERE payment_method_id = $1 AND payment_customer_id = $2 LIMIT 1
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_method_id = $1 AND payment_customer_id = $2 LIMIT 1
 `
 
 type GetPaymentMethodCustomerParams struct {
@@ -199,6 +400,428 @@ func (q *Queries) GetPaymentMethodCustomer(ctx context.Context, arg *GetPaymentM
 		&i.UpdatedAt,
 		&i.ExpiresAt,
 		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
+	)
+	return &i, err
+}
+
+const getPaymentMethodCustomerForUpdate = `-- name: GetPaymentMethodCustomerForUpdate :one
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_method_id = $1 AND payment_customer_id = $2 LIMIT 1 FOR UPDATE
+`
+
+type GetPaymentMethodCustomerForUpdateParams struct {
+	PaymentMethodID   string `json:"payment_method_id"`
+	PaymentCustomerID string `json:"payment_customer_id"`
+}
+
+func (q *Queries) GetPaymentMethodCustomerForUpdate(ctx context.Context, arg *GetPaymentMethodCustomerForUpdateParams) (*PaymentMethod, error) {
+	row := q.db.QueryRow(ctx, getPaymentMethodCustomerForUpdate, arg.PaymentMethodID, arg.PaymentCustomerID)
+	var i PaymentMethod
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.PaymentBusinessID,
+		&i.PaymentCustomerID,
+		&i.PaymentType,
+		&i.PaymentStatus,
+		&i.PaymentReusability,
+		&i.PaymentChannel,
+		&i.PaymentAmount,
+		&i.PaymentQrCode,
+		&i.PaymentVirtualAccountNumber,
+		&i.PaymentUrl,
+		&i.PaymentDescription,
+		&i.PaymentFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
+	)
+	return &i, err
+}
+
+const listOverduePaymentMethods = `-- name: ListOverduePaymentMethods :many
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method
+WHERE
+    payment_status = ANY($1::varchar[])
+AND
+    expires_at > '0001-01-01 00:00:00Z'
+AND
+    expires_at < $2
+AND
+    (expires_at, uid) > ($3::timestamptz, $4::varchar)
+ORDER BY expires_at ASC, uid ASC
+LIMIT $5
+`
+
+type ListOverduePaymentMethodsParams struct {
+	PaymentStatuses []string           `json:"payment_statuses"`
+	ExpiresBefore   pgtype.Timestamptz `json:"expires_before"`
+	AfterExpiresAt  pgtype.Timestamptz `json:"after_expires_at"`
+	AfterUid        string             `json:"after_uid"`
+	BatchSize       int32              `json:"batch_size"`
+}
+
+func (q *Queries) ListOverduePaymentMethods(ctx context.Context, arg *ListOverduePaymentMethodsParams) ([]*PaymentMethod, error) {
+	rows, err := q.db.Query(ctx, listOverduePaymentMethods,
+		arg.PaymentStatuses,
+		arg.ExpiresBefore,
+		arg.AfterExpiresAt,
+		arg.AfterUid,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentMethod{}
+	for rows.Next() {
+		var i PaymentMethod
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodID,
+			&i.PaymentRequestID,
+			&i.PaymentReferenceID,
+			&i.PaymentBusinessID,
+			&i.PaymentCustomerID,
+			&i.PaymentType,
+			&i.PaymentStatus,
+			&i.PaymentReusability,
+			&i.PaymentChannel,
+			&i.PaymentAmount,
+			&i.PaymentQrCode,
+			&i.PaymentVirtualAccountNumber,
+			&i.PaymentUrl,
+			&i.PaymentDescription,
+			&i.PaymentFailureCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.PaidAt,
+			&i.Currency,
+			&i.PaymentCaptureMethod,
+			&i.PaymentCapturedAmount,
+			&i.PaymentLinkedMethodID,
+			&i.PaymentCode,
+			&i.PaymentParentUid,
+			&i.PaymentInvoiceUid,
+			&i.PaymentForUserID,
+			&i.PaymentFeeAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentMethods = `-- name: ListPaymentMethods :many
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method
+WHERE
+    ($1::varchar IS NULL OR payment_customer_id = $1)
+AND
+    ($2::varchar IS NULL OR payment_status = $2)
+AND
+    ($3::varchar IS NULL OR payment_type = $3)
+AND
+    ($4::varchar IS NULL OR payment_channel = $4)
+AND
+    ($5::varchar IS NULL OR payment_reference_id = $5)
+AND
+    created_at >= $6
+AND
+    created_at <= $7
+AND
+    (created_at, uid) < ($8::timestamptz, $9::varchar)
+ORDER BY created_at DESC, uid DESC
+LIMIT $10
+`
+
+type ListPaymentMethodsParams struct {
+	PaymentCustomerID  pgtype.Text        `json:"payment_customer_id"`
+	PaymentStatus      pgtype.Text        `json:"payment_status"`
+	PaymentType        pgtype.Text        `json:"payment_type"`
+	PaymentChannel     pgtype.Text        `json:"payment_channel"`
+	PaymentReferenceID pgtype.Text        `json:"payment_reference_id"`
+	CreatedFrom        pgtype.Timestamptz `json:"created_from"`
+	CreatedTo          pgtype.Timestamptz `json:"created_to"`
+	BeforeCreatedAt    pgtype.Timestamptz `json:"before_created_at"`
+	BeforeUid          string             `json:"before_uid"`
+	PageSize           int32              `json:"page_size"`
+}
+
+func (q *Queries) ListPaymentMethods(ctx context.Context, arg *ListPaymentMethodsParams) ([]*PaymentMethod, error) {
+	rows, err := q.db.Query(ctx, listPaymentMethods,
+		arg.PaymentCustomerID,
+		arg.PaymentStatus,
+		arg.PaymentType,
+		arg.PaymentChannel,
+		arg.PaymentReferenceID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.BeforeCreatedAt,
+		arg.BeforeUid,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentMethod{}
+	for rows.Next() {
+		var i PaymentMethod
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodID,
+			&i.PaymentRequestID,
+			&i.PaymentReferenceID,
+			&i.PaymentBusinessID,
+			&i.PaymentCustomerID,
+			&i.PaymentType,
+			&i.PaymentStatus,
+			&i.PaymentReusability,
+			&i.PaymentChannel,
+			&i.PaymentAmount,
+			&i.PaymentQrCode,
+			&i.PaymentVirtualAccountNumber,
+			&i.PaymentUrl,
+			&i.PaymentDescription,
+			&i.PaymentFailureCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.PaidAt,
+			&i.Currency,
+			&i.PaymentCaptureMethod,
+			&i.PaymentCapturedAmount,
+			&i.PaymentLinkedMethodID,
+			&i.PaymentCode,
+			&i.PaymentParentUid,
+			&i.PaymentInvoiceUid,
+			&i.PaymentForUserID,
+			&i.PaymentFeeAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentMethodsByReferenceID = `-- name: ListPaymentMethodsByReferenceID :many
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method WHERE payment_reference_id = $1 ORDER BY created_at DESC, uid DESC
+`
+
+func (q *Queries) ListPaymentMethodsByReferenceID(ctx context.Context, paymentReferenceID string) ([]*PaymentMethod, error) {
+	rows, err := q.db.Query(ctx, listPaymentMethodsByReferenceID, paymentReferenceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentMethod{}
+	for rows.Next() {
+		var i PaymentMethod
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodID,
+			&i.PaymentRequestID,
+			&i.PaymentReferenceID,
+			&i.PaymentBusinessID,
+			&i.PaymentCustomerID,
+			&i.PaymentType,
+			&i.PaymentStatus,
+			&i.PaymentReusability,
+			&i.PaymentChannel,
+			&i.PaymentAmount,
+			&i.PaymentQrCode,
+			&i.PaymentVirtualAccountNumber,
+			&i.PaymentUrl,
+			&i.PaymentDescription,
+			&i.PaymentFailureCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.PaidAt,
+			&i.Currency,
+			&i.PaymentCaptureMethod,
+			&i.PaymentCapturedAmount,
+			&i.PaymentLinkedMethodID,
+			&i.PaymentCode,
+			&i.PaymentParentUid,
+			&i.PaymentInvoiceUid,
+			&i.PaymentForUserID,
+			&i.PaymentFeeAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSucceededPaymentMethods = `-- name: ListSucceededPaymentMethods :many
+SELECT uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount FROM payment_method
+WHERE
+    payment_status = 'SUCCEEDED'
+AND
+    COALESCE(paid_at, updated_at) >= $1
+AND
+    COALESCE(paid_at, updated_at) < $2
+AND
+    uid > $3::varchar
+ORDER BY uid ASC
+LIMIT $4
+`
+
+type ListSucceededPaymentMethodsParams struct {
+	PaidFrom  pgtype.Timestamptz `json:"paid_from"`
+	PaidTo    pgtype.Timestamptz `json:"paid_to"`
+	AfterUid  string             `json:"after_uid"`
+	BatchSize int32              `json:"batch_size"`
+}
+
+func (q *Queries) ListSucceededPaymentMethods(ctx context.Context, arg *ListSucceededPaymentMethodsParams) ([]*PaymentMethod, error) {
+	rows, err := q.db.Query(ctx, listSucceededPaymentMethods,
+		arg.PaidFrom,
+		arg.PaidTo,
+		arg.AfterUid,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentMethod{}
+	for rows.Next() {
+		var i PaymentMethod
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodID,
+			&i.PaymentRequestID,
+			&i.PaymentReferenceID,
+			&i.PaymentBusinessID,
+			&i.PaymentCustomerID,
+			&i.PaymentType,
+			&i.PaymentStatus,
+			&i.PaymentReusability,
+			&i.PaymentChannel,
+			&i.PaymentAmount,
+			&i.PaymentQrCode,
+			&i.PaymentVirtualAccountNumber,
+			&i.PaymentUrl,
+			&i.PaymentDescription,
+			&i.PaymentFailureCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.PaidAt,
+			&i.Currency,
+			&i.PaymentCaptureMethod,
+			&i.PaymentCapturedAmount,
+			&i.PaymentLinkedMethodID,
+			&i.PaymentCode,
+			&i.PaymentParentUid,
+			&i.PaymentInvoiceUid,
+			&i.PaymentForUserID,
+			&i.PaymentFeeAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPaymentReferenceID = `-- name: LockPaymentReferenceID :exec
+SELECT pg_advisory_xact_lock(hashtext($1::varchar))
+`
+
+func (q *Queries) LockPaymentReferenceID(ctx context.Context, paymentReferenceID string) error {
+	_, err := q.db.Exec(ctx, lockPaymentReferenceID, paymentReferenceID)
+	return err
+}
+
+const updatePaymentMethodCapturedAmount = `-- name: UpdatePaymentMethodCapturedAmount :one
+UPDATE payment_method
+SET
+    payment_captured_amount = $1
+WHERE
+    payment_method_id = $2
+AND
+    payment_customer_id = $3
+RETURNING uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount
+`
+
+type UpdatePaymentMethodCapturedAmountParams struct {
+	PaymentCapturedAmount decimal.Decimal `json:"payment_captured_amount"`
+	PaymentMethodID       string          `json:"payment_method_id"`
+	PaymentCustomerID     string          `json:"payment_customer_id"`
+}
+
+func (q *Queries) UpdatePaymentMethodCapturedAmount(ctx context.Context, arg *UpdatePaymentMethodCapturedAmountParams) (*PaymentMethod, error) {
+	row := q.db.QueryRow(ctx, updatePaymentMethodCapturedAmount, arg.PaymentCapturedAmount, arg.PaymentMethodID, arg.PaymentCustomerID)
+	var i PaymentMethod
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.PaymentBusinessID,
+		&i.PaymentCustomerID,
+		&i.PaymentType,
+		&i.PaymentStatus,
+		&i.PaymentReusability,
+		&i.PaymentChannel,
+		&i.PaymentAmount,
+		&i.PaymentQrCode,
+		&i.PaymentVirtualAccountNumber,
+		&i.PaymentUrl,
+		&i.PaymentDescription,
+		&i.PaymentFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
 	return &i, err
 }
@@ -214,7 +837,7 @@ WHERE
     payment_method_id = $5
 AND
     payment_customer_id = $6
-RETURNING uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at
+RETURNING uid, payment_method_id, payment_request_id, payment_reference_id, payment_business_id, payment_customer_id, payment_type, payment_status, payment_reusability, payment_channel, payment_amount, payment_qr_code, payment_virtual_account_number, payment_url, payment_description, payment_failure_code, created_at, updated_at, expires_at, paid_at, currency, payment_capture_method, payment_captured_amount, payment_linked_method_id, payment_code, payment_parent_uid, payment_invoice_uid, payment_for_user_id, payment_fee_amount
 `
 
 type UpdatePaymentMethodCustomerParams struct {
@@ -257,6 +880,15 @@ func (q *Queries) UpdatePaymentMethodCustomer(ctx context.Context, arg *UpdatePa
 		&i.UpdatedAt,
 		&i.ExpiresAt,
 		&i.PaidAt,
+		&i.Currency,
+		&i.PaymentCaptureMethod,
+		&i.PaymentCapturedAmount,
+		&i.PaymentLinkedMethodID,
+		&i.PaymentCode,
+		&i.PaymentParentUid,
+		&i.PaymentInvoiceUid,
+		&i.PaymentForUserID,
+		&i.PaymentFeeAmount,
 	)
 	return &i, err
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
@@ -120,6 +121,105 @@ func TestRepoUpdatePaymentMethod(t *testing.T) {
 	require.Equal(t, res.PaymentStatus, paymentStatus.Psname)
 }
 
+func TestRepoListOverduePaymentMethods(t *testing.T) {
+	pm := createRandomPaymentMethod(t)
+
+	arg := ListOverduePaymentMethodsParams{
+		PaymentStatuses: []string{pm.PaymentStatus},
+		ExpiresBefore: pgtype.Timestamptz{
+			Time:  pm.ExpiresAt.Time.Add(time.Second),
+			Valid: true,
+		},
+		AfterExpiresAt: pgtype.Timestamptz{
+			Time:  time.Time{},
+			Valid: true,
+		},
+		BatchSize: 10,
+	}
+
+	res, err := testStore.ListOverduePaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, pm.Uid, res[0].Uid)
+
+	// the keyset cursor skips the rows of the previous batch.
+	arg.AfterExpiresAt = res[0].ExpiresAt
+	arg.AfterUid = res[0].Uid
+
+	res, err = testStore.ListOverduePaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// payments which are not overdue yet are left alone.
+	arg.AfterExpiresAt = pgtype.Timestamptz{Valid: true}
+	arg.AfterUid = ""
+	arg.ExpiresBefore = pm.CreatedAt
+
+	res, err = testStore.ListOverduePaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestRepoListPaymentMethods(t *testing.T) {
+	pm := createRandomPaymentMethod(t)
+
+	arg := ListPaymentMethodsParams{
+		PaymentCustomerID: pgtype.Text{
+			String: pm.PaymentCustomerID,
+			Valid:  true,
+		},
+		CreatedFrom: pgtype.Timestamptz{
+			Time:  pm.CreatedAt.Time.Add(-time.Second),
+			Valid: true,
+		},
+		CreatedTo: pgtype.Timestamptz{
+			Time:  pm.CreatedAt.Time.Add(time.Second),
+			Valid: true,
+		},
+		BeforeCreatedAt: pgtype.Timestamptz{
+			InfinityModifier: pgtype.Infinity,
+			Valid:            true,
+		},
+		PageSize: 10,
+	}
+
+	res, err := testStore.ListPaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, pm.Uid, res[0].Uid)
+
+	count, err := testStore.CountPaymentMethods(context.TODO(), &CountPaymentMethodsParams{
+		PaymentCustomerID: arg.PaymentCustomerID,
+		CreatedFrom:       arg.CreatedFrom,
+		CreatedTo:         arg.CreatedTo,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// the keyset cursor skips the rows of the previous page.
+	arg.BeforeCreatedAt = res[0].CreatedAt
+	arg.BeforeUid = res[0].Uid
+
+	res, err = testStore.ListPaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// filters narrow down the result.
+	arg.BeforeCreatedAt = pgtype.Timestamptz{
+		InfinityModifier: pgtype.Infinity,
+		Valid:            true,
+	}
+	arg.BeforeUid = ""
+	arg.PaymentReferenceID = pgtype.Text{
+		String: helper.RandomString(26),
+		Valid:  true,
+	}
+
+	res, err = testStore.ListPaymentMethods(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
 func createRandomPaymentMethod(t *testing.T) *PaymentMethod {
 	ulid, err := helper.GenerateULID()
 	require.NoError(t, err)
@@ -206,3 +306,19 @@ func createRandomPaymentMethod(t *testing.T) *PaymentMethod {
 
 	return res
 }
+
+// createRandomActivePaymentMethod creates a payment method in the ACTIVE status, which the status
+// updates of a test can move on from.
+func createRandomActivePaymentMethod(t *testing.T) *PaymentMethod {
+	pm := createRandomPaymentMethod(t)
+
+	res, err := testStore.UpdatePaymentMethodCustomer(context.TODO(), &UpdatePaymentMethodCustomerParams{
+		PaymentStatus:     pgtype.Text{String: payment.STATUS_ACTIVE, Valid: true},
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_ACTIVE, res.PaymentStatus)
+
+	return res
+}
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payment_split_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createPaymentSplit = `-- name: CreatePaymentSplit :one
+INSERT INTO payment_split (
+    uid,
+    payment_method_uid,
+    split_rule_id,
+    split_type,
+    destination_account_id,
+    split_amount,
+    currency,
+    split_reference_id,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING uid, payment_method_uid, split_rule_id, split_type, destination_account_id, split_amount, currency, split_reference_id, created_at
+`
+
+type CreatePaymentSplitParams struct {
+	Uid                  string             `json:"uid"`
+	PaymentMethodUid     string             `json:"payment_method_uid"`
+	SplitRuleID          pgtype.Text        `json:"split_rule_id"`
+	SplitType            string             `json:"split_type"`
+	DestinationAccountID string             `json:"destination_account_id"`
+	SplitAmount          decimal.Decimal    `json:"split_amount"`
+	Currency             string             `json:"currency"`
+	SplitReferenceID     pgtype.Text        `json:"split_reference_id"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreatePaymentSplit(ctx context.Context, arg *CreatePaymentSplitParams) (*PaymentSplit, error) {
+	row := q.db.QueryRow(ctx, createPaymentSplit,
+		arg.Uid,
+		arg.PaymentMethodUid,
+		arg.SplitRuleID,
+		arg.SplitType,
+		arg.DestinationAccountID,
+		arg.SplitAmount,
+		arg.Currency,
+		arg.SplitReferenceID,
+		arg.CreatedAt,
+	)
+	var i PaymentSplit
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodUid,
+		&i.SplitRuleID,
+		&i.SplitType,
+		&i.DestinationAccountID,
+		&i.SplitAmount,
+		&i.Currency,
+		&i.SplitReferenceID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listPaymentSplitsByPaymentMethodUid = `-- name: ListPaymentSplitsByPaymentMethodUid :many
+SELECT uid, payment_method_uid, split_rule_id, split_type, destination_account_id, split_amount, currency, split_reference_id, created_at FROM payment_split WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC
+`
+
+func (q *Queries) ListPaymentSplitsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*PaymentSplit, error) {
+	rows, err := q.db.Query(ctx, listPaymentSplitsByPaymentMethodUid, paymentMethodUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentSplit{}
+	for rows.Next() {
+		var i PaymentSplit
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodUid,
+			&i.SplitRuleID,
+			&i.SplitType,
+			&i.DestinationAccountID,
+			&i.SplitAmount,
+			&i.Currency,
+			&i.SplitReferenceID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
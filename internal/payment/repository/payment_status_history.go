@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// recordStatusChange appends an update of a payment to its status history, previousStatus is the status
+// the payment had before it. gatewayUpdatedAt is the updated_at the event carried, if any.
+func (r *Store) recordStatusChange(
+	ctx context.Context,
+	q *Queries,
+	pm *PaymentMethod,
+	previousStatus string,
+	paymentEvent string,
+	eventID string,
+	gatewayUpdatedAt pgtype.Timestamptz,
+) error {
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreatePaymentStatusHistory(ctx, &CreatePaymentStatusHistoryParams{
+		Uid:              uid.String(),
+		PaymentMethodUid: pm.Uid,
+		PreviousStatus:   previousStatus,
+		NewStatus:        pm.PaymentStatus,
+		FailureCode:      pm.PaymentFailureCode,
+		PaymentEvent:     paymentEvent,
+		EventID:          pgtype.Text{String: eventID, Valid: eventID != ""},
+		GatewayUpdatedAt: gatewayUpdatedAt,
+		CreatedAt:        pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("q.CreatePaymentStatusHistory.err: %w", err)
+	}
+
+	return nil
+}
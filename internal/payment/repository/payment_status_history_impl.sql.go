@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payment_status_history_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPaymentStatusHistory = `-- name: CreatePaymentStatusHistory :one
+INSERT INTO payment_status_history (
+    uid,
+    payment_method_uid,
+    previous_status,
+    new_status,
+    failure_code,
+    payment_event,
+    event_id,
+    gateway_updated_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING uid, payment_method_uid, previous_status, new_status, failure_code, payment_event, event_id, gateway_updated_at, created_at
+`
+
+type CreatePaymentStatusHistoryParams struct {
+	Uid              string             `json:"uid"`
+	PaymentMethodUid string             `json:"payment_method_uid"`
+	PreviousStatus   string             `json:"previous_status"`
+	NewStatus        string             `json:"new_status"`
+	FailureCode      pgtype.Text        `json:"failure_code"`
+	PaymentEvent     string             `json:"payment_event"`
+	EventID          pgtype.Text        `json:"event_id"`
+	GatewayUpdatedAt pgtype.Timestamptz `json:"gateway_updated_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreatePaymentStatusHistory(ctx context.Context, arg *CreatePaymentStatusHistoryParams) (*PaymentStatusHistory, error) {
+	row := q.db.QueryRow(ctx, createPaymentStatusHistory,
+		arg.Uid,
+		arg.PaymentMethodUid,
+		arg.PreviousStatus,
+		arg.NewStatus,
+		arg.FailureCode,
+		arg.PaymentEvent,
+		arg.EventID,
+		arg.GatewayUpdatedAt,
+		arg.CreatedAt,
+	)
+	var i PaymentStatusHistory
+	err := row.Scan(
+		&i.Uid,
+		&i.PaymentMethodUid,
+		&i.PreviousStatus,
+		&i.NewStatus,
+		&i.FailureCode,
+		&i.PaymentEvent,
+		&i.EventID,
+		&i.GatewayUpdatedAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listPaymentStatusHistoryByPaymentMethodUid = `-- name: ListPaymentStatusHistoryByPaymentMethodUid :many
+SELECT uid, payment_method_uid, previous_status, new_status, failure_code, payment_event, event_id, gateway_updated_at, created_at FROM payment_status_history WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC
+`
+
+func (q *Queries) ListPaymentStatusHistoryByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*PaymentStatusHistory, error) {
+	rows, err := q.db.Query(ctx, listPaymentStatusHistoryByPaymentMethodUid, paymentMethodUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*PaymentStatusHistory{}
+	for rows.Next() {
+		var i PaymentStatusHistory
+		if err := rows.Scan(
+			&i.Uid,
+			&i.PaymentMethodUid,
+			&i.PreviousStatus,
+			&i.NewStatus,
+			&i.FailureCode,
+			&i.PaymentEvent,
+			&i.EventID,
+			&i.GatewayUpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
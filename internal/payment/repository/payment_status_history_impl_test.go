@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_PAYMENT_STATUS_HISTORY(t *testing.T) {
+	pm := createRandomActivePaymentMethod(t)
+
+	for _, status := range []string{payment.STATUS_PENDING, payment.STATUS_SUCCEEDED, payment.STATUS_SUCCEEDED} {
+		_, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+			UpdateParams: UpdatePaymentMethodCustomerParams{
+				PaymentStatus:     pgtype.Text{String: status, Valid: true},
+				UpdatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				PaymentMethodID:   pm.PaymentMethodID,
+				PaymentCustomerID: pm.PaymentCustomerID,
+			},
+			PaymentEvent: "payment." + status,
+		})
+		require.NoError(t, err)
+	}
+
+	// the redelivered SUCCEEDED event finds the payment settled, it changes nothing and is not recorded.
+	history, err := testStore.ListPaymentStatusHistoryByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	require.Equal(t, pm.PaymentStatus, history[0].PreviousStatus)
+	require.Equal(t, payment.STATUS_PENDING, history[0].NewStatus)
+	require.Equal(t, "payment."+payment.STATUS_PENDING, history[0].PaymentEvent)
+	require.True(t, history[0].GatewayUpdatedAt.Valid)
+	require.False(t, history[0].EventID.Valid)
+
+	require.Equal(t, payment.STATUS_PENDING, history[1].PreviousStatus)
+	require.Equal(t, payment.STATUS_SUCCEEDED, history[1].NewStatus)
+}
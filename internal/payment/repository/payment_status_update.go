@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// markEventProcessed records that the event eventID was handled for pm, it reports false when the
+// event was already handled by an earlier delivery. An update without an event id is never deduped.
+func (r *Store) markEventProcessed(
+	ctx context.Context,
+	q *Queries,
+	pm *PaymentMethod,
+	paymentEvent string,
+	eventID string,
+) (bool, error) {
+	if eventID == "" {
+		return true, nil
+	}
+
+	_, err := q.CreateProcessedEvent(ctx, &CreateProcessedEventParams{
+		EventID:          eventID,
+		PaymentEvent:     paymentEvent,
+		PaymentMethodUid: pm.Uid,
+		ProcessedAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("q.CreateProcessedEvent.err: %w", err)
+	}
+
+	return true, nil
+}
+
+// statusUpdateRejection tells why the update of pm to status with the gateway updatedAt can not be
+// applied, it is empty when the update may be applied.
+func statusUpdateRejection(pm *PaymentMethod, status pgtype.Text, updatedAt pgtype.Timestamptz) string {
+	if updatedAt.Valid && pm.UpdatedAt.Valid && updatedAt.Time.Before(pm.UpdatedAt.Time) {
+		return payment.STATUS_UPDATE_REJECTED_STALE
+	}
+
+	if status.Valid && !payment.CanTransitionStatus(pm.PaymentStatus, status.String) {
+		return payment.STATUS_UPDATE_REJECTED_TRANSITION
+	}
+
+	return ""
+}
@@ -10,7 +10,8 @@ import (
 )
 
 type Customer struct {
-	Uid               string             `json:"uid"`
+	Uid string `json:"uid"`
+	// the uid of the app user the customer belongs to, the uid of their access token
 	CustomerAppID     string             `json:"customer_app_id"`
 	PaymentCustomerID string             `json:"payment_customer_id"`
 	CustomerName      string             `json:"customer_name"`
@@ -19,6 +20,119 @@ type Customer struct {
 	PhoneNumber       pgtype.Text        `json:"phone_number"`
 }
 
+type DeadLetterMessage struct {
+	Uid string `json:"uid"`
+	// the topic the message was first published to, a replay publishes it there again
+	SourceTopic         string `json:"source_topic"`
+	SourcePartition     int32  `json:"source_partition"`
+	SourceOffset        int64  `json:"source_offset"`
+	DeadLetterTopic     string `json:"dead_letter_topic"`
+	DeadLetterPartition int32  `json:"dead_letter_partition"`
+	DeadLetterOffset    int64  `json:"dead_letter_offset"`
+	MessageKey          []byte `json:"message_key"`
+	Payload             []byte `json:"payload"`
+	// the headers the message was first published with
+	Headers      []byte `json:"headers"`
+	ErrorMessage string `json:"error_message"`
+	// how many times processing the message failed before it was dead-lettered
+	Attempts int32 `json:"attempts"`
+	// when the message first failed
+	FirstSeenAt      pgtype.Timestamptz `json:"first_seen_at"`
+	DeadLetteredAt   pgtype.Timestamptz `json:"dead_lettered_at"`
+	DeadLetterStatus string             `json:"dead_letter_status"`
+	ReplayCount      int32              `json:"replay_count"`
+	ReplayedAt       pgtype.Timestamptz `json:"replayed_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	// sha256 of the create payment request without its idempotency key, a reused key with another body is rejected
+	RequestFingerprint string `json:"request_fingerprint"`
+	IdempotencyStatus  string `json:"idempotency_status"`
+	// the token of the request holding the reservation, only that request may complete it
+	FencingToken int64 `json:"fencing_token"`
+	// the create payment response replayed to exact retries once the key is completed
+	ResponsePayload []byte             `json:"response_payload"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	CompletedAt     pgtype.Timestamptz `json:"completed_at"`
+	// the end of the lease of the request holding the reservation, another request may only take it over after it
+	ReservedUntil pgtype.Timestamptz `json:"reserved_until"`
+}
+
+type Invoice struct {
+	Uid                string `json:"uid"`
+	InvoiceID          string `json:"invoice_id"`
+	InvoiceReferenceID string `json:"invoice_reference_id"`
+	InvoiceBusinessID  string `json:"invoice_business_id"`
+	PaymentCustomerID  string `json:"payment_customer_id"`
+	// PENDING, PAID, SETTLED or EXPIRED
+	InvoiceStatus string          `json:"invoice_status"`
+	InvoiceAmount decimal.Decimal `json:"invoice_amount"`
+	Currency      string          `json:"currency"`
+	// the payment channels the customer can choose from on the hosted checkout page
+	InvoiceAllowedChannels []string           `json:"invoice_allowed_channels"`
+	InvoiceUrl             string             `json:"invoice_url"`
+	InvoiceDescription     string             `json:"invoice_description"`
+	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+	ExpiresAt              pgtype.Timestamptz `json:"expires_at"`
+	PaidAt                 pgtype.Timestamptz `json:"paid_at"`
+}
+
+type LedgerAccount struct {
+	Uid string `json:"uid"`
+	// GATEWAY_CLEARING, MERCHANT_PAYABLE, SUB_ACCOUNT_PAYABLE, PLATFORM_FEE_REVENUE or GATEWAY_FEE_EXPENSE
+	AccountCode string `json:"account_code"`
+	// the sub-account a SUB_ACCOUNT_PAYABLE is owed to, empty for the accounts of the platform
+	AccountOwner string `json:"account_owner"`
+	// ASSET, LIABILITY, REVENUE or EXPENSE, it tells which side the balance of the account is on
+	AccountType string             `json:"account_type"`
+	Currency    string             `json:"currency"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type LedgerEntry struct {
+	Uid string `json:"uid"`
+	// the entry type and the row it books, an event is never booked twice
+	IdempotencyKey string `json:"idempotency_key"`
+	// PAYMENT, FEE or REFUND
+	EntryType        string             `json:"entry_type"`
+	PaymentMethodUid string             `json:"payment_method_uid"`
+	RefundUid        pgtype.Text        `json:"refund_uid"`
+	Currency         string             `json:"currency"`
+	EntryDescription string             `json:"entry_description"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type LedgerPosting struct {
+	Uid        string `json:"uid"`
+	EntryUid   string `json:"entry_uid"`
+	AccountUid string `json:"account_uid"`
+	Direction  string `json:"direction"`
+	// always positive, the direction tells the side of the account it is posted to
+	Amount    decimal.Decimal    `json:"amount"`
+	Currency  string             `json:"currency"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type Outbox struct {
+	Uid string `json:"uid"`
+	// the order the events were written in, the relay publishes them in the same order
+	Sequence int64 `json:"sequence"`
+	// the uid of the payment method the event is about
+	AggregateUid string `json:"aggregate_uid"`
+	EventType    string `json:"event_type"`
+	// the snapshot of the payment the event publishes, taken in the transaction which changed it
+	Payload      []byte `json:"payload"`
+	OutboxStatus string `json:"outbox_status"`
+	// how many times publishing the event has failed
+	Attempts  int32              `json:"attempts"`
+	LastError pgtype.Text        `json:"last_error"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	SentAt    pgtype.Timestamptz `json:"sent_at"`
+}
+
 type PaymentChannel struct {
 	Uid             string          `json:"uid"`
 	Pcname          string          `json:"pcname"`
@@ -30,6 +144,10 @@ type PaymentChannel struct {
 	IsTaxPercentage bool            `json:"is_tax_percentage"`
 	IsActive        bool            `json:"is_active"`
 	IsAvailable     bool            `json:"is_available"`
+	// ISO 4217 currency code, min_amount and max_amount are in this currency
+	Currency string `json:"currency"`
+	// ISO 3166-1 alpha-2 country code
+	Country string `json:"country"`
 }
 
 type PaymentMethod struct {
@@ -58,16 +176,136 @@ type PaymentMethod struct {
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 	ExpiresAt          pgtype.Timestamptz `json:"expires_at"`
 	PaidAt             pgtype.Timestamptz `json:"paid_at"`
+	Currency           string             `json:"currency"`
+	// MANUAL holds an authorized CARD payment in AWAITING_CAPTURE until it is captured or voided
+	PaymentCaptureMethod string `json:"payment_capture_method"`
+	// for CARD payment type, the amount captured out of the authorized payment_amount
+	PaymentCapturedAmount decimal.Decimal `json:"payment_captured_amount"`
+	// for DIRECT_DEBIT charges, the MULTIPLE_USE payment method linked to the customer bank account, the charge itself is keyed by its payment request
+	PaymentLinkedMethodID pgtype.Text `json:"payment_linked_method_id"`
+	// for OVER_THE_COUNTER payment type, the code the customer pays with at the retail outlet
+	PaymentCode pgtype.Text `json:"payment_code"`
+	// for payments made to a MULTIPLE_USE VIRTUAL_ACCOUNT or QR_CODE payment method, the uid of that payment method
+	PaymentParentUid pgtype.Text `json:"payment_parent_uid"`
+	// for payments made through a hosted checkout page, the uid of the invoice it paid
+	PaymentInvoiceUid pgtype.Text `json:"payment_invoice_uid"`
+	// for marketplace payments, the sub-account the payment is collected on behalf of
+	PaymentForUserID pgtype.Text `json:"payment_for_user_id"`
+	// the channel fee the payment was priced with, booked to the ledger once the payment succeeds
+	PaymentFeeAmount decimal.Decimal `json:"payment_fee_amount"`
 }
 
 type PaymentReusability struct {
 	Prname string `json:"prname"`
 }
 
+type PaymentSplit struct {
+	Uid              string `json:"uid"`
+	PaymentMethodUid string `json:"payment_method_uid"`
+	// the split rule the gateway routed the payment with, the REMAINDER stays with the sub-account
+	SplitRuleID pgtype.Text `json:"split_rule_id"`
+	// PLATFORM_FEE, ROUTE or REMAINDER
+	SplitType            string             `json:"split_type"`
+	DestinationAccountID string             `json:"destination_account_id"`
+	SplitAmount          decimal.Decimal    `json:"split_amount"`
+	Currency             string             `json:"currency"`
+	SplitReferenceID     pgtype.Text        `json:"split_reference_id"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+}
+
 type PaymentStatus struct {
 	Psname string `json:"psname"`
 }
 
+type PaymentStatusHistory struct {
+	Uid              string      `json:"uid"`
+	PaymentMethodUid string      `json:"payment_method_uid"`
+	PreviousStatus   string      `json:"previous_status"`
+	NewStatus        string      `json:"new_status"`
+	FailureCode      pgtype.Text `json:"failure_code"`
+	// the event the update came from, the callback event of the gateway or the operation which made it
+	PaymentEvent string `json:"payment_event"`
+	// the kafka topic/partition/offset or the webhook id of the event, empty when it has none
+	EventID pgtype.Text `json:"event_id"`
+	// when the gateway updated the payment, as the event reported it
+	GatewayUpdatedAt pgtype.Timestamptz `json:"gateway_updated_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
 type PaymentType struct {
 	Ptname string `json:"ptname"`
 }
+
+type Payout struct {
+	Uid string `json:"uid"`
+	// the id of the payout at the gateway, null until the gateway accepted it
+	PayoutID pgtype.Text `json:"payout_id"`
+	// sent to the gateway as well, a retried request with the same key never pays out twice
+	IdempotencyKey    string          `json:"idempotency_key"`
+	PayoutReferenceID string          `json:"payout_reference_id"`
+	PayoutBusinessID  pgtype.Text     `json:"payout_business_id"`
+	PayoutChannel     string          `json:"payout_channel"`
+	AccountNumber     string          `json:"account_number"`
+	AccountHolderName string          `json:"account_holder_name"`
+	PayoutAmount      decimal.Decimal `json:"payout_amount"`
+	Currency          string          `json:"currency"`
+	PayoutDescription string          `json:"payout_description"`
+	// PENDING until the gateway accepted it, then ACCEPTED, REQUESTED, SUCCEEDED, FAILED, CANCELLED or REVERSED
+	PayoutStatus       string             `json:"payout_status"`
+	PayoutFailureCode  pgtype.Text        `json:"payout_failure_code"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	EstimatedArrivalAt pgtype.Timestamptz `json:"estimated_arrival_at"`
+}
+
+type ProcessedEvent struct {
+	// the gateway event id, or the kafka topic/partition/offset of an event without one
+	EventID      string `json:"event_id"`
+	PaymentEvent string `json:"payment_event"`
+	// the payment the event was applied to, or rejected for
+	PaymentMethodUid string             `json:"payment_method_uid"`
+	ProcessedAt      pgtype.Timestamptz `json:"processed_at"`
+}
+
+type ReconciliationDiscrepancy struct {
+	Uid string `json:"uid"`
+	// MISSING, EXTRA, AMOUNT_MISMATCH or STATUS_MISMATCH
+	DiscrepancyType string `json:"discrepancy_type"`
+	// the payment the discrepancy is about, a discrepancy found again by a later report is updated in place
+	DiscrepancyKey string `json:"discrepancy_key"`
+	// the matching payment, empty for an EXTRA row of the report
+	PaymentMethodUid   pgtype.Text `json:"payment_method_uid"`
+	PaymentMethodID    pgtype.Text `json:"payment_method_id"`
+	PaymentRequestID   pgtype.Text `json:"payment_request_id"`
+	PaymentReferenceID pgtype.Text `json:"payment_reference_id"`
+	// the amount of our record, 0 for an EXTRA row of the report
+	ExpectedAmount decimal.Decimal `json:"expected_amount"`
+	// the amount of the report, 0 for a MISSING payment
+	ReportedAmount decimal.Decimal `json:"reported_amount"`
+	ExpectedStatus pgtype.Text     `json:"expected_status"`
+	ReportedStatus pgtype.Text     `json:"reported_status"`
+	Currency       string          `json:"currency"`
+	// GATEWAY for a report fetched from the gateway or FILE for a report dropped on disk
+	ReportSource string `json:"report_source"`
+	// the gateway report id or the name of the file the discrepancy was last found in
+	ReportReference string             `json:"report_reference"`
+	DetectedAt      pgtype.Timestamptz `json:"detected_at"`
+	LastDetectedAt  pgtype.Timestamptz `json:"last_detected_at"`
+}
+
+type Refund struct {
+	Uid string `json:"uid"`
+	// empty until the gateway accepted the refund
+	RefundID          pgtype.Text     `json:"refund_id"`
+	PaymentMethodUid  string          `json:"payment_method_uid"`
+	PaymentMethodID   string          `json:"payment_method_id"`
+	RefundReferenceID string          `json:"refund_reference_id"`
+	RefundStatus      string          `json:"refund_status"`
+	RefundAmount      decimal.Decimal `json:"refund_amount"`
+	// FRAUDULENT, DUPLICATE, REQUESTED_BY_CUSTOMER, CANCELLATION or OTHERS
+	RefundReason      string             `json:"refund_reason"`
+	RefundFailureCode pgtype.Text        `json:"refund_failure_code"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	Currency          string             `json:"currency"`
+}
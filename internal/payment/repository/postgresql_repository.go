@@ -7,29 +7,82 @@ package repository
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
+	CompleteIdempotencyKey(ctx context.Context, arg *CompleteIdempotencyKeyParams) (*IdempotencyKey, error)
+	CountDeadLetterMessages(ctx context.Context, arg *CountDeadLetterMessagesParams) (int64, error)
+	CountPaymentMethods(ctx context.Context, arg *CountPaymentMethodsParams) (int64, error)
+	CountPaymentMethodsByReferenceIDAndStatuses(ctx context.Context, arg *CountPaymentMethodsByReferenceIDAndStatusesParams) (int64, error)
+	CountReconciliationDiscrepancies(ctx context.Context, arg *CountReconciliationDiscrepanciesParams) (int64, error)
 	CreateCustomer(ctx context.Context, arg *CreateCustomerParams) (*Customer, error)
+	CreateDeadLetterMessage(ctx context.Context, arg *CreateDeadLetterMessageParams) (*DeadLetterMessage, error)
+	CreateInvoice(ctx context.Context, arg *CreateInvoiceParams) (*Invoice, error)
+	CreateLedgerEntry(ctx context.Context, arg *CreateLedgerEntryParams) (*LedgerEntry, error)
+	CreateLedgerPosting(ctx context.Context, arg *CreateLedgerPostingParams) (*LedgerPosting, error)
+	CreateOutboxMessage(ctx context.Context, arg *CreateOutboxMessageParams) (*Outbox, error)
 	CreatePaymentChannel(ctx context.Context, arg *CreatePaymentChannelParams) (*PaymentChannel, error)
 	CreatePaymentMethod(ctx context.Context, arg *CreatePaymentMethodParams) (*PaymentMethod, error)
 	CreatePaymentReusability(ctx context.Context, prname string) (string, error)
+	CreatePaymentSplit(ctx context.Context, arg *CreatePaymentSplitParams) (*PaymentSplit, error)
 	CreatePaymentStatus(ctx context.Context, psname string) (string, error)
+	CreatePaymentStatusHistory(ctx context.Context, arg *CreatePaymentStatusHistoryParams) (*PaymentStatusHistory, error)
 	CreatePaymentType(ctx context.Context, ptname string) (string, error)
+	CreateProcessedEvent(ctx context.Context, arg *CreateProcessedEventParams) (*ProcessedEvent, error)
+	CreateRefund(ctx context.Context, arg *CreateRefundParams) (*Refund, error)
+	DeleteProcessedEventsBefore(ctx context.Context, processedBefore pgtype.Timestamptz) (int64, error)
 	GetAvailablePaymentChannel(ctx context.Context, arg *GetAvailablePaymentChannelParams) (*PaymentChannel, error)
-	GetAvailablePaymentChannels(ctx context.Context, minAmount decimal.Decimal) ([]*PaymentChannel, error)
+	GetAvailablePaymentChannels(ctx context.Context, arg *GetAvailablePaymentChannelsParams) ([]*PaymentChannel, error)
+	GetChildPaymentMethod(ctx context.Context, arg *GetChildPaymentMethodParams) (*PaymentMethod, error)
 	GetCustomerByCustomerAppID(ctx context.Context, customerAppID string) (*Customer, error)
 	GetCustomerByPaymentCustomerID(ctx context.Context, paymentCustomerID string) (*Customer, error)
+	GetDeadLetterMessage(ctx context.Context, uid string) (*DeadLetterMessage, error)
+	GetIdempotencyKey(ctx context.Context, idempotencyKey string) (*IdempotencyKey, error)
+	GetInvoice(ctx context.Context, uid string) (*Invoice, error)
+	GetInvoiceByInvoiceID(ctx context.Context, invoiceID string) (*Invoice, error)
+	GetInvoiceByInvoiceIDForUpdate(ctx context.Context, invoiceID string) (*Invoice, error)
+	GetOutboxLag(ctx context.Context, outboxStatus string) (*GetOutboxLagRow, error)
 	GetPaymentChannelByID(ctx context.Context, uid string) (*PaymentChannel, error)
-	GetPaymentChannelByName(ctx context.Context, pcname string) (*PaymentChannel, error)
+	GetPaymentChannelByNameAndCurrency(ctx context.Context, arg *GetPaymentChannelByNameAndCurrencyParams) (*PaymentChannel, error)
+	GetPaymentMethodByInvoiceUid(ctx context.Context, paymentInvoiceUid pgtype.Text) (*PaymentMethod, error)
 	GetPaymentMethodByPaymentMethodID(ctx context.Context, paymentMethodID string) (*PaymentMethod, error)
+	GetPaymentMethodByPaymentRequestID(ctx context.Context, paymentRequestID pgtype.Text) (*PaymentMethod, error)
 	GetPaymentMethodByReferenceID(ctx context.Context, paymentReferenceID string) (*PaymentMethod, error)
 	GetPaymentMethodCustomer(ctx context.Context, arg *GetPaymentMethodCustomerParams) (*PaymentMethod, error)
+	GetPaymentMethodCustomerForUpdate(ctx context.Context, arg *GetPaymentMethodCustomerForUpdateParams) (*PaymentMethod, error)
 	GetPaymentReusabilityByName(ctx context.Context, prname string) (string, error)
 	GetPaymentStatusByName(ctx context.Context, psname string) (string, error)
 	GetPaymentTypeByName(ctx context.Context, ptname string) (string, error)
+	GetProcessedEvent(ctx context.Context, eventID string) (*ProcessedEvent, error)
+	GetRefund(ctx context.Context, uid string) (*Refund, error)
+	GetRefundByRefundID(ctx context.Context, refundID pgtype.Text) (*Refund, error)
+	GetRefundedAmount(ctx context.Context, paymentMethodUid string) (decimal.Decimal, error)
+	ListDeadLetterMessages(ctx context.Context, arg *ListDeadLetterMessagesParams) ([]*DeadLetterMessage, error)
+	ListLedgerAccountBalances(ctx context.Context, arg *ListLedgerAccountBalancesParams) ([]*ListLedgerAccountBalancesRow, error)
+	ListLedgerEntriesByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*LedgerEntry, error)
+	ListLedgerPostingsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*ListLedgerPostingsByPaymentMethodUidRow, error)
+	ListOverduePaymentMethods(ctx context.Context, arg *ListOverduePaymentMethodsParams) ([]*PaymentMethod, error)
+	ListPaymentMethods(ctx context.Context, arg *ListPaymentMethodsParams) ([]*PaymentMethod, error)
+	ListPaymentMethodsByReferenceID(ctx context.Context, paymentReferenceID string) ([]*PaymentMethod, error)
+	ListPaymentSplitsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*PaymentSplit, error)
+	ListPaymentStatusHistoryByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*PaymentStatusHistory, error)
+	ListPendingOutboxMessages(ctx context.Context, arg *ListPendingOutboxMessagesParams) ([]*Outbox, error)
+	ListReconciliationDiscrepancies(ctx context.Context, arg *ListReconciliationDiscrepanciesParams) ([]*ReconciliationDiscrepancy, error)
+	ListRefundsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*Refund, error)
+	ListSucceededPaymentMethods(ctx context.Context, arg *ListSucceededPaymentMethodsParams) ([]*PaymentMethod, error)
+	LockPaymentReferenceID(ctx context.Context, paymentReferenceID string) error
+	MarkDeadLetterMessageReplayed(ctx context.Context, arg *MarkDeadLetterMessageReplayedParams) (*DeadLetterMessage, error)
+	MarkOutboxMessageFailed(ctx context.Context, arg *MarkOutboxMessageFailedParams) error
+	MarkOutboxMessageSent(ctx context.Context, arg *MarkOutboxMessageSentParams) error
+	ReserveIdempotencyKey(ctx context.Context, arg *ReserveIdempotencyKeyParams) (*IdempotencyKey, error)
+	UpdateInvoice(ctx context.Context, arg *UpdateInvoiceParams) (*Invoice, error)
+	UpdatePaymentMethodCapturedAmount(ctx context.Context, arg *UpdatePaymentMethodCapturedAmountParams) (*PaymentMethod, error)
 	UpdatePaymentMethodCustomer(ctx context.Context, arg *UpdatePaymentMethodCustomerParams) (*PaymentMethod, error)
+	UpdateRefund(ctx context.Context, arg *UpdateRefundParams) (*Refund, error)
+	UpsertLedgerAccount(ctx context.Context, arg *UpsertLedgerAccountParams) (*LedgerAccount, error)
+	UpsertReconciliationDiscrepancy(ctx context.Context, arg *UpsertReconciliationDiscrepancyParams) (*ReconciliationDiscrepancy, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,79 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: processed_event_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createProcessedEvent = `-- name: CreateProcessedEvent :one
+INSERT INTO processed_event (
+    event_id,
+    payment_event,
+    payment_method_uid,
+    processed_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (event_id) DO NOTHING
+RETURNING event_id, payment_event, payment_method_uid, processed_at
+`
+
+type CreateProcessedEventParams struct {
+	EventID          string             `json:"event_id"`
+	PaymentEvent     string             `json:"payment_event"`
+	PaymentMethodUid string             `json:"payment_method_uid"`
+	ProcessedAt      pgtype.Timestamptz `json:"processed_at"`
+}
+
+func (q *Queries) CreateProcessedEvent(ctx context.Context, arg *CreateProcessedEventParams) (*ProcessedEvent, error) {
+	row := q.db.QueryRow(ctx, createProcessedEvent,
+		arg.EventID,
+		arg.PaymentEvent,
+		arg.PaymentMethodUid,
+		arg.ProcessedAt,
+	)
+	var i ProcessedEvent
+	err := row.Scan(
+		&i.EventID,
+		&i.PaymentEvent,
+		&i.PaymentMethodUid,
+		&i.ProcessedAt,
+	)
+	return &i, err
+}
+
+const deleteProcessedEventsBefore = `-- name: DeleteProcessedEventsBefore :execrows
+DELETE FROM processed_event
+WHERE processed_at < $1::timestamptz
+`
+
+func (q *Queries) DeleteProcessedEventsBefore(ctx context.Context, processedBefore pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteProcessedEventsBefore, processedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getProcessedEvent = `-- name: GetProcessedEvent :one
+SELECT event_id, payment_event, payment_method_uid, processed_at FROM processed_event
+WHERE event_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetProcessedEvent(ctx context.Context, eventID string) (*ProcessedEvent, error) {
+	row := q.db.QueryRow(ctx, getProcessedEvent, eventID)
+	var i ProcessedEvent
+	err := row.Scan(
+		&i.EventID,
+		&i.PaymentEvent,
+		&i.PaymentMethodUid,
+		&i.ProcessedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_DELETE_PROCESSED_EVENTS_BEFORE(t *testing.T) {
+	retention := 30 * 24 * time.Hour
+
+	expired := createRandomProcessedEvent(t, time.Now().Add(-2*retention))
+	retained := createRandomProcessedEvent(t, time.Now())
+
+	deleted, err := testStore.DeleteProcessedEventsBefore(context.TODO(), pgtype.Timestamptz{
+		Time:  time.Now().Add(-retention),
+		Valid: true,
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = testStore.GetProcessedEvent(context.TODO(), expired.EventID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	found, err := testStore.GetProcessedEvent(context.TODO(), retained.EventID)
+	require.NoError(t, err)
+	require.Equal(t, retained.PaymentMethodUid, found.PaymentMethodUid)
+}
+
+func createRandomProcessedEvent(t *testing.T, processedAt time.Time) *ProcessedEvent {
+	arg := &CreateProcessedEventParams{
+		EventID:          helper.RandomString(32),
+		PaymentEvent:     "payment.succeeded",
+		PaymentMethodUid: helper.RandomString(26),
+		ProcessedAt:      pgtype.Timestamptz{Time: processedAt, Valid: true},
+	}
+
+	res, err := testStore.CreateProcessedEvent(context.TODO(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.EventID, res.EventID)
+
+	return res
+}
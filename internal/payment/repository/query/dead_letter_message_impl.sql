@@ -0,0 +1,60 @@
+-- name: CreateDeadLetterMessage :one
+INSERT INTO dead_letter_message (
+    uid,
+    source_topic,
+    source_partition,
+    source_offset,
+    dead_letter_topic,
+    dead_letter_partition,
+    dead_letter_offset,
+    message_key,
+    payload,
+    headers,
+    error_message,
+    attempts,
+    first_seen_at,
+    dead_lettered_at,
+    dead_letter_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+) ON CONFLICT (dead_letter_topic, dead_letter_partition, dead_letter_offset) DO NOTHING
+RETURNING *;
+
+-- name: GetDeadLetterMessage :one
+SELECT * FROM dead_letter_message WHERE uid = $1 LIMIT 1;
+
+-- name: ListDeadLetterMessages :many
+SELECT * FROM dead_letter_message
+WHERE
+    (sqlc.narg(source_topic)::varchar IS NULL OR source_topic = sqlc.narg(source_topic))
+AND
+    (sqlc.narg(dead_letter_status)::varchar IS NULL OR dead_letter_status = sqlc.narg(dead_letter_status))
+AND
+    dead_lettered_at >= sqlc.arg(dead_lettered_from)
+AND
+    dead_lettered_at < sqlc.arg(dead_lettered_to)
+AND
+    (dead_lettered_at, uid) < (sqlc.arg(before_dead_lettered_at)::timestamptz, sqlc.arg(before_uid)::varchar)
+ORDER BY dead_lettered_at DESC, uid DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountDeadLetterMessages :one
+SELECT COUNT(*) FROM dead_letter_message
+WHERE
+    (sqlc.narg(source_topic)::varchar IS NULL OR source_topic = sqlc.narg(source_topic))
+AND
+    (sqlc.narg(dead_letter_status)::varchar IS NULL OR dead_letter_status = sqlc.narg(dead_letter_status))
+AND
+    dead_lettered_at >= sqlc.arg(dead_lettered_from)
+AND
+    dead_lettered_at < sqlc.arg(dead_lettered_to);
+
+-- name: MarkDeadLetterMessageReplayed :one
+UPDATE dead_letter_message
+SET
+    dead_letter_status = @dead_letter_status::varchar,
+    replay_count = replay_count + 1,
+    replayed_at = @replayed_at::timestamptz
+WHERE uid = @uid::varchar
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,33 @@
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_key
+WHERE idempotency_key = $1 LIMIT 1;
+
+-- name: ReserveIdempotencyKey :one
+INSERT INTO idempotency_key (
+    idempotency_key,
+    request_fingerprint,
+    idempotency_status,
+    fencing_token,
+    created_at,
+    reserved_until
+) VALUES (
+    $1, $2, $3, 1, $4, $5
+)
+ON CONFLICT (idempotency_key) DO UPDATE
+SET
+    fencing_token = idempotency_key.fencing_token + 1,
+    reserved_until = EXCLUDED.reserved_until
+WHERE idempotency_key.idempotency_status = EXCLUDED.idempotency_status
+    AND idempotency_key.request_fingerprint = EXCLUDED.request_fingerprint
+    AND idempotency_key.reserved_until < now()
+RETURNING *;
+
+-- name: CompleteIdempotencyKey :one
+UPDATE idempotency_key
+SET
+    idempotency_status = @idempotency_status::varchar,
+    response_payload = @response_payload::bytea,
+    completed_at = @completed_at::timestamptz
+WHERE idempotency_key = @idempotency_key::varchar
+    AND fencing_token = @fencing_token::bigint
+RETURNING *;
\ No newline at end of file
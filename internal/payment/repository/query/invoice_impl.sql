@@ -0,0 +1,40 @@
+-- name: CreateInvoice :one
+INSERT INTO invoice (
+    uid,
+    invoice_id,
+    invoice_reference_id,
+    invoice_business_id,
+    payment_customer_id,
+    invoice_status,
+    invoice_amount,
+    currency,
+    invoice_allowed_channels,
+    invoice_url,
+    invoice_description,
+    created_at,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING *;
+
+-- name: GetInvoice :one
+SELECT * FROM invoice WHERE uid = $1 LIMIT 1;
+
+-- name: GetInvoiceByInvoiceID :one
+SELECT * FROM invoice WHERE invoice_id = $1 LIMIT 1;
+
+-- name: GetInvoiceByInvoiceIDForUpdate :one
+SELECT * FROM invoice WHERE invoice_id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: GetPaymentMethodByInvoiceUid :one
+SELECT * FROM payment_method WHERE payment_invoice_uid = $1 LIMIT 1;
+
+-- name: UpdateInvoice :one
+UPDATE invoice
+SET
+    invoice_status = COALESCE(sqlc.narg(invoice_status), invoice_status),
+    updated_at = COALESCE(sqlc.narg(updated_at), updated_at),
+    paid_at = COALESCE(sqlc.narg(paid_at), paid_at)
+WHERE
+    uid = sqlc.arg(uid)
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,70 @@
+-- name: UpsertLedgerAccount :one
+INSERT INTO ledger_account (
+    uid,
+    account_code,
+    account_owner,
+    account_type,
+    currency,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (account_code, account_owner, currency) DO UPDATE SET
+    account_code = EXCLUDED.account_code
+RETURNING *;
+
+-- name: CreateLedgerEntry :one
+INSERT INTO ledger_entry (
+    uid,
+    idempotency_key,
+    entry_type,
+    payment_method_uid,
+    refund_uid,
+    currency,
+    entry_description,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) ON CONFLICT (idempotency_key) DO NOTHING
+RETURNING *;
+
+-- name: CreateLedgerPosting :one
+INSERT INTO ledger_posting (
+    uid,
+    entry_uid,
+    account_uid,
+    direction,
+    amount,
+    currency,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListLedgerEntriesByPaymentMethodUid :many
+SELECT * FROM ledger_entry WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC;
+
+-- name: ListLedgerPostingsByPaymentMethodUid :many
+SELECT
+    ledger_posting.*,
+    ledger_account.account_code,
+    ledger_account.account_owner,
+    ledger_account.account_type
+FROM ledger_posting
+JOIN ledger_entry ON ledger_entry.uid = ledger_posting.entry_uid
+JOIN ledger_account ON ledger_account.uid = ledger_posting.account_uid
+WHERE ledger_entry.payment_method_uid = $1
+ORDER BY ledger_posting.created_at ASC, ledger_posting.uid ASC;
+
+-- name: ListLedgerAccountBalances :many
+SELECT
+    ledger_account.*,
+    COALESCE(SUM(ledger_posting.amount) FILTER (WHERE ledger_posting.direction = 'DEBIT'), 0)::numeric AS debit_amount,
+    COALESCE(SUM(ledger_posting.amount) FILTER (WHERE ledger_posting.direction = 'CREDIT'), 0)::numeric AS credit_amount
+FROM ledger_account
+LEFT JOIN ledger_posting ON ledger_posting.account_uid = ledger_account.uid
+WHERE
+    (sqlc.narg(currency)::varchar IS NULL OR ledger_account.currency = sqlc.narg(currency)) AND
+    (sqlc.narg(account_code)::varchar IS NULL OR ledger_account.account_code = sqlc.narg(account_code)) AND
+    (sqlc.narg(account_owner)::varchar IS NULL OR ledger_account.account_owner = sqlc.narg(account_owner))
+GROUP BY ledger_account.uid
+ORDER BY ledger_account.account_code ASC, ledger_account.account_owner ASC, ledger_account.currency ASC;
\ No newline at end of file
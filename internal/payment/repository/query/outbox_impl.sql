@@ -0,0 +1,40 @@
+-- name: CreateOutboxMessage :one
+INSERT INTO outbox (
+    uid,
+    aggregate_uid,
+    event_type,
+    payload,
+    outbox_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListPendingOutboxMessages :many
+SELECT * FROM outbox
+WHERE outbox_status = @outbox_status::varchar
+ORDER BY sequence ASC
+LIMIT @batch_size::int;
+
+-- name: MarkOutboxMessageSent :exec
+UPDATE outbox
+SET
+    outbox_status = @outbox_status::varchar,
+    last_error = NULL,
+    sent_at = @sent_at::timestamptz
+WHERE uid = @uid::varchar;
+
+-- name: MarkOutboxMessageFailed :exec
+UPDATE outbox
+SET
+    outbox_status = @outbox_status::varchar,
+    attempts = attempts + 1,
+    last_error = @last_error::varchar
+WHERE uid = @uid::varchar;
+
+-- name: GetOutboxLag :one
+SELECT
+    COUNT(*)::bigint AS pending_count,
+    COALESCE(MIN(created_at), now())::timestamptz AS oldest_created_at
+FROM outbox
+WHERE outbox_status = @outbox_status::varchar;
\ No newline at end of file
@@ -6,19 +6,21 @@ INSERT INTO payment_channel (
     min_amount,
     max_amount,
     tax,
-    is_tax_percentage
+    is_tax_percentage,
+    currency,
+    country
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: GetPaymentChannelByID :one
 SELECT * FROM payment_channel WHERE uid = $1 LIMIT 1;
 
--- name: GetPaymentChannelByName :one
-SELECT * FROM payment_channel WHERE pcname = $1 LIMIT 1;
+-- name: GetPaymentChannelByNameAndCurrency :one
+SELECT * FROM payment_channel WHERE pcname = $1 AND currency = $2 LIMIT 1;
 
 -- name: GetAvailablePaymentChannels :many
-SELECT * FROM payment_channel WHERE  $1 > min_amount  AND $1 < max_amount AND is_active = true AND is_available = true;
+SELECT * FROM payment_channel WHERE currency = @currency AND @min_amount > min_amount AND @min_amount < max_amount AND is_active = true AND is_available = true;
 
 -- name: GetAvailablePaymentChannel :one
-SELECT * FROM payment_channel WHERE pc_type = $1 AND pcname = $2 AND $3 > min_amount AND $3 < max_amount AND is_active = true AND is_available = true LIMIT 1;
\ No newline at end of file
+SELECT * FROM payment_channel WHERE pc_type = $1 AND pcname = $2 AND $3 > min_amount AND $3 < max_amount AND currency = $4 AND is_active = true AND is_available = true LIMIT 1;
\ No newline at end of file
@@ -16,17 +16,41 @@ INSERT INTO payment_method (
     payment_url,
     payment_description,
     created_at,
-    expires_at
+    expires_at,
+    currency,
+    payment_capture_method,
+    payment_linked_method_id,
+    payment_code,
+    payment_parent_uid,
+    payment_invoice_uid,
+    payment_for_user_id,
+    payment_fee_amount
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25
 ) RETURNING *;
 
 -- name: GetPaymentMethodByPaymentMethodID :one
 SELECT * FROM payment_method WHERE payment_method_id = $1 LIMIT 1;
 
+-- name: GetPaymentMethodByPaymentRequestID :one
+SELECT * FROM payment_method WHERE payment_request_id = $1 LIMIT 1;
+
 -- name: GetPaymentMethodByReferenceID :one
 SELECT * FROM payment_method WHERE payment_reference_id = $1 LIMIT 1;
 
+-- name: ListPaymentMethodsByReferenceID :many
+SELECT * FROM payment_method WHERE payment_reference_id = $1 ORDER BY created_at DESC, uid DESC;
+
+-- name: CountPaymentMethodsByReferenceIDAndStatuses :one
+SELECT COUNT(*) FROM payment_method
+WHERE
+    payment_reference_id = sqlc.arg(payment_reference_id)
+AND
+    payment_status = ANY(sqlc.arg(payment_statuses)::varchar[]);
+
+-- name: LockPaymentReferenceID :exec
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg(payment_reference_id)::varchar));
+
 -- name: GetPaymentMethodCustomer :one
 SELECT * FROM payment_method WHERE payment_method_id = $1 AND payment_customer_id = $2 LIMIT 1;
 
@@ -41,4 +65,84 @@ WHERE
     payment_method_id = sqlc.arg(payment_method_id)
 AND
     payment_customer_id = sqlc.arg(payment_customer_id)
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: UpdatePaymentMethodCapturedAmount :one
+UPDATE payment_method
+SET
+    payment_captured_amount = sqlc.arg(payment_captured_amount)
+WHERE
+    payment_method_id = sqlc.arg(payment_method_id)
+AND
+    payment_customer_id = sqlc.arg(payment_customer_id)
+RETURNING *;
+
+-- name: GetChildPaymentMethod :one
+SELECT * FROM payment_method WHERE payment_parent_uid = $1 AND payment_method_id = $2 LIMIT 1;
+
+-- name: GetPaymentMethodCustomerForUpdate :one
+SELECT * FROM payment_method WHERE payment_method_id = $1 AND payment_customer_id = $2 LIMIT 1 FOR UPDATE;
+
+-- name: ListOverduePaymentMethods :many
+SELECT * FROM payment_method
+WHERE
+    payment_status = ANY(sqlc.arg(payment_statuses)::varchar[])
+AND
+    expires_at > '0001-01-01 00:00:00Z'
+AND
+    expires_at < sqlc.arg(expires_before)
+AND
+    (expires_at, uid) > (sqlc.arg(after_expires_at)::timestamptz, sqlc.arg(after_uid)::varchar)
+ORDER BY expires_at ASC, uid ASC
+LIMIT sqlc.arg(batch_size);
+
+-- name: ListPaymentMethods :many
+SELECT * FROM payment_method
+WHERE
+    (sqlc.narg(payment_customer_id)::varchar IS NULL OR payment_customer_id = sqlc.narg(payment_customer_id))
+AND
+    (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+AND
+    (sqlc.narg(payment_type)::varchar IS NULL OR payment_type = sqlc.narg(payment_type))
+AND
+    (sqlc.narg(payment_channel)::varchar IS NULL OR payment_channel = sqlc.narg(payment_channel))
+AND
+    (sqlc.narg(payment_reference_id)::varchar IS NULL OR payment_reference_id = sqlc.narg(payment_reference_id))
+AND
+    created_at >= sqlc.arg(created_from)
+AND
+    created_at <= sqlc.arg(created_to)
+AND
+    (created_at, uid) < (sqlc.arg(before_created_at)::timestamptz, sqlc.arg(before_uid)::varchar)
+ORDER BY created_at DESC, uid DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountPaymentMethods :one
+SELECT COUNT(*) FROM payment_method
+WHERE
+    (sqlc.narg(payment_customer_id)::varchar IS NULL OR payment_customer_id = sqlc.narg(payment_customer_id))
+AND
+    (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+AND
+    (sqlc.narg(payment_type)::varchar IS NULL OR payment_type = sqlc.narg(payment_type))
+AND
+    (sqlc.narg(payment_channel)::varchar IS NULL OR payment_channel = sqlc.narg(payment_channel))
+AND
+    (sqlc.narg(payment_reference_id)::varchar IS NULL OR payment_reference_id = sqlc.narg(payment_reference_id))
+AND
+    created_at >= sqlc.arg(created_from)
+AND
+    created_at <= sqlc.arg(created_to);
+
+-- name: ListSucceededPaymentMethods :many
+SELECT * FROM payment_method
+WHERE
+    payment_status = 'SUCCEEDED'
+AND
+    COALESCE(paid_at, updated_at) >= sqlc.arg(paid_from)
+AND
+    COALESCE(paid_at, updated_at) < sqlc.arg(paid_to)
+AND
+    uid > sqlc.arg(after_uid)::varchar
+ORDER BY uid ASC
+LIMIT sqlc.arg(batch_size);
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- name: CreatePaymentSplit :one
+INSERT INTO payment_split (
+    uid,
+    payment_method_uid,
+    split_rule_id,
+    split_type,
+    destination_account_id,
+    split_amount,
+    currency,
+    split_reference_id,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListPaymentSplitsByPaymentMethodUid :many
+SELECT * FROM payment_split WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC;
\ No newline at end of file
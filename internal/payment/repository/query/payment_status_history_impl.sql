@@ -0,0 +1,17 @@
+-- name: CreatePaymentStatusHistory :one
+INSERT INTO payment_status_history (
+    uid,
+    payment_method_uid,
+    previous_status,
+    new_status,
+    failure_code,
+    payment_event,
+    event_id,
+    gateway_updated_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListPaymentStatusHistoryByPaymentMethodUid :many
+SELECT * FROM payment_status_history WHERE payment_method_uid = $1 ORDER BY created_at ASC, uid ASC;
\ No newline at end of file
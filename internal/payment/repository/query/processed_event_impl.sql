@@ -0,0 +1,19 @@
+-- name: CreateProcessedEvent :one
+INSERT INTO processed_event (
+    event_id,
+    payment_event,
+    payment_method_uid,
+    processed_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (event_id) DO NOTHING
+RETURNING *;
+
+-- name: GetProcessedEvent :one
+SELECT * FROM processed_event
+WHERE event_id = $1 LIMIT 1;
+
+-- name: DeleteProcessedEventsBefore :execrows
+DELETE FROM processed_event
+WHERE processed_at < @processed_before::timestamptz;
\ No newline at end of file
@@ -0,0 +1,56 @@
+-- name: UpsertReconciliationDiscrepancy :one
+INSERT INTO reconciliation_discrepancy (
+    uid,
+    discrepancy_type,
+    discrepancy_key,
+    payment_method_uid,
+    payment_method_id,
+    payment_request_id,
+    payment_reference_id,
+    expected_amount,
+    reported_amount,
+    expected_status,
+    reported_status,
+    currency,
+    report_source,
+    report_reference,
+    detected_at,
+    last_detected_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $15
+) ON CONFLICT (discrepancy_type, discrepancy_key) DO UPDATE SET
+    payment_method_uid = EXCLUDED.payment_method_uid,
+    expected_amount = EXCLUDED.expected_amount,
+    reported_amount = EXCLUDED.reported_amount,
+    expected_status = EXCLUDED.expected_status,
+    reported_status = EXCLUDED.reported_status,
+    report_source = EXCLUDED.report_source,
+    report_reference = EXCLUDED.report_reference,
+    last_detected_at = EXCLUDED.last_detected_at
+RETURNING *;
+
+-- name: ListReconciliationDiscrepancies :many
+SELECT * FROM reconciliation_discrepancy
+WHERE
+    (sqlc.narg(discrepancy_type)::varchar IS NULL OR discrepancy_type = sqlc.narg(discrepancy_type))
+AND
+    (sqlc.narg(payment_method_id)::varchar IS NULL OR payment_method_id = sqlc.narg(payment_method_id))
+AND
+    last_detected_at >= sqlc.arg(detected_from)
+AND
+    last_detected_at < sqlc.arg(detected_to)
+AND
+    (last_detected_at, uid) < (sqlc.arg(before_detected_at)::timestamptz, sqlc.arg(before_uid)::varchar)
+ORDER BY last_detected_at DESC, uid DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountReconciliationDiscrepancies :one
+SELECT COUNT(*) FROM reconciliation_discrepancy
+WHERE
+    (sqlc.narg(discrepancy_type)::varchar IS NULL OR discrepancy_type = sqlc.narg(discrepancy_type))
+AND
+    (sqlc.narg(payment_method_id)::varchar IS NULL OR payment_method_id = sqlc.narg(payment_method_id))
+AND
+    last_detected_at >= sqlc.arg(detected_from)
+AND
+    last_detected_at < sqlc.arg(detected_to);
\ No newline at end of file
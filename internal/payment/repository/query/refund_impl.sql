@@ -0,0 +1,40 @@
+-- name: CreateRefund :one
+INSERT INTO refund (
+    uid,
+    payment_method_uid,
+    payment_method_id,
+    refund_reference_id,
+    refund_status,
+    refund_amount,
+    refund_reason,
+    created_at,
+    currency
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetRefund :one
+SELECT * FROM refund WHERE uid = $1 LIMIT 1;
+
+-- name: GetRefundByRefundID :one
+SELECT * FROM refund WHERE refund_id = $1 LIMIT 1;
+
+-- name: ListRefundsByPaymentMethodUid :many
+SELECT * FROM refund WHERE payment_method_uid = $1 ORDER BY created_at ASC;
+
+-- name: GetRefundedAmount :one
+SELECT COALESCE(SUM(refund_amount), 0)::numeric AS refunded_amount
+FROM refund
+WHERE payment_method_uid = $1
+AND refund_status NOT IN ('FAILED', 'CANCELLED');
+
+-- name: UpdateRefund :one
+UPDATE refund
+SET
+    refund_id = COALESCE(sqlc.narg(refund_id), refund_id),
+    refund_status = COALESCE(sqlc.narg(refund_status), refund_status),
+    refund_failure_code = COALESCE(sqlc.narg(refund_failure_code), refund_failure_code),
+    updated_at = COALESCE(sqlc.narg(updated_at), updated_at)
+WHERE
+    uid = sqlc.arg(uid)
+RETURNING *;
\ No newline at end of file
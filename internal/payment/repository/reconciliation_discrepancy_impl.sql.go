@@ -0,0 +1,205 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: reconciliation_discrepancy_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const countReconciliationDiscrepancies = `-- name: CountReconciliationDiscrepancies :one
+SELECT COUNT(*) FROM reconciliation_discrepancy
+WHERE
+    ($1::varchar IS NULL OR discrepancy_type = $1)
+AND
+    ($2::varchar IS NULL OR payment_method_id = $2)
+AND
+    last_detected_at >= $3
+AND
+    last_detected_at < $4
+`
+
+type CountReconciliationDiscrepanciesParams struct {
+	DiscrepancyType pgtype.Text        `json:"discrepancy_type"`
+	PaymentMethodID pgtype.Text        `json:"payment_method_id"`
+	DetectedFrom    pgtype.Timestamptz `json:"detected_from"`
+	DetectedTo      pgtype.Timestamptz `json:"detected_to"`
+}
+
+func (q *Queries) CountReconciliationDiscrepancies(ctx context.Context, arg *CountReconciliationDiscrepanciesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countReconciliationDiscrepancies,
+		arg.DiscrepancyType,
+		arg.PaymentMethodID,
+		arg.DetectedFrom,
+		arg.DetectedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listReconciliationDiscrepancies = `-- name: ListReconciliationDiscrepancies :many
+SELECT uid, discrepancy_type, discrepancy_key, payment_method_uid, payment_method_id, payment_request_id, payment_reference_id, expected_amount, reported_amount, expected_status, reported_status, currency, report_source, report_reference, detected_at, last_detected_at FROM reconciliation_discrepancy
+WHERE
+    ($1::varchar IS NULL OR discrepancy_type = $1)
+AND
+    ($2::varchar IS NULL OR payment_method_id = $2)
+AND
+    last_detected_at >= $3
+AND
+    last_detected_at < $4
+AND
+    (last_detected_at, uid) < ($5::timestamptz, $6::varchar)
+ORDER BY last_detected_at DESC, uid DESC
+LIMIT $7
+`
+
+type ListReconciliationDiscrepanciesParams struct {
+	DiscrepancyType  pgtype.Text        `json:"discrepancy_type"`
+	PaymentMethodID  pgtype.Text        `json:"payment_method_id"`
+	DetectedFrom     pgtype.Timestamptz `json:"detected_from"`
+	DetectedTo       pgtype.Timestamptz `json:"detected_to"`
+	BeforeDetectedAt pgtype.Timestamptz `json:"before_detected_at"`
+	BeforeUid        string             `json:"before_uid"`
+	PageSize         int32              `json:"page_size"`
+}
+
+func (q *Queries) ListReconciliationDiscrepancies(ctx context.Context, arg *ListReconciliationDiscrepanciesParams) ([]*ReconciliationDiscrepancy, error) {
+	rows, err := q.db.Query(ctx, listReconciliationDiscrepancies,
+		arg.DiscrepancyType,
+		arg.PaymentMethodID,
+		arg.DetectedFrom,
+		arg.DetectedTo,
+		arg.BeforeDetectedAt,
+		arg.BeforeUid,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ReconciliationDiscrepancy{}
+	for rows.Next() {
+		var i ReconciliationDiscrepancy
+		if err := rows.Scan(
+			&i.Uid,
+			&i.DiscrepancyType,
+			&i.DiscrepancyKey,
+			&i.PaymentMethodUid,
+			&i.PaymentMethodID,
+			&i.PaymentRequestID,
+			&i.PaymentReferenceID,
+			&i.ExpectedAmount,
+			&i.ReportedAmount,
+			&i.ExpectedStatus,
+			&i.ReportedStatus,
+			&i.Currency,
+			&i.ReportSource,
+			&i.ReportReference,
+			&i.DetectedAt,
+			&i.LastDetectedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertReconciliationDiscrepancy = `-- name: UpsertReconciliationDiscrepancy :one
+INSERT INTO reconciliation_discrepancy (
+    uid,
+    discrepancy_type,
+    discrepancy_key,
+    payment_method_uid,
+    payment_method_id,
+    payment_request_id,
+    payment_reference_id,
+    expected_amount,
+    reported_amount,
+    expected_status,
+    reported_status,
+    currency,
+    report_source,
+    report_reference,
+    detected_at,
+    last_detected_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $15
+) ON CONFLICT (discrepancy_type, discrepancy_key) DO UPDATE SET
+    payment_method_uid = EXCLUDED.payment_method_uid,
+    expected_amount = EXCLUDED.expected_amount,
+    reported_amount = EXCLUDED.reported_amount,
+    expected_status = EXCLUDED.expected_status,
+    reported_status = EXCLUDED.reported_status,
+    report_source = EXCLUDED.report_source,
+    report_reference = EXCLUDED.report_reference,
+    last_detected_at = EXCLUDED.last_detected_at
+RETURNING uid, discrepancy_type, discrepancy_key, payment_method_uid, payment_method_id, payment_request_id, payment_reference_id, expected_amount, reported_amount, expected_status, reported_status, currency, report_source, report_reference, detected_at, last_detected_at
+`
+
+type UpsertReconciliationDiscrepancyParams struct {
+	Uid                string             `json:"uid"`
+	DiscrepancyType    string             `json:"discrepancy_type"`
+	DiscrepancyKey     string             `json:"discrepancy_key"`
+	PaymentMethodUid   pgtype.Text        `json:"payment_method_uid"`
+	PaymentMethodID    pgtype.Text        `json:"payment_method_id"`
+	PaymentRequestID   pgtype.Text        `json:"payment_request_id"`
+	PaymentReferenceID pgtype.Text        `json:"payment_reference_id"`
+	ExpectedAmount     decimal.Decimal    `json:"expected_amount"`
+	ReportedAmount     decimal.Decimal    `json:"reported_amount"`
+	ExpectedStatus     pgtype.Text        `json:"expected_status"`
+	ReportedStatus     pgtype.Text        `json:"reported_status"`
+	Currency           string             `json:"currency"`
+	ReportSource       string             `json:"report_source"`
+	ReportReference    string             `json:"report_reference"`
+	DetectedAt         pgtype.Timestamptz `json:"detected_at"`
+}
+
+func (q *Queries) UpsertReconciliationDiscrepancy(ctx context.Context, arg *UpsertReconciliationDiscrepancyParams) (*ReconciliationDiscrepancy, error) {
+	row := q.db.QueryRow(ctx, upsertReconciliationDiscrepancy,
+		arg.Uid,
+		arg.DiscrepancyType,
+		arg.DiscrepancyKey,
+		arg.PaymentMethodUid,
+		arg.PaymentMethodID,
+		arg.PaymentRequestID,
+		arg.PaymentReferenceID,
+		arg.ExpectedAmount,
+		arg.ReportedAmount,
+		arg.ExpectedStatus,
+		arg.ReportedStatus,
+		arg.Currency,
+		arg.ReportSource,
+		arg.ReportReference,
+		arg.DetectedAt,
+	)
+	var i ReconciliationDiscrepancy
+	err := row.Scan(
+		&i.Uid,
+		&i.DiscrepancyType,
+		&i.DiscrepancyKey,
+		&i.PaymentMethodUid,
+		&i.PaymentMethodID,
+		&i.PaymentRequestID,
+		&i.PaymentReferenceID,
+		&i.ExpectedAmount,
+		&i.ReportedAmount,
+		&i.ExpectedStatus,
+		&i.ReportedStatus,
+		&i.Currency,
+		&i.ReportSource,
+		&i.ReportReference,
+		&i.DetectedAt,
+		&i.LastDetectedAt,
+	)
+	return &i, err
+}
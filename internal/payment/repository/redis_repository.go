@@ -10,9 +10,12 @@ import (
 )
 
 type RedisRepository interface {
-	PutCreatePaymentIdempotencyKey(ctx context.Context, key string, arg *pb.CreatePaymentResponse)
-	GetCreatePaymentIdempotencyKey(ctx context.Context, key string) (*pb.CreatePaymentResponse, error)
-	DeleteCreatePaymentIdempotencyKey(ctx context.Context, key string)
+	PutCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string, arg *pb.CreatePaymentResponse)
+	GetCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string) (*pb.CreatePaymentResponse, error)
+	DeleteCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string)
+
+	AcquireCreatePaymentLock(ctx context.Context, key string) (string, bool, error)
+	ReleaseCreatePaymentLock(ctx context.Context, key string, ticket string)
 
 	PutCache(ctx context.Context, arg *PaymentMethod)
 	GetCache(ctx context.Context, paymentCustomerID string, paymentMethodID string) (*PaymentMethod, error)
@@ -21,6 +24,18 @@ type RedisRepository interface {
 	PutCustomerCache(ctx context.Context, arg *Customer)
 	GetCustomerCache(ctx context.Context, key string) (*Customer, error)
 	DeleteCustomerCache(ctx context.Context, key string)
+
+	AcquireExpirySweeperLock(ctx context.Context, token string) (bool, error)
+	RefreshExpirySweeperLock(ctx context.Context, token string) (bool, error)
+	ReleaseExpirySweeperLock(ctx context.Context, token string)
+
+	AcquireReconciliationLock(ctx context.Context, token string) (bool, error)
+	RefreshReconciliationLock(ctx context.Context, token string) (bool, error)
+	ReleaseReconciliationLock(ctx context.Context, token string)
+
+	AcquireOutboxRelayLock(ctx context.Context, token string) (bool, error)
+	RefreshOutboxRelayLock(ctx context.Context, token string) (bool, error)
+	ReleaseOutboxRelayLock(ctx context.Context, token string)
 }
 
 type RedisRepositoryImpl struct {
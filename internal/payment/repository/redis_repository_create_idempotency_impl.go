@@ -15,9 +15,12 @@ import (
 
 var (
 	redisCreatePaymentIdempotencyPrefixKey = "reader:create_payment"
+	redisCreatePaymentLockPrefixKey        = "lock:create_payment"
 )
 
-func (r *RedisRepositoryImpl) PutCreatePaymentIdempotencyKey(ctx context.Context, key string, arg *pb.CreatePaymentResponse) {
+// PutCreatePaymentIdempotencyKey caches the response of a completed create payment under its idempotency key
+// and request fingerprint, a reused key with another body misses the cache.
+func (r *RedisRepositoryImpl) PutCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string, arg *pb.CreatePaymentResponse) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepository.PutCreatePaymentIdempotencyKey")
 	defer span.Finish()
 
@@ -28,7 +31,7 @@ func (r *RedisRepositoryImpl) PutCreatePaymentIdempotencyKey(ctx context.Context
 	}
 
 	prefixKey := helper.RedisPrefixes(
-		key,
+		helper.StringBuilder(key, ":", fingerprint),
 		redisCreatePaymentIdempotencyPrefixKey,
 		r.cfg.Databases.Redis.Prefixes.CreatePayment.Prefix,
 		r.cfg.Databases.Redis.AppID,
@@ -46,12 +49,12 @@ func (r *RedisRepositoryImpl) PutCreatePaymentIdempotencyKey(ctx context.Context
 	r.log.Debugf("put-prefix: %s, key: %s", prefixKey, key)
 }
 
-func (r *RedisRepositoryImpl) GetCreatePaymentIdempotencyKey(ctx context.Context, key string) (*pb.CreatePaymentResponse, error) {
+func (r *RedisRepositoryImpl) GetCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string) (*pb.CreatePaymentResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositorytImpl.GetCreatePaymentIdempotencyKey")
 	defer span.Finish()
 
 	prefixKey := helper.RedisPrefixes(
-		key,
+		helper.StringBuilder(key, ":", fingerprint),
 		redisCreatePaymentIdempotencyPrefixKey,
 		r.cfg.Databases.Redis.Prefixes.CreatePayment.Prefix,
 		r.cfg.Databases.Redis.AppID,
@@ -75,12 +78,12 @@ func (r *RedisRepositoryImpl) GetCreatePaymentIdempotencyKey(ctx context.Context
 	return &payload, nil
 }
 
-func (r *RedisRepositoryImpl) DeleteCreatePaymentIdempotencyKey(ctx context.Context, key string) {
+func (r *RedisRepositoryImpl) DeleteCreatePaymentIdempotencyKey(ctx context.Context, key string, fingerprint string) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.DeleteCreatePaymentIdempotencyKey")
 	defer span.Finish()
 
 	prefixKey := helper.RedisPrefixes(
-		key,
+		helper.StringBuilder(key, ":", fingerprint),
 		redisCreatePaymentIdempotencyPrefixKey,
 		r.cfg.Databases.Redis.Prefixes.CreatePayment.Prefix,
 		r.cfg.Databases.Redis.AppID,
@@ -92,3 +95,45 @@ func (r *RedisRepositoryImpl) DeleteCreatePaymentIdempotencyKey(ctx context.Cont
 	}
 	r.log.Debugf("del-prefix: %s, key: %s", prefixKey, key)
 }
+
+// AcquireCreatePaymentLock keeps a second request with the same idempotency key out while one is in flight.
+// The returned ticket only releases the lock, the fencing token of the reservation is issued by postgres.
+func (r *RedisRepositoryImpl) AcquireCreatePaymentLock(ctx context.Context, key string) (string, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.AcquireCreatePaymentLock")
+	defer span.Finish()
+
+	prefixKey := r.createPaymentLockKey(key)
+	ticket := helper.RandomString(32)
+
+	ok, err := r.redisClient.SetNX(ctx, prefixKey, ticket, r.cfg.Databases.Redis.Prefixes.CreatePaymentLock.ExpirationDuration).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("unable to acquire lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, acquired: %v", prefixKey, ok)
+
+	return ticket, ok, nil
+}
+
+func (r *RedisRepositoryImpl) ReleaseCreatePaymentLock(ctx context.Context, key string, ticket string) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.ReleaseCreatePaymentLock")
+	defer span.Finish()
+
+	prefixKey := r.createPaymentLockKey(key)
+
+	if err := releaseLockScript.Run(ctx, r.redisClient, []string{prefixKey}, ticket).Err(); err != nil {
+		r.log.Warnf("release.lock.run.err: %v", err)
+		return
+	}
+
+	r.log.Debugf("unlock-prefix: %s", prefixKey)
+}
+
+func (r *RedisRepositoryImpl) createPaymentLockKey(key string) string {
+	return helper.RedisPrefixes(
+		key,
+		redisCreatePaymentLockPrefixKey,
+		r.cfg.Databases.Redis.Prefixes.CreatePaymentLock.Prefix,
+		r.cfg.Databases.Redis.AppID,
+	)
+}
@@ -15,9 +15,9 @@ func TestRepoCreateCreatePaymentIdempotencyKey(t *testing.T) {
 }
 
 func TestRepoGetCreatePaymentIdempotencyKey(t *testing.T) {
-	key, arg := createRandomCreatePaymentIdempotencyKey(t)
+	key, fingerprint, arg := createRandomCreatePaymentIdempotencyKey(t)
 
-	res, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key)
+	res, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key, fingerprint)
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
 
@@ -44,9 +44,9 @@ func TestRepoGetCreatePaymentIdempotencyKey(t *testing.T) {
 }
 
 func TestRepoDeleteCreatePaymentIdempotencyKey(t *testing.T) {
-	key, arg := createRandomCreatePaymentIdempotencyKey(t)
+	key, fingerprint, arg := createRandomCreatePaymentIdempotencyKey(t)
 
-	res, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key)
+	res, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key, fingerprint)
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
 
@@ -71,14 +71,52 @@ func TestRepoDeleteCreatePaymentIdempotencyKey(t *testing.T) {
 	require.Equal(t, res.GetPaymentMethod().GetPaymentVirtualAccountNumber(), arg.GetPaymentMethod().GetPaymentVirtualAccountNumber())
 	require.Equal(t, res.GetPaymentMethod().GetPaymentUrl(), arg.GetPaymentMethod().GetPaymentUrl())
 
-	testStore.DeleteCreatePaymentIdempotencyKey(context.TODO(), key)
+	testStore.DeleteCreatePaymentIdempotencyKey(context.TODO(), key, fingerprint)
 
-	res2, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key)
+	res2, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key, fingerprint)
 	require.Error(t, err)
 	require.Empty(t, res2)
 }
 
-func createRandomCreatePaymentIdempotencyKey(t *testing.T) (string, *pb.CreatePaymentResponse) {
+func TestRepoGetCreatePaymentIdempotencyKeyOtherFingerprint(t *testing.T) {
+	key, _, _ := createRandomCreatePaymentIdempotencyKey(t)
+
+	// the same key sent with another body never reads the response cached for the first one.
+	res, err := testStore.GetCreatePaymentIdempotencyKey(context.TODO(), key, helper.RandomString(64))
+	require.Error(t, err)
+	require.Empty(t, res)
+}
+
+func TestRepoCreatePaymentLock(t *testing.T) {
+	key := helper.RandomString(26)
+
+	first, ok, err := testStore.AcquireCreatePaymentLock(context.TODO(), key)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	second, ok, err := testStore.AcquireCreatePaymentLock(context.TODO(), key)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NotEqual(t, first, second)
+
+	// only the holder of the reservation is able to release it.
+	testStore.ReleaseCreatePaymentLock(context.TODO(), key, second)
+
+	_, ok, err = testStore.AcquireCreatePaymentLock(context.TODO(), key)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	testStore.ReleaseCreatePaymentLock(context.TODO(), key, first)
+
+	third, ok, err := testStore.AcquireCreatePaymentLock(context.TODO(), key)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEqual(t, first, third)
+
+	testStore.ReleaseCreatePaymentLock(context.TODO(), key, third)
+}
+
+func createRandomCreatePaymentIdempotencyKey(t *testing.T) (string, string, *pb.CreatePaymentResponse) {
 	customer := createRandomCustomer(t)
 	paymentMethod := createRandomPaymentMethod(t)
 
@@ -119,7 +157,8 @@ func createRandomCreatePaymentIdempotencyKey(t *testing.T) (string, *pb.CreatePa
 		},
 	}
 
-	testStore.PutCreatePaymentIdempotencyKey(context.TODO(), idmptKey.String(), resp)
+	fingerprint := helper.RandomString(64)
+	testStore.PutCreatePaymentIdempotencyKey(context.TODO(), idmptKey.String(), fingerprint, resp)
 
-	return idmptKey.String(), resp
+	return idmptKey.String(), fingerprint, resp
 }
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisExpirySweeperPrefixKey = "lock:expiry_sweeper"
+	redisExpirySweeperLockKey   = "leader"
+)
+
+// releaseLockScript only deletes the lock while it is still held by the given token,
+// so a replica whose lock already expired can never release the lock of the next leader.
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (r *RedisRepositoryImpl) AcquireExpirySweeperLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.AcquireExpirySweeperLock")
+	defer span.Finish()
+
+	prefixKey := r.expirySweeperLockKey()
+
+	ok, err := r.redisClient.SetNX(ctx, prefixKey, token, r.cfg.Databases.Redis.Prefixes.ExpirySweeper.ExpirationDuration).Result()
+	if err != nil {
+		return false, fmt.Errorf("unable to acquire lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, acquired: %v", prefixKey, ok)
+
+	return ok, nil
+}
+
+// RefreshExpirySweeperLock extends the sweeper lock by its expiration duration, it reports false when the
+// lock has expired or is held by another replica.
+func (r *RedisRepositoryImpl) RefreshExpirySweeperLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.RefreshExpirySweeperLock")
+	defer span.Finish()
+
+	prefixKey := r.expirySweeperLockKey()
+
+	res, err := refreshLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token, r.cfg.Databases.Redis.Prefixes.ExpirySweeper.ExpirationDuration.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("unable to refresh lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, refreshed: %v", prefixKey, res == 1)
+
+	return res == 1, nil
+}
+
+func (r *RedisRepositoryImpl) ReleaseExpirySweeperLock(ctx context.Context, token string) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.ReleaseExpirySweeperLock")
+	defer span.Finish()
+
+	prefixKey := r.expirySweeperLockKey()
+
+	if err := releaseLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token).Err(); err != nil {
+		r.log.Warnf("release.lock.run.err: %v", err)
+		return
+	}
+
+	r.log.Debugf("unlock-prefix: %s", prefixKey)
+}
+
+func (r *RedisRepositoryImpl) expirySweeperLockKey() string {
+	return helper.RedisPrefixes(
+		redisExpirySweeperLockKey,
+		redisExpirySweeperPrefixKey,
+		r.cfg.Databases.Redis.Prefixes.ExpirySweeper.Prefix,
+		r.cfg.Databases.Redis.AppID,
+	)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoExpirySweeperLock(t *testing.T) {
+	leader := helper.RandomString(32)
+	follower := helper.RandomString(32)
+
+	ok, err := testStore.AcquireExpirySweeperLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = testStore.AcquireExpirySweeperLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// only the holder of the lock is able to refresh or release it.
+	ok, err = testStore.RefreshExpirySweeperLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.RefreshExpirySweeperLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseExpirySweeperLock(context.TODO(), follower)
+
+	ok, err = testStore.AcquireExpirySweeperLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	testStore.ReleaseExpirySweeperLock(context.TODO(), leader)
+
+	ok, err = testStore.RefreshExpirySweeperLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.AcquireExpirySweeperLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseExpirySweeperLock(context.TODO(), follower)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisOutboxRelayPrefixKey = "lock:outbox_relay"
+	redisOutboxRelayLockKey   = "leader"
+)
+
+// refreshLockScript extends the expiry of a lock only while it is still held by the given token.
+var refreshLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+func (r *RedisRepositoryImpl) AcquireOutboxRelayLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.AcquireOutboxRelayLock")
+	defer span.Finish()
+
+	prefixKey := r.outboxRelayLockKey()
+
+	ok, err := r.redisClient.SetNX(ctx, prefixKey, token, r.cfg.Databases.Redis.Prefixes.OutboxRelay.ExpirationDuration).Result()
+	if err != nil {
+		return false, fmt.Errorf("unable to acquire lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, acquired: %v", prefixKey, ok)
+
+	return ok, nil
+}
+
+// RefreshOutboxRelayLock extends the relay lock by its expiration duration, it reports false when the
+// lock has expired or is held by another replica.
+func (r *RedisRepositoryImpl) RefreshOutboxRelayLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.RefreshOutboxRelayLock")
+	defer span.Finish()
+
+	prefixKey := r.outboxRelayLockKey()
+
+	res, err := refreshLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token, r.cfg.Databases.Redis.Prefixes.OutboxRelay.ExpirationDuration.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("unable to refresh lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, refreshed: %v", prefixKey, res == 1)
+
+	return res == 1, nil
+}
+
+func (r *RedisRepositoryImpl) ReleaseOutboxRelayLock(ctx context.Context, token string) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.ReleaseOutboxRelayLock")
+	defer span.Finish()
+
+	prefixKey := r.outboxRelayLockKey()
+
+	if err := releaseLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token).Err(); err != nil {
+		r.log.Warnf("release.lock.run.err: %v", err)
+		return
+	}
+
+	r.log.Debugf("unlock-prefix: %s", prefixKey)
+}
+
+func (r *RedisRepositoryImpl) outboxRelayLockKey() string {
+	return helper.RedisPrefixes(
+		redisOutboxRelayLockKey,
+		redisOutboxRelayPrefixKey,
+		r.cfg.Databases.Redis.Prefixes.OutboxRelay.Prefix,
+		r.cfg.Databases.Redis.AppID,
+	)
+}
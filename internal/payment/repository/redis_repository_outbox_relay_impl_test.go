@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoOutboxRelayLock(t *testing.T) {
+	leader := helper.RandomString(32)
+	follower := helper.RandomString(32)
+
+	ok, err := testStore.AcquireOutboxRelayLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = testStore.AcquireOutboxRelayLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// only the holder of the lock is able to refresh or release it.
+	ok, err = testStore.RefreshOutboxRelayLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.RefreshOutboxRelayLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseOutboxRelayLock(context.TODO(), follower)
+
+	ok, err = testStore.AcquireOutboxRelayLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	testStore.ReleaseOutboxRelayLock(context.TODO(), leader)
+
+	ok, err = testStore.RefreshOutboxRelayLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.AcquireOutboxRelayLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseOutboxRelayLock(context.TODO(), follower)
+}
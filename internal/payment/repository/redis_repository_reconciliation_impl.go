@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	redisReconciliationPrefixKey = "lock:reconciliation"
+	redisReconciliationLockKey   = "leader"
+)
+
+func (r *RedisRepositoryImpl) AcquireReconciliationLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.AcquireReconciliationLock")
+	defer span.Finish()
+
+	prefixKey := r.reconciliationLockKey()
+
+	ok, err := r.redisClient.SetNX(ctx, prefixKey, token, r.cfg.Databases.Redis.Prefixes.Reconciliation.ExpirationDuration).Result()
+	if err != nil {
+		return false, fmt.Errorf("unable to acquire lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, acquired: %v", prefixKey, ok)
+
+	return ok, nil
+}
+
+// RefreshReconciliationLock extends the reconciliation lock by its expiration duration, it reports false when the
+// lock has expired or is held by another replica.
+func (r *RedisRepositoryImpl) RefreshReconciliationLock(ctx context.Context, token string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.RefreshReconciliationLock")
+	defer span.Finish()
+
+	prefixKey := r.reconciliationLockKey()
+
+	res, err := refreshLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token, r.cfg.Databases.Redis.Prefixes.Reconciliation.ExpirationDuration.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("unable to refresh lock: %w", tracing.TraceWithError(span, err))
+	}
+
+	r.log.Debugf("lock-prefix: %s, refreshed: %v", prefixKey, res == 1)
+
+	return res == 1, nil
+}
+
+func (r *RedisRepositoryImpl) ReleaseReconciliationLock(ctx context.Context, token string) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRepositoryImpl.ReleaseReconciliationLock")
+	defer span.Finish()
+
+	prefixKey := r.reconciliationLockKey()
+
+	if err := releaseLockScript.Run(ctx, r.redisClient, []string{prefixKey}, token).Err(); err != nil {
+		r.log.Warnf("release.lock.run.err: %v", err)
+		return
+	}
+
+	r.log.Debugf("unlock-prefix: %s", prefixKey)
+}
+
+func (r *RedisRepositoryImpl) reconciliationLockKey() string {
+	return helper.RedisPrefixes(
+		redisReconciliationLockKey,
+		redisReconciliationPrefixKey,
+		r.cfg.Databases.Redis.Prefixes.Reconciliation.Prefix,
+		r.cfg.Databases.Redis.AppID,
+	)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReconciliationLock(t *testing.T) {
+	leader := helper.RandomString(32)
+	follower := helper.RandomString(32)
+
+	ok, err := testStore.AcquireReconciliationLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = testStore.AcquireReconciliationLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// only the holder of the lock is able to refresh or release it.
+	ok, err = testStore.RefreshReconciliationLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.RefreshReconciliationLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseReconciliationLock(context.TODO(), follower)
+
+	ok, err = testStore.AcquireReconciliationLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	testStore.ReleaseReconciliationLock(context.TODO(), leader)
+
+	ok, err = testStore.RefreshReconciliationLock(context.TODO(), leader)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = testStore.AcquireReconciliationLock(context.TODO(), follower)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	testStore.ReleaseReconciliationLock(context.TODO(), follower)
+}
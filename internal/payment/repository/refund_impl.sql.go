@@ -0,0 +1,216 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: refund_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createRefund = `-- name: CreateRefund :one
+INSERT INTO refund (
+    uid,
+    payment_method_uid,
+    payment_method_id,
+    refund_reference_id,
+    refund_status,
+    refund_amount,
+    refund_reason,
+    created_at,
+    currency
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING uid, refund_id, payment_method_uid, payment_method_id, refund_reference_id, refund_status, refund_amount, refund_reason, refund_failure_code, created_at, updated_at, currency
+`
+
+type CreateRefundParams struct {
+	Uid               string             `json:"uid"`
+	PaymentMethodUid  string             `json:"payment_method_uid"`
+	PaymentMethodID   string             `json:"payment_method_id"`
+	RefundReferenceID string             `json:"refund_reference_id"`
+	RefundStatus      string             `json:"refund_status"`
+	RefundAmount      decimal.Decimal    `json:"refund_amount"`
+	RefundReason      string             `json:"refund_reason"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Currency          string             `json:"currency"`
+}
+
+func (q *Queries) CreateRefund(ctx context.Context, arg *CreateRefundParams) (*Refund, error) {
+	row := q.db.QueryRow(ctx, createRefund,
+		arg.Uid,
+		arg.PaymentMethodUid,
+		arg.PaymentMethodID,
+		arg.RefundReferenceID,
+		arg.RefundStatus,
+		arg.RefundAmount,
+		arg.RefundReason,
+		arg.CreatedAt,
+		arg.Currency,
+	)
+	var i Refund
+	err := row.Scan(
+		&i.Uid,
+		&i.RefundID,
+		&i.PaymentMethodUid,
+		&i.PaymentMethodID,
+		&i.RefundReferenceID,
+		&i.RefundStatus,
+		&i.RefundAmount,
+		&i.RefundReason,
+		&i.RefundFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Currency,
+	)
+	return &i, err
+}
+
+const getRefund = `-- name: GetRefund :one
+SELECT uid, refund_id, payment_method_uid, payment_method_id, refund_reference_id, refund_status, refund_amount, refund_reason, refund_failure_code, created_at, updated_at, currency FROM refund WHERE uid = $1 LIMIT 1
+`
+
+func (q *Queries) GetRefund(ctx context.Context, uid string) (*Refund, error) {
+	row := q.db.QueryRow(ctx, getRefund, uid)
+	var i Refund
+	err := row.Scan(
+		&i.Uid,
+		&i.RefundID,
+		&i.PaymentMethodUid,
+		&i.PaymentMethodID,
+		&i.RefundReferenceID,
+		&i.RefundStatus,
+		&i.RefundAmount,
+		&i.RefundReason,
+		&i.RefundFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Currency,
+	)
+	return &i, err
+}
+
+const getRefundByRefundID = `-- name: GetRefundByRefundID :one
+SELECT uid, refund_id, payment_method_uid, payment_method_id, refund_reference_id, refund_status, refund_amount, refund_reason, refund_failure_code, created_at, updated_at, currency FROM refund WHERE refund_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetRefundByRefundID(ctx context.Context, refundID pgtype.Text) (*Refund, error) {
+	row := q.db.QueryRow(ctx, getRefundByRefundID, refundID)
+	var i Refund
+	err := row.Scan(
+		&i.Uid,
+		&i.RefundID,
+		&i.PaymentMethodUid,
+		&i.PaymentMethodID,
+		&i.RefundReferenceID,
+		&i.RefundStatus,
+		&i.RefundAmount,
+		&i.RefundReason,
+		&i.RefundFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Currency,
+	)
+	return &i, err
+}
+
+const getRefundedAmount = `-- name: GetRefundedAmount :one
+SELECT COALESCE(SUM(refund_amount), 0)::numeric AS refunded_amount
+FROM refund
+WHERE payment_method_uid = $1
+AND refund_status NOT IN ('FAILED', 'CANCELLED')
+`
+
+func (q *Queries) GetRefundedAmount(ctx context.Context, paymentMethodUid string) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getRefundedAmount, paymentMethodUid)
+	var refunded_amount decimal.Decimal
+	err := row.Scan(&refunded_amount)
+	return refunded_amount, err
+}
+
+const listRefundsByPaymentMethodUid = `-- name: ListRefundsByPaymentMethodUid :many
+SELECT uid, refund_id, payment_method_uid, payment_method_id, refund_reference_id, refund_status, refund_amount, refund_reason, refund_failure_code, created_at, updated_at, currency FROM refund WHERE payment_method_uid = $1 ORDER BY created_at ASC
+`
+
+func (q *Queries) ListRefundsByPaymentMethodUid(ctx context.Context, paymentMethodUid string) ([]*Refund, error) {
+	rows, err := q.db.Query(ctx, listRefundsByPaymentMethodUid, paymentMethodUid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Refund{}
+	for rows.Next() {
+		var i Refund
+		if err := rows.Scan(
+			&i.Uid,
+			&i.RefundID,
+			&i.PaymentMethodUid,
+			&i.PaymentMethodID,
+			&i.RefundReferenceID,
+			&i.RefundStatus,
+			&i.RefundAmount,
+			&i.RefundReason,
+			&i.RefundFailureCode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRefund = `-- name: UpdateRefund :one
+UPDATE refund
+SET
+    refund_id = COALESCE($1, refund_id),
+    refund_status = COALESCE($2, refund_status),
+    refund_failure_code = COALESCE($3, refund_failure_code),
+    updated_at = COALESCE($4, updated_at)
+WHERE
+    uid = $5
+RETURNING uid, refund_id, payment_method_uid, payment_method_id, refund_reference_id, refund_status, refund_amount, refund_reason, refund_failure_code, created_at, updated_at, currency
+`
+
+type UpdateRefundParams struct {
+	RefundID          pgtype.Text        `json:"refund_id"`
+	RefundStatus      pgtype.Text        `json:"refund_status"`
+	RefundFailureCode pgtype.Text        `json:"refund_failure_code"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	Uid               string             `json:"uid"`
+}
+
+func (q *Queries) UpdateRefund(ctx context.Context, arg *UpdateRefundParams) (*Refund, error) {
+	row := q.db.QueryRow(ctx, updateRefund,
+		arg.RefundID,
+		arg.RefundStatus,
+		arg.RefundFailureCode,
+		arg.UpdatedAt,
+		arg.Uid,
+	)
+	var i Refund
+	err := row.Scan(
+		&i.Uid,
+		&i.RefundID,
+		&i.PaymentMethodUid,
+		&i.PaymentMethodID,
+		&i.RefundReferenceID,
+		&i.RefundStatus,
+		&i.RefundAmount,
+		&i.RefundReason,
+		&i.RefundFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Currency,
+	)
+	return &i, err
+}
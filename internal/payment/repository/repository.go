@@ -9,19 +9,19 @@ import (
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
-	"github.com/xendit/xendit-go/v5"
 )
 
 type Repository interface {
 	Querier
-	PaymentProvider
 	RedisRepository
 
 	CreateCustomerTx(ctx context.Context, arg *CreateCustomerTxParams) (CreateCustomerTxResult, error)
-	CreateEwalletPaymentTx(ctx context.Context, arg *CreateEwalletPaymentTxParams) (CreateEwalletPaymentTxResult, error)
-	CreateQrCodePaymentTx(ctx context.Context, arg *CreateQrCodePaymentTxParams) (CreateQrCodePaymentTxResult, error)
-	CreateVirtualAccountBankPaymentTx(ctx context.Context, arg *CreateVirtualAccountBankPaymentTxParams) (CreateVirtualAccountBankPaymentTxResult, error)
+	CreatePaymentTx(ctx context.Context, arg *CreatePaymentTxParams) (CreatePaymentTxResult, error)
+	CreateRefundTx(ctx context.Context, arg *CreateRefundTxParams) (CreateRefundTxResult, error)
 	UpdateTx(ctx context.Context, arg *UpdateTxParams) (UpdateTxResult, error)
+	RecordChildPaymentTx(ctx context.Context, arg *RecordChildPaymentTxParams) (RecordChildPaymentTxResult, error)
+	UpdateInvoiceTx(ctx context.Context, arg *UpdateInvoiceTxParams) (UpdateInvoiceTxResult, error)
+	UpdateRefundTx(ctx context.Context, arg *UpdateRefundTxParams) (UpdateRefundTxResult, error)
 
 	OnConfigUpdate(key string, config *config.App)
 	OnPqsqlUpdate(key string, pqsqlConnection *pgxpool.Pool)
@@ -33,7 +33,6 @@ type Store struct {
 	cfg *config.App
 	db  *pgxpool.Pool
 	*Queries
-	*PaymentProviderImpl
 	*RedisRepositoryImpl
 }
 
@@ -42,7 +41,6 @@ func NewStore(
 	cfg *config.App,
 	db *pgxpool.Pool,
 	redisClient redis.UniversalClient,
-	xenditClient *xendit.APIClient,
 ) Repository {
 	log = log.WithPrefix(fmt.Sprintf("%s-%s", "payment", constants.Repository))
 	return &Store{
@@ -50,7 +48,6 @@ func NewStore(
 		cfg:                 cfg,
 		db:                  db,
 		Queries:             New(db),
-		PaymentProviderImpl: NewPaymentProviderImpl(log, cfg, xenditClient),
 		RedisRepositoryImpl: NewRedisRepositoryImpl(log, cfg, redisClient),
 	}
 }
@@ -3,13 +3,15 @@ package repository
 import (
 	"context"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/opentracing/opentracing-go"
 )
 
 type CreateCustomerTxParams struct {
-	CreateCustomer CreateCustomerParams
+	CreateCustomer  CreateCustomerParams
+	PaymentCustomer *gateway.Customer
 }
 
 type CreateCustomerTxResult struct {
@@ -28,34 +30,21 @@ func (r *Store) CreateCustomerTx(ctx context.Context, arg *CreateCustomerTxParam
 	err := r.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		createCustomerPaymentArg := CreateCustomerPaymentParams{
-			CustomerName:   arg.CreateCustomer.CustomerName,
-			CustomerNumber: arg.CreateCustomer.PhoneNumber.String,
-			CustomerUID:    arg.CreateCustomer.Uid,
-			CustomerEmail:  arg.CreateCustomer.Email.String,
-			ReferenceID:    arg.CreateCustomer.Uid,
-		}
-
-		customerRes, err := r.CreateCustomerPayment(ctx, &createCustomerPaymentArg)
-		if err != nil {
-			return tracing.TraceWithError(span, err)
-		}
-
-		if customerRes != nil {
-			arg.CreateCustomer.PaymentCustomerID = customerRes.Id
-		}
+		if arg.PaymentCustomer != nil {
+			arg.CreateCustomer.PaymentCustomerID = arg.PaymentCustomer.ID
 
-		if customerRes.PhoneNumber.Get() != nil {
-			arg.CreateCustomer.PhoneNumber = pgtype.Text{
-				String: customerRes.GetPhoneNumber(),
-				Valid:  true,
+			if arg.PaymentCustomer.PhoneNumber != "" {
+				arg.CreateCustomer.PhoneNumber = pgtype.Text{
+					String: arg.PaymentCustomer.PhoneNumber,
+					Valid:  true,
+				}
 			}
-		}
 
-		if customerRes.Email.Get() != nil {
-			arg.CreateCustomer.Email = pgtype.Text{
-				String: customerRes.GetEmail(),
-				Valid:  true,
+			if arg.PaymentCustomer.Email != "" {
+				arg.CreateCustomer.Email = pgtype.Text{
+					String: arg.PaymentCustomer.Email,
+					Valid:  true,
+				}
 			}
 		}
 
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+type CreatePaymentTxParams struct {
+	Payment *gateway.Payment
+	// UniqueActiveReference rejects the payment when its reference id already has an outstanding payment.
+	UniqueActiveReference bool
+	// ForUserID is the marketplace sub-account the payment is collected on behalf of.
+	ForUserID string
+	// SplitRuleID is the split rule the gateway routes the payment with, Splits are its legs,
+	// their uid, payment method uid and split rule id are filled in by the transaction.
+	SplitRuleID string
+	Splits      []*CreatePaymentSplitParams
+	// FeeAmount is the channel fee the payment was priced with, it is booked to the ledger once the payment succeeds.
+	FeeAmount decimal.Decimal
+}
+
+type CreatePaymentTxResult struct {
+	Payment *PaymentMethod
+	Splits  []*PaymentSplit
+}
+
+func (r *Store) CreatePaymentTx(ctx context.Context, arg *CreatePaymentTxParams) (CreatePaymentTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.CreatePaymentTx")
+	defer span.Finish()
+
+	var result CreatePaymentTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		if arg.UniqueActiveReference {
+			// serializes concurrent creates of the same reference id until the transaction ends.
+			err = q.LockPaymentReferenceID(ctx, arg.Payment.ReferenceID)
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+
+			count, err := q.CountPaymentMethodsByReferenceIDAndStatuses(ctx, &CountPaymentMethodsByReferenceIDAndStatusesParams{
+				PaymentReferenceID: arg.Payment.ReferenceID,
+				PaymentStatuses:    payment.OutstandingStatuses(),
+			})
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+
+			if count > 0 {
+				return tracing.TraceWithError(span, unierror.ErrDuplicateActivePayment)
+			}
+		}
+
+		paymentMethodInternalID, err := helper.GenerateULID()
+		if err != nil {
+			return tracing.TraceWithError(span, err)
+		}
+
+		captureMethod := arg.Payment.CaptureMethod
+		if captureMethod == "" {
+			captureMethod = payment.CAPTURE_METHOD_AUTOMATIC
+		}
+
+		createPaymentMethodArg := CreatePaymentMethodParams{
+			Uid:                         paymentMethodInternalID.String(),
+			PaymentMethodID:             arg.Payment.ID,
+			PaymentRequestID:            textOrNull(arg.Payment.RequestID),
+			PaymentReferenceID:          arg.Payment.ReferenceID,
+			PaymentBusinessID:           arg.Payment.BusinessID,
+			PaymentCustomerID:           arg.Payment.CustomerID,
+			PaymentType:                 arg.Payment.Type,
+			PaymentStatus:               arg.Payment.Status,
+			PaymentReusability:          arg.Payment.Reusability,
+			PaymentChannel:              arg.Payment.Channel,
+			PaymentAmount:               arg.Payment.Amount,
+			Currency:                    arg.Payment.Currency,
+			PaymentDescription:          arg.Payment.Description,
+			PaymentQrCode:               textOrNull(arg.Payment.QrCode),
+			PaymentVirtualAccountNumber: textOrNull(arg.Payment.VirtualAccountNumber),
+			PaymentCode:                 textOrNull(arg.Payment.PaymentCode),
+			PaymentUrl:                  textOrNull(arg.Payment.URL),
+			PaymentCaptureMethod:        captureMethod,
+			PaymentLinkedMethodID:       textOrNull(arg.Payment.LinkedPaymentMethodID),
+			PaymentForUserID:            textOrNull(arg.ForUserID),
+			PaymentFeeAmount:            arg.FeeAmount,
+			CreatedAt: pgtype.Timestamptz{
+				Time:  arg.Payment.CreatedAt,
+				Valid: true,
+			},
+			ExpiresAt: pgtype.Timestamptz{
+				Time:  arg.Payment.ExpiresAt,
+				Valid: true,
+			},
+		}
+
+		result.Payment, err = q.CreatePaymentMethod(ctx, &createPaymentMethodArg)
+		if err != nil {
+			return tracing.TraceWithError(span, err)
+		}
+
+		result.Splits = make([]*PaymentSplit, 0, len(arg.Splits))
+		for _, split := range arg.Splits {
+			splitUID, err := helper.GenerateULID()
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+
+			createSplitArg := *split
+			createSplitArg.Uid = splitUID.String()
+			createSplitArg.PaymentMethodUid = result.Payment.Uid
+			createSplitArg.SplitRuleID = textOrNull(arg.SplitRuleID)
+			createSplitArg.CreatedAt = result.Payment.CreatedAt
+
+			res, err := q.CreatePaymentSplit(ctx, &createSplitArg)
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+
+			result.Splits = append(result.Splits, res)
+		}
+
+		return err
+	})
+
+	return result, err
+}
+
+func textOrNull(arg string) pgtype.Text {
+	return pgtype.Text{
+		String: arg,
+		Valid:  arg != "",
+	}
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoCreatePaymentTxEWALLET(t *testing.T) {
+	createRandomPaymentTx(t, payment.METHODE_TYPE_EWALLET, "OVO")
+}
+
+func TestRepoCreatePaymentTxQRCODE(t *testing.T) {
+	createRandomPaymentTx(t, payment.METHODE_TYPE_QR_CODE, "QRIS")
+}
+
+func TestRepoCreatePaymentTxVIRTUALACCOUNT(t *testing.T) {
+	createRandomPaymentTx(t, payment.METHODE_TYPE_VIRTUAL_ACCOUNT, "BCA")
+}
+
+func createRandomPaymentTx(t *testing.T, typ string, channel string) *PaymentMethod {
+	paymentMethodID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, paymentMethodID)
+
+	referenceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, referenceID)
+
+	arg := &gateway.Payment{
+		ID:          paymentMethodID.String(),
+		ReferenceID: referenceID.String(),
+		BusinessID:  helper.RandomString(24),
+		CustomerID:  helper.RandomString(24),
+		Type:        typ,
+		Status:      payment.STATUS_ACTIVE,
+		Reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+		Channel:     channel,
+		Amount:      decimal.NewFromInt(helper.RandomInt(100000, 200000)),
+		Currency:    payment.DEFAULT_CURRENCY,
+		Description: helper.RandomString(100),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   time.Now().Add(24 * 3 * time.Hour),
+	}
+
+	switch typ {
+	case payment.METHODE_TYPE_EWALLET:
+		arg.RequestID = helper.RandomString(24)
+		arg.URL = helper.RandomUrl()
+	case payment.METHODE_TYPE_QR_CODE:
+		arg.QrCode = helper.RandomString(64)
+	case payment.METHODE_TYPE_VIRTUAL_ACCOUNT:
+		arg.VirtualAccountNumber = helper.RandomStringInt(16)
+	}
+
+	res, err := testStore.CreatePaymentTx(context.TODO(), &CreatePaymentTxParams{Payment: arg})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	require.NotEmpty(t, res.Payment.Uid)
+	require.Equal(t, res.Payment.PaymentMethodID, arg.ID)
+	require.Equal(t, res.Payment.PaymentRequestID.String, arg.RequestID)
+	require.Equal(t, res.Payment.PaymentCustomerID, arg.CustomerID)
+	require.Equal(t, res.Payment.PaymentType, arg.Type)
+	require.Equal(t, res.Payment.PaymentReusability, arg.Reusability)
+	require.Equal(t, res.Payment.PaymentChannel, arg.Channel)
+	require.Equal(t, res.Payment.PaymentDescription, arg.Description)
+	require.Equal(t, res.Payment.PaymentQrCode.String, arg.QrCode)
+	require.Equal(t, res.Payment.PaymentVirtualAccountNumber.String, arg.VirtualAccountNumber)
+	require.Equal(t, res.Payment.PaymentUrl.String, arg.URL)
+	require.True(t, res.Payment.PaymentAmount.Equal(arg.Amount))
+	require.Equal(t, arg.Currency, res.Payment.Currency)
+
+	return res.Payment
+}
+
+func TestRepoCreatePaymentTxUniqueActiveReference(t *testing.T) {
+	pm := createRandomPaymentTx(t, payment.METHODE_TYPE_VIRTUAL_ACCOUNT, "BCA")
+
+	paymentMethodID, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	arg := &gateway.Payment{
+		ID:                   paymentMethodID.String(),
+		ReferenceID:          pm.PaymentReferenceID,
+		BusinessID:           pm.PaymentBusinessID,
+		CustomerID:           pm.PaymentCustomerID,
+		Type:                 pm.PaymentType,
+		Status:               payment.STATUS_PENDING,
+		Reusability:          pm.PaymentReusability,
+		Channel:              pm.PaymentChannel,
+		Amount:               pm.PaymentAmount,
+		Currency:             pm.Currency,
+		Description:          pm.PaymentDescription,
+		VirtualAccountNumber: helper.RandomStringInt(16),
+		CreatedAt:            time.Now(),
+		ExpiresAt:            time.Now().Add(24 * 3 * time.Hour),
+	}
+
+	res, err := testStore.CreatePaymentTx(context.TODO(), &CreatePaymentTxParams{Payment: arg, UniqueActiveReference: true})
+	require.ErrorIs(t, err, unierror.ErrDuplicateActivePayment)
+	require.Empty(t, res.Payment)
+
+	// without the policy every retry of the reference id is stored as a new attempt.
+	res, err = testStore.CreatePaymentTx(context.TODO(), &CreatePaymentTxParams{Payment: arg})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Payment)
+
+	list, err := testStore.ListPaymentMethodsByReferenceID(context.TODO(), pm.PaymentReferenceID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, res.Payment.Uid, list[0].Uid)
+	require.Equal(t, pm.Uid, list[1].Uid)
+}
+
+func TestRepoCreatePaymentTxSplits(t *testing.T) {
+	paymentMethodID, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	referenceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	forUserID := helper.RandomString(24)
+	splitRuleID := helper.RandomString(24)
+
+	arg := &gateway.Payment{
+		ID:                   paymentMethodID.String(),
+		ReferenceID:          referenceID.String(),
+		BusinessID:           helper.RandomString(24),
+		CustomerID:           helper.RandomString(24),
+		Type:                 payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+		Status:               payment.STATUS_PENDING,
+		Reusability:          payment.USAGE_TYPE_ONE_TIME_USE,
+		Channel:              "BCA",
+		Amount:               decimal.NewFromInt(100000),
+		Currency:             payment.DEFAULT_CURRENCY,
+		Description:          helper.RandomString(100),
+		VirtualAccountNumber: helper.RandomStringInt(16),
+		CreatedAt:            time.Now(),
+		ExpiresAt:            time.Now().Add(24 * 3 * time.Hour),
+	}
+
+	splits := []*CreatePaymentSplitParams{
+		{
+			SplitType:            payment.SPLIT_TYPE_PLATFORM_FEE,
+			DestinationAccountID: helper.RandomString(24),
+			SplitAmount:          decimal.NewFromInt(5000),
+			Currency:             payment.DEFAULT_CURRENCY,
+		},
+		{
+			SplitType:            payment.SPLIT_TYPE_ROUTE,
+			DestinationAccountID: helper.RandomString(24),
+			SplitAmount:          decimal.NewFromInt(15000),
+			Currency:             payment.DEFAULT_CURRENCY,
+			SplitReferenceID:     textOrNull(helper.RandomString(12)),
+		},
+		{
+			SplitType:            payment.SPLIT_TYPE_REMAINDER,
+			DestinationAccountID: forUserID,
+			SplitAmount:          decimal.NewFromInt(80000),
+			Currency:             payment.DEFAULT_CURRENCY,
+		},
+	}
+
+	res, err := testStore.CreatePaymentTx(context.TODO(), &CreatePaymentTxParams{
+		Payment:     arg,
+		ForUserID:   forUserID,
+		SplitRuleID: splitRuleID,
+		Splits:      splits,
+	})
+	require.NoError(t, err)
+	require.Equal(t, forUserID, res.Payment.PaymentForUserID.String)
+	require.Len(t, res.Splits, len(splits))
+
+	list, err := testStore.ListPaymentSplitsByPaymentMethodUid(context.TODO(), res.Payment.Uid)
+	require.NoError(t, err)
+	require.Len(t, list, len(splits))
+
+	for i := range res.Splits {
+		require.NotEmpty(t, res.Splits[i].Uid)
+		require.Equal(t, res.Payment.Uid, res.Splits[i].PaymentMethodUid)
+		require.Equal(t, splitRuleID, res.Splits[i].SplitRuleID.String)
+		require.Equal(t, splits[i].SplitType, res.Splits[i].SplitType)
+		require.Equal(t, splits[i].DestinationAccountID, res.Splits[i].DestinationAccountID)
+		require.True(t, splits[i].SplitAmount.Equal(res.Splits[i].SplitAmount))
+		require.Equal(t, splits[i].SplitReferenceID, res.Splits[i].SplitReferenceID)
+		// the caller's params are left untouched.
+		require.Empty(t, splits[i].Uid)
+	}
+}
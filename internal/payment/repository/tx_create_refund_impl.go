@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+type CreateRefundTxParams struct {
+	PaymentMethodID   string
+	PaymentCustomerID string
+	// Amount is the amount to refund, nil refunds whatever is left of the payment.
+	Amount *decimal.Decimal
+	// AmountMinor is the amount to refund in minor units of the payment currency, it takes precedence over Amount.
+	AmountMinor *int64
+	Reason      string
+}
+
+type CreateRefundTxResult struct {
+	Payment *PaymentMethod
+	Refund  *Refund
+}
+
+// CreateRefundTx reserves a pending refund against a succeeded payment. The payment row stays locked
+// for the whole transaction so concurrent refunds can never add up to more than the payment amount.
+func (r *Store) CreateRefundTx(ctx context.Context, arg *CreateRefundTxParams) (CreateRefundTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.CreateRefundTx")
+	defer span.Finish()
+
+	var result CreateRefundTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Payment, err = q.GetPaymentMethodCustomerForUpdate(ctx, &GetPaymentMethodCustomerForUpdateParams{
+			PaymentMethodID:   arg.PaymentMethodID,
+			PaymentCustomerID: arg.PaymentCustomerID,
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodCustomerForUpdate.err: %w", err))
+		}
+
+		if result.Payment.PaymentStatus != payment.STATUS_SUCCEEDED {
+			return tracing.TraceWithError(span, unierror.ErrPaymentNotRefundable)
+		}
+
+		refundedAmount, err := q.GetRefundedAmount(ctx, result.Payment.Uid)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetRefundedAmount.err: %w", err))
+		}
+
+		// a manually captured payment can only give back what was captured of its authorization.
+		paidAmount := result.Payment.PaymentAmount
+		if result.Payment.PaymentCaptureMethod == payment.CAPTURE_METHOD_MANUAL {
+			paidAmount = result.Payment.PaymentCapturedAmount
+		}
+
+		refundableAmount := paidAmount.Sub(refundedAmount)
+
+		amount := refundableAmount
+		switch {
+		case arg.AmountMinor != nil:
+			amount = payment.FromMinorUnits(*arg.AmountMinor, result.Payment.Currency)
+		case arg.Amount != nil:
+			amount = *arg.Amount
+		}
+
+		if !payment.IsExactInCurrency(amount, result.Payment.Currency) {
+			return tracing.TraceWithError(span, unierror.ErrInvalidRefundAmount)
+		}
+
+		if !amount.IsPositive() || amount.GreaterThan(refundableAmount) {
+			return tracing.TraceWithError(span, unierror.ErrRefundAmountExceeded)
+		}
+
+		refundInternalID, err := helper.GenerateULID()
+		if err != nil {
+			return tracing.TraceWithError(span, err)
+		}
+
+		result.Refund, err = q.CreateRefund(ctx, &CreateRefundParams{
+			Uid:               refundInternalID.String(),
+			PaymentMethodUid:  result.Payment.Uid,
+			PaymentMethodID:   result.Payment.PaymentMethodID,
+			RefundReferenceID: refundInternalID.String(),
+			RefundStatus:      payment.REFUND_STATUS_PENDING,
+			RefundAmount:      amount,
+			Currency:          result.Payment.Currency,
+			RefundReason:      arg.Reason,
+			CreatedAt: pgtype.Timestamptz{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.CreateRefund.err: %w", err))
+		}
+
+		return err
+	})
+
+	return result, err
+}
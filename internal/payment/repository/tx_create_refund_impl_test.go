@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSucceededPaymentMethod(t *testing.T) *PaymentMethod {
+	pm := createRandomPaymentMethod(t)
+
+	res, err := testStore.UpdatePaymentMethodCustomer(context.TODO(), &UpdatePaymentMethodCustomerParams{
+		PaymentStatus: pgtype.Text{
+			String: payment.STATUS_SUCCEEDED,
+			Valid:  true,
+		},
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_SUCCEEDED, res.PaymentStatus)
+
+	return res
+}
+
+func Test_REPO_CREATE_REFUND_TX(t *testing.T) {
+	pm := createRandomSucceededPaymentMethod(t)
+
+	partial := pm.PaymentAmount.Div(decimal.NewFromInt(2)).Round(0)
+
+	res, err := testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Amount:            &partial,
+		Reason:            payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Refund)
+
+	require.Equal(t, pm.Uid, res.Refund.PaymentMethodUid)
+	require.Equal(t, pm.PaymentMethodID, res.Refund.PaymentMethodID)
+	require.Equal(t, res.Refund.Uid, res.Refund.RefundReferenceID)
+	require.Equal(t, payment.REFUND_STATUS_PENDING, res.Refund.RefundStatus)
+	require.Equal(t, partial.String(), res.Refund.RefundAmount.String())
+	require.False(t, res.Refund.RefundID.Valid)
+
+	// no amount refunds whatever is left.
+	res, err = testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_OTHERS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pm.PaymentAmount.Sub(partial).String(), res.Refund.RefundAmount.String())
+
+	refundedAmount, err := testStore.GetRefundedAmount(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Equal(t, pm.PaymentAmount.String(), refundedAmount.String())
+
+	_, err = testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_OTHERS,
+	})
+	require.ErrorIs(t, err, unierror.ErrRefundAmountExceeded)
+}
+
+func Test_REPO_CREATE_REFUND_TX_FAILED_REFUND_RELEASES_AMOUNT(t *testing.T) {
+	pm := createRandomSucceededPaymentMethod(t)
+
+	res, err := testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_DUPLICATE,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.UpdateRefund(context.TODO(), &UpdateRefundParams{
+		RefundStatus: pgtype.Text{
+			String: payment.REFUND_STATUS_FAILED,
+			Valid:  true,
+		},
+		Uid: res.Refund.Uid,
+	})
+	require.NoError(t, err)
+
+	res, err = testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_DUPLICATE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pm.PaymentAmount.String(), res.Refund.RefundAmount.String())
+}
+
+func Test_REPO_CREATE_REFUND_TX_ERR_NOT_SUCCEEDED(t *testing.T) {
+	pm := createRandomPaymentMethod(t)
+
+	amount := decimal.NewFromInt(helper.RandomInt(1, 1000))
+
+	_, err := testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Amount:            &amount,
+		Reason:            payment.REFUND_REASON_OTHERS,
+	})
+	require.ErrorIs(t, err, unierror.ErrPaymentNotRefundable)
+}
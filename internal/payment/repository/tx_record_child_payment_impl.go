@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+type RecordChildPaymentTxParams struct {
+	// ParentPaymentMethodID is the MULTIPLE_USE payment method the payment was made to.
+	ParentPaymentMethodID string
+	PaymentCustomerID     string
+	// PaymentID is the gateway id of the payment, the child row is keyed by it.
+	PaymentID          string
+	PaymentRequestID   string
+	PaymentStatus      string
+	PaymentFailureCode pgtype.Text
+	// Amount is the amount paid, nil falls back to the amount of the parent.
+	Amount    *decimal.Decimal
+	UpdatedAt pgtype.Timestamptz
+	PaidAt    pgtype.Timestamptz
+	// PaymentEvent and EventID name the event the payment was reported by, they are kept in the status history.
+	PaymentEvent string
+	EventID      string
+}
+
+type RecordChildPaymentTxResult struct {
+	Parent  *PaymentMethod
+	Payment *PaymentMethod
+	// Rejection is set to one of the payment.STATUS_UPDATE_REJECTED_ reasons when the child payment
+	// was not updated.
+	Rejection string
+}
+
+// RecordChildPaymentTx records a payment made to a MULTIPLE_USE payment method as a child row of it,
+// a later event of the same payment updates that row. The parent stays untouched and open for payments.
+func (r *Store) RecordChildPaymentTx(ctx context.Context, arg *RecordChildPaymentTxParams) (RecordChildPaymentTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.RecordChildPaymentTx")
+	defer span.Finish()
+
+	var result RecordChildPaymentTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// serializes the events of the parent so a redelivered event can not record its payment twice.
+		result.Parent, err = q.GetPaymentMethodCustomerForUpdate(ctx, &GetPaymentMethodCustomerForUpdateParams{
+			PaymentMethodID:   arg.ParentPaymentMethodID,
+			PaymentCustomerID: arg.PaymentCustomerID,
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodCustomerForUpdate.err: %w", err))
+		}
+
+		if result.Parent.PaymentReusability != payment.USAGE_TYPE_MULTIPLE_USE {
+			return tracing.TraceWithError(span, unierror.ErrUnsupportedPaymentReusability)
+		}
+
+		result.Payment, err = q.GetChildPaymentMethod(ctx, &GetChildPaymentMethodParams{
+			PaymentParentUid: pgtype.Text{String: result.Parent.Uid, Valid: true},
+			PaymentMethodID:  arg.PaymentID,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			result.Payment, err = r.createChildPayment(ctx, q, result.Parent, arg)
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.createChildPayment.err: %w", err))
+			}
+		case err != nil:
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetChildPaymentMethod.err: %w", err))
+		}
+
+		processed, err := r.markEventProcessed(ctx, q, result.Payment, arg.PaymentEvent, arg.EventID)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.markEventProcessed.err: %w", err))
+		}
+
+		if !processed {
+			result.Rejection = payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT
+			return nil
+		}
+
+		status := pgtype.Text{String: arg.PaymentStatus, Valid: true}
+
+		result.Rejection = statusUpdateRejection(result.Payment, status, arg.UpdatedAt)
+		if result.Rejection != "" {
+			return nil
+		}
+
+		previousStatus := result.Payment.PaymentStatus
+
+		result.Payment, err = q.UpdatePaymentMethodCustomer(ctx, &UpdatePaymentMethodCustomerParams{
+			PaymentMethodID:    result.Payment.PaymentMethodID,
+			PaymentCustomerID:  result.Payment.PaymentCustomerID,
+			PaymentStatus:      status,
+			PaymentFailureCode: arg.PaymentFailureCode,
+			UpdatedAt:          arg.UpdatedAt,
+			PaidAt:             arg.PaidAt,
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePaymentMethodCustomer.err: %w", err))
+		}
+
+		err = r.recordStatusChange(ctx, q, result.Payment, previousStatus, arg.PaymentEvent, arg.EventID, arg.UpdatedAt)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.recordStatusChange.err: %w", err))
+		}
+
+		if err := r.enqueuePaymentStatusUpdated(ctx, q, result.Payment); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.enqueuePaymentStatusUpdated.err: %w", err))
+		}
+
+		if result.Payment.PaymentStatus == payment.STATUS_SUCCEEDED {
+			if err := r.bookPayment(ctx, q, result.Payment); err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.bookPayment.err: %w", err))
+			}
+		}
+
+		return err
+	})
+
+	return result, err
+}
+
+func (r *Store) createChildPayment(ctx context.Context, q *Queries, parent *PaymentMethod, arg *RecordChildPaymentTxParams) (*PaymentMethod, error) {
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return nil, err
+	}
+
+	amount := parent.PaymentAmount
+	if arg.Amount != nil {
+		amount = *arg.Amount
+	}
+
+	createdAt := time.Now()
+	if arg.UpdatedAt.Valid {
+		createdAt = arg.UpdatedAt.Time
+	}
+
+	return q.CreatePaymentMethod(ctx, &CreatePaymentMethodParams{
+		Uid:                  uid.String(),
+		PaymentMethodID:      arg.PaymentID,
+		PaymentRequestID:     textOrNull(arg.PaymentRequestID),
+		PaymentReferenceID:   parent.PaymentReferenceID,
+		PaymentBusinessID:    parent.PaymentBusinessID,
+		PaymentCustomerID:    parent.PaymentCustomerID,
+		PaymentType:          parent.PaymentType,
+		PaymentStatus:        payment.STATUS_PENDING,
+		PaymentReusability:   payment.USAGE_TYPE_ONE_TIME_USE,
+		PaymentChannel:       parent.PaymentChannel,
+		PaymentAmount:        amount,
+		Currency:             parent.Currency,
+		PaymentDescription:   parent.PaymentDescription,
+		PaymentCaptureMethod: parent.PaymentCaptureMethod,
+		PaymentParentUid:     pgtype.Text{String: parent.Uid, Valid: true},
+		PaymentForUserID:     parent.PaymentForUserID,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  createdAt,
+			Valid: true,
+		},
+		// a child is already paid or on its way, it is never swept as overdue.
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Time{},
+			Valid: true,
+		},
+	})
+}
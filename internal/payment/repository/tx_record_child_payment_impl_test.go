@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomMultipleUsePaymentMethod(t *testing.T) *PaymentMethod {
+	customer := createRandomCustomer(t)
+	paymentType := createRandomPaymentType(t)
+	paymentChannel := createRandomPaymentChannel(t)
+
+	res, err := testStore.CreatePaymentMethod(context.TODO(), &CreatePaymentMethodParams{
+		Uid:                helper.RandomString(26),
+		PaymentMethodID:    helper.RandomString(26),
+		PaymentBusinessID:  helper.RandomString(26),
+		PaymentReferenceID: helper.RandomString(26),
+		PaymentCustomerID:  customer.PaymentCustomerID,
+		PaymentType:        paymentType.Ptname,
+		PaymentStatus:      payment.STATUS_PENDING,
+		PaymentReusability: payment.USAGE_TYPE_MULTIPLE_USE,
+		PaymentChannel:     paymentChannel.Pcname,
+		PaymentAmount:      decimal.NewFromInt(helper.RandomInt(5000, 500000)),
+		PaymentVirtualAccountNumber: pgtype.Text{
+			String: helper.RandomString(16),
+			Valid:  true,
+		},
+		PaymentDescription: helper.RandomString(100),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Now().Add(24 * 3 * time.Hour),
+			Valid: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, res.PaymentReusability)
+
+	return res
+}
+
+func Test_REPO_RECORD_CHILD_PAYMENT_TX(t *testing.T) {
+	parent := createRandomMultipleUsePaymentMethod(t)
+
+	paymentID := helper.RandomString(26)
+	paymentRequestID := helper.RandomString(26)
+	amount := decimal.NewFromInt(helper.RandomInt(5000, 500000))
+
+	res, err := testStore.RecordChildPaymentTx(context.TODO(), &RecordChildPaymentTxParams{
+		ParentPaymentMethodID: parent.PaymentMethodID,
+		PaymentCustomerID:     parent.PaymentCustomerID,
+		PaymentID:             paymentID,
+		PaymentRequestID:      paymentRequestID,
+		PaymentStatus:         payment.STATUS_PENDING,
+		Amount:                &amount,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Payment)
+
+	child := res.Payment
+	require.Equal(t, paymentID, child.PaymentMethodID)
+	require.Equal(t, paymentRequestID, child.PaymentRequestID.String)
+	require.Equal(t, parent.Uid, child.PaymentParentUid.String)
+	require.Equal(t, parent.PaymentCustomerID, child.PaymentCustomerID)
+	require.Equal(t, parent.PaymentType, child.PaymentType)
+	require.Equal(t, parent.PaymentChannel, child.PaymentChannel)
+	require.Equal(t, payment.USAGE_TYPE_ONE_TIME_USE, child.PaymentReusability)
+	require.Equal(t, payment.STATUS_PENDING, child.PaymentStatus)
+	require.Equal(t, amount.String(), child.PaymentAmount.String())
+
+	// a later event of the same payment updates the child row.
+	res, err = testStore.RecordChildPaymentTx(context.TODO(), &RecordChildPaymentTxParams{
+		ParentPaymentMethodID: parent.PaymentMethodID,
+		PaymentCustomerID:     parent.PaymentCustomerID,
+		PaymentID:             paymentID,
+		PaymentRequestID:      paymentRequestID,
+		PaymentStatus:         payment.STATUS_SUCCEEDED,
+		PaidAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, child.Uid, res.Payment.Uid)
+	require.Equal(t, payment.STATUS_SUCCEEDED, res.Payment.PaymentStatus)
+	require.True(t, res.Payment.PaidAt.Valid)
+
+	// the parent stays open for payments.
+	require.Equal(t, payment.STATUS_PENDING, res.Parent.PaymentStatus)
+
+	pm := createRandomPaymentMethod(t)
+	_, err = testStore.RecordChildPaymentTx(context.TODO(), &RecordChildPaymentTxParams{
+		ParentPaymentMethodID: pm.PaymentMethodID,
+		PaymentCustomerID:     pm.PaymentCustomerID,
+		PaymentID:             helper.RandomString(26),
+		PaymentStatus:         payment.STATUS_SUCCEEDED,
+	})
+	require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentReusability)
+}
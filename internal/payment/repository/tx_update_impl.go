@@ -7,14 +7,23 @@ import (
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
 	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
 )
 
 type UpdateTxParams struct {
 	UpdateParams UpdatePaymentMethodCustomerParams
+	// CapturedAmount is set once the gateway has captured an authorized payment.
+	CapturedAmount *decimal.Decimal
+	// PaymentEvent and EventID name the event the update came from, they are kept in the status history.
+	PaymentEvent string
+	EventID      string
 }
 
 type UpdateTxResult struct {
 	Payment *PaymentMethod
+	// Rejection is set to one of the payment.STATUS_UPDATE_REJECTED_ reasons when the update was not
+	// applied, Payment is then the payment as it is stored.
+	Rejection string
 }
 
 func (r *Store) UpdateTx(ctx context.Context, arg *UpdateTxParams) (UpdateTxResult, error) {
@@ -29,23 +38,80 @@ func (r *Store) UpdateTx(ctx context.Context, arg *UpdateTxParams) (UpdateTxResu
 	err := r.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		getPaymentArg := GetPaymentMethodCustomerParams{
+		// serializes the updates of the payment so concurrent events are checked against each other.
+		result.Payment, err = q.GetPaymentMethodCustomerForUpdate(ctx, &GetPaymentMethodCustomerForUpdateParams{
 			PaymentMethodID:   arg.UpdateParams.PaymentMethodID,
 			PaymentCustomerID: arg.UpdateParams.PaymentCustomerID,
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodCustomerForUpdate.err: %w", err))
 		}
 
-		result.Payment, err = q.GetPaymentMethodCustomer(ctx, &getPaymentArg)
+		processed, err := r.markEventProcessed(ctx, q, result.Payment, arg.PaymentEvent, arg.EventID)
 		if err != nil {
-			return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodCustomer.err: %v", err))
+			return tracing.TraceWithError(span, fmt.Errorf("r.markEventProcessed.err: %w", err))
 		}
 
-		if result.Payment.PaymentStatus == payment.STATUS_SUCCEEDED || result.Payment.PaymentStatus == payment.STATUS_FAILED {
+		if !processed {
+			result.Rejection = payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT
+			return nil
+		}
+
+		result.Rejection = statusUpdateRejection(result.Payment, arg.UpdateParams.PaymentStatus, arg.UpdateParams.UpdatedAt)
+		if result.Rejection != "" {
+			if !isUnrecordedCapture(result.Payment, arg.CapturedAmount) {
+				return nil
+			}
+
+			// the payment webhook and the capture call race each other, whichever settles the payment
+			// last still brings the captured amount it was booked without.
+			result.Payment, err = q.UpdatePaymentMethodCapturedAmount(ctx, &UpdatePaymentMethodCapturedAmountParams{
+				PaymentMethodID:       arg.UpdateParams.PaymentMethodID,
+				PaymentCustomerID:     arg.UpdateParams.PaymentCustomerID,
+				PaymentCapturedAmount: *arg.CapturedAmount,
+			})
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePaymentMethodCapturedAmount.err: %w", err))
+			}
+
+			if err := r.bookPayment(ctx, q, result.Payment); err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.bookPayment.err: %w", err))
+			}
+
 			return nil
 		}
 
+		previousStatus := result.Payment.PaymentStatus
+
 		result.Payment, err = q.UpdatePaymentMethodCustomer(ctx, &arg.UpdateParams)
 		if err != nil {
-			return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePaymentMethodCustomer.err: %v", err))
+			return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePaymentMethodCustomer.err: %w", err))
+		}
+
+		if arg.CapturedAmount != nil {
+			result.Payment, err = q.UpdatePaymentMethodCapturedAmount(ctx, &UpdatePaymentMethodCapturedAmountParams{
+				PaymentMethodID:       arg.UpdateParams.PaymentMethodID,
+				PaymentCustomerID:     arg.UpdateParams.PaymentCustomerID,
+				PaymentCapturedAmount: *arg.CapturedAmount,
+			})
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePaymentMethodCapturedAmount.err: %w", err))
+			}
+		}
+
+		err = r.recordStatusChange(ctx, q, result.Payment, previousStatus, arg.PaymentEvent, arg.EventID, arg.UpdateParams.UpdatedAt)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.recordStatusChange.err: %w", err))
+		}
+
+		if err := r.enqueuePaymentStatusUpdated(ctx, q, result.Payment); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.enqueuePaymentStatusUpdated.err: %w", err))
+		}
+
+		if result.Payment.PaymentStatus == payment.STATUS_SUCCEEDED {
+			if err := r.bookPayment(ctx, q, result.Payment); err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.bookPayment.err: %w", err))
+			}
 		}
 
 		return err
@@ -53,3 +119,12 @@ func (r *Store) UpdateTx(ctx context.Context, arg *UpdateTxParams) (UpdateTxResu
 
 	return result, err
 }
+
+// isUnrecordedCapture reports whether capturedAmount settles the capture of pm, a succeeded manually
+// captured payment whose captured amount is not stored yet.
+func isUnrecordedCapture(pm *PaymentMethod, capturedAmount *decimal.Decimal) bool {
+	return capturedAmount != nil &&
+		pm.PaymentCaptureMethod == payment.CAPTURE_METHOD_MANUAL &&
+		pm.PaymentStatus == payment.STATUS_SUCCEEDED &&
+		!pm.PaymentCapturedAmount.IsPositive()
+}
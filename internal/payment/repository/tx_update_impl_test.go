@@ -2,28 +2,38 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_REPO_UPDATE_TX(t *testing.T) {
-	pm := createRandomPaymentMethod(t)
-	paymentStatus := createRandomPaymentStatus(t)
+	pm := createRandomActivePaymentMethod(t)
 
 	arg := UpdatePaymentMethodCustomerParams{
 		PaymentStatus: pgtype.Text{
-			String: paymentStatus.Psname,
+			String: payment.STATUS_PENDING,
 			Valid:  true,
 		},
 		PaymentMethodID:   pm.PaymentMethodID,
 		PaymentCustomerID: pm.PaymentCustomerID,
 	}
 
-	res, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{UpdateParams: arg})
+	res, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+		UpdateParams: arg,
+		PaymentEvent: "payment_method.updated",
+		EventID:      helper.RandomString(32),
+	})
 	require.NoError(t, err)
 	require.NotEmpty(t, res)
+	require.Empty(t, res.Rejection)
 
 	require.Equal(t, res.Payment.Uid, pm.Uid)
 	require.Equal(t, res.Payment.PaymentMethodID, pm.PaymentMethodID)
@@ -39,5 +49,164 @@ func Test_REPO_UPDATE_TX(t *testing.T) {
 	require.Equal(t, res.Payment.PaymentUrl, pm.PaymentUrl)
 
 	require.NotEqual(t, res.Payment.PaymentStatus, pm.PaymentStatus)
-	require.Equal(t, res.Payment.PaymentStatus, paymentStatus.Psname)
+	require.Equal(t, res.Payment.PaymentStatus, payment.STATUS_PENDING)
+
+	history, err := testStore.ListPaymentStatusHistoryByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, pm.PaymentStatus, history[0].PreviousStatus)
+	require.Equal(t, payment.STATUS_PENDING, history[0].NewStatus)
+	require.Equal(t, "payment_method.updated", history[0].PaymentEvent)
+	require.True(t, history[0].EventID.Valid)
+}
+
+func Test_REPO_UPDATE_TX_NOT_FOUND(t *testing.T) {
+	_, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+		UpdateParams: UpdatePaymentMethodCustomerParams{
+			PaymentStatus: pgtype.Text{
+				String: payment.STATUS_SUCCEEDED,
+				Valid:  true,
+			},
+			PaymentMethodID:   helper.RandomString(32),
+			PaymentCustomerID: helper.RandomString(32),
+		},
+		PaymentEvent: "payment_method.updated",
+		EventID:      helper.RandomString(32),
+	})
+	require.Error(t, err)
+	// the handlers tell a payment they do not know of from a failure by its wrapped error.
+	require.True(t, errors.Is(err, pgx.ErrNoRows))
+}
+
+func Test_REPO_UPDATE_TX_REJECTIONS(t *testing.T) {
+	pm := createRandomActivePaymentMethod(t)
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	update := func(status string, updatedAt time.Time, eventID string) UpdateTxResult {
+		res, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+			UpdateParams: UpdatePaymentMethodCustomerParams{
+				PaymentStatus:     pgtype.Text{String: status, Valid: true},
+				UpdatedAt:         pgtype.Timestamptz{Time: updatedAt, Valid: true},
+				PaymentMethodID:   pm.PaymentMethodID,
+				PaymentCustomerID: pm.PaymentCustomerID,
+			},
+			PaymentEvent: "payment." + status,
+			EventID:      eventID,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	eventID := helper.RandomString(32)
+	res := update(payment.STATUS_REQUIRES_ACTION, updatedAt, eventID)
+	require.Empty(t, res.Rejection)
+	require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.Payment.PaymentStatus)
+
+	// the same event delivered twice is only applied once.
+	res = update(payment.STATUS_REQUIRES_ACTION, updatedAt, eventID)
+	require.Equal(t, payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT, res.Rejection)
+
+	processed, err := testStore.GetProcessedEvent(context.TODO(), eventID)
+	require.NoError(t, err)
+	require.Equal(t, pm.Uid, processed.PaymentMethodUid)
+
+	// a PENDING event the gateway sent before REQUIRES_ACTION arrives late.
+	res = update(payment.STATUS_PENDING, updatedAt.Add(-time.Minute), helper.RandomString(32))
+	require.Equal(t, payment.STATUS_UPDATE_REJECTED_STALE, res.Rejection)
+	require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.Payment.PaymentStatus)
+
+	// REQUIRES_ACTION never goes back to PENDING, whatever its timestamp says.
+	res = update(payment.STATUS_PENDING, updatedAt.Add(time.Minute), helper.RandomString(32))
+	require.Equal(t, payment.STATUS_UPDATE_REJECTED_TRANSITION, res.Rejection)
+	require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.Payment.PaymentStatus)
+
+	res = update(payment.STATUS_SUCCEEDED, updatedAt.Add(time.Minute), helper.RandomString(32))
+	require.Empty(t, res.Rejection)
+	require.Equal(t, payment.STATUS_SUCCEEDED, res.Payment.PaymentStatus)
+
+	history, err := testStore.ListPaymentStatusHistoryByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, payment.STATUS_REQUIRES_ACTION, history[0].NewStatus)
+	require.Equal(t, payment.STATUS_SUCCEEDED, history[1].NewStatus)
+}
+
+func Test_REPO_UPDATE_TX_MANUAL_CAPTURE_SETTLED_BY_WEBHOOK(t *testing.T) {
+	pm := createRandomAwaitingCapturePaymentMethod(t)
+	captured := pm.PaymentAmount.Sub(decimal.NewFromInt(1000))
+	updatedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	succeeded := func(paymentEvent string, capturedAmount *decimal.Decimal, updatedAt time.Time) UpdateTxResult {
+		res, err := testStore.UpdateTx(context.TODO(), &UpdateTxParams{
+			UpdateParams: UpdatePaymentMethodCustomerParams{
+				PaymentStatus:     pgtype.Text{String: payment.STATUS_SUCCEEDED, Valid: true},
+				UpdatedAt:         pgtype.Timestamptz{Time: updatedAt, Valid: true},
+				PaymentMethodID:   pm.PaymentMethodID,
+				PaymentCustomerID: pm.PaymentCustomerID,
+			},
+			CapturedAmount: capturedAmount,
+			PaymentEvent:   paymentEvent,
+			EventID:        helper.RandomString(32),
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the webhook settles the payment before the capture call records what it captured.
+	res := succeeded("payment.capture", nil, updatedAt)
+	require.Empty(t, res.Rejection)
+	require.Equal(t, payment.STATUS_SUCCEEDED, res.Payment.PaymentStatus)
+	require.True(t, res.Payment.PaymentCapturedAmount.IsZero())
+
+	entries, err := testStore.ListLedgerEntriesByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	res = succeeded(payment.PAYMENT_EVENT_CAPTURE, &captured, updatedAt.Add(time.Second))
+	require.Equal(t, payment.STATUS_UPDATE_REJECTED_TRANSITION, res.Rejection)
+	require.Equal(t, captured.String(), res.Payment.PaymentCapturedAmount.String())
+
+	postings, err := testStore.ListLedgerPostingsByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	for _, posting := range postings {
+		if posting.AccountCode == payment.LEDGER_ACCOUNT_MERCHANT_PAYABLE {
+			require.Equal(t, captured.String(), posting.Amount.String())
+		}
+	}
+
+	// a captured amount already stored is never overwritten.
+	other := captured.Sub(decimal.NewFromInt(1000))
+	res = succeeded(payment.PAYMENT_EVENT_CAPTURE, &other, updatedAt.Add(2*time.Second))
+	require.Equal(t, payment.STATUS_UPDATE_REJECTED_TRANSITION, res.Rejection)
+	require.Equal(t, captured.String(), res.Payment.PaymentCapturedAmount.String())
+
+	refund, err := testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_OTHERS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, captured.String(), refund.Refund.RefundAmount.String())
+}
+
+func createRandomAwaitingCapturePaymentMethod(t *testing.T) *PaymentMethod {
+	pm := createRandomPaymentMethod(t)
+
+	// the capture method is only ever set when the payment is created.
+	_, err := pqConn.Exec(
+		context.TODO(),
+		`UPDATE "payment_method" SET "payment_capture_method" = $1 WHERE "uid" = $2`,
+		payment.CAPTURE_METHOD_MANUAL, pm.Uid,
+	)
+	require.NoError(t, err)
+
+	res, err := testStore.UpdatePaymentMethodCustomer(context.TODO(), &UpdatePaymentMethodCustomerParams{
+		PaymentStatus:     pgtype.Text{String: payment.STATUS_AWAITING_CAPTURE, Valid: true},
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.CAPTURE_METHOD_MANUAL, res.PaymentCaptureMethod)
+
+	return res
 }
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+type UpdateInvoiceTxParams struct {
+	InvoiceID     string
+	InvoiceStatus string
+	// PaymentID and PaymentChannel describe the payment the customer made through the checkout page,
+	// they are only known once the invoice is paid.
+	PaymentID      string
+	PaymentChannel string
+	// Amount is the amount paid, nil falls back to the amount of the invoice.
+	Amount    *decimal.Decimal
+	UpdatedAt pgtype.Timestamptz
+	PaidAt    pgtype.Timestamptz
+}
+
+type UpdateInvoiceTxResult struct {
+	Invoice *Invoice
+	// Payment is the payment method linked to the invoice, nil until the invoice is paid.
+	Payment *PaymentMethod
+	// Paid is only true for the update which moved the invoice into a paid status,
+	// redelivered and later callbacks of a paid invoice leave it false.
+	Paid bool
+}
+
+// UpdateInvoiceTx moves an invoice into the status of a callback, the first paid callback records the
+// payment made through the checkout page as a payment method linked back to the invoice.
+func (r *Store) UpdateInvoiceTx(ctx context.Context, arg *UpdateInvoiceTxParams) (UpdateInvoiceTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.UpdateInvoiceTx")
+	defer span.Finish()
+
+	var result UpdateInvoiceTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// serializes the callbacks of the invoice so a redelivered one can not record its payment twice.
+		result.Invoice, err = q.GetInvoiceByInvoiceIDForUpdate(ctx, arg.InvoiceID)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetInvoiceByInvoiceIDForUpdate.err: %w", err))
+		}
+
+		if payment.IsInvoicePaidStatus(result.Invoice.InvoiceStatus) {
+			result.Payment, err = q.GetPaymentMethodByInvoiceUid(ctx, pgtype.Text{String: result.Invoice.Uid, Valid: true})
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodByInvoiceUid.err: %w", err))
+			}
+
+			// a paid invoice only moves on once its funds are settled.
+			if result.Invoice.InvoiceStatus != payment.INVOICE_STATUS_PAID || arg.InvoiceStatus != payment.INVOICE_STATUS_SETTLED {
+				return nil
+			}
+
+			result.Invoice, err = r.updateInvoiceStatus(ctx, q, result.Invoice, arg)
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.updateInvoiceStatus.err: %w", err))
+			}
+
+			return nil
+		}
+
+		if !payment.IsInvoicePaidStatus(arg.InvoiceStatus) {
+			if result.Invoice.InvoiceStatus == payment.INVOICE_STATUS_EXPIRED {
+				return nil
+			}
+
+			result.Invoice, err = r.updateInvoiceStatus(ctx, q, result.Invoice, arg)
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("r.updateInvoiceStatus.err: %w", err))
+			}
+
+			return nil
+		}
+
+		if !slices.Contains(result.Invoice.InvoiceAllowedChannels, arg.PaymentChannel) {
+			return tracing.TraceWithError(span, fmt.Errorf("%w: %s", unierror.ErrInvoiceChannelNotAllowed, arg.PaymentChannel))
+		}
+
+		result.Payment, err = r.createInvoicePayment(ctx, q, result.Invoice, arg)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.createInvoicePayment.err: %w", err))
+		}
+
+		if err := r.bookPayment(ctx, q, result.Payment); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.bookPayment.err: %w", err))
+		}
+
+		result.Invoice, err = r.updateInvoiceStatus(ctx, q, result.Invoice, arg)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.updateInvoiceStatus.err: %w", err))
+		}
+
+		result.Paid = true
+
+		return err
+	})
+
+	return result, err
+}
+
+func (r *Store) updateInvoiceStatus(ctx context.Context, q *Queries, invoice *Invoice, arg *UpdateInvoiceTxParams) (*Invoice, error) {
+	return q.UpdateInvoice(ctx, &UpdateInvoiceParams{
+		Uid:           invoice.Uid,
+		InvoiceStatus: pgtype.Text{String: arg.InvoiceStatus, Valid: true},
+		UpdatedAt:     arg.UpdatedAt,
+		PaidAt:        arg.PaidAt,
+	})
+}
+
+// createInvoicePayment records the payment made through the checkout page, its type is the one of the
+// channel the customer picked.
+func (r *Store) createInvoicePayment(ctx context.Context, q *Queries, invoice *Invoice, arg *UpdateInvoiceTxParams) (*PaymentMethod, error) {
+	channel, err := q.GetPaymentChannelByNameAndCurrency(ctx, &GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   arg.PaymentChannel,
+		Currency: invoice.Currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("q.GetPaymentChannelByNameAndCurrency.err: %w", err)
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return nil, err
+	}
+
+	paymentMethodID := arg.PaymentID
+	if paymentMethodID == "" {
+		paymentMethodID = invoice.InvoiceID
+	}
+
+	amount := invoice.InvoiceAmount
+	if arg.Amount != nil {
+		amount = *arg.Amount
+	}
+
+	createdAt := time.Now()
+	if arg.PaidAt.Valid {
+		createdAt = arg.PaidAt.Time
+	}
+
+	res, err := q.CreatePaymentMethod(ctx, &CreatePaymentMethodParams{
+		Uid:                  uid.String(),
+		PaymentMethodID:      paymentMethodID,
+		PaymentReferenceID:   invoice.InvoiceReferenceID,
+		PaymentBusinessID:    invoice.InvoiceBusinessID,
+		PaymentCustomerID:    invoice.PaymentCustomerID,
+		PaymentType:          channel.PcType,
+		PaymentStatus:        payment.STATUS_SUCCEEDED,
+		PaymentReusability:   payment.USAGE_TYPE_ONE_TIME_USE,
+		PaymentChannel:       channel.Pcname,
+		PaymentAmount:        amount,
+		Currency:             invoice.Currency,
+		PaymentUrl:           textOrNull(invoice.InvoiceUrl),
+		PaymentDescription:   invoice.InvoiceDescription,
+		PaymentCaptureMethod: payment.CAPTURE_METHOD_AUTOMATIC,
+		PaymentInvoiceUid:    pgtype.Text{String: invoice.Uid, Valid: true},
+		CreatedAt: pgtype.Timestamptz{
+			Time:  createdAt,
+			Valid: true,
+		},
+		// the payment is made when it is recorded, it is never swept as overdue.
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Time{},
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("q.CreatePaymentMethod.err: %w", err)
+	}
+
+	return q.UpdatePaymentMethodCustomer(ctx, &UpdatePaymentMethodCustomerParams{
+		PaymentMethodID:   res.PaymentMethodID,
+		PaymentCustomerID: res.PaymentCustomerID,
+		UpdatedAt:         arg.UpdatedAt,
+		PaidAt:            arg.PaidAt,
+	})
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_UPDATE_INVOICE_TX(t *testing.T) {
+	channel := createRandomPaymentChannel(t)
+	invoice := createRandomInvoice(t, channel)
+
+	paidAt := pgtype.Timestamptz{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	_, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:      invoice.InvoiceID,
+		InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+		PaymentID:      helper.RandomString(26),
+		PaymentChannel: helper.RandomString(32),
+		UpdatedAt:      paidAt,
+		PaidAt:         paidAt,
+	})
+	require.ErrorIs(t, err, unierror.ErrInvoiceChannelNotAllowed)
+
+	paymentID := helper.RandomString(26)
+	res, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:      invoice.InvoiceID,
+		InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+		PaymentID:      paymentID,
+		PaymentChannel: channel.Pcname,
+		UpdatedAt:      paidAt,
+		PaidAt:         paidAt,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Paid)
+	require.Equal(t, payment.INVOICE_STATUS_PAID, res.Invoice.InvoiceStatus)
+	require.True(t, res.Invoice.PaidAt.Valid)
+
+	require.NotEmpty(t, res.Payment)
+	require.Equal(t, paymentID, res.Payment.PaymentMethodID)
+	require.Equal(t, invoice.Uid, res.Payment.PaymentInvoiceUid.String)
+	require.Equal(t, channel.PcType, res.Payment.PaymentType)
+	require.Equal(t, payment.STATUS_SUCCEEDED, res.Payment.PaymentStatus)
+	require.Equal(t, invoice.InvoiceAmount.String(), res.Payment.PaymentAmount.String())
+
+	// a redelivered paid callback records nothing.
+	redelivered, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:      invoice.InvoiceID,
+		InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+		PaymentID:      paymentID,
+		PaymentChannel: channel.Pcname,
+		UpdatedAt:      paidAt,
+		PaidAt:         paidAt,
+	})
+	require.NoError(t, err)
+	require.False(t, redelivered.Paid)
+	require.Equal(t, res.Payment.Uid, redelivered.Payment.Uid)
+
+	// a paid invoice never expires, it only settles.
+	expired, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:     invoice.InvoiceID,
+		InvoiceStatus: payment.INVOICE_STATUS_EXPIRED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.INVOICE_STATUS_PAID, expired.Invoice.InvoiceStatus)
+
+	settled, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:     invoice.InvoiceID,
+		InvoiceStatus: payment.INVOICE_STATUS_SETTLED,
+	})
+	require.NoError(t, err)
+	require.False(t, settled.Paid)
+	require.Equal(t, payment.INVOICE_STATUS_SETTLED, settled.Invoice.InvoiceStatus)
+}
+
+func Test_REPO_UPDATE_INVOICE_TX_EXPIRED(t *testing.T) {
+	channel := createRandomPaymentChannel(t)
+	invoice := createRandomInvoice(t, channel)
+
+	res, err := testStore.UpdateInvoiceTx(context.TODO(), &UpdateInvoiceTxParams{
+		InvoiceID:     invoice.InvoiceID,
+		InvoiceStatus: payment.INVOICE_STATUS_EXPIRED,
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, res.Paid)
+	require.Nil(t, res.Payment)
+	require.Equal(t, payment.INVOICE_STATUS_EXPIRED, res.Invoice.InvoiceStatus)
+}
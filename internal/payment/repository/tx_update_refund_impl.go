@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+type UpdateRefundTxParams struct {
+	UpdateParams UpdateRefundParams
+}
+
+type UpdateRefundTxResult struct {
+	Refund *Refund
+}
+
+// UpdateRefundTx moves a refund to the status of its callback, a refund which succeeds is booked to the
+// ledger in the same transaction.
+func (r *Store) UpdateRefundTx(ctx context.Context, arg *UpdateRefundTxParams) (UpdateRefundTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.UpdateRefundTx")
+	defer span.Finish()
+
+	var result UpdateRefundTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Refund, err = q.UpdateRefund(ctx, &arg.UpdateParams)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.UpdateRefund.err: %w", err))
+		}
+
+		if result.Refund.RefundStatus != payment.REFUND_STATUS_SUCCEEDED {
+			return nil
+		}
+
+		pm, err := q.GetPaymentMethodByPaymentMethodID(ctx, result.Refund.PaymentMethodID)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetPaymentMethodByPaymentMethodID.err: %w", err))
+		}
+
+		if err := r.bookRefund(ctx, q, pm, result.Refund); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("r.bookRefund.err: %w", err))
+		}
+
+		return nil
+	})
+
+	return result, err
+}
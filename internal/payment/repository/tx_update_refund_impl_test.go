@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_UPDATE_REFUND_TX(t *testing.T) {
+	pm := createRandomSucceededPaymentMethod(t)
+
+	created, err := testStore.CreateRefundTx(context.TODO(), &CreateRefundTxParams{
+		PaymentMethodID:   pm.PaymentMethodID,
+		PaymentCustomerID: pm.PaymentCustomerID,
+		Reason:            payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+	})
+	require.NoError(t, err)
+
+	arg := UpdateRefundParams{
+		Uid: created.Refund.Uid,
+		RefundID: pgtype.Text{
+			String: helper.RandomString(24),
+			Valid:  true,
+		},
+		RefundStatus: pgtype.Text{
+			String: payment.REFUND_STATUS_PENDING,
+			Valid:  true,
+		},
+	}
+
+	res, err := testStore.UpdateRefundTx(context.TODO(), &UpdateRefundTxParams{UpdateParams: arg})
+	require.NoError(t, err)
+	require.Equal(t, payment.REFUND_STATUS_PENDING, res.Refund.RefundStatus)
+
+	// a pending refund is not booked yet.
+	entries, err := testStore.ListLedgerEntriesByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	arg.RefundStatus.String = payment.REFUND_STATUS_SUCCEEDED
+	res, err = testStore.UpdateRefundTx(context.TODO(), &UpdateRefundTxParams{UpdateParams: arg})
+	require.NoError(t, err)
+	require.Equal(t, payment.REFUND_STATUS_SUCCEEDED, res.Refund.RefundStatus)
+
+	entries, err = testStore.ListLedgerEntriesByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, payment.LEDGER_ENTRY_TYPE_REFUND, entries[0].EntryType)
+	require.Equal(t, res.Refund.Uid, entries[0].RefundUid.String)
+
+	postings, err := testStore.ListLedgerPostingsByPaymentMethodUid(context.TODO(), pm.Uid)
+	require.NoError(t, err)
+	require.Len(t, postings, 2)
+	for _, posting := range postings {
+		require.Equal(t, res.Refund.RefundAmount.String(), posting.Amount.String())
+		if posting.Direction == payment.LEDGER_DIRECTION_DEBIT {
+			require.Equal(t, payment.LEDGER_ACCOUNT_MERCHANT_PAYABLE, posting.AccountCode)
+		} else {
+			require.Equal(t, payment.LEDGER_ACCOUNT_GATEWAY_CLEARING, posting.AccountCode)
+		}
+	}
+}
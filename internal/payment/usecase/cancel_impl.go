@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Cancel deactivates an outstanding payment at the gateway before moving it to a final status,
+// so a customer can never pay a payment that has already been reported as canceled.
+func (u *usecaseImpl) Cancel(ctx context.Context, arg *models.CancelPaymentRequest) (*pb.CancelPaymentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.Cancel")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	// a direct debit charge is keyed by its payment request, expiring it would unlink the customer account.
+	if !payment.IsOutstandingStatus(res.PaymentStatus) || res.PaymentLinkedMethodID.Valid {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsOutstandingStatus", res.PaymentStatus),
+			unierror.ErrPaymentNotCancelable,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	expired, err := provider.ExpirePayment(gateway.ForUser(ctx, res.PaymentForUserID.String), res.PaymentMethodID)
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.ExpirePayment.err", err)
+	}
+
+	status := payment.STATUS_CANCELED
+	if expired.Status == payment.STATUS_EXPIRED {
+		status = payment.STATUS_EXPIRED
+	}
+
+	updatedAt := time.Now()
+	if !expired.UpdatedAt.IsZero() {
+		updatedAt = expired.UpdatedAt
+	}
+
+	updateTx, err := u.repo.UpdateTx(ctx, &repository.UpdateTxParams{
+		UpdateParams: repository.UpdatePaymentMethodCustomerParams{
+			PaymentMethodID:   res.PaymentMethodID,
+			PaymentCustomerID: res.PaymentCustomerID,
+			PaymentStatus: pgtype.Text{
+				String: status,
+				Valid:  true,
+			},
+			UpdatedAt: pgtype.Timestamptz{
+				Time:  updatedAt,
+				Valid: true,
+			},
+		},
+		PaymentEvent: payment.PAYMENT_EVENT_CANCEL,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdateTx.err", err)
+	}
+
+	u.repo.DeleteCache(ctx, updateTx.Payment.PaymentCustomerID, updateTx.Payment.PaymentMethodID)
+
+	return &pb.CancelPaymentResponse{
+		PaymentMethod: mapper.PaymentToDto(updateTx.Payment),
+	}, nil
+}
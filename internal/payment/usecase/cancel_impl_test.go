@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CANCEL_PAYMENT(t *testing.T) {
+	_, paymentSucceeded := createRandomVirtualAccountBankPayment(t)
+	paymentSucceeded.PaymentStatus = payment.STATUS_SUCCEEDED
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod)
+		checkResponse func(t *testing.T, res *pb.CancelPaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod) {
+				paymentExpired := *paymentActive
+				paymentExpired.PaymentStatus = payment.STATUS_EXPIRED
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentActive, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+						require.Equal(t, paymentActive.PaymentMethodID, arg.UpdateParams.PaymentMethodID)
+						require.Equal(t, paymentActive.PaymentCustomerID, arg.UpdateParams.PaymentCustomerID)
+						require.Equal(t, payment.STATUS_EXPIRED, arg.UpdateParams.PaymentStatus.String)
+						require.True(t, arg.UpdateParams.UpdatedAt.Valid)
+						require.Equal(t, payment.PAYMENT_EVENT_CANCEL, arg.PaymentEvent)
+						return repository.UpdateTxResult{Payment: &paymentExpired}, nil
+					},
+				)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Eq(paymentActive.PaymentCustomerID), gomock.Eq(paymentActive.PaymentMethodID)).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_EXPIRED, res.GetPaymentMethod().GetPaymentStatus())
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelPaymentResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_CANCELABLE",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentSucceeded, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentNotCancelable)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_ERROR",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentActive, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelPaymentResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_UPDATE_TX_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentActive *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentActive, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelPaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			paymentActive := createRandomProviderPayment(t, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, provider, paymentActive)
+
+			res, err := u.Cancel(context.TODO(), &models.CancelPaymentRequest{
+				PaymentCustomerId: paymentActive.PaymentCustomerID,
+				PaymentMethodId:   paymentActive.PaymentMethodID,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+// createRandomProviderPayment creates an active virtual account payment on the fake provider
+// and returns the row the repository would hold for it.
+func createRandomProviderPayment(t *testing.T, provider *gateway.FakeProvider) *repository.PaymentMethod {
+	_, res := createRandomVirtualAccountBankPayment(t)
+
+	providerPayment, err := provider.CreateVirtualAccountBankPayment(context.TODO(), &gateway.CreatePaymentParams{
+		CustomerPaymentID: res.PaymentCustomerID,
+		ReferenceID:       helper.RandomString(26),
+		Amount:            res.PaymentAmount,
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "BCA",
+	})
+	require.NoError(t, err)
+
+	res.PaymentMethodID = providerPayment.ID
+	res.PaymentStatus = providerPayment.Status
+
+	return res
+}
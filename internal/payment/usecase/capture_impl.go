@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Capture collects the held funds of a manually captured card payment, the capture amount
+// defaults to the authorized amount and may be lower when only part of an order is fulfilled.
+func (u *usecaseImpl) Capture(ctx context.Context, arg *models.CapturePaymentRequest) (*pb.CapturePaymentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.Capture")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	if res.PaymentStatus != payment.STATUS_AWAITING_CAPTURE {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "res.PaymentStatus", res.PaymentStatus),
+			unierror.ErrPaymentNotCapturable,
+		)
+	}
+
+	amount := res.PaymentAmount
+	switch {
+	case arg.CaptureAmountMinor != nil:
+		amount = payment.FromMinorUnits(*arg.CaptureAmountMinor, res.Currency)
+	case arg.CaptureAmount != nil:
+		amount = *arg.CaptureAmount
+	}
+
+	if !amount.IsPositive() || amount.GreaterThan(res.PaymentAmount) || !payment.IsExactInCurrency(amount, res.Currency) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "amount", amount),
+			unierror.ErrInvalidCaptureAmount,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	capture, err := provider.CapturePayment(gateway.ForUser(ctx, res.PaymentForUserID.String), &gateway.CapturePaymentParams{
+		PaymentRequestID: res.PaymentRequestID.String,
+		ReferenceID:      res.PaymentReferenceID,
+		Amount:           amount,
+		Currency:         res.Currency,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.CapturePayment.err", err)
+	}
+
+	updatedAt := time.Now()
+	if !capture.UpdatedAt.IsZero() {
+		updatedAt = capture.UpdatedAt
+	}
+
+	updateArg := repository.UpdateTxParams{
+		UpdateParams: repository.UpdatePaymentMethodCustomerParams{
+			PaymentMethodID:   res.PaymentMethodID,
+			PaymentCustomerID: res.PaymentCustomerID,
+			UpdatedAt: pgtype.Timestamptz{
+				Time:  updatedAt,
+				Valid: true,
+			},
+		},
+		PaymentEvent: payment.PAYMENT_EVENT_CAPTURE,
+	}
+
+	switch capture.Status {
+	case payment.CAPTURE_STATUS_SUCCEEDED:
+		updateArg.UpdateParams.PaymentStatus = pgtype.Text{String: payment.STATUS_SUCCEEDED, Valid: true}
+		updateArg.UpdateParams.PaidAt = pgtype.Timestamptz{Time: updatedAt, Valid: true}
+		updateArg.CapturedAmount = &capture.CapturedAmount
+	case payment.CAPTURE_STATUS_FAILED:
+		updateArg.UpdateParams.PaymentStatus = pgtype.Text{String: payment.STATUS_FAILED, Valid: true}
+		updateArg.UpdateParams.PaymentFailureCode = pgtype.Text{
+			String: capture.FailureCode,
+			Valid:  capture.FailureCode != "",
+		}
+	default:
+		// a pending capture is settled by the payment webhook.
+		return &pb.CapturePaymentResponse{
+			PaymentMethod: mapper.PaymentToDto(res),
+		}, nil
+	}
+
+	updateTx, err := u.repo.UpdateTx(ctx, &updateArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdateTx.err", err)
+	}
+
+	u.repo.DeleteCache(ctx, updateTx.Payment.PaymentCustomerID, updateTx.Payment.PaymentMethodID)
+
+	return &pb.CapturePaymentResponse{
+		PaymentMethod: mapper.PaymentToDto(updateTx.Payment),
+	}, nil
+}
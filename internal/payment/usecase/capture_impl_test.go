@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CAPTURE_PAYMENT(t *testing.T) {
+	testCases := []struct {
+		tname         string
+		body          func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod)
+		checkResponse func(t *testing.T, res *pb.CapturePaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				paymentCaptured := *paymentAuthorized
+				paymentCaptured.PaymentStatus = payment.STATUS_SUCCEEDED
+				paymentCaptured.PaymentCapturedAmount = paymentAuthorized.PaymentAmount
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+						require.Equal(t, paymentAuthorized.PaymentMethodID, arg.UpdateParams.PaymentMethodID)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.UpdateParams.PaymentStatus.String)
+						require.True(t, arg.UpdateParams.PaidAt.Valid)
+						require.NotNil(t, arg.CapturedAmount)
+						require.True(t, paymentAuthorized.PaymentAmount.Equal(*arg.CapturedAmount))
+						require.Equal(t, payment.PAYMENT_EVENT_CAPTURE, arg.PaymentEvent)
+						return repository.UpdateTxResult{Payment: &paymentCaptured}, nil
+					},
+				)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Eq(paymentAuthorized.PaymentCustomerID), gomock.Eq(paymentAuthorized.PaymentMethodID)).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_SUCCEEDED, res.GetPaymentMethod().GetPaymentStatus())
+				require.Equal(t, res.GetPaymentMethod().GetPaymentAmountMinor(), res.GetPaymentMethod().GetCapturedAmountMinor())
+			},
+		},
+		{
+			tname: "OK_PARTIAL_CAPTURE",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				amountMinor := payment.ToMinorUnits(paymentAuthorized.PaymentAmount, paymentAuthorized.Currency) - 1000
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId:  paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:    paymentAuthorized.PaymentMethodID,
+					CaptureAmountMinor: &amountMinor,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				paymentCaptured := *paymentAuthorized
+				paymentCaptured.PaymentStatus = payment.STATUS_SUCCEEDED
+				paymentCaptured.PaymentCapturedAmount = paymentAuthorized.PaymentAmount.Sub(decimal.NewFromInt(10))
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+						require.True(t, paymentCaptured.PaymentCapturedAmount.Equal(*arg.CapturedAmount))
+						return repository.UpdateTxResult{Payment: &paymentCaptured}, nil
+					},
+				)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Less(t, res.GetPaymentMethod().GetCapturedAmountMinor(), res.GetPaymentMethod().GetPaymentAmountMinor())
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_CAPTURABLE",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				paymentRequiresAction := *paymentAuthorized
+				paymentRequiresAction.PaymentStatus = payment.STATUS_REQUIRES_ACTION
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(&paymentRequiresAction, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentNotCapturable)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_CAPTURE_AMOUNT_EXCEEDS_AUTHORIZED",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				amount := paymentAuthorized.PaymentAmount.Add(decimal.NewFromInt(1))
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+					CaptureAmount:     &amount,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidCaptureAmount)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_CAPTURE_AMOUNT_PRECISION",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				amount := decimal.RequireFromString("1000.555")
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+					CaptureAmount:     &amount,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidCaptureAmount)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_ERROR",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_UPDATE_TX_INTERNAL_SERVER_ERROR",
+			body: func(paymentAuthorized *repository.PaymentMethod) *models.CapturePaymentRequest {
+				return &models.CapturePaymentRequest{
+					PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+					PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+				}
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CapturePaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			paymentAuthorized := createRandomProviderCardPayment(t, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, provider, paymentAuthorized)
+
+			res, err := u.Capture(context.TODO(), tc.body(paymentAuthorized))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+// createRandomProviderCardPayment creates a manually captured card payment on the fake provider
+// which has passed 3DS, and returns the row the repository would hold for it.
+func createRandomProviderCardPayment(t *testing.T, provider *gateway.FakeProvider) *repository.PaymentMethod {
+	_, res := createRandomVirtualAccountBankPayment(t)
+
+	providerPayment, err := provider.CreateCardPayment(context.TODO(), &gateway.CreatePaymentParams{
+		CustomerPaymentID: res.PaymentCustomerID,
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+		Currency:          payment.CURRENCY_IDR,
+		Expiry:            time.Now().Add(72 * time.Hour),
+		ChannelCode:       "CARDS",
+		CardToken:         "pm-" + helper.RandomString(26),
+		CaptureMethod:     payment.CAPTURE_METHOD_MANUAL,
+	})
+	require.NoError(t, err)
+	require.NoError(t, provider.SetPaymentStatus(providerPayment.ID, payment.STATUS_AWAITING_CAPTURE))
+
+	res.PaymentMethodID = providerPayment.ID
+	res.PaymentRequestID = pgtype.Text{String: providerPayment.RequestID, Valid: true}
+	res.PaymentReferenceID = providerPayment.ReferenceID
+	res.PaymentType = payment.METHODE_TYPE_CARD
+	res.PaymentChannel = providerPayment.Channel
+	res.PaymentAmount = providerPayment.Amount
+	res.Currency = payment.CURRENCY_IDR
+	res.PaymentCaptureMethod = payment.CAPTURE_METHOD_MANUAL
+	res.PaymentStatus = payment.STATUS_AWAITING_CAPTURE
+
+	return res
+}
+
+func Test_MOCK_CAPTURE_PAYMENT_SETTLED_BY_WEBHOOK(t *testing.T) {
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	workerCtrl := gomock.NewController(t)
+	defer workerCtrl.Finish()
+	wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+	provider := gateway.NewFakeProvider()
+	registry := gateway.NewRegistry()
+	registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+	paymentAuthorized := createRandomProviderCardPayment(t, provider)
+	captured := paymentAuthorized.PaymentAmount.Sub(decimal.NewFromInt(5000))
+	capturedMinor := payment.ToMinorUnits(captured, paymentAuthorized.Currency)
+
+	u := New(tlog, conf, store, registry, wkstore)
+
+	// the gateway takes the capture but only settles it later through the payment webhook.
+	provider.HoldNextCapture()
+
+	store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+	store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+
+	captureRes, err := u.Capture(context.TODO(), &models.CapturePaymentRequest{
+		PaymentCustomerId:  paymentAuthorized.PaymentCustomerID,
+		PaymentMethodId:    paymentAuthorized.PaymentMethodID,
+		CaptureAmountMinor: &capturedMinor,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payment.STATUS_AWAITING_CAPTURE, captureRes.GetPaymentMethod().GetPaymentStatus())
+
+	paymentCaptured := *paymentAuthorized
+	paymentCaptured.PaymentStatus = payment.STATUS_SUCCEEDED
+	paymentCaptured.PaymentCapturedAmount = captured
+
+	updatedAt := time.Now()
+	store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+			require.Equal(t, payment.STATUS_SUCCEEDED, arg.UpdateParams.PaymentStatus.String)
+			require.NotNil(t, arg.CapturedAmount)
+			require.True(t, captured.Equal(*arg.CapturedAmount))
+			return repository.UpdateTxResult{Payment: &paymentCaptured}, nil
+		},
+	)
+	store.EXPECT().PutCache(gomock.Any(), gomock.Eq(&paymentCaptured)).Times(1)
+
+	updateRes, err := u.Update(context.TODO(), &models.UpdatePaymentRequest{
+		PaymentEvent:       "payment.capture",
+		PaymentType:        payment.METHODE_TYPE_CARD,
+		PaymentCustomerId:  paymentAuthorized.PaymentCustomerID,
+		PaymentMethodId:    paymentAuthorized.PaymentMethodID,
+		PaymentStatus:      payment.STATUS_SUCCEEDED,
+		PaymentReusability: payment.USAGE_TYPE_ONE_TIME_USE,
+		UpdatedAt:          &updatedAt,
+		CapturedAmount:     &captured,
+		EventId:            helper.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.Empty(t, updateRes.Rejection)
+
+	// what is left to refund is the captured amount, not the authorized one.
+	pendingRefund := createRandomPendingRefund(t, &paymentCaptured)
+	pendingRefund.RefundAmount = captured
+
+	store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, arg *repository.CreateRefundTxParams) (repository.CreateRefundTxResult, error) {
+			require.Nil(t, arg.Amount)
+			require.Nil(t, arg.AmountMinor)
+			return repository.CreateRefundTxResult{Payment: &paymentCaptured, Refund: pendingRefund}, nil
+		},
+	)
+	store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(pendingRefund, nil)
+	wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+	refundRes, err := u.Refund(context.TODO(), &models.RefundPaymentRequest{
+		PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+		PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+		RefundReason:      payment.REFUND_REASON_CANCELLATION,
+	})
+	require.NoError(t, err)
+	require.Equal(t, capturedMinor, refundRes.GetRefund().GetRefundAmountMinor())
+}
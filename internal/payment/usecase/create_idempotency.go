@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// createPaymentFingerprint identifies the body of a create payment request, the idempotency key is left out
+// so an exact retry has the same fingerprint as the request it retries.
+func createPaymentFingerprint(arg *models.CreatePaymentRequest) (string, error) {
+	body := *arg
+	body.XIdempotencyKey = ""
+
+	payload, err := json.Marshal(&body)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotencyReservation is held by the request which reserved an idempotency key, the lock ticket releases
+// the redis lock and the fencing token issued by postgres completes the key.
+type idempotencyReservation struct {
+	lockTicket   string
+	fencingToken int64
+}
+
+// reserveIdempotencyKey reserves the idempotency key of a create payment request before any gateway call is
+// made. Redis keeps a second request with the same key out while the first one is in flight, postgres keeps
+// the reservation leased once redis forgot it. A request which gets the redis lock while the key is still in
+// progress only takes the reservation over once its lease ran out, postgres then issues it a greater fencing
+// token so the stale holder can no longer complete the key. A key which was already completed returns the
+// response to replay instead of a reservation.
+func (u *usecaseImpl) reserveIdempotencyKey(
+	ctx context.Context,
+	span opentracing.Span,
+	key string,
+	fingerprint string,
+) (*idempotencyReservation, *pb.CreatePaymentResponse, error) {
+	ticket, ok, err := u.repo.AcquireCreatePaymentLock(ctx, key)
+	if err != nil {
+		return nil, nil, u.errorResponse(span, "u.repo.AcquireCreatePaymentLock.err", err)
+	}
+
+	if !ok {
+		return nil, nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "u.repo.AcquireCreatePaymentLock", key),
+			unierror.ErrPaymentIdempotencyKeyInProgress,
+		)
+	}
+
+	now := time.Now()
+	reserved, err := u.repo.ReserveIdempotencyKey(ctx, &repository.ReserveIdempotencyKeyParams{
+		IdempotencyKey:     key,
+		RequestFingerprint: fingerprint,
+		IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_IN_PROGRESS,
+		CreatedAt:          pgtype.Timestamptz{Time: now, Valid: true},
+		ReservedUntil:      pgtype.Timestamptz{Time: now.Add(u.cfg.Services.Internal.IdempotencyKeyLease), Valid: true},
+	})
+	if err == nil {
+		return &idempotencyReservation{lockTicket: ticket, fencingToken: reserved.FencingToken}, nil, nil
+	}
+
+	u.repo.ReleaseCreatePaymentLock(ctx, key, ticket)
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, u.errorResponse(span, "u.repo.ReserveIdempotencyKey.err", err)
+	}
+
+	// the key is completed, still leased or was used with another body.
+	existing, err := u.repo.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		return nil, nil, u.errorResponse(span, "u.repo.GetIdempotencyKey.err", err)
+	}
+
+	if existing.RequestFingerprint != fingerprint {
+		return nil, nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "existing.RequestFingerprint", key),
+			unierror.ErrPaymentIdempotencyKeyReused,
+		)
+	}
+
+	if existing.IdempotencyStatus != payment.IDEMPOTENCY_STATUS_COMPLETED {
+		return nil, nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "existing.IdempotencyStatus", key),
+			unierror.ErrPaymentIdempotencyKeyInProgress,
+		)
+	}
+
+	var res pb.CreatePaymentResponse
+	if err := proto.Unmarshal(existing.ResponsePayload, &res); err != nil {
+		return nil, nil, u.errorResponse(span, "proto.Unmarshal.err", err)
+	}
+
+	return nil, &res, nil
+}
+
+// completeIdempotencyKey stores the response of a created payment for exact retries. The payment exists
+// whether or not the key could be completed, so a failure is logged and the response is still returned.
+func (u *usecaseImpl) completeIdempotencyKey(
+	ctx context.Context,
+	key string,
+	fingerprint string,
+	token int64,
+	res *pb.CreatePaymentResponse,
+) {
+	payload, err := proto.Marshal(res)
+	if err != nil {
+		u.log.Warnf("proto.Marshal.err: idempotency_key: %s, err: %v", key, err)
+		return
+	}
+
+	_, err = u.repo.CompleteIdempotencyKey(ctx, &repository.CompleteIdempotencyKeyParams{
+		IdempotencyStatus: payment.IDEMPOTENCY_STATUS_COMPLETED,
+		ResponsePayload:   payload,
+		CompletedAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		IdempotencyKey:    key,
+		FencingToken:      token,
+	})
+	if err != nil {
+		// pgx.ErrNoRows means a request with a greater fencing token took the reservation over.
+		u.log.Warnf("u.repo.CompleteIdempotencyKey.err: idempotency_key: %s, fencing_token: %d, err: %v", key, token, err)
+		return
+	}
+
+	u.repo.PutCreatePaymentIdempotencyKey(ctx, key, fingerprint, res)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
@@ -17,7 +18,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/opentracing/opentracing-go"
-	"github.com/xendit/xendit-go/v5/payment_method"
+	"github.com/shopspring/decimal"
 )
 
 func (u *usecaseImpl) Create(ctx context.Context, arg *models.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
@@ -27,7 +28,12 @@ func (u *usecaseImpl) Create(ctx context.Context, arg *models.CreatePaymentReque
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	if payload, err := u.repo.GetCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey); err == nil && payload != nil {
+	fingerprint, err := createPaymentFingerprint(arg)
+	if err != nil {
+		return nil, u.errorResponse(span, "createPaymentFingerprint.err", err)
+	}
+
+	if payload, err := u.repo.GetCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey, fingerprint); err == nil && payload != nil {
 		return payload, nil
 	}
 
@@ -36,42 +42,85 @@ func (u *usecaseImpl) Create(ctx context.Context, arg *models.CreatePaymentReque
 		return nil, tracing.TraceWithError(span, err)
 	}
 
-	customer, err := u.processGetCustomer(ctx, span, arg, phoneNumber)
+	reservation, replay, err := u.reserveIdempotencyKey(ctx, span, arg.XIdempotencyKey, fingerprint)
 	if err != nil {
 		return nil, tracing.TraceWithError(span, err)
 	}
 
-	switch arg.PaymentType {
-	case payment.METHODE_TYPE_EWALLET:
-		return u.processEwalletPayment(ctx, span, arg, customer)
-	case payment.METHODE_TYPE_QR_CODE:
-		return u.processQrCodePayment(ctx, span, arg, customer)
-	case payment.METHODE_TYPE_VIRTUAL_ACCOUNT:
-		return u.processVirtualAccountPayment(ctx, span, arg, customer)
-	default:
-		return nil, unierror.ErrUnsupportedPaymentType
+	if replay != nil {
+		u.repo.PutCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey, fingerprint, replay)
+		return replay, nil
+	}
+
+	defer u.repo.ReleaseCreatePaymentLock(ctx, arg.XIdempotencyKey, reservation.lockTicket)
+
+	customer, err := u.processGetCustomer(ctx, span, *arg.CustomerUid, arg.CustomerName, phoneNumber)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	res, err := u.processPayment(ctx, span, arg, customer)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
 	}
+
+	u.completeIdempotencyKey(ctx, arg.XIdempotencyKey, fingerprint, reservation.fencingToken, res)
+	return res, nil
 }
 
 func (u *usecaseImpl) validateParams(span opentracing.Span, arg *models.CreatePaymentRequest) (string, error) {
-	_, err := payment_method.NewPaymentMethodTypeFromValue(arg.PaymentType)
+	err := u.isSupportedPaymentType(arg.PaymentType)
 	if err != nil {
 		return "", u.errorResponse(
 			span,
-			fmt.Sprintf("%s: %v", "payment_method.NewPaymentMethodTypeFromValue.err", arg.PaymentType),
+			fmt.Sprintf("%s: %v", "u.isSupportedPaymentType.err", arg.PaymentType),
 			err,
 		)
 	}
 
-	err = u.isSupportedPaymentType(arg.PaymentType)
-	if err != nil {
+	// funds can only be held on a card authorization, every other type is paid in one step.
+	if !payment.IsCaptureMethod(arg.CaptureMethod) ||
+		(arg.CaptureMethod == payment.CAPTURE_METHOD_MANUAL && arg.PaymentType != payment.METHODE_TYPE_CARD) {
 		return "", u.errorResponse(
 			span,
-			fmt.Sprintf("%s: %v", "u.isSupportedPaymentType.err", arg.PaymentType),
+			fmt.Sprintf("%s: %v", "payment.IsCaptureMethod", arg.CaptureMethod),
+			unierror.ErrUnsupportedCaptureMethod,
+		)
+	}
+
+	if !payment.IsUsageType(arg.PaymentReusability) ||
+		(arg.PaymentReusability == payment.USAGE_TYPE_MULTIPLE_USE && !payment.IsReusableType(arg.PaymentType)) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsUsageType", arg.PaymentReusability),
+			unierror.ErrUnsupportedPaymentReusability,
+		)
+	}
+
+	if err := validateSplitParams(arg); err != nil {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "validateSplitParams", arg.PaymentReferenceId),
 			err,
 		)
 	}
 
+	if arg.PaymentType == payment.METHODE_TYPE_CARD && (arg.CardToken == nil || *arg.CardToken == "") {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.CardToken", arg.PaymentType),
+			unierror.ErrCardTokenRequired,
+		)
+	}
+
+	if arg.PaymentType == payment.METHODE_TYPE_DIRECT_DEBIT && (arg.LinkedPaymentMethodId == nil || *arg.LinkedPaymentMethodId == "") {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.LinkedPaymentMethodId", arg.PaymentType),
+			unierror.ErrLinkedPaymentMethodRequired,
+		)
+	}
+
 	res, err := helper.ValidatePhoneNumber(arg.CustomerPhoneNumber)
 	if err != nil {
 		return "", u.errorResponse(
@@ -89,7 +138,16 @@ func (u *usecaseImpl) validateParams(span opentracing.Span, arg *models.CreatePa
 		)
 	}
 
-	if arg.PaymentAmount < 100 {
+	if !payment.IsSupportedCurrency(arg.Currency) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsSupportedCurrency", arg.Currency),
+			unierror.ErrUnsupportedCurrency,
+		)
+	}
+
+	// the channel rows hold the min and max amount of every currency, IDR keeps its historical floor.
+	if !arg.PaymentAmount.IsPositive() || (arg.Currency == payment.CURRENCY_IDR && arg.PaymentAmount.LessThan(decimal.NewFromInt(100))) {
 		return "", u.errorResponse(
 			span,
 			fmt.Sprintf("%s: %v", "arg.GetPaymentAmount", arg.PaymentAmount),
@@ -97,6 +155,14 @@ func (u *usecaseImpl) validateParams(span opentracing.Span, arg *models.CreatePa
 		)
 	}
 
+	if !payment.IsExactInCurrency(arg.PaymentAmount, arg.Currency) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsExactInCurrency", arg.PaymentAmount),
+			unierror.ErrInvalidAmountPrecision,
+		)
+	}
+
 	successURLOK := helper.IsValidURL(arg.PaymentSuccessReturnUrl)
 	if !successURLOK {
 		return "", u.errorResponse(
@@ -128,7 +194,12 @@ func (u *usecaseImpl) validateParams(span opentracing.Span, arg *models.CreatePa
 
 func (u *usecaseImpl) isSupportedPaymentType(typ string) error {
 	switch typ {
-	case "EWALLET", "QR_CODE", "VIRTUAL_ACCOUNT":
+	case payment.METHODE_TYPE_EWALLET,
+		payment.METHODE_TYPE_QR_CODE,
+		payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+		payment.METHODE_TYPE_OVER_THE_COUNTER,
+		payment.METHODE_TYPE_CARD,
+		payment.METHODE_TYPE_DIRECT_DEBIT:
 		return nil
 	default:
 		return unierror.ErrUnsupportedPaymentType
@@ -138,27 +209,39 @@ func (u *usecaseImpl) isSupportedPaymentType(typ string) error {
 func (u *usecaseImpl) processGetCustomer(
 	ctx context.Context,
 	span opentracing.Span,
-	arg *models.CreatePaymentRequest,
+	customerUid string,
+	customerName string,
 	phoneNumber string,
 ) (*repository.Customer, error) {
-	if payload, err := u.repo.GetCustomerCache(ctx, *arg.CustomerUid); err == nil && payload != nil {
+	if payload, err := u.repo.GetCustomerCache(ctx, customerUid); err == nil && payload != nil {
 		return payload, nil
 	}
 
-	customer, err := u.repo.GetCustomerByCustomerAppID(ctx, *arg.CustomerUid)
+	customer, err := u.repo.GetCustomerByCustomerAppID(ctx, customerUid)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			customerUlid, err := helper.GenerateULID()
+			provider, err := u.provider()
 			if err != nil {
-				return nil, u.errorResponse(span, "helper.GenerateULID.err", err)
+				return nil, u.errorResponse(span, "u.provider.err", err)
 			}
 
+			paymentCustomer, err := provider.CreateCustomerPayment(ctx, &gateway.CreateCustomerPaymentParams{
+				CustomerName:   customerName,
+				CustomerNumber: phoneNumber,
+				CustomerUID:    customerUid,
+				ReferenceID:    customerUid,
+			})
+			if err != nil {
+				return nil, u.errorResponse(span, "provider.CreateCustomerPayment.err", err)
+			}
+
+			// the customer is kept under the uid of the caller, the auth interceptor matches it against their token.
 			customerArg := repository.CreateCustomerTxParams{
 				CreateCustomer: repository.CreateCustomerParams{
-					Uid:               customerUlid.String(),
-					CustomerAppID:     customerUlid.String(),
+					Uid:               customerUid,
+					CustomerAppID:     customerUid,
 					PaymentCustomerID: "",
-					CustomerName:      arg.CustomerName,
+					CustomerName:      customerName,
 					PhoneNumber: pgtype.Text{
 						String: phoneNumber,
 						Valid:  true,
@@ -168,6 +251,7 @@ func (u *usecaseImpl) processGetCustomer(
 						Valid: true,
 					},
 				},
+				PaymentCustomer: paymentCustomer,
 			}
 
 			customerTx, err := u.repo.CreateCustomerTx(ctx, &customerArg)
@@ -185,86 +269,175 @@ func (u *usecaseImpl) processGetCustomer(
 	return customer, nil
 }
 
-func (u *usecaseImpl) processEwalletPayment(
+func (u *usecaseImpl) processPayment(
 	ctx context.Context,
 	span opentracing.Span,
 	arg *models.CreatePaymentRequest,
 	cust *repository.Customer,
 ) (*pb.CreatePaymentResponse, error) {
-	channelCode, err := payment_method.NewEWalletChannelCodeFromValue(arg.PaymentChannel)
+	uniqueActiveReference := u.cfg.Services.Internal.UniqueActiveReference
+	if uniqueActiveReference {
+		// fails fast before a gateway payment is made, the create transaction re-checks it under a lock.
+		count, err := u.repo.CountPaymentMethodsByReferenceIDAndStatuses(ctx, &repository.CountPaymentMethodsByReferenceIDAndStatusesParams{
+			PaymentReferenceID: arg.PaymentReferenceId,
+			PaymentStatuses:    payment.OutstandingStatuses(),
+		})
+		if err != nil {
+			return nil, u.errorResponse(span, "u.repo.CountPaymentMethodsByReferenceIDAndStatuses.err", err)
+		}
+
+		if count > 0 {
+			return nil, u.errorResponse(
+				span,
+				fmt.Sprintf("%s: %v", "u.repo.CountPaymentMethodsByReferenceIDAndStatuses", arg.PaymentReferenceId),
+				unierror.ErrDuplicateActivePayment,
+			)
+		}
+	}
+
+	channel, err := u.repo.GetAvailablePaymentChannel(ctx, &repository.GetAvailablePaymentChannelParams{
+		PcType:    arg.PaymentType,
+		Pcname:    arg.PaymentChannel,
+		MinAmount: arg.PaymentAmount,
+		Currency:  arg.Currency,
+	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = u.unavailableChannelErr(ctx, arg)
+		}
+
 		return nil, u.errorResponse(
 			span,
-			fmt.Sprintf("%s: %v", "payment_method.NewEWalletChannelCodeFromValue.err", arg.PaymentChannel),
+			fmt.Sprintf("%s: %v", "u.repo.GetAvailablePaymentChannel.err", arg.PaymentChannel),
 			err,
 		)
 	}
 
-	createArg := repository.CreateEwalletPaymentParams{
-		CustomerName:      cust.CustomerName,
-		CustomerPaymentID: cust.PaymentCustomerID,
-		CustomerNumber:    cust.PhoneNumber.String,
-		ReferenceID:       arg.PaymentReferenceId,
-		Description:       arg.PaymentDescription,
-		Amount:            arg.PaymentAmount,
-		ChannelCode:       *channelCode,
-		Expiry:            time.Now().Add(time.Duration(arg.ExpiryHour+6) * time.Hour),
-		SuccessReturnURL:  arg.PaymentSuccessReturnUrl,
-		FailureReturnURL:  arg.PaymentFailureReturnUrl,
+	if channel.Country != arg.Country {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "channel.Country", arg.Country),
+			unierror.ErrUnsupportedCountry,
+		)
 	}
 
-	res, err := u.repo.CreateEwalletPaymentTx(ctx, &repository.CreateEwalletPaymentTxParams{CreateEwalletPayment: createArg})
+	price := u.price(channel, arg.PaymentAmount)
+
+	splits, err := u.split(arg, price)
 	if err != nil {
 		return nil, u.errorResponse(
 			span,
-			"u.repo.CreateEwalletPaymentTx.err",
+			fmt.Sprintf("%s: %v", "u.split.err", arg.PaymentReferenceId),
 			err,
 		)
 	}
 
-	respDto := &pb.CreatePaymentResponse{
-		Customer:      mapper.CustomerToDto(cust),
-		PaymentMethod: mapper.PaymentToDto(res.Payment),
+	var linkedPaymentMethodID string
+	if arg.PaymentType == payment.METHODE_TYPE_DIRECT_DEBIT {
+		linked, err := u.linkedDirectDebit(ctx, arg, cust)
+		if err != nil {
+			return nil, u.errorResponse(
+				span,
+				fmt.Sprintf("%s: %v", "u.linkedDirectDebit.err", *arg.LinkedPaymentMethodId),
+				err,
+			)
+		}
+
+		linkedPaymentMethodID = linked.PaymentMethodID
 	}
 
-	u.repo.PutCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey, respDto)
-	u.repo.PutCache(ctx, res.Payment)
-	return respDto, nil
-}
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
 
-func (u *usecaseImpl) processQrCodePayment(
-	ctx context.Context,
-	span opentracing.Span,
-	arg *models.CreatePaymentRequest,
-	cust *repository.Customer,
-) (*pb.CreatePaymentResponse, error) {
-	channelCode, err := payment_method.NewQRCodeChannelCodeFromValue(arg.PaymentChannel)
+	createArg := gateway.CreatePaymentParams{
+		CustomerName:          cust.CustomerName,
+		CustomerPaymentID:     cust.PaymentCustomerID,
+		CustomerNumber:        cust.PhoneNumber.String,
+		ReferenceID:           arg.PaymentReferenceId,
+		Description:           arg.PaymentDescription,
+		Amount:                price.TotalAmount,
+		Currency:              channel.Currency,
+		Country:               channel.Country,
+		ChannelCode:           arg.PaymentChannel,
+		Expiry:                time.Now().Add(time.Duration(arg.ExpiryHour) * time.Hour),
+		SuccessReturnURL:      arg.PaymentSuccessReturnUrl,
+		FailureReturnURL:      arg.PaymentFailureReturnUrl,
+		CaptureMethod:         arg.CaptureMethod,
+		LinkedPaymentMethodID: linkedPaymentMethodID,
+		Reusability:           arg.PaymentReusability,
+		IdempotencyKey:        arg.XIdempotencyKey,
+	}
+
+	if arg.CardToken != nil {
+		createArg.CardToken = *arg.CardToken
+	}
+
+	if arg.ForUserId != nil {
+		createArg.ForUserID = *arg.ForUserId
+	}
+
+	// the remainder is left with the sub-account, only the other legs are routed through a split rule.
+	if routes := splitRoutes(splits); len(routes) > 0 {
+		splitRule, err := provider.CreateSplitRule(ctx, &gateway.CreateSplitRuleParams{
+			Name:        arg.PaymentReferenceId,
+			Description: arg.PaymentDescription,
+			Routes:      routes,
+		})
+		if err != nil {
+			return nil, u.errorResponse(span, "provider.CreateSplitRule.err", err)
+		}
+
+		createArg.SplitRuleID = splitRule.ID
+	}
+
+	var gatewayPayment *gateway.Payment
+
+	switch arg.PaymentType {
+	case payment.METHODE_TYPE_EWALLET:
+		createArg.Expiry = time.Now().Add(time.Duration(arg.ExpiryHour+6) * time.Hour)
+		gatewayPayment, err = provider.CreateEwalletPayment(ctx, &createArg)
+	case payment.METHODE_TYPE_QR_CODE:
+		gatewayPayment, err = provider.CreateQrCodePayment(ctx, &createArg)
+	case payment.METHODE_TYPE_VIRTUAL_ACCOUNT:
+		gatewayPayment, err = provider.CreateVirtualAccountBankPayment(ctx, &createArg)
+	case payment.METHODE_TYPE_OVER_THE_COUNTER:
+		gatewayPayment, err = provider.CreateOverTheCounterPayment(ctx, &createArg)
+	case payment.METHODE_TYPE_CARD:
+		gatewayPayment, err = provider.CreateCardPayment(ctx, &createArg)
+	case payment.METHODE_TYPE_DIRECT_DEBIT:
+		gatewayPayment, err = provider.CreateDirectDebitPayment(ctx, &createArg)
+	default:
+		return nil, unierror.ErrUnsupportedPaymentType
+	}
 	if err != nil {
 		return nil, u.errorResponse(
 			span,
-			fmt.Sprintf("%s: %v", "payment_method.NewQRCodeChannelCodeFromValue.err", arg.PaymentChannel),
+			fmt.Sprintf("%s: %v", "provider.CreatePayment.err", arg.PaymentType),
 			err,
 		)
 	}
 
-	createArg := repository.CreateQrCodePaymentParams{
-		CustomerName:      cust.CustomerName,
-		CustomerPaymentID: cust.PaymentCustomerID,
-		CustomerNumber:    cust.PhoneNumber.String,
-		ReferenceID:       arg.PaymentReferenceId,
-		Description:       arg.PaymentDescription,
-		Amount:            arg.PaymentAmount,
-		ChannelCode:       *channelCode,
-		Expiry:            time.Now().Add(time.Duration(arg.ExpiryHour) * time.Hour),
-		SuccessReturnURL:  arg.PaymentSuccessReturnUrl,
-		FailureReturnURL:  arg.PaymentFailureReturnUrl,
-	}
-
-	res, err := u.repo.CreateQrCodePaymentTx(ctx, &repository.CreateQrCodePaymentTxParams{CreateQrCodePayment: createArg})
+	res, err := u.repo.CreatePaymentTx(ctx, &repository.CreatePaymentTxParams{
+		Payment:               gatewayPayment,
+		UniqueActiveReference: uniqueActiveReference,
+		ForUserID:             createArg.ForUserID,
+		SplitRuleID:           createArg.SplitRuleID,
+		Splits:                splits,
+		FeeAmount:             price.FeeAmount,
+	})
 	if err != nil {
+		if errors.Is(err, unierror.ErrDuplicateActivePayment) {
+			// a concurrent create won the race, the payment made at the gateway is never stored so it is expired.
+			if _, expireErr := provider.ExpirePayment(gateway.ForUser(ctx, createArg.ForUserID), gatewayPayment.ID); expireErr != nil {
+				u.log.Warnf("provider.ExpirePayment.err: payment_method_id: %s, err: %v", gatewayPayment.ID, expireErr)
+			}
+		}
+
 		return nil, u.errorResponse(
 			span,
-			"u.repo.CreateQrCodePaymentTx.err",
+			"u.repo.CreatePaymentTx.err",
 			err,
 		)
 	}
@@ -272,56 +445,57 @@ func (u *usecaseImpl) processQrCodePayment(
 	respDto := &pb.CreatePaymentResponse{
 		Customer:      mapper.CustomerToDto(cust),
 		PaymentMethod: mapper.PaymentToDto(res.Payment),
+		Price:         mapper.PaymentPriceToDto(price),
+	}
+
+	if len(res.Splits) > 0 {
+		respDto.Splits = mapper.PaymentSplitsToDto(res.Splits)
 	}
 
-	u.repo.PutCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey, respDto)
 	u.repo.PutCache(ctx, res.Payment)
 	return respDto, nil
 }
 
-func (u *usecaseImpl) processVirtualAccountPayment(
+// unavailableChannelErr tells a channel which does not exist in the currency apart from one
+// which is inactive or out of its amount range.
+func (u *usecaseImpl) unavailableChannelErr(ctx context.Context, arg *models.CreatePaymentRequest) error {
+	_, err := u.repo.GetPaymentChannelByNameAndCurrency(ctx, &repository.GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   arg.PaymentChannel,
+		Currency: arg.Currency,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return unierror.ErrChannelCurrencyNotSupported
+	}
+
+	return unierror.ErrUnsupportedPaymentChannel
+}
+
+// linkedDirectDebit returns the direct debit link a DIRECT_DEBIT payment charges, it has to belong to the
+// customer and be active in the channel and currency of the payment.
+func (u *usecaseImpl) linkedDirectDebit(
 	ctx context.Context,
-	span opentracing.Span,
 	arg *models.CreatePaymentRequest,
 	cust *repository.Customer,
-) (*pb.CreatePaymentResponse, error) {
-	channelCode, err := payment_method.NewVirtualAccountChannelCodeFromValue(arg.PaymentChannel)
+) (*repository.PaymentMethod, error) {
+	res, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   *arg.LinkedPaymentMethodId,
+		PaymentCustomerID: cust.PaymentCustomerID,
+	})
 	if err != nil {
-		return nil, u.errorResponse(
-			span,
-			fmt.Sprintf("%s: %v", "payment_method.NewVirtualAccountChannelCodeFromValue.err", arg.PaymentChannel),
-			err,
-		)
-	}
-
-	createArg := repository.CreateVirtualAccountBankPaymentParams{
-		CustomerName:      cust.CustomerName,
-		CustomerPaymentID: cust.PaymentCustomerID,
-		CustomerNumber:    cust.PhoneNumber.String,
-		ReferenceID:       arg.PaymentReferenceId,
-		Description:       arg.PaymentDescription,
-		Amount:            arg.PaymentAmount,
-		ChannelCode:       *channelCode,
-		Expiry:            time.Now().Add(time.Duration(arg.ExpiryHour) * time.Hour),
-		SuccessReturnURL:  arg.PaymentSuccessReturnUrl,
-		FailureReturnURL:  arg.PaymentFailureReturnUrl,
-	}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, unierror.ErrDirectDebitNotLinked
+		}
 
-	res, err := u.repo.CreateVirtualAccountBankPaymentTx(ctx, &repository.CreateVirtualAccountBankPaymentTxParams{CreateVirtualAccountBankPayment: createArg})
-	if err != nil {
-		return nil, u.errorResponse(
-			span,
-			"u.repo.CreateVirtualAccountBankPaymentTx.err",
-			err,
-		)
+		return nil, err
 	}
 
-	respDto := &pb.CreatePaymentResponse{
-		Customer:      mapper.CustomerToDto(cust),
-		PaymentMethod: mapper.PaymentToDto(res.Payment),
+	if res.PaymentType != payment.METHODE_TYPE_DIRECT_DEBIT ||
+		res.PaymentReusability != payment.USAGE_TYPE_MULTIPLE_USE ||
+		res.PaymentStatus != payment.STATUS_ACTIVE ||
+		res.PaymentChannel != arg.PaymentChannel ||
+		res.Currency != arg.Currency {
+		return nil, unierror.ErrDirectDebitNotLinked
 	}
 
-	u.repo.PutCreatePaymentIdempotencyKey(ctx, arg.XIdempotencyKey, respDto)
-	u.repo.PutCache(ctx, res.Payment)
-	return respDto, nil
+	return res, nil
 }
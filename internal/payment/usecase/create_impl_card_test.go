@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_CARD_PAYMENT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomEwalletPayment(t)
+
+	paymentParamsOK.ChannelCode = "CARDS"
+	paymentParamsOK.Amount = decimal.NewFromInt(helper.RandomInt(10000, 200000))
+	paymentRespOK.PaymentType = payment.METHODE_TYPE_CARD
+	paymentRespOK.PaymentChannel = paymentParamsOK.ChannelCode
+	paymentRespOK.PaymentAmount = paymentParamsOK.Amount
+
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_CARD, paymentParamsOK)
+
+	cardToken := "pm-" + helper.RandomString(26)
+	emptyCardToken := ""
+
+	testCases := []struct {
+		tname         string
+		paymentType   string
+		channel       string
+		cardToken     *string
+		captureMethod string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname:         "OK_MANUAL_CAPTURE",
+			paymentType:   payment.METHODE_TYPE_CARD,
+			channel:       paymentParamsOK.ChannelCode,
+			cardToken:     &cardToken,
+			captureMethod: payment.CAPTURE_METHOD_MANUAL,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, cardToken, arg.Payment.ID)
+						require.Equal(t, payment.METHODE_TYPE_CARD, arg.Payment.Type)
+						require.Equal(t, payment.STATUS_REQUIRES_ACTION, arg.Payment.Status)
+						require.Equal(t, payment.CAPTURE_METHOD_MANUAL, arg.Payment.CaptureMethod)
+						require.NotEmpty(t, arg.Payment.URL)
+
+						res := *paymentRespOK
+						res.PaymentMethodID = arg.Payment.ID
+						res.PaymentStatus = arg.Payment.Status
+						res.PaymentCaptureMethod = arg.Payment.CaptureMethod
+						res.PaymentUrl = pgtype.Text{String: arg.Payment.URL, Valid: true}
+						return repository.CreatePaymentTxResult{Payment: &res}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.GetPaymentMethod().GetPaymentStatus())
+				require.Equal(t, payment.CAPTURE_METHOD_MANUAL, res.GetPaymentMethod().GetCaptureMethod())
+				require.NotEmpty(t, res.GetPaymentMethod().GetPaymentUrl())
+			},
+		},
+		{
+			tname:         "ERR_CARD_TOKEN_REQUIRED",
+			paymentType:   payment.METHODE_TYPE_CARD,
+			channel:       paymentParamsOK.ChannelCode,
+			cardToken:     &emptyCardToken,
+			captureMethod: payment.CAPTURE_METHOD_AUTOMATIC,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrCardTokenRequired)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:         "ERR_MANUAL_CAPTURE_UNSUPPORTED_PAYMENT_TYPE",
+			paymentType:   payment.METHODE_TYPE_EWALLET,
+			channel:       "OVO",
+			captureMethod: payment.CAPTURE_METHOD_MANUAL,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedCaptureMethod)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:         "ERR_UNKNOWN_CAPTURE_METHOD",
+			paymentType:   payment.METHODE_TYPE_CARD,
+			channel:       paymentParamsOK.ChannelCode,
+			cardToken:     &cardToken,
+			captureMethod: "LATER",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedCaptureMethod)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.CURRENCY_IDR,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           tc.captureMethod,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				CardToken:               tc.cardToken,
+				PaymentType:             tc.paymentType,
+				PaymentChannel:          tc.channel,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_PAYMENT_CURRENCY(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomEwalletPayment(t)
+
+	paymentParamsOK.ChannelCode = "GCASH"
+	paymentParamsOK.Amount = decimal.RequireFromString("250.5")
+	paymentParamsOK.Currency = payment.CURRENCY_PHP
+	paymentParamsOK.Country = payment.COUNTRY_PH
+
+	paymentRespOK.PaymentChannel = paymentParamsOK.ChannelCode
+	paymentRespOK.PaymentAmount = paymentParamsOK.Amount
+	paymentRespOK.Currency = paymentParamsOK.Currency
+
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+	priceOK := New(tlog, conf, nil, gateway.NewRegistry(), nil).(*usecaseImpl).price(channelOK, paymentParamsOK.Amount)
+
+	testCases := []struct {
+		tname         string
+		currency      string
+		country       string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname:    "OK_PHP",
+			currency: payment.CURRENCY_PHP,
+			country:  payment.COUNTRY_PH,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, payment.CURRENCY_PHP, arg.Payment.Currency)
+						require.True(t, priceOK.TotalAmount.Equal(arg.Payment.Amount))
+						return repository.CreatePaymentTxResult{Payment: paymentRespOK}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.CURRENCY_PHP, res.GetPaymentMethod().GetCurrency())
+				require.Equal(t, int64(25050), res.GetPaymentMethod().GetPaymentAmountMinor())
+				require.Equal(t, payment.CURRENCY_PHP, res.GetPrice().GetCurrency())
+				require.Equal(t, payment.ToMinorUnits(priceOK.TotalAmount, payment.CURRENCY_PHP), res.GetPrice().GetTotalAmountMinor())
+			},
+		},
+		{
+			tname:    "ERR_UNSUPPORTED_COUNTRY",
+			currency: payment.CURRENCY_PHP,
+			country:  payment.COUNTRY_TH,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedCountry)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_CHANNEL_CURRENCY_NOT_SUPPORTED",
+			currency: payment.CURRENCY_PHP,
+			country:  payment.COUNTRY_PH,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Eq(&repository.GetPaymentChannelByNameAndCurrencyParams{
+					Pcname:   paymentParamsOK.ChannelCode,
+					Currency: payment.CURRENCY_PHP,
+				})).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_CHANNEL_OUT_OF_CURRENCY_RANGE",
+			currency: payment.CURRENCY_PHP,
+			country:  payment.COUNTRY_PH,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentChannel)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_UNSUPPORTED_CURRENCY",
+			currency: payment.CURRENCY_SGD,
+			country:  payment.COUNTRY_PH,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedCurrency)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                tc.currency,
+				Country:                 tc.country,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentRespOK.PaymentType,
+				PaymentChannel:          paymentParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
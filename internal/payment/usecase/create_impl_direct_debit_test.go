@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_DIRECT_DEBIT_PAYMENT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomEwalletPayment(t)
+
+	paymentParamsOK.ChannelCode = "BCA_ONEKLIK"
+	paymentParamsOK.Amount = decimal.NewFromInt(helper.RandomInt(10000, 200000))
+	paymentRespOK.PaymentType = payment.METHODE_TYPE_DIRECT_DEBIT
+	paymentRespOK.PaymentChannel = paymentParamsOK.ChannelCode
+	paymentRespOK.PaymentAmount = paymentParamsOK.Amount
+
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_DIRECT_DEBIT, paymentParamsOK)
+
+	emptyLinkedPaymentMethodID := ""
+
+	testCases := []struct {
+		tname         string
+		linked        func(link *repository.PaymentMethod) *string
+		stubs         func(store *mock.MockRepository, link *repository.PaymentMethod)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, link *repository.PaymentMethod, err error)
+	}{
+		{
+			tname: "OK",
+			linked: func(link *repository.PaymentMethod) *string {
+				return &link.PaymentMethodID
+			},
+			stubs: func(store *mock.MockRepository, link *repository.PaymentMethod) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&repository.GetPaymentMethodCustomerParams{
+					PaymentMethodID:   link.PaymentMethodID,
+					PaymentCustomerID: custRespOK.PaymentCustomerID,
+				})).Times(1).Return(link, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.NotEqual(t, link.PaymentMethodID, arg.Payment.ID)
+						require.Equal(t, link.PaymentMethodID, arg.Payment.LinkedPaymentMethodID)
+						require.Equal(t, payment.METHODE_TYPE_DIRECT_DEBIT, arg.Payment.Type)
+						require.Equal(t, payment.STATUS_PENDING, arg.Payment.Status)
+						require.Empty(t, arg.Payment.URL)
+
+						res := *paymentRespOK
+						res.PaymentMethodID = arg.Payment.ID
+						res.PaymentStatus = arg.Payment.Status
+						res.PaymentUrl = pgtype.Text{}
+						res.PaymentLinkedMethodID = pgtype.Text{String: arg.Payment.LinkedPaymentMethodID, Valid: true}
+						return repository.CreatePaymentTxResult{Payment: &res}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, link *repository.PaymentMethod, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_PENDING, res.GetPaymentMethod().GetPaymentStatus())
+				require.Equal(t, link.PaymentMethodID, res.GetPaymentMethod().GetLinkedPaymentMethodId())
+				require.Empty(t, res.GetPaymentMethod().GetPaymentUrl())
+			},
+		},
+		{
+			tname: "ERR_LINKED_PAYMENT_METHOD_REQUIRED",
+			linked: func(link *repository.PaymentMethod) *string {
+				return &emptyLinkedPaymentMethodID
+			},
+			stubs: func(store *mock.MockRepository, link *repository.PaymentMethod) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, link *repository.PaymentMethod, err error) {
+				require.ErrorIs(t, err, unierror.ErrLinkedPaymentMethodRequired)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_DIRECT_DEBIT_NOT_LINKED",
+			linked: func(link *repository.PaymentMethod) *string {
+				return &link.PaymentMethodID
+			},
+			stubs: func(store *mock.MockRepository, link *repository.PaymentMethod) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, link *repository.PaymentMethod, err error) {
+				require.ErrorIs(t, err, unierror.ErrDirectDebitNotLinked)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_DIRECT_DEBIT_LINK_NOT_ACTIVE",
+			linked: func(link *repository.PaymentMethod) *string {
+				return &link.PaymentMethodID
+			},
+			stubs: func(store *mock.MockRepository, link *repository.PaymentMethod) {
+				pendingLink := *link
+				pendingLink.PaymentStatus = payment.STATUS_REQUIRES_ACTION
+
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(&pendingLink, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, link *repository.PaymentMethod, err error) {
+				require.ErrorIs(t, err, unierror.ErrDirectDebitNotLinked)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			link := createRandomProviderDirectDebitLink(t, provider, custRespOK.PaymentCustomerID)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store, link)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.CURRENCY_IDR,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				LinkedPaymentMethodId:   tc.linked(link),
+				PaymentType:             payment.METHODE_TYPE_DIRECT_DEBIT,
+				PaymentChannel:          paymentParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, link, err)
+		})
+	}
+}
+
+// createRandomProviderDirectDebitLink links and validates a BCA_ONEKLIK account on the fake provider
+// and returns the row the repository would hold for the link.
+func createRandomProviderDirectDebitLink(t *testing.T, provider *gateway.FakeProvider, customerPaymentID string) *repository.PaymentMethod {
+	res := createRandomProviderPendingDirectDebitLink(t, provider, customerPaymentID)
+
+	link, err := provider.ValidateDirectDebitLink(context.TODO(), &gateway.ValidateDirectDebitLinkParams{
+		PaymentMethodID: res.PaymentMethodID,
+		OTPCode:         gateway.FakeProviderDirectDebitOTP,
+	})
+	require.NoError(t, err)
+
+	res.PaymentStatus = link.Status
+
+	return res
+}
+
+// createRandomProviderPendingDirectDebitLink links a BCA_ONEKLIK account on the fake provider which
+// still waits for its OTP and returns the row the repository would hold for the link.
+func createRandomProviderPendingDirectDebitLink(t *testing.T, provider *gateway.FakeProvider, customerPaymentID string) *repository.PaymentMethod {
+	_, res := createRandomEwalletPayment(t)
+
+	link, err := provider.LinkDirectDebit(context.TODO(), &gateway.LinkDirectDebitParams{
+		CustomerPaymentID: customerPaymentID,
+		ReferenceID:       helper.RandomString(26),
+		Country:           payment.COUNTRY_ID,
+		ChannelCode:       "BCA_ONEKLIK",
+		MobileNumber:      "+628" + helper.RandomStringInt(9),
+	})
+	require.NoError(t, err)
+
+	res.PaymentMethodID = link.ID
+	res.PaymentCustomerID = customerPaymentID
+	res.PaymentType = link.Type
+	res.PaymentStatus = link.Status
+	res.PaymentReusability = link.Reusability
+	res.PaymentChannel = link.Channel
+	res.PaymentAmount = decimal.Zero
+	res.Currency = payment.CURRENCY_IDR
+
+	return res
+}
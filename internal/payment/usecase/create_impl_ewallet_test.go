@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
@@ -25,7 +26,6 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
-	"github.com/xendit/xendit-go/v5/payment_method"
 	"go.uber.org/mock/gomock"
 )
 
@@ -33,19 +33,19 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 	custParamsOK, custRespOK := createRandomCustomer(t)
 
 	paymentEwalletParamsOK, paymentEwalletRespOK := createRandomEwalletPayment(t)
-	paymentQrCodeParamsOK, paymentQrCodeRespOK := createRandomQrCodePayment(t)
-	paymentVirtualAccountBankParamsOK, paymentVirtualAccountBankRespOK := createRandomVirtualAccountBankPayment(t)
 
 	idempotentKey, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, idempotentKey)
 
-	mockRes := createMockRes(t, custRespOK, paymentEwalletRespOK)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentEwalletRespOK.PaymentType, paymentEwalletParamsOK)
+
+	mockRes := createMockRes(t, custRespOK, paymentEwalletRespOK, channelOK)
 
 	testCases := []struct {
 		tname         string
 		body          *models.CreatePaymentRequest
-		stubs         func(store *mock.MockRepository)
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
 		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
 	}{
 		{
@@ -56,16 +56,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(mockRes, nil)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(mockRes, nil)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -74,16 +78,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -117,16 +115,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -135,16 +137,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{Payment: paymentEwalletRespOK}, nil)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentEwalletRespOK}, nil)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -178,16 +176,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -196,16 +198,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{Payment: paymentEwalletRespOK}, nil)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentEwalletRespOK}, nil)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -239,16 +237,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, redis.ErrClosed)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -257,16 +259,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{Payment: paymentEwalletRespOK}, nil)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentEwalletRespOK}, nil)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -300,16 +298,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -318,16 +320,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{Payment: paymentEwalletRespOK}, nil)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentEwalletRespOK}, nil)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -361,16 +359,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             "ASDKOGNSAIOJFGHSAOFHGSAKDFHGOSADFHG",
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -379,16 +381,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -403,16 +399,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             "CRYPTOCURRENCY",
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -421,16 +421,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -447,16 +441,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     "Q3RGNQ29U3GRNVGW",
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -465,16 +463,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -490,16 +482,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -508,16 +504,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -533,16 +523,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -551,16 +545,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -576,16 +564,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
 				PaymentSuccessReturnUrl: "gsdfljglskdfjsgldkfjgsldkfj",
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -594,16 +586,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -619,16 +605,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
 				PaymentFailureReturnUrl: "asdfgijasdfigjaisdfgjaofdigsadifhg",
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -637,16 +627,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -662,16 +646,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 1,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -680,16 +668,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -705,16 +687,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, sql.ErrConnDone)
@@ -723,16 +709,52 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.Error(t, err)
+				require.Empty(t, res)
+			},
+		},
+		{
+			tname: "ERR_EWALLET_CREATE_CUSTOMER_NOT_EXISTS_PROVIDER_ERROR",
+			body: &models.CreatePaymentRequest{
+				XIdempotencyKey:         idempotentKey.String(),
+				PaymentReferenceId:      paymentEwalletRespOK.Uid,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentEwalletRespOK.PaymentType,
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
+			},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
+				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
+
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
+
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -747,16 +769,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -765,16 +791,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, sql.ErrConnDone)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -789,16 +809,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -807,16 +831,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxClosed)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -831,16 +849,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -849,16 +871,10 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxCommitRollback)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -873,16 +889,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          "paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String()",
+				PaymentChannel:          "paymentEwalletParamsOK.ChannelCode",
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -891,16 +911,55 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.Error(t, err)
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
+				require.Empty(t, res)
+			},
+		},
+		{
+			tname: "ERR_EWALLET_CREATE_PAYMENT_PROVIDER_ERROR",
+			body: &models.CreatePaymentRequest{
+				XIdempotencyKey:         idempotentKey.String(),
+				PaymentReferenceId:      paymentEwalletRespOK.Uid,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentEwalletRespOK.PaymentType,
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
+			},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
+
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -915,16 +974,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -933,16 +996,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{}, sql.ErrConnDone)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -957,16 +1016,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -975,16 +1038,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{}, pgx.ErrTxClosed)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxClosed)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -999,16 +1058,20 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentEwalletParamsOK.CreateEwalletPayment.Description,
-				PaymentAmount:           paymentEwalletParamsOK.CreateEwalletPayment.Amount,
+				PaymentDescription:      paymentEwalletParamsOK.Description,
+				PaymentAmount:           paymentEwalletParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentEwalletRespOK.PaymentType,
-				PaymentChannel:          paymentEwalletParamsOK.CreateEwalletPayment.ChannelCode.String(),
+				PaymentChannel:          paymentEwalletParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentEwalletParamsOK.CreateEwalletPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentEwalletParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentEwalletParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -1017,16 +1080,12 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(1).Return(repository.CreateEwalletPaymentTxResult{}, pgx.ErrTxCommitRollback)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_EWALLET, paymentEwalletParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxCommitRollback)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -1047,8 +1106,13 @@ func Test_MOCK_CREATE_EWALLET_PAYMENT(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
-			tc.stubs(store)
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, createPricingConfig(&defaultPricing), store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store, provider)
 
 			actualBody, actualError := u.Create(context.TODO(), tc.body)
 			tc.checkResponse(t, actualBody, actualError)
@@ -1095,7 +1159,7 @@ func createRandomCustomer(t *testing.T) (*repository.CreateCustomerTxParams, *re
 	return createParams, customerParams
 }
 
-func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentTxParams, *repository.PaymentMethod) {
+func createRandomEwalletPayment(t *testing.T) (*gateway.CreatePaymentParams, *repository.PaymentMethod) {
 	ulid, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, ulid)
@@ -1108,18 +1172,19 @@ func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentT
 	require.NoError(t, err)
 	require.NotEmpty(t, customerPaymentMethodID)
 
-	createParams := &repository.CreateEwalletPaymentTxParams{
-		CreateEwalletPayment: repository.CreateEwalletPaymentParams{
-			CustomerName:      helper.RandomString(30),
-			CustomerPaymentID: customerPaymentID.String(),
-			CustomerNumber:    "628" + helper.RandomStringInt(9),
-			Description:       helper.RandomString(100),
-			Amount:            float64(helper.RandomInt(100, 200000)),
-			Expiry:            time.Now().Add(24 * 3 * time.Hour),
-			ChannelCode:       payment_method.EWALLETCHANNELCODE_OVO,
-			SuccessReturnURL:  helper.RandomUrl(),
-			FailureReturnURL:  helper.RandomUrl(),
-		},
+	createParams := &gateway.CreatePaymentParams{
+		CustomerName:      helper.RandomString(30),
+		CustomerPaymentID: customerPaymentID.String(),
+		CustomerNumber:    "628" + helper.RandomStringInt(9),
+		Description:       helper.RandomString(100),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		Country:           payment.COUNTRY_ID,
+		CaptureMethod:     payment.CAPTURE_METHOD_AUTOMATIC,
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       "OVO",
+		SuccessReturnURL:  helper.RandomUrl(),
+		FailureReturnURL:  helper.RandomUrl(),
 	}
 
 	respParams := &repository.PaymentMethod{
@@ -1131,12 +1196,13 @@ func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentT
 		},
 		PaymentReferenceID: customerPaymentMethodID.String(),
 		PaymentBusinessID:  customerPaymentMethodID.String(),
-		PaymentCustomerID:  createParams.CreateEwalletPayment.CustomerPaymentID,
+		PaymentCustomerID:  createParams.CustomerPaymentID,
 		PaymentType:        payment.METHODE_TYPE_EWALLET,
 		PaymentStatus:      payment.STATUS_ACTIVE,
 		PaymentReusability: payment.USAGE_TYPE_ONE_TIME_USE,
-		PaymentChannel:     string(createParams.CreateEwalletPayment.ChannelCode),
-		PaymentAmount:      decimal.NewFromFloat(createParams.CreateEwalletPayment.Amount),
+		PaymentChannel:     createParams.ChannelCode,
+		PaymentAmount:      createParams.Amount,
+		Currency:           createParams.Currency,
 		PaymentQrCode: pgtype.Text{
 			String: helper.RandomString(16),
 			Valid:  true,
@@ -1149,7 +1215,7 @@ func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentT
 			String: helper.RandomUrl(),
 			Valid:  true,
 		},
-		PaymentDescription: createParams.CreateEwalletPayment.Description,
+		PaymentDescription: createParams.Description,
 		CreatedAt: pgtype.Timestamptz{
 			Time:  time.Now(),
 			Valid: true,
@@ -1159,7 +1225,7 @@ func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentT
 			Valid: true,
 		},
 		ExpiresAt: pgtype.Timestamptz{
-			Time:  createParams.CreateEwalletPayment.Expiry,
+			Time:  createParams.Expiry,
 			Valid: true,
 		},
 	}
@@ -1167,11 +1233,48 @@ func createRandomEwalletPayment(t *testing.T) (*repository.CreateEwalletPaymentT
 	return createParams, respParams
 }
 
-func createMockRes(t *testing.T, customer *repository.Customer, paymentMethod *repository.PaymentMethod) *pb.CreatePaymentResponse {
+func createMockRes(t *testing.T, customer *repository.Customer, paymentMethod *repository.PaymentMethod, channel *repository.PaymentChannel) *pb.CreatePaymentResponse {
 	return &pb.CreatePaymentResponse{
 		Customer:      mapper.CustomerToDto(customer),
 		PaymentMethod: mapper.PaymentToDto(paymentMethod),
+		Price: mapper.PaymentPriceToDto(&models.PaymentPrice{
+			BaseAmount:  paymentMethod.PaymentAmount,
+			FeeAmount:   channel.Tax,
+			TotalAmount: paymentMethod.PaymentAmount,
+			FeeBearer:   payment.FEE_BEARER_MERCHANT,
+			Currency:    payment.DEFAULT_CURRENCY,
+		}),
+	}
+}
+
+// createRandomAvailablePaymentChannel creates a flat fee channel the payment of arg can be made through.
+func createRandomAvailablePaymentChannel(t *testing.T, typ string, arg *gateway.CreatePaymentParams) (*repository.GetAvailablePaymentChannelParams, *repository.PaymentChannel) {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	channel := &repository.PaymentChannel{
+		Uid:             ulid.String(),
+		Pcname:          arg.ChannelCode,
+		PcType:          typ,
+		MinAmount:       decimal.NewFromInt(1),
+		MaxAmount:       decimal.NewFromInt(50000000),
+		Tax:             decimal.NewFromInt(4000),
+		IsTaxPercentage: false,
+		IsActive:        true,
+		IsAvailable:     true,
+		Currency:        arg.Currency,
+		Country:         arg.Country,
 	}
+
+	params := &repository.GetAvailablePaymentChannelParams{
+		PcType:    typ,
+		Pcname:    arg.ChannelCode,
+		MinAmount: arg.Amount,
+		Currency:  arg.Currency,
+	}
+
+	return params, channel
 }
 
 type eqCreateCustomerTxParamsMatcher struct {
@@ -1189,13 +1292,16 @@ func (ex *eqCreateCustomerTxParamsMatcher) Matches(x interface{}) bool {
 	}
 
 	ex.arg.CreateCustomer.CustomerName = arg.CreateCustomer.CustomerName
-	ex.arg.CreateCustomer.CustomerAppID = arg.CreateCustomer.CustomerAppID
 	ex.arg.CreateCustomer.PaymentCustomerID = arg.CreateCustomer.PaymentCustomerID
-	ex.arg.CreateCustomer.Uid = arg.CreateCustomer.Uid
 	ex.arg.CreateCustomer.CustomerName = arg.CreateCustomer.CustomerName
 	ex.arg.CreateCustomer.Email = arg.CreateCustomer.Email
 	ex.arg.CreateCustomer.CreatedAt = arg.CreateCustomer.CreatedAt
 	ex.arg.CreateCustomer.PhoneNumber = arg.CreateCustomer.PhoneNumber
+	ex.arg.PaymentCustomer = arg.PaymentCustomer
+
+	if arg.PaymentCustomer == nil || arg.PaymentCustomer.ID == "" {
+		return false
+	}
 
 	return reflect.DeepEqual(ex.arg, arg)
 }
@@ -1206,64 +1312,44 @@ func (ex *eqCreateCustomerTxParamsMatcher) String() string {
 	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
 }
 
-type eqCreateEwalletPaymentTxParamsMatcher struct {
-	arg *repository.CreateEwalletPaymentTxParams
+type eqCreatePaymentTxParamsMatcher struct {
+	paymentType string
+	arg         *gateway.CreatePaymentParams
 }
 
-func EqCreateEwalletPaymentTxParamsMatcher(arg *repository.CreateEwalletPaymentTxParams) gomock.Matcher {
-	return &eqCreateEwalletPaymentTxParamsMatcher{arg: arg}
+func EqCreatePaymentTxParamsMatcher(paymentType string, arg *gateway.CreatePaymentParams) gomock.Matcher {
+	return &eqCreatePaymentTxParamsMatcher{paymentType: paymentType, arg: arg}
 }
 
-func (ex *eqCreateEwalletPaymentTxParamsMatcher) Matches(x interface{}) bool {
-	arg, ok := x.(*repository.CreateEwalletPaymentTxParams)
-	if !ok {
+func (ex *eqCreatePaymentTxParamsMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(*repository.CreatePaymentTxParams)
+	if !ok || arg.Payment == nil {
 		return false
 	}
 
-	ex.arg.CreateEwalletPayment = arg.CreateEwalletPayment
-	ex.arg.CreateEwalletPayment.CustomerName = arg.CreateEwalletPayment.CustomerName
-	ex.arg.CreateEwalletPayment.ReferenceID = arg.CreateEwalletPayment.ReferenceID
-	ex.arg.CreateEwalletPayment.Amount = arg.CreateEwalletPayment.Amount
-	ex.arg.CreateEwalletPayment.CustomerNumber = arg.CreateEwalletPayment.CustomerNumber
-	ex.arg.CreateEwalletPayment.CustomerPaymentID = arg.CreateEwalletPayment.CustomerPaymentID
-	ex.arg.CreateEwalletPayment.Description = arg.CreateEwalletPayment.Description
-	ex.arg.CreateEwalletPayment.Expiry = arg.CreateEwalletPayment.Expiry
-	ex.arg.CreateEwalletPayment.SuccessReturnURL = arg.CreateEwalletPayment.SuccessReturnURL
-	ex.arg.CreateEwalletPayment.FailureReturnURL = arg.CreateEwalletPayment.FailureReturnURL
-
-	if ex.arg.CreateEwalletPayment.CustomerPaymentID == "" {
+	if arg.Payment.ID == "" || arg.Payment.CustomerID == "" {
 		return false
 	}
 
-	if len(ex.arg.CreateEwalletPayment.CustomerName) > 30 {
+	if arg.Payment.Type != ex.paymentType {
 		return false
 	}
 
-	if len(ex.arg.CreateEwalletPayment.Description) > 100 {
+	if arg.Payment.Channel != ex.arg.ChannelCode {
 		return false
 	}
 
-	if !reflect.DeepEqual(ex.arg, arg) {
+	if !arg.Payment.Amount.Equal(ex.arg.Amount) {
 		return false
 	}
 
-	return true
-}
-
-func (ex *eqCreateEwalletPaymentTxParamsMatcher) String() string {
-	var errMsg string
-
-	if ex.arg.CreateEwalletPayment.CustomerPaymentID == "" {
-		errMsg += "CustomerPaymentID should not be empty\n"
-	}
-
-	if len(ex.arg.CreateEwalletPayment.CustomerName) > 30 {
-		errMsg += "CustomerName should not exceeding 30 characters"
+	if len(arg.Payment.Description) > 100 {
+		return false
 	}
 
-	if len(ex.arg.CreateEwalletPayment.Description) > 100 {
-		errMsg += "PaymentDescription should not exceeding 100 characters"
-	}
+	return true
+}
 
-	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
+func (ex *eqCreatePaymentTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches payment type: %s, arg: %v", ex.paymentType, ex.arg)
 }
@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_MOCK_CREATE_PAYMENT_IDEMPOTENCY(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+
+	body := &models.CreatePaymentRequest{
+		XIdempotencyKey:         helper.RandomString(26),
+		PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+		CustomerUid:             &custRespOK.Uid,
+		CustomerName:            custRespOK.CustomerName,
+		CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+		PaymentDescription:      paymentParamsOK.Description,
+		PaymentAmount:           paymentParamsOK.Amount,
+		Currency:                payment.DEFAULT_CURRENCY,
+		Country:                 payment.COUNTRY_ID,
+		CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+		PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+		PaymentType:             paymentRespOK.PaymentType,
+		PaymentChannel:          paymentParamsOK.ChannelCode,
+		ExpiryHour:              24 * 3,
+		PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+		PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+	}
+
+	fingerprint, err := createPaymentFingerprint(body)
+	require.NoError(t, err)
+	require.Len(t, fingerprint, 64)
+
+	// the same body under another key is the same request.
+	retried := *body
+	retried.XIdempotencyKey = helper.RandomString(26)
+	retriedFingerprint, err := createPaymentFingerprint(&retried)
+	require.NoError(t, err)
+	require.Equal(t, fingerprint, retriedFingerprint)
+
+	stored := &pb.CreatePaymentResponse{
+		Customer:      &pb.Customer{Uid: custRespOK.Uid},
+		PaymentMethod: &pb.PaymentMethod{Uid: paymentRespOK.Uid, PaymentMethodId: paymentRespOK.PaymentMethodID},
+	}
+	storedPayload, err := proto.Marshal(stored)
+	require.NoError(t, err)
+
+	ticket := helper.RandomString(32)
+	token := helper.RandomInt(1, 1000)
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(fingerprint)).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Eq(body.XIdempotencyKey)).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ReserveIdempotencyKeyParams) (*repository.IdempotencyKey, error) {
+						require.Equal(t, body.XIdempotencyKey, arg.IdempotencyKey)
+						require.Equal(t, fingerprint, arg.RequestFingerprint)
+						require.Equal(t, payment.IDEMPOTENCY_STATUS_IN_PROGRESS, arg.IdempotencyStatus)
+						require.Equal(t, arg.CreatedAt.Time.Add(conf.Services.Internal.IdempotencyKeyLease), arg.ReservedUntil.Time)
+						return &repository.IdempotencyKey{IdempotencyKey: arg.IdempotencyKey, FencingToken: 1}, nil
+					},
+				)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentRespOK}, nil)
+				store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CompleteIdempotencyKeyParams) (*repository.IdempotencyKey, error) {
+						require.Equal(t, body.XIdempotencyKey, arg.IdempotencyKey)
+						require.Equal(t, int64(1), arg.FencingToken)
+						require.Equal(t, payment.IDEMPOTENCY_STATUS_COMPLETED, arg.IdempotencyStatus)
+
+						var res pb.CreatePaymentResponse
+						require.NoError(t, proto.Unmarshal(arg.ResponsePayload, &res))
+						require.Equal(t, paymentRespOK.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+						return &repository.IdempotencyKey{}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(fingerprint), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(ticket)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, paymentRespOK.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+			},
+		},
+		{
+			tname: "OK_TAKES_OVER_STALE_RESERVATION",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				// the request holding the key before reached the gateway, a takeover gets the same payment back.
+				charged, err := provider.CreateVirtualAccountBankPayment(context.TODO(), &gateway.CreatePaymentParams{
+					CustomerPaymentID: custRespOK.PaymentCustomerID,
+					ReferenceID:       body.PaymentReferenceId,
+					Amount:            body.PaymentAmount,
+					ChannelCode:       body.PaymentChannel,
+					IdempotencyKey:    body.XIdempotencyKey,
+				})
+				require.NoError(t, err)
+
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, true, nil)
+				// the request holding the key before died with it in progress, postgres hands out the next token.
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(&repository.IdempotencyKey{
+					IdempotencyKey:     body.XIdempotencyKey,
+					RequestFingerprint: fingerprint,
+					IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_IN_PROGRESS,
+					FencingToken:       token + 1,
+				}, nil)
+				store.EXPECT().GetIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, charged.ID, arg.Payment.ID)
+						return repository.CreatePaymentTxResult{Payment: paymentRespOK}, nil
+					},
+				)
+				store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CompleteIdempotencyKeyParams) (*repository.IdempotencyKey, error) {
+						require.Equal(t, token+1, arg.FencingToken)
+						return &repository.IdempotencyKey{}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(fingerprint), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(ticket)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, paymentRespOK.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+			},
+		},
+		{
+			tname: "OK_REPLAY_COMPLETED",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(fingerprint)).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Eq(body.XIdempotencyKey)).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(ticket)).Times(1)
+				store.EXPECT().GetIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey)).Times(1).Return(&repository.IdempotencyKey{
+					IdempotencyKey:     body.XIdempotencyKey,
+					RequestFingerprint: fingerprint,
+					IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_COMPLETED,
+					ResponsePayload:    storedPayload,
+				}, nil)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey), gomock.Eq(fingerprint), gomock.Any()).Times(1)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(stored, res))
+			},
+		},
+		{
+			tname: "ERR_IDEMPOTENCY_KEY_REUSED",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Eq(ticket)).Times(1)
+				store.EXPECT().GetIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey)).Times(1).Return(&repository.IdempotencyKey{
+					IdempotencyKey:     body.XIdempotencyKey,
+					RequestFingerprint: helper.RandomString(64),
+					IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_COMPLETED,
+					ResponsePayload:    storedPayload,
+				}, nil)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentIdempotencyKeyReused)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_IDEMPOTENCY_KEY_LOCKED",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, false, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentIdempotencyKeyInProgress)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_IDEMPOTENCY_KEY_IN_PROGRESS",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Eq(ticket)).Times(1)
+				store.EXPECT().GetIdempotencyKey(gomock.Any(), gomock.Eq(body.XIdempotencyKey)).Times(1).Return(&repository.IdempotencyKey{
+					IdempotencyKey:     body.XIdempotencyKey,
+					RequestFingerprint: fingerprint,
+					IdempotencyStatus:  payment.IDEMPOTENCY_STATUS_IN_PROGRESS,
+				}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentIdempotencyKeyInProgress)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_LOCK_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return("", false, redis.ErrClosed)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, redis.ErrClosed)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "OK_FENCED_OFF",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(&repository.IdempotencyKey{}, nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentRespOK}, nil)
+				store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Eq(ticket)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, paymentRespOK.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+			},
+		},
+		{
+			tname: "ERR_CREATE_PAYMENT_TX_LEAVES_KEY_IN_PROGRESS",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).Times(1).Return(ticket, true, nil)
+				store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(1).Return(&repository.IdempotencyKey{}, nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Eq(ticket)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, provider)
+
+			res, err := u.Create(context.TODO(), body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+// stubCreatePaymentReservation lets the create payment tests of every payment type reserve and complete
+// their idempotency key, the reservation itself is covered by Test_MOCK_CREATE_PAYMENT_IDEMPOTENCY.
+func stubCreatePaymentReservation(store *mock.MockRepository) {
+	store.EXPECT().AcquireCreatePaymentLock(gomock.Any(), gomock.Any()).AnyTimes().Return(helper.RandomString(32), true, nil)
+	store.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).AnyTimes().Return(&repository.IdempotencyKey{}, nil)
+	store.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).AnyTimes().Return(&repository.IdempotencyKey{}, nil)
+	store.EXPECT().ReleaseCreatePaymentLock(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+type eqCreatePaymentResponseMatcher struct {
+	arg *pb.CreatePaymentResponse
+}
+
+// EqCreatePaymentResponseMatcher compares responses with proto.Equal,
+// marshalling the response into the idempotency_key row fills its size cache which reflect.DeepEqual would trip on.
+func EqCreatePaymentResponseMatcher(arg *pb.CreatePaymentResponse) gomock.Matcher {
+	return &eqCreatePaymentResponseMatcher{arg: arg}
+}
+
+func (ex *eqCreatePaymentResponseMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(*pb.CreatePaymentResponse)
+	if !ok {
+		return false
+	}
+
+	return proto.Equal(ex.arg, arg)
+}
+
+func (ex *eqCreatePaymentResponseMatcher) String() string {
+	return fmt.Sprintf("matches arg: %v", ex.arg)
+}
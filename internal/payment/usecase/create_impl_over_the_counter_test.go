@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_OVER_THE_COUNTER_PAYMENT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+
+	paymentParamsOK.ChannelCode = "ALFAMART"
+	paymentParamsOK.Amount = decimal.NewFromInt(helper.RandomInt(10000, 200000))
+	paymentRespOK.PaymentType = payment.METHODE_TYPE_OVER_THE_COUNTER
+	paymentRespOK.PaymentChannel = paymentParamsOK.ChannelCode
+	paymentRespOK.PaymentAmount = paymentParamsOK.Amount
+	paymentRespOK.PaymentVirtualAccountNumber = pgtype.Text{}
+
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_OVER_THE_COUNTER, paymentParamsOK)
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, payment.METHODE_TYPE_OVER_THE_COUNTER, arg.Payment.Type)
+						require.Equal(t, payment.STATUS_ACTIVE, arg.Payment.Status)
+						require.Equal(t, paymentParamsOK.ChannelCode, arg.Payment.Channel)
+						require.NotEmpty(t, arg.Payment.PaymentCode)
+						require.Empty(t, arg.Payment.VirtualAccountNumber)
+						require.False(t, arg.Payment.ExpiresAt.IsZero())
+
+						res := *paymentRespOK
+						res.PaymentMethodID = arg.Payment.ID
+						res.PaymentStatus = arg.Payment.Status
+						res.PaymentCode = pgtype.Text{String: arg.Payment.PaymentCode, Valid: true}
+						res.ExpiresAt = pgtype.Timestamptz{Time: arg.Payment.ExpiresAt, Valid: true}
+						return repository.CreatePaymentTxResult{Payment: &res}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.METHODE_TYPE_OVER_THE_COUNTER, res.GetPaymentMethod().GetPaymentType())
+				require.NotEmpty(t, res.GetPaymentMethod().GetPaymentCode())
+				require.Empty(t, res.GetPaymentMethod().GetPaymentVirtualAccountNumber())
+				require.NotNil(t, res.GetPaymentMethod().GetExpiresAt())
+			},
+		},
+		{
+			tname: "ERR_CREATE_PAYMENT_TX_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.CURRENCY_IDR,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             payment.METHODE_TYPE_OVER_THE_COUNTER,
+				PaymentChannel:          paymentParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
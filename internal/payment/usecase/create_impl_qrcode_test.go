@@ -4,12 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
-	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -24,27 +23,26 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
-	"github.com/xendit/xendit-go/v5/payment_method"
 	"go.uber.org/mock/gomock"
 )
 
 func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 	custParamsOK, custRespOK := createRandomCustomer(t)
 
-	paymentEwalletParamsOK, paymentEwalletRespOK := createRandomEwalletPayment(t)
 	paymentQrCodeParamsOK, paymentQrCodeRespOK := createRandomQrCodePayment(t)
-	paymentVirtualAccountBankParamsOK, paymentVirtualAccountBankRespOK := createRandomVirtualAccountBankPayment(t)
 
 	idempotentKey, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, idempotentKey)
 
-	mockRes := createMockRes(t, custRespOK, paymentQrCodeRespOK)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentQrCodeRespOK.PaymentType, paymentQrCodeParamsOK)
+
+	mockRes := createMockRes(t, custRespOK, paymentQrCodeRespOK, channelOK)
 
 	testCases := []struct {
 		tname         string
 		body          *models.CreatePaymentRequest
-		stubs         func(store *mock.MockRepository)
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
 		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
 	}{
 		{
@@ -54,17 +52,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(mockRes, nil)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(mockRes, nil)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -73,16 +75,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -115,17 +111,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -134,16 +134,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(1)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -176,17 +172,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -195,16 +195,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(1)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -237,17 +233,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, redis.ErrClosed)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -256,16 +256,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(1)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -298,17 +294,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -317,16 +317,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentQrCodeRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(1)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -359,17 +355,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             "ASDKOGNSAIOJFGHSAOFHGSAKDFHGOSADFHG",
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -378,16 +378,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -401,17 +395,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             "CRYPTOCURRENCY",
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -420,16 +418,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -444,17 +436,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     "Q3RGNQ29U3GRNVGW",
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -463,16 +459,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -487,17 +477,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -506,16 +500,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -530,17 +518,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      "",
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -549,16 +541,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -573,17 +559,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
 				PaymentSuccessReturnUrl: "gsdfljglskdfjsgldkfjgsldkfj",
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -592,16 +582,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -616,17 +600,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
 				PaymentFailureReturnUrl: "asdfgijasdfigjaisdfgjaofdigsadifhg",
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -635,16 +623,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -659,17 +641,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 1,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -678,16 +664,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -702,17 +682,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, sql.ErrConnDone)
@@ -721,16 +705,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -744,17 +722,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -763,16 +745,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, sql.ErrConnDone)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -786,17 +762,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -805,16 +785,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxClosed)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -828,17 +802,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -847,16 +825,10 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxCommitRollback)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -870,17 +842,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          "paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String()",
+				PaymentChannel:          "paymentQrCodeParamsOK.ChannelCode",
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -889,19 +865,17 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
 				require.Empty(t, res)
 			},
 		},
@@ -912,17 +886,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -931,16 +909,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -954,17 +928,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -973,16 +951,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{}, pgx.ErrTxClosed)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxClosed)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -996,17 +970,21 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentQrCodeParamsOK.CreateQrCodePayment.Description,
-				PaymentAmount:           paymentQrCodeParamsOK.CreateQrCodePayment.Amount,
+				PaymentDescription:      paymentQrCodeParamsOK.Description,
+				PaymentAmount:           paymentQrCodeParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentReferenceId:      paymentQrCodeRespOK.Uid,
 				PaymentType:             paymentQrCodeRespOK.PaymentType,
-				PaymentChannel:          paymentQrCodeParamsOK.CreateQrCodePayment.ChannelCode.String(),
+				PaymentChannel:          paymentQrCodeParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentQrCodeParamsOK.CreateQrCodePayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentQrCodeParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentQrCodeParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -1015,16 +993,12 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(1).Return(repository.CreateQrCodePaymentTxResult{}, pgx.ErrTxCommitRollback)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_QR_CODE, paymentQrCodeParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxCommitRollback)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
-
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -1045,8 +1019,13 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
-			tc.stubs(store)
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, createPricingConfig(&defaultPricing), store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store, provider)
 
 			actualBody, actualError := u.Create(context.TODO(), tc.body)
 			tc.checkResponse(t, actualBody, actualError)
@@ -1054,7 +1033,7 @@ func Test_MOCK_CREATE_QRCODE_PAYMENT(t *testing.T) {
 	}
 }
 
-func createRandomQrCodePayment(t *testing.T) (*repository.CreateQrCodePaymentTxParams, *repository.PaymentMethod) {
+func createRandomQrCodePayment(t *testing.T) (*gateway.CreatePaymentParams, *repository.PaymentMethod) {
 	ulid, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, ulid)
@@ -1067,18 +1046,19 @@ func createRandomQrCodePayment(t *testing.T) (*repository.CreateQrCodePaymentTxP
 	require.NoError(t, err)
 	require.NotEmpty(t, customerPaymentMethodID)
 
-	createParams := &repository.CreateQrCodePaymentTxParams{
-		CreateQrCodePayment: repository.CreateQrCodePaymentParams{
-			CustomerName:      helper.RandomString(30),
-			CustomerPaymentID: customerPaymentID.String(),
-			CustomerNumber:    "+628" + helper.RandomString(9),
-			Description:       helper.RandomString(100),
-			Amount:            float64(helper.RandomInt(100, 200000)),
-			Expiry:            time.Now().Add(24 * 3 * time.Hour),
-			ChannelCode:       payment_method.QRCODECHANNELCODE_QRIS,
-			SuccessReturnURL:  helper.RandomUrl(),
-			FailureReturnURL:  helper.RandomUrl(),
-		},
+	createParams := &gateway.CreatePaymentParams{
+		CustomerName:      helper.RandomString(30),
+		CustomerPaymentID: customerPaymentID.String(),
+		CustomerNumber:    "+628" + helper.RandomString(9),
+		Description:       helper.RandomString(100),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		Country:           payment.COUNTRY_ID,
+		CaptureMethod:     payment.CAPTURE_METHOD_AUTOMATIC,
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       "QRIS",
+		SuccessReturnURL:  helper.RandomUrl(),
+		FailureReturnURL:  helper.RandomUrl(),
 	}
 
 	respParams := &repository.PaymentMethod{
@@ -1090,12 +1070,13 @@ func createRandomQrCodePayment(t *testing.T) (*repository.CreateQrCodePaymentTxP
 		},
 		PaymentReferenceID: customerPaymentMethodID.String(),
 		PaymentBusinessID:  customerPaymentMethodID.String(),
-		PaymentCustomerID:  createParams.CreateQrCodePayment.CustomerPaymentID,
+		PaymentCustomerID:  createParams.CustomerPaymentID,
 		PaymentType:        payment.METHODE_TYPE_QR_CODE,
 		PaymentStatus:      payment.STATUS_ACTIVE,
 		PaymentReusability: payment.USAGE_TYPE_ONE_TIME_USE,
-		PaymentChannel:     string(createParams.CreateQrCodePayment.ChannelCode),
-		PaymentAmount:      decimal.NewFromFloat(createParams.CreateQrCodePayment.Amount),
+		PaymentChannel:     createParams.ChannelCode,
+		PaymentAmount:      createParams.Amount,
+		Currency:           createParams.Currency,
 		PaymentQrCode: pgtype.Text{
 			String: helper.RandomString(16),
 			Valid:  true,
@@ -1108,7 +1089,7 @@ func createRandomQrCodePayment(t *testing.T) (*repository.CreateQrCodePaymentTxP
 			String: helper.RandomUrl(),
 			Valid:  true,
 		},
-		PaymentDescription: createParams.CreateQrCodePayment.Description,
+		PaymentDescription: createParams.Description,
 		CreatedAt: pgtype.Timestamptz{
 			Time:  time.Now(),
 			Valid: true,
@@ -1118,72 +1099,10 @@ func createRandomQrCodePayment(t *testing.T) (*repository.CreateQrCodePaymentTxP
 			Valid: true,
 		},
 		ExpiresAt: pgtype.Timestamptz{
-			Time:  createParams.CreateQrCodePayment.Expiry,
+			Time:  createParams.Expiry,
 			Valid: true,
 		},
 	}
 
 	return createParams, respParams
 }
-
-type eqCreateQrCodePaymentTxParamsMatcher struct {
-	arg *repository.CreateQrCodePaymentTxParams
-}
-
-func EqCreateQrCodePaymentTxParamsMatcher(arg *repository.CreateQrCodePaymentTxParams) gomock.Matcher {
-	return &eqCreateQrCodePaymentTxParamsMatcher{arg: arg}
-}
-
-func (ex *eqCreateQrCodePaymentTxParamsMatcher) Matches(x interface{}) bool {
-	arg, ok := x.(*repository.CreateQrCodePaymentTxParams)
-	if !ok {
-		return false
-	}
-
-	ex.arg.CreateQrCodePayment = arg.CreateQrCodePayment
-	ex.arg.CreateQrCodePayment.CustomerName = arg.CreateQrCodePayment.CustomerName
-	ex.arg.CreateQrCodePayment.ReferenceID = arg.CreateQrCodePayment.ReferenceID
-	ex.arg.CreateQrCodePayment.Amount = arg.CreateQrCodePayment.Amount
-	ex.arg.CreateQrCodePayment.CustomerNumber = arg.CreateQrCodePayment.CustomerNumber
-	ex.arg.CreateQrCodePayment.CustomerPaymentID = arg.CreateQrCodePayment.CustomerPaymentID
-	ex.arg.CreateQrCodePayment.Description = arg.CreateQrCodePayment.Description
-	ex.arg.CreateQrCodePayment.Expiry = arg.CreateQrCodePayment.Expiry
-	ex.arg.CreateQrCodePayment.SuccessReturnURL = arg.CreateQrCodePayment.SuccessReturnURL
-	ex.arg.CreateQrCodePayment.FailureReturnURL = arg.CreateQrCodePayment.FailureReturnURL
-
-	if ex.arg.CreateQrCodePayment.CustomerPaymentID == "" {
-		return false
-	}
-
-	if len(ex.arg.CreateQrCodePayment.CustomerName) > 30 {
-		return false
-	}
-
-	if len(ex.arg.CreateQrCodePayment.Description) > 100 {
-		return false
-	}
-
-	if !reflect.DeepEqual(ex.arg, arg) {
-		return false
-	}
-
-	return true
-}
-
-func (ex *eqCreateQrCodePaymentTxParamsMatcher) String() string {
-	var errMsg string
-
-	if ex.arg.CreateQrCodePayment.CustomerPaymentID == "" {
-		errMsg += "CustomerPaymentID should not be empty\n"
-	}
-
-	if len(ex.arg.CreateQrCodePayment.CustomerName) > 30 {
-		errMsg += "CustomerName should not exceeding 30 characters"
-	}
-
-	if len(ex.arg.CreateQrCodePayment.Description) > 100 {
-		errMsg += "PaymentDescription should not exceeding 100 characters"
-	}
-
-	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
-}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_REUSABLE_PAYMENT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentParamsOK)
+
+	testCases := []struct {
+		tname         string
+		paymentType   string
+		channel       string
+		reusability   string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname:       "OK_MULTIPLE_USE_VIRTUAL_ACCOUNT",
+			paymentType: payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+			channel:     paymentParamsOK.ChannelCode,
+			reusability: payment.USAGE_TYPE_MULTIPLE_USE,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, arg.Payment.Reusability)
+						require.NotEmpty(t, arg.Payment.VirtualAccountNumber)
+
+						res := *paymentRespOK
+						res.PaymentMethodID = arg.Payment.ID
+						res.PaymentReusability = arg.Payment.Reusability
+						return repository.CreatePaymentTxResult{Payment: &res}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, res.GetPaymentMethod().GetPaymentReusability())
+			},
+		},
+		{
+			tname:       "ERR_MULTIPLE_USE_EWALLET",
+			paymentType: payment.METHODE_TYPE_EWALLET,
+			channel:     "OVO",
+			reusability: payment.USAGE_TYPE_MULTIPLE_USE,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentReusability)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:       "ERR_UNKNOWN_REUSABILITY",
+			paymentType: payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+			channel:     paymentParamsOK.ChannelCode,
+			reusability: "SOMETIMES",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentReusability)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.CURRENCY_IDR,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      tc.reusability,
+				PaymentType:             tc.paymentType,
+				PaymentChannel:          tc.channel,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
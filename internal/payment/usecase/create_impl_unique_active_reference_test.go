@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_PAYMENT_UNIQUE_ACTIVE_REFERENCE(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+
+	uniqueConf := createPricingConfig(&defaultPricing)
+	uniqueConf.Services.Internal.UniqueActiveReference = true
+
+	// the payment the gateway made before the create transaction rejected it.
+	var orphanPaymentID string
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, provider *gateway.FakeProvider, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().CountPaymentMethodsByReferenceIDAndStatuses(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CountPaymentMethodsByReferenceIDAndStatusesParams) (int64, error) {
+						require.Equal(t, paymentRespOK.PaymentReferenceID, arg.PaymentReferenceID)
+						require.ElementsMatch(t, payment.OutstandingStatuses(), arg.PaymentStatuses)
+						return 0, nil
+					},
+				)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.True(t, arg.UniqueActiveReference)
+						return repository.CreatePaymentTxResult{Payment: paymentRespOK}, nil
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, provider *gateway.FakeProvider, err error) {
+				require.NoError(t, err)
+				require.Equal(t, paymentRespOK.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+			},
+		},
+		{
+			tname: "ERR_DUPLICATE_ACTIVE_PAYMENT",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().CountPaymentMethodsByReferenceIDAndStatuses(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, provider *gateway.FakeProvider, err error) {
+				require.ErrorIs(t, err, unierror.ErrDuplicateActivePayment)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_DUPLICATE_ACTIVE_PAYMENT_CONCURRENT_CREATE",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().CountPaymentMethodsByReferenceIDAndStatuses(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						orphanPaymentID = arg.Payment.ID
+						return repository.CreatePaymentTxResult{}, unierror.ErrDuplicateActivePayment
+					},
+				)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, provider *gateway.FakeProvider, err error) {
+				require.ErrorIs(t, err, unierror.ErrDuplicateActivePayment)
+				require.Nil(t, res)
+
+				orphan, err := provider.GetVirtualAccountBankPaymentByID(context.TODO(), orphanPaymentID)
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_EXPIRED, orphan.Status)
+			},
+		},
+		{
+			tname: "ERR_COUNT_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().CountPaymentMethodsByReferenceIDAndStatuses(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, provider *gateway.FakeProvider, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(uniqueConf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, uniqueConf, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store, provider)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentRespOK.PaymentType,
+				PaymentChannel:          paymentParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, provider, err)
+		})
+	}
+}
@@ -4,12 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
-	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -24,27 +23,26 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
-	"github.com/xendit/xendit-go/v5/payment_method"
 	"go.uber.org/mock/gomock"
 )
 
 func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 	custParamsOK, custRespOK := createRandomCustomer(t)
 
-	paymentEwalletParamsOK, paymentEwalletRespOK := createRandomEwalletPayment(t)
-	paymentQrCodeParamsOK, paymentQrCodeRespOK := createRandomQrCodePayment(t)
 	paymentVirtualAccountBankParamsOK, paymentVirtualAccountBankRespOK := createRandomVirtualAccountBankPayment(t)
 
 	idempotentKey, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, idempotentKey)
 
-	mockRes := createMockRes(t, custRespOK, paymentVirtualAccountBankRespOK)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentVirtualAccountBankRespOK.PaymentType, paymentVirtualAccountBankParamsOK)
+
+	mockRes := createMockRes(t, custRespOK, paymentVirtualAccountBankRespOK, channelOK)
 
 	testCases := []struct {
 		tname         string
 		body          *models.CreatePaymentRequest
-		stubs         func(store *mock.MockRepository)
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
 		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
 	}{
 		{
@@ -55,16 +53,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(mockRes, nil)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(mockRes, nil)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -73,16 +75,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -116,16 +112,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -134,16 +134,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -177,16 +173,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -195,16 +195,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -238,16 +234,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, redis.ErrClosed)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
@@ -256,16 +256,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -299,16 +295,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -317,16 +317,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(1)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(1)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(1)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.NoError(t, err)
@@ -360,16 +356,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             "ASDKOGNSAIOJFGHSAOFHGSAKDFHGOSADFHG",
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -378,16 +378,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -402,16 +396,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             "CRYPTOCURRENCY",
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -420,16 +418,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -445,16 +437,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     "Q3RGNQ29U3GRNVGW",
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -463,16 +459,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -488,16 +478,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -506,41 +500,79 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.Error(t, err)
+				require.True(t, strings.Contains(strings.ToLower(err.Error()), strings.ToLower(unierror.ErrInvalidAmount.Error())))
+				require.Empty(t, res)
+			},
+		},
+		{
+			tname: "ERR_VIRTUAL_ACCOUNT_BANK_AMOUNT_PRECISION",
+			body: &models.CreatePaymentRequest{
+				XIdempotencyKey:         idempotentKey.String(),
+				PaymentReferenceId:      paymentVirtualAccountBankRespOK.Uid,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           decimal.RequireFromString("10000.001"),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
+			},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
+
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
+				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
-				require.True(t, strings.Contains(strings.ToLower(err.Error()), strings.ToLower(unierror.ErrInvalidAmount.Error())))
+				require.True(t, strings.Contains(strings.ToLower(err.Error()), strings.ToLower(unierror.ErrInvalidAmountPrecision.Error())))
 				require.Empty(t, res)
 			},
 		},
 		{
-			tname: "ERR_VIRTUAL_ACCOUNT_BANK_REFERENCE_ID_SHOULD_NOT_BE_EMPTY",
+			tname: "ERR_VIRTUAL_ACCOUNT_BANK_UNSUPPORTED_CURRENCY",
 			body: &models.CreatePaymentRequest{
 				XIdempotencyKey:         idempotentKey.String(),
-				PaymentReferenceId:      "",
+				PaymentReferenceId:      paymentVirtualAccountBankRespOK.Uid,
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           float64(99),
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.CURRENCY_USD,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -549,16 +581,51 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.Error(t, err)
+				require.True(t, strings.Contains(strings.ToLower(err.Error()), strings.ToLower(unierror.ErrUnsupportedCurrency.Error())))
+				require.Empty(t, res)
+			},
+		},
+		{
+			tname: "ERR_VIRTUAL_ACCOUNT_BANK_REFERENCE_ID_SHOULD_NOT_BE_EMPTY",
+			body: &models.CreatePaymentRequest{
+				XIdempotencyKey:         idempotentKey.String(),
+				PaymentReferenceId:      "",
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           decimal.NewFromInt(99),
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
+			},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
+
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
+				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
+				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -574,16 +641,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
 				PaymentSuccessReturnUrl: "gsdfljglskdfjsgldkfjgsldkfj",
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -592,16 +663,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -617,16 +682,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
 				PaymentFailureReturnUrl: "asdfgijasdfigjaisdfgjaofdigsadifhg",
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -635,16 +704,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -660,16 +723,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 1,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(0)
@@ -678,16 +745,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -703,16 +764,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, sql.ErrConnDone)
@@ -721,16 +786,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(0)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -745,16 +804,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -763,16 +826,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, sql.ErrConnDone)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -787,16 +844,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -805,16 +866,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxClosed)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -829,16 +884,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -847,16 +906,10 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{}, pgx.ErrTxCommitRollback)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(0)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -871,16 +924,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          "paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String()",
+				PaymentChannel:          "paymentVirtualAccountBankParamsOK.ChannelCode",
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -889,19 +946,17 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
 				require.Empty(t, res)
 			},
 		},
@@ -913,16 +968,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -931,16 +990,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -955,16 +1010,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -973,16 +1032,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
-
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{}, pgx.ErrTxClosed)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxClosed)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -997,16 +1052,20 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				CustomerUid:             &custRespOK.Uid,
 				CustomerName:            custRespOK.CustomerName,
 				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
-				PaymentDescription:      paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Description,
-				PaymentAmount:           paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.Amount,
+				PaymentDescription:      paymentVirtualAccountBankParamsOK.Description,
+				PaymentAmount:           paymentVirtualAccountBankParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
 				PaymentType:             paymentVirtualAccountBankRespOK.PaymentType,
-				PaymentChannel:          paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.ChannelCode.String(),
+				PaymentChannel:          paymentVirtualAccountBankParamsOK.ChannelCode,
 				ExpiryHour:              24 * 3,
-				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.SuccessReturnURL,
-				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.CreateVirtualAccountBankPayment.FailureReturnURL,
+				PaymentSuccessReturnUrl: paymentVirtualAccountBankParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentVirtualAccountBankParamsOK.FailureReturnURL,
 			},
-			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String())).Times(1).Return(nil, redis.ErrClosed)
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any()).Times(1).Return(nil, redis.ErrClosed)
 
 				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, errors.New("not-found"))
 				store.EXPECT().GetCustomerByCustomerAppID(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -1015,16 +1074,12 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 				store.EXPECT().CreateCustomerTx(gomock.Any(), EqCreateCustomerTxParamsMatcher(custParamsOK)).Times(1).Return(repository.CreateCustomerTxResult{Customer: custRespOK}, nil)
 				store.EXPECT().PutCustomerCache(gomock.Any(), gomock.Eq(custRespOK)).Times(1)
 
-				store.EXPECT().CreateEwalletPaymentTx(gomock.Any(), EqCreateEwalletPaymentTxParamsMatcher(paymentEwalletParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentEwalletRespOK)).Times(0)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
 
-				store.EXPECT().CreateQrCodePaymentTx(gomock.Any(), EqCreateQrCodePaymentTxParamsMatcher(paymentQrCodeParamsOK)).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentQrCodeRespOK)).Times(0)
-
-				store.EXPECT().CreateVirtualAccountBankPaymentTx(gomock.Any(), EqCreateVirtualAccountBankPaymentTxParamsMatcher(paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreateVirtualAccountBankPaymentTxResult{}, pgx.ErrTxCommitRollback)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), EqCreatePaymentTxParamsMatcher(payment.METHODE_TYPE_VIRTUAL_ACCOUNT, paymentVirtualAccountBankParamsOK)).Times(1).Return(repository.CreatePaymentTxResult{}, pgx.ErrTxCommitRollback)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 
-				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Eq(mockRes)).Times(0)
+				store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Eq(idempotentKey.String()), gomock.Any(), EqCreatePaymentResponseMatcher(mockRes)).Times(0)
 			},
 			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
 				require.Error(t, err)
@@ -1045,8 +1100,13 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
-			tc.stubs(store)
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, createPricingConfig(&defaultPricing), store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store, provider)
 
 			actualBody, actualError := u.Create(context.TODO(), tc.body)
 			tc.checkResponse(t, actualBody, actualError)
@@ -1054,7 +1114,7 @@ func Test_MOCK_CREATE_VIRTUAL_ACCOUNT_BANK_PAYMENT(t *testing.T) {
 	}
 }
 
-func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirtualAccountBankPaymentTxParams, *repository.PaymentMethod) {
+func createRandomVirtualAccountBankPayment(t *testing.T) (*gateway.CreatePaymentParams, *repository.PaymentMethod) {
 	ulid, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, ulid)
@@ -1067,18 +1127,19 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 	require.NoError(t, err)
 	require.NotEmpty(t, customerPaymentMethodID)
 
-	createParams := &repository.CreateVirtualAccountBankPaymentTxParams{
-		CreateVirtualAccountBankPayment: repository.CreateVirtualAccountBankPaymentParams{
-			CustomerName:      helper.RandomString(30),
-			CustomerPaymentID: customerPaymentID.String(),
-			CustomerNumber:    "+628" + helper.RandomString(9),
-			Description:       helper.RandomString(100),
-			Amount:            float64(helper.RandomInt(100, 200000)),
-			Expiry:            time.Now().Add(24 * 3 * time.Hour),
-			ChannelCode:       payment_method.VIRTUALACCOUNTCHANNELCODE_BCA,
-			SuccessReturnURL:  helper.RandomUrl(),
-			FailureReturnURL:  helper.RandomUrl(),
-		},
+	createParams := &gateway.CreatePaymentParams{
+		CustomerName:      helper.RandomString(30),
+		CustomerPaymentID: customerPaymentID.String(),
+		CustomerNumber:    "+628" + helper.RandomString(9),
+		Description:       helper.RandomString(100),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		Country:           payment.COUNTRY_ID,
+		CaptureMethod:     payment.CAPTURE_METHOD_AUTOMATIC,
+		Expiry:            time.Now().Add(24 * 3 * time.Hour),
+		ChannelCode:       "BCA",
+		SuccessReturnURL:  helper.RandomUrl(),
+		FailureReturnURL:  helper.RandomUrl(),
 	}
 
 	respParams := &repository.PaymentMethod{
@@ -1090,12 +1151,13 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 		},
 		PaymentReferenceID: customerPaymentMethodID.String(),
 		PaymentBusinessID:  customerPaymentMethodID.String(),
-		PaymentCustomerID:  createParams.CreateVirtualAccountBankPayment.CustomerPaymentID,
+		PaymentCustomerID:  createParams.CustomerPaymentID,
 		PaymentType:        payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
 		PaymentStatus:      payment.STATUS_ACTIVE,
 		PaymentReusability: payment.USAGE_TYPE_ONE_TIME_USE,
-		PaymentChannel:     string(createParams.CreateVirtualAccountBankPayment.ChannelCode),
-		PaymentAmount:      decimal.NewFromFloat(createParams.CreateVirtualAccountBankPayment.Amount),
+		PaymentChannel:     createParams.ChannelCode,
+		PaymentAmount:      createParams.Amount,
+		Currency:           createParams.Currency,
 		PaymentQrCode: pgtype.Text{
 			String: helper.RandomString(16),
 			Valid:  true,
@@ -1108,7 +1170,7 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 			String: helper.RandomUrl(),
 			Valid:  true,
 		},
-		PaymentDescription: createParams.CreateVirtualAccountBankPayment.Description,
+		PaymentDescription: createParams.Description,
 		CreatedAt: pgtype.Timestamptz{
 			Time:  time.Now(),
 			Valid: true,
@@ -1118,72 +1180,10 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 			Valid: true,
 		},
 		ExpiresAt: pgtype.Timestamptz{
-			Time:  createParams.CreateVirtualAccountBankPayment.Expiry,
+			Time:  createParams.Expiry,
 			Valid: true,
 		},
 	}
 
 	return createParams, respParams
 }
-
-type eqCreateVirtualAccountBankPaymentTxParamsMatcher struct {
-	arg *repository.CreateVirtualAccountBankPaymentTxParams
-}
-
-func EqCreateVirtualAccountBankPaymentTxParamsMatcher(arg *repository.CreateVirtualAccountBankPaymentTxParams) gomock.Matcher {
-	return &eqCreateVirtualAccountBankPaymentTxParamsMatcher{arg: arg}
-}
-
-func (ex *eqCreateVirtualAccountBankPaymentTxParamsMatcher) Matches(x interface{}) bool {
-	arg, ok := x.(*repository.CreateVirtualAccountBankPaymentTxParams)
-	if !ok {
-		return false
-	}
-
-	ex.arg.CreateVirtualAccountBankPayment = arg.CreateVirtualAccountBankPayment
-	ex.arg.CreateVirtualAccountBankPayment.CustomerName = arg.CreateVirtualAccountBankPayment.CustomerName
-	ex.arg.CreateVirtualAccountBankPayment.ReferenceID = arg.CreateVirtualAccountBankPayment.ReferenceID
-	ex.arg.CreateVirtualAccountBankPayment.Amount = arg.CreateVirtualAccountBankPayment.Amount
-	ex.arg.CreateVirtualAccountBankPayment.CustomerNumber = arg.CreateVirtualAccountBankPayment.CustomerNumber
-	ex.arg.CreateVirtualAccountBankPayment.CustomerPaymentID = arg.CreateVirtualAccountBankPayment.CustomerPaymentID
-	ex.arg.CreateVirtualAccountBankPayment.Description = arg.CreateVirtualAccountBankPayment.Description
-	ex.arg.CreateVirtualAccountBankPayment.Expiry = arg.CreateVirtualAccountBankPayment.Expiry
-	ex.arg.CreateVirtualAccountBankPayment.SuccessReturnURL = arg.CreateVirtualAccountBankPayment.SuccessReturnURL
-	ex.arg.CreateVirtualAccountBankPayment.FailureReturnURL = arg.CreateVirtualAccountBankPayment.FailureReturnURL
-
-	if ex.arg.CreateVirtualAccountBankPayment.CustomerPaymentID == "" {
-		return false
-	}
-
-	if len(ex.arg.CreateVirtualAccountBankPayment.CustomerName) > 30 {
-		return false
-	}
-
-	if len(ex.arg.CreateVirtualAccountBankPayment.Description) > 100 {
-		return false
-	}
-
-	if !reflect.DeepEqual(ex.arg, arg) {
-		return false
-	}
-
-	return true
-}
-
-func (ex *eqCreateVirtualAccountBankPaymentTxParamsMatcher) String() string {
-	var errMsg string
-
-	if ex.arg.CreateVirtualAccountBankPayment.CustomerPaymentID == "" {
-		errMsg += "CustomerPaymentID should not be empty\n"
-	}
-
-	if len(ex.arg.CreateVirtualAccountBankPayment.CustomerName) > 30 {
-		errMsg += "CustomerName should not exceeding 30 characters"
-	}
-
-	if len(ex.arg.CreateVirtualAccountBankPayment.Description) > 100 {
-		errMsg += "PaymentDescription should not exceeding 100 characters"
-	}
-
-	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
-}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+// CreateInvoice creates a hosted checkout page the customer pays on through any of the allowed channels,
+// the payment is recorded and linked back to the invoice once the gateway calls back.
+func (u *usecaseImpl) CreateInvoice(ctx context.Context, arg *models.CreateInvoiceRequest) (*pb.CreateInvoiceResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.CreateInvoice")
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	phoneNumber, err := u.validateCreateInvoiceParams(span, arg)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	customer, err := u.processGetCustomer(ctx, span, *arg.CustomerUid, arg.CustomerName, phoneNumber)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	allowedChannels, err := u.invoiceChannels(ctx, arg)
+	if err != nil {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "u.invoiceChannels.err", arg.InvoiceAllowedChannels),
+			err,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	gatewayInvoice, err := provider.CreateInvoice(ctx, &gateway.CreateInvoiceParams{
+		CustomerName:      customer.CustomerName,
+		CustomerPaymentID: customer.PaymentCustomerID,
+		CustomerNumber:    customer.PhoneNumber.String,
+		Description:       arg.InvoiceDescription,
+		ReferenceID:       arg.InvoiceReferenceId,
+		Amount:            arg.InvoiceAmount,
+		Currency:          arg.Currency,
+		Expiry:            time.Now().Add(time.Duration(arg.ExpiryHour) * time.Hour),
+		AllowedChannels:   allowedChannels,
+		SuccessReturnURL:  arg.InvoiceSuccessReturnUrl,
+		FailureReturnURL:  arg.InvoiceFailureReturnUrl,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.CreateInvoice.err", err)
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return nil, u.errorResponse(span, "helper.GenerateULID.err", err)
+	}
+
+	res, err := u.repo.CreateInvoice(ctx, &repository.CreateInvoiceParams{
+		Uid:                    uid.String(),
+		InvoiceID:              gatewayInvoice.ID,
+		InvoiceReferenceID:     arg.InvoiceReferenceId,
+		InvoiceBusinessID:      gatewayInvoice.BusinessID,
+		PaymentCustomerID:      customer.PaymentCustomerID,
+		InvoiceStatus:          gatewayInvoice.Status,
+		InvoiceAmount:          arg.InvoiceAmount,
+		Currency:               arg.Currency,
+		InvoiceAllowedChannels: allowedChannels,
+		InvoiceUrl:             gatewayInvoice.URL,
+		InvoiceDescription:     arg.InvoiceDescription,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  gatewayInvoice.CreatedAt,
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  gatewayInvoice.ExpiresAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CreateInvoice.err", err)
+	}
+
+	return &pb.CreateInvoiceResponse{
+		Customer: mapper.CustomerToDto(customer),
+		Invoice:  mapper.InvoiceToDto(res),
+	}, nil
+}
+
+func (u *usecaseImpl) validateCreateInvoiceParams(span opentracing.Span, arg *models.CreateInvoiceRequest) (string, error) {
+	res, err := helper.ValidatePhoneNumber(arg.CustomerPhoneNumber)
+	if err != nil {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.ValidatePhoneNumber.err", arg.CustomerPhoneNumber),
+			unierror.ErrInvalidCustomerPhoneNumberInput,
+		)
+	}
+
+	if arg.InvoiceReferenceId == "" {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetInvoiceReferenceId", arg.InvoiceReferenceId),
+			unierror.ErrReferenceIDShouldNotBeEmpty,
+		)
+	}
+
+	if len(arg.InvoiceAllowedChannels) == 0 {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetInvoiceAllowedChannels", arg.InvoiceAllowedChannels),
+			unierror.ErrInvoiceChannelsRequired,
+		)
+	}
+
+	if !payment.IsSupportedCurrency(arg.Currency) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsSupportedCurrency", arg.Currency),
+			unierror.ErrUnsupportedCurrency,
+		)
+	}
+
+	if !arg.InvoiceAmount.IsPositive() || (arg.Currency == payment.CURRENCY_IDR && arg.InvoiceAmount.LessThan(decimal.NewFromInt(100))) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetInvoiceAmount", arg.InvoiceAmount),
+			unierror.ErrInvalidAmount,
+		)
+	}
+
+	if !payment.IsExactInCurrency(arg.InvoiceAmount, arg.Currency) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsExactInCurrency", arg.InvoiceAmount),
+			unierror.ErrInvalidAmountPrecision,
+		)
+	}
+
+	if !helper.IsValidURL(arg.InvoiceSuccessReturnUrl) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.IsValidURL", arg.InvoiceSuccessReturnUrl),
+			unierror.ErrInvalidSuccessURL,
+		)
+	}
+
+	if !helper.IsValidURL(arg.InvoiceFailureReturnUrl) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.IsValidURL", arg.InvoiceFailureReturnUrl),
+			unierror.ErrInvalidFailureURL,
+		)
+	}
+
+	if arg.ExpiryHour < 72 {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetExpiryHour", arg.ExpiryHour),
+			unierror.ErrExpiryLessThan3Days,
+		)
+	}
+
+	return res, nil
+}
+
+// invoiceChannels returns the allowed channels without duplicates, every one of them has to be active
+// and take the invoice amount in its currency.
+func (u *usecaseImpl) invoiceChannels(ctx context.Context, arg *models.CreateInvoiceRequest) ([]string, error) {
+	res := make([]string, 0, len(arg.InvoiceAllowedChannels))
+
+	for _, name := range arg.InvoiceAllowedChannels {
+		if slices.Contains(res, name) {
+			continue
+		}
+
+		channel, err := u.repo.GetPaymentChannelByNameAndCurrency(ctx, &repository.GetPaymentChannelByNameAndCurrencyParams{
+			Pcname:   name,
+			Currency: arg.Currency,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("%w: %s", unierror.ErrChannelCurrencyNotSupported, name)
+			}
+
+			return nil, err
+		}
+
+		if !channel.IsActive || !channel.IsAvailable ||
+			arg.InvoiceAmount.LessThan(channel.MinAmount) || arg.InvoiceAmount.GreaterThan(channel.MaxAmount) {
+			return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPaymentChannel, name)
+		}
+
+		res = append(res, channel.Pcname)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_INVOICE(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, _ := createRandomEwalletPayment(t)
+
+	_, ewalletChannelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_EWALLET, paymentParamsOK)
+
+	virtualAccountParams := *paymentParamsOK
+	virtualAccountParams.ChannelCode = "BCA"
+	_, virtualAccountChannelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_VIRTUAL_ACCOUNT, &virtualAccountParams)
+
+	testCases := []struct {
+		tname         string
+		channels      []string
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.CreateInvoiceResponse, err error)
+	}{
+		{
+			tname:    "OK",
+			channels: []string{ewalletChannelOK.Pcname, virtualAccountChannelOK.Pcname, ewalletChannelOK.Pcname},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Eq(&repository.GetPaymentChannelByNameAndCurrencyParams{
+					Pcname:   ewalletChannelOK.Pcname,
+					Currency: payment.CURRENCY_IDR,
+				})).Times(1).Return(ewalletChannelOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Eq(&repository.GetPaymentChannelByNameAndCurrencyParams{
+					Pcname:   virtualAccountChannelOK.Pcname,
+					Currency: payment.CURRENCY_IDR,
+				})).Times(1).Return(virtualAccountChannelOK, nil)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreateInvoiceParams) (*repository.Invoice, error) {
+						require.NotEmpty(t, arg.Uid)
+						require.NotEmpty(t, arg.InvoiceID)
+						require.NotEmpty(t, arg.InvoiceUrl)
+						require.Equal(t, custRespOK.PaymentCustomerID, arg.PaymentCustomerID)
+						require.Equal(t, payment.INVOICE_STATUS_PENDING, arg.InvoiceStatus)
+						require.Equal(t, []string{ewalletChannelOK.Pcname, virtualAccountChannelOK.Pcname}, arg.InvoiceAllowedChannels)
+						require.True(t, arg.ExpiresAt.Time.After(time.Now().Add(71*time.Hour)))
+
+						return &repository.Invoice{
+							Uid:                    arg.Uid,
+							InvoiceID:              arg.InvoiceID,
+							InvoiceReferenceID:     arg.InvoiceReferenceID,
+							InvoiceBusinessID:      arg.InvoiceBusinessID,
+							PaymentCustomerID:      arg.PaymentCustomerID,
+							InvoiceStatus:          arg.InvoiceStatus,
+							InvoiceAmount:          arg.InvoiceAmount,
+							Currency:               arg.Currency,
+							InvoiceAllowedChannels: arg.InvoiceAllowedChannels,
+							InvoiceUrl:             arg.InvoiceUrl,
+							InvoiceDescription:     arg.InvoiceDescription,
+							CreatedAt:              arg.CreatedAt,
+							ExpiresAt:              arg.ExpiresAt,
+							PaidAt:                 pgtype.Timestamptz{Valid: true},
+						}, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, custRespOK.PaymentCustomerID, res.GetCustomer().GetPaymentCustomerId())
+				require.Equal(t, payment.INVOICE_STATUS_PENDING, res.GetInvoice().GetInvoiceStatus())
+				require.Len(t, res.GetInvoice().GetInvoiceAllowedChannels(), 2)
+				require.NotEmpty(t, res.GetInvoice().GetInvoiceUrl())
+			},
+		},
+		{
+			tname:    "ERR_CHANNELS_REQUIRED",
+			channels: []string{},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvoiceChannelsRequired)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_CHANNEL_CURRENCY_NOT_SUPPORTED",
+			channels: []string{ewalletChannelOK.Pcname},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_CHANNEL_INACTIVE",
+			channels: []string{ewalletChannelOK.Pcname, virtualAccountChannelOK.Pcname},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				inactiveChannel := *virtualAccountChannelOK
+				inactiveChannel.IsActive = false
+
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(ewalletChannelOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(&inactiveChannel, nil)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentChannel)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_PROVIDER_UNAVAILABLE",
+			channels: []string{ewalletChannelOK.Pcname},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(ewalletChannelOK, nil)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:    "ERR_CREATE_INVOICE_INTERNAL_SERVER_ERROR",
+			channels: []string{ewalletChannelOK.Pcname},
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(ewalletChannelOK, nil)
+				store.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateInvoiceResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, provider)
+
+			res, err := u.CreateInvoice(context.TODO(), &models.CreateInvoiceRequest{
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				InvoiceDescription:      paymentParamsOK.Description,
+				InvoiceReferenceId:      helper.RandomString(26),
+				InvoiceAmount:           paymentParamsOK.Amount,
+				Currency:                payment.CURRENCY_IDR,
+				InvoiceAllowedChannels:  tc.channels,
+				ExpiryHour:              72,
+				InvoiceSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				InvoiceFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
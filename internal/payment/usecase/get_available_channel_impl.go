@@ -8,9 +8,10 @@ import (
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
 	"github.com/opentracing/opentracing-go"
 	"github.com/shopspring/decimal"
-	"github.com/xendit/xendit-go/v5/payment_method"
 )
 
 func (u *usecaseImpl) GetAvailableChannel(ctx context.Context, arg *models.GetPaymentChannelRequest) (*pb.GetPaymentChannelResponse, error) {
@@ -20,12 +21,11 @@ func (u *usecaseImpl) GetAvailableChannel(ctx context.Context, arg *models.GetPa
 	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
 	defer cancel()
 
-	_, err := payment_method.NewPaymentMethodTypeFromValue(arg.PaymentChannelType)
-	if err != nil {
+	if !payment.IsMethodType(arg.PaymentChannelType) {
 		return nil, u.errorResponse(
 			span,
-			fmt.Sprintf("%s: %v", "payment_method.NewPaymentMethodTypeFromValue.err", arg.PaymentChannelType),
-			err,
+			fmt.Sprintf("%s: %v", "payment.IsMethodType", arg.PaymentChannelType),
+			unierror.ErrUnsupportedPaymentType,
 		)
 	}
 
@@ -33,6 +33,7 @@ func (u *usecaseImpl) GetAvailableChannel(ctx context.Context, arg *models.GetPa
 		PcType:    arg.PaymentChannelType,
 		Pcname:    arg.PaymentChannelName,
 		MinAmount: decimal.NewFromFloat(arg.Amount),
+		Currency:  arg.Currency,
 	}
 
 	channel, err := u.repo.GetAvailablePaymentChannel(ctx, getArg)
@@ -44,7 +45,10 @@ func (u *usecaseImpl) GetAvailableChannel(ctx context.Context, arg *models.GetPa
 		)
 	}
 
+	res := mapper.PaymentChannelToDto(channel)
+	res.Price = mapper.PaymentPriceToDto(u.price(channel, getArg.MinAmount))
+
 	return &pb.GetPaymentChannelResponse{
-		PaymentChannel: mapper.PaymentChannelToDto(channel),
+		PaymentChannel: res,
 	}, nil
 }
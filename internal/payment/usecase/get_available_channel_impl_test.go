@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -37,6 +38,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 				Amount:             okParams.MinAmount.InexactFloat64(),
 				PaymentChannelName: okParams.Pcname,
 				PaymentChannelType: okParams.PcType,
+				Currency:           okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
 				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), EqGetAvailablePaymentChannelParamsMatcher(okParams)).Times(1).Return(okResp, nil)
@@ -54,6 +56,9 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 				require.Equal(t, res.GetPaymentChannel().GetIsTaxPercentage(), okResp.IsTaxPercentage)
 				require.Equal(t, res.GetPaymentChannel().GetIsActive(), okResp.IsActive)
 				require.Equal(t, res.GetPaymentChannel().GetIsAvailable(), okResp.IsAvailable)
+
+				require.NotNil(t, res.GetPaymentChannel().GetPrice())
+				require.Equal(t, okParams.MinAmount.InexactFloat64(), res.GetPaymentChannel().GetPrice().GetBaseAmount())
 			},
 		},
 		{
@@ -62,6 +67,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 				Amount:             typeNotAvaiableParams.MinAmount.InexactFloat64(),
 				PaymentChannelName: typeNotAvaiableParams.Pcname,
 				PaymentChannelType: typeNotAvaiableParams.PcType,
+				Currency:           typeNotAvaiableParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
 				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), EqGetAvailablePaymentChannelParamsMatcher(typeNotAvaiableParams)).Times(0)
@@ -77,6 +83,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 				Amount:             okParams.MinAmount.InexactFloat64(),
 				PaymentChannelName: okParams.Pcname,
 				PaymentChannelType: okParams.PcType,
+				Currency:           okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
 				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), EqGetAvailablePaymentChannelParamsMatcher(okParams)).Times(1).Return(nil, pgx.ErrNoRows)
@@ -92,6 +99,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 				Amount:             okParams.MinAmount.InexactFloat64(),
 				PaymentChannelName: okParams.Pcname,
 				PaymentChannelType: okParams.PcType,
+				Currency:           okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
 				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), EqGetAvailablePaymentChannelParamsMatcher(okParams)).Times(1).Return(nil, sql.ErrConnDone)
@@ -115,7 +123,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNEL(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
 			tc.stubs(store)
 
 			actualBody, actualError := u.GetAvailableChannel(context.TODO(), tc.body)
@@ -139,12 +147,15 @@ func createRandomPaymentChannel(t *testing.T) (*repository.GetAvailablePaymentCh
 		IsTaxPercentage: true,
 		IsActive:        true,
 		IsAvailable:     true,
+		Currency:        payment.DEFAULT_CURRENCY,
+		Country:         payment.COUNTRY_ID,
 	}
 
 	params := &repository.GetAvailablePaymentChannelParams{
 		PcType:    paymentChannel.PcType,
 		Pcname:    paymentChannel.Pcname,
 		MinAmount: decimal.NewFromInt(helper.RandomInt(int64(paymentChannel.MinAmount.IntPart()), int64(paymentChannel.MaxAmount.IntPart()))),
+		Currency:  paymentChannel.Currency,
 	}
 
 	return params, paymentChannel
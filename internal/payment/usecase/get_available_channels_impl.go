@@ -5,6 +5,7 @@ import (
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
 	"github.com/opentracing/opentracing-go"
 	"github.com/shopspring/decimal"
@@ -17,7 +18,12 @@ func (u *usecaseImpl) GetAvailableChannels(ctx context.Context, arg *models.GetP
 	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
 	defer cancel()
 
-	res, err := u.repo.GetAvailablePaymentChannels(ctx, decimal.NewFromFloat(arg.Amount))
+	amount := decimal.NewFromFloat(arg.Amount)
+
+	res, err := u.repo.GetAvailablePaymentChannels(ctx, &repository.GetAvailablePaymentChannelsParams{
+		MinAmount: amount,
+		Currency:  arg.Currency,
+	})
 	if err != nil {
 		return nil, u.errorResponse(
 			span,
@@ -26,7 +32,12 @@ func (u *usecaseImpl) GetAvailableChannels(ctx context.Context, arg *models.GetP
 		)
 	}
 
+	list := mapper.PaymentChannelsToDto(res)
+	for i, channel := range res {
+		list[i].Price = mapper.PaymentPriceToDto(u.price(channel, amount))
+	}
+
 	return &pb.GetPaymentChannelsResponse{
-		List: mapper.PaymentChannelsToDto(res),
+		List: list,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -29,10 +30,11 @@ func Test_MOCK_GET_AVAILABLE_CHANNELS(t *testing.T) {
 		{
 			tname: "OK",
 			body: &models.GetPaymentChannelsRequest{
-				Amount: okParams.MinAmount.InexactFloat64(),
+				Amount:   okParams.MinAmount.InexactFloat64(),
+				Currency: okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(okParams.MinAmount)).Times(1).Return(mockRes, nil)
+				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(&repository.GetAvailablePaymentChannelsParams{MinAmount: okParams.MinAmount, Currency: okParams.Currency})).Times(1).Return(mockRes, nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetPaymentChannelsResponse, err error) {
 				require.NoError(t, err)
@@ -50,16 +52,21 @@ func Test_MOCK_GET_AVAILABLE_CHANNELS(t *testing.T) {
 					require.Equal(t, v.GetIsTaxPercentage(), okResp.IsTaxPercentage)
 					require.Equal(t, v.GetIsActive(), okResp.IsActive)
 					require.Equal(t, v.GetIsAvailable(), okResp.IsAvailable)
+
+					require.NotNil(t, v.GetPrice())
+					require.Equal(t, okParams.MinAmount.InexactFloat64(), v.GetPrice().GetBaseAmount())
+					require.Greater(t, v.GetPrice().GetFeeAmount(), float64(0))
 				}
 			},
 		},
 		{
 			tname: "OK_EMPTY_RES",
 			body: &models.GetPaymentChannelsRequest{
-				Amount: okParams.MinAmount.InexactFloat64(),
+				Amount:   okParams.MinAmount.InexactFloat64(),
+				Currency: okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(okParams.MinAmount)).Times(1).Return(emptyRes, nil)
+				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(&repository.GetAvailablePaymentChannelsParams{MinAmount: okParams.MinAmount, Currency: okParams.Currency})).Times(1).Return(emptyRes, nil)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetPaymentChannelsResponse, err error) {
 				require.NoError(t, err)
@@ -71,10 +78,11 @@ func Test_MOCK_GET_AVAILABLE_CHANNELS(t *testing.T) {
 		{
 			tname: "ERR_NOT_FOUND",
 			body: &models.GetPaymentChannelsRequest{
-				Amount: okParams.MinAmount.InexactFloat64(),
+				Amount:   okParams.MinAmount.InexactFloat64(),
+				Currency: okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(okParams.MinAmount)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(&repository.GetAvailablePaymentChannelsParams{MinAmount: okParams.MinAmount, Currency: okParams.Currency})).Times(1).Return(nil, pgx.ErrNoRows)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetPaymentChannelsResponse, err error) {
 				require.Error(t, err)
@@ -84,10 +92,11 @@ func Test_MOCK_GET_AVAILABLE_CHANNELS(t *testing.T) {
 		{
 			tname: "ERR_INTERNAL_SERVER_ERROR",
 			body: &models.GetPaymentChannelsRequest{
-				Amount: okParams.MinAmount.InexactFloat64(),
+				Amount:   okParams.MinAmount.InexactFloat64(),
+				Currency: okParams.Currency,
 			},
 			stubs: func(store *mock.MockRepository) {
-				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(okParams.MinAmount)).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().GetAvailablePaymentChannels(gomock.Any(), gomock.Eq(&repository.GetAvailablePaymentChannelsParams{MinAmount: okParams.MinAmount, Currency: okParams.Currency})).Times(1).Return(nil, sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, res *pb.GetPaymentChannelsResponse, err error) {
 				require.Error(t, err)
@@ -108,7 +117,7 @@ func Test_MOCK_GET_AVAILABLE_CHANNELS(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
 			tc.stubs(store)
 
 			actualBody, actualError := u.GetAvailableChannels(context.TODO(), tc.body)
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -130,7 +131,7 @@ func Test_MOCK_GET_PAYMENT_BY_ID(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
 			tc.stubs(store)
 
 			actualBody, actualError := u.GetByID(context.TODO(), tc.body)
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetByReferenceID returns every payment attempt made for a merchant reference id, newest first.
+func (u *usecaseImpl) GetByReferenceID(ctx context.Context, arg *models.GetByReferenceIDPaymentRequest) (*pb.GetByReferenceIDPaymentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetByReferenceID")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.ListPaymentMethodsByReferenceID(ctx, arg.PaymentReferenceId)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListPaymentMethodsByReferenceID.err", err)
+	}
+
+	if len(res) == 0 {
+		return nil, u.errorResponse(span, "u.repo.ListPaymentMethodsByReferenceID.empty", pgx.ErrNoRows)
+	}
+
+	return &pb.GetByReferenceIDPaymentResponse{
+		List: mapper.PaymentsToDto(res),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_GET_PAYMENT_BY_REFERENCE_ID(t *testing.T) {
+	_, paymentExpired := createRandomVirtualAccountBankPayment(t)
+	paymentExpired.PaymentStatus = payment.STATUS_EXPIRED
+
+	_, paymentRetry := createRandomVirtualAccountBankPayment(t)
+	paymentRetry.PaymentReferenceID = paymentExpired.PaymentReferenceID
+
+	testCases := []struct {
+		tname         string
+		body          *models.GetByReferenceIDPaymentRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.GetByReferenceIDPaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.GetByReferenceIDPaymentRequest{
+				PaymentReferenceId: paymentExpired.PaymentReferenceID,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethodsByReferenceID(gomock.Any(), gomock.Eq(paymentExpired.PaymentReferenceID)).Times(1).Return([]*repository.PaymentMethod{paymentRetry, paymentExpired}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetByReferenceIDPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 2)
+
+				require.Equal(t, paymentRetry.PaymentMethodID, res.GetList()[0].GetPaymentMethodId())
+				require.Equal(t, payment.STATUS_ACTIVE, res.GetList()[0].GetPaymentStatus())
+				require.Equal(t, paymentExpired.PaymentMethodID, res.GetList()[1].GetPaymentMethodId())
+				require.Equal(t, payment.STATUS_EXPIRED, res.GetList()[1].GetPaymentStatus())
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			body: &models.GetByReferenceIDPaymentRequest{
+				PaymentReferenceId: paymentExpired.PaymentReferenceID,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethodsByReferenceID(gomock.Any(), gomock.Eq(paymentExpired.PaymentReferenceID)).Times(1).Return([]*repository.PaymentMethod{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetByReferenceIDPaymentResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			body: &models.GetByReferenceIDPaymentRequest{
+				PaymentReferenceId: paymentExpired.PaymentReferenceID,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethodsByReferenceID(gomock.Any(), gomock.Eq(paymentExpired.PaymentReferenceID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetByReferenceIDPaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, gateway.NewFakeProvider())
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store)
+
+			res, err := u.GetByReferenceID(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetCustomer returns the customer a payment gateway customer id belongs to, the gRPC auth interceptor
+// uses it to tie a payment customer id back to the app uid carried by the caller's token.
+func (u *usecaseImpl) GetCustomer(ctx context.Context, arg *models.GetCustomerRequest) (*pb.Customer, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetCustomer")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	customer, err := u.repo.GetCustomerByPaymentCustomerID(ctx, arg.PaymentCustomerId)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetCustomerByPaymentCustomerID.err", err)
+	}
+
+	return mapper.CustomerToDto(customer), nil
+}
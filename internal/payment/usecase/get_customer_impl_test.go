@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_GET_CUSTOMER(t *testing.T) {
+	_, customer := createRandomCustomer(t)
+
+	body := &models.GetCustomerRequest{
+		PaymentCustomerId: customer.PaymentCustomerID,
+	}
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.Customer, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerByPaymentCustomerID(gomock.Any(), gomock.Eq(customer.PaymentCustomerID)).Times(1).Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.Customer, err error) {
+				require.NoError(t, err)
+				require.Equal(t, customer.Uid, res.GetUid())
+				require.Equal(t, customer.CustomerAppID, res.GetCustomerAppId())
+				require.Equal(t, customer.PaymentCustomerID, res.GetPaymentCustomerId())
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerByPaymentCustomerID(gomock.Any(), gomock.Eq(customer.PaymentCustomerID)).Times(1).Return(nil, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.Customer, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerByPaymentCustomerID(gomock.Any(), gomock.Eq(customer.PaymentCustomerID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.Customer, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			actualBody, actualError := u.GetCustomer(context.TODO(), body)
+			tc.checkResponse(t, actualBody, actualError)
+		})
+	}
+}
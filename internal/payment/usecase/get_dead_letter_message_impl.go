@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (u *usecaseImpl) GetDeadLetterMessage(ctx context.Context, arg *models.GetDeadLetterMessageRequest) (*pb.GetDeadLetterMessageResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetDeadLetterMessage")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetDeadLetterMessage(ctx, arg.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetDeadLetterMessage.err", err)
+	}
+
+	headers, err := models.DecodeKafkaHeaders(res.Headers)
+	if err != nil {
+		return nil, u.errorResponse(span, "models.DecodeKafkaHeaders.err", err)
+	}
+
+	return &pb.GetDeadLetterMessageResponse{
+		DeadLetterMessage: mapper.DeadLetterMessageToDto(res, headers),
+	}, nil
+}
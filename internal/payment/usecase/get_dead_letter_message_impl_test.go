@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_GET_DEAD_LETTER_MESSAGE(t *testing.T) {
+	msg := createRandomDeadLetterMessage(t, time.Now())
+	body := &models.GetDeadLetterMessageRequest{Uid: msg.Uid}
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.GetDeadLetterMessageResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(msg, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetDeadLetterMessageResponse, err error) {
+				require.NoError(t, err)
+
+				dlm := res.GetDeadLetterMessage()
+				require.Equal(t, msg.Uid, dlm.GetUid())
+				require.Equal(t, msg.SourceTopic, dlm.GetSourceTopic())
+				require.Equal(t, msg.ErrorMessage, dlm.GetErrorMessage())
+				require.Equal(t, msg.Attempts, dlm.GetAttempts())
+				require.Len(t, dlm.GetHeaders(), 2)
+				require.Equal(t, "x-error", dlm.GetHeaders()[0].GetKey())
+				require.Nil(t, dlm.ReplayedAt)
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetDeadLetterMessageResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetDeadLetterMessageResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			res, err := u.GetDeadLetterMessage(context.TODO(), body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (u *usecaseImpl) GetLedgerBalances(ctx context.Context, arg *models.GetLedgerBalancesRequest) (*pb.GetLedgerBalancesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetLedgerBalances")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.ListLedgerAccountBalances(ctx, &repository.ListLedgerAccountBalancesParams{
+		Currency:     toPgText(arg.Currency),
+		AccountCode:  toPgText(arg.AccountCode),
+		AccountOwner: toPgText(arg.AccountOwner),
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListLedgerAccountBalances.err", err)
+	}
+
+	return &pb.GetLedgerBalancesResponse{
+		List: mapper.LedgerAccountBalancesToDto(res),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_GET_LEDGER_BALANCES(t *testing.T) {
+	currency := payment.DEFAULT_CURRENCY
+	subAccountID := helper.RandomString(24)
+
+	clearing := &repository.ListLedgerAccountBalancesRow{
+		Uid:          helper.RandomString(26),
+		AccountCode:  payment.LEDGER_ACCOUNT_GATEWAY_CLEARING,
+		AccountType:  payment.LEDGER_ACCOUNT_TYPE_ASSET,
+		Currency:     currency,
+		DebitAmount:  decimal.NewFromInt(100000),
+		CreditAmount: decimal.NewFromInt(25000),
+	}
+
+	subAccountPayable := &repository.ListLedgerAccountBalancesRow{
+		Uid:          helper.RandomString(26),
+		AccountCode:  payment.LEDGER_ACCOUNT_SUB_ACCOUNT_PAYABLE,
+		AccountOwner: subAccountID,
+		AccountType:  payment.LEDGER_ACCOUNT_TYPE_LIABILITY,
+		Currency:     currency,
+		DebitAmount:  decimal.NewFromInt(25000),
+		CreditAmount: decimal.NewFromInt(100000),
+	}
+
+	testCases := []struct {
+		tname         string
+		body          *models.GetLedgerBalancesRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.GetLedgerBalancesResponse, err error)
+	}{
+		{
+			tname: "OK",
+			body:  &models.GetLedgerBalancesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListLedgerAccountBalances(gomock.Any(), gomock.Eq(&repository.ListLedgerAccountBalancesParams{})).Times(1).
+					Return([]*repository.ListLedgerAccountBalancesRow{clearing, subAccountPayable}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetLedgerBalancesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 2)
+
+				// an asset grows with its debits, a liability with its credits.
+				require.Equal(t, payment.LEDGER_ACCOUNT_GATEWAY_CLEARING, res.GetList()[0].GetAccountCode())
+				require.Equal(t, float64(75000), res.GetList()[0].GetBalance())
+				require.Equal(t, payment.ToMinorUnits(decimal.NewFromInt(75000), currency), res.GetList()[0].GetBalanceMinor())
+
+				require.Equal(t, subAccountID, res.GetList()[1].GetAccountOwner())
+				require.Equal(t, float64(75000), res.GetList()[1].GetBalance())
+				require.Equal(t, float64(100000), res.GetList()[1].GetCreditAmount())
+			},
+		},
+		{
+			tname: "OK_FILTERED_BY_OWNER",
+			body: &models.GetLedgerBalancesRequest{
+				Currency:     &currency,
+				AccountOwner: &subAccountID,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListLedgerAccountBalances(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListLedgerAccountBalancesParams) ([]*repository.ListLedgerAccountBalancesRow, error) {
+						require.Equal(t, currency, arg.Currency.String)
+						require.Equal(t, subAccountID, arg.AccountOwner.String)
+						require.False(t, arg.AccountCode.Valid)
+						return []*repository.ListLedgerAccountBalancesRow{subAccountPayable}, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetLedgerBalancesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 1)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			body:  &models.GetLedgerBalancesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListLedgerAccountBalances(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetLedgerBalancesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			actualBody, actualError := u.GetLedgerBalances(context.TODO(), tc.body)
+			tc.checkResponse(t, actualBody, actualError)
+		})
+	}
+}
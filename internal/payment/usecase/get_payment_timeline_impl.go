@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+// GetPaymentTimeline returns a payment along with the history of its status, oldest change first.
+func (u *usecaseImpl) GetPaymentTimeline(ctx context.Context, arg *models.GetPaymentTimelineRequest) (*pb.GetPaymentTimelineResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetPaymentTimeline")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	pm, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	history, err := u.repo.ListPaymentStatusHistoryByPaymentMethodUid(ctx, pm.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListPaymentStatusHistoryByPaymentMethodUid.err", err)
+	}
+
+	return &pb.GetPaymentTimelineResponse{
+		PaymentMethod: mapper.PaymentToDto(pm),
+		List:          mapper.PaymentStatusHistoryToDto(history),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_GET_PAYMENT_TIMELINE(t *testing.T) {
+	_, paymentMethod := createRandomVirtualAccountBankPayment(t)
+
+	arg := repository.GetPaymentMethodCustomerParams{
+		PaymentCustomerID: paymentMethod.PaymentCustomerID,
+		PaymentMethodID:   paymentMethod.PaymentMethodID,
+	}
+
+	history := []*repository.PaymentStatusHistory{
+		{
+			Uid:              helper.RandomString(26),
+			PaymentMethodUid: paymentMethod.Uid,
+			PreviousStatus:   payment.STATUS_ACTIVE,
+			NewStatus:        payment.STATUS_PENDING,
+			PaymentEvent:     "payment.pending",
+			EventID:          pgtype.Text{String: helper.RandomString(32), Valid: true},
+			GatewayUpdatedAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+			CreatedAt:        pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+		},
+		{
+			Uid:              helper.RandomString(26),
+			PaymentMethodUid: paymentMethod.Uid,
+			PreviousStatus:   payment.STATUS_PENDING,
+			NewStatus:        payment.STATUS_FAILED,
+			FailureCode:      pgtype.Text{String: "INSUFFICIENT_BALANCE", Valid: true},
+			PaymentEvent:     "payment.failed",
+			CreatedAt:        pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+	}
+
+	body := &models.GetPaymentTimelineRequest{
+		PaymentCustomerId: paymentMethod.PaymentCustomerID,
+		PaymentMethodId:   paymentMethod.PaymentMethodID,
+	}
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.GetPaymentTimelineResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListPaymentStatusHistoryByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return(history, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetPaymentTimelineResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, paymentMethod.PaymentMethodID, res.GetPaymentMethod().GetPaymentMethodId())
+				require.Len(t, res.GetList(), 2)
+
+				pending := res.GetList()[0]
+				require.Equal(t, payment.STATUS_ACTIVE, pending.GetPreviousStatus())
+				require.Equal(t, payment.STATUS_PENDING, pending.GetNewStatus())
+				require.Equal(t, history[0].EventID.String, pending.GetEventId())
+				require.Nil(t, pending.FailureCode)
+				require.NotNil(t, pending.GatewayUpdatedAt)
+
+				failed := res.GetList()[1]
+				require.Equal(t, payment.STATUS_FAILED, failed.GetNewStatus())
+				require.Equal(t, "INSUFFICIENT_BALANCE", failed.GetFailureCode())
+				require.Equal(t, "payment.failed", failed.GetPaymentEvent())
+				require.Nil(t, failed.EventId)
+				require.Nil(t, failed.GatewayUpdatedAt)
+			},
+		},
+		{
+			tname: "OK_NO_HISTORY",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListPaymentStatusHistoryByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return([]*repository.PaymentStatusHistory{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetPaymentTimelineResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res.GetPaymentMethod())
+				require.Empty(t, res.GetList())
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().ListPaymentStatusHistoryByPaymentMethodUid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetPaymentTimelineResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListPaymentStatusHistoryByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetPaymentTimelineResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			actualBody, actualError := u.GetPaymentTimeline(context.TODO(), body)
+			tc.checkResponse(t, actualBody, actualError)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+)
+
+// LinkDirectDebit links a customer bank account through the gateway and stores the link as a
+// MULTIPLE_USE payment method, it can be charged once ValidateDirectDebitLink has activated it.
+func (u *usecaseImpl) LinkDirectDebit(ctx context.Context, arg *models.LinkDirectDebitRequest) (*pb.LinkDirectDebitResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.LinkDirectDebit")
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	phoneNumber, err := u.validateLinkDirectDebitParams(span, arg)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	customer, err := u.processGetCustomer(ctx, span, *arg.CustomerUid, arg.CustomerName, phoneNumber)
+	if err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	channel, err := u.repo.GetPaymentChannelByNameAndCurrency(ctx, &repository.GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   arg.PaymentChannel,
+		Currency: arg.Currency,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = unierror.ErrChannelCurrencyNotSupported
+		}
+
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "u.repo.GetPaymentChannelByNameAndCurrency.err", arg.PaymentChannel),
+			err,
+		)
+	}
+
+	if channel.PcType != payment.METHODE_TYPE_DIRECT_DEBIT || !channel.IsActive || !channel.IsAvailable {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "channel.PcType", channel.PcType),
+			unierror.ErrUnsupportedPaymentChannel,
+		)
+	}
+
+	if channel.Country != arg.Country {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "channel.Country", arg.Country),
+			unierror.ErrUnsupportedCountry,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	link, err := provider.LinkDirectDebit(ctx, &gateway.LinkDirectDebitParams{
+		CustomerPaymentID: customer.PaymentCustomerID,
+		ReferenceID:       arg.PaymentReferenceId,
+		Description:       arg.PaymentDescription,
+		Country:           channel.Country,
+		ChannelCode:       arg.PaymentChannel,
+		SuccessReturnURL:  arg.PaymentSuccessReturnUrl,
+		FailureReturnURL:  arg.PaymentFailureReturnUrl,
+		MobileNumber:      arg.AccountMobileNumber,
+		CardLastFour:      arg.AccountCardLastFour,
+		CardExpiry:        arg.AccountCardExpiry,
+		Email:             arg.AccountEmail,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.LinkDirectDebit.err", err)
+	}
+
+	// a link carries no amount, its currency is the one every charge of it is made in.
+	link.Currency = channel.Currency
+
+	res, err := u.repo.CreatePaymentTx(ctx, &repository.CreatePaymentTxParams{Payment: link})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CreatePaymentTx.err", err)
+	}
+
+	u.repo.PutCache(ctx, res.Payment)
+	return &pb.LinkDirectDebitResponse{
+		Customer:      mapper.CustomerToDto(customer),
+		PaymentMethod: mapper.PaymentToDto(res.Payment),
+	}, nil
+}
+
+func (u *usecaseImpl) validateLinkDirectDebitParams(span opentracing.Span, arg *models.LinkDirectDebitRequest) (string, error) {
+	res, err := helper.ValidatePhoneNumber(arg.CustomerPhoneNumber)
+	if err != nil {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.ValidatePhoneNumber.err", arg.CustomerPhoneNumber),
+			unierror.ErrInvalidCustomerPhoneNumberInput,
+		)
+	}
+
+	if arg.PaymentReferenceId == "" {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetPaymentReferenceId", arg.PaymentReferenceId),
+			unierror.ErrReferenceIDShouldNotBeEmpty,
+		)
+	}
+
+	if !payment.IsSupportedCurrency(arg.Currency) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsSupportedCurrency", arg.Currency),
+			unierror.ErrUnsupportedCurrency,
+		)
+	}
+
+	if !helper.IsValidURL(arg.PaymentSuccessReturnUrl) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.IsValidURL", arg.PaymentSuccessReturnUrl),
+			unierror.ErrInvalidSuccessURL,
+		)
+	}
+
+	if !helper.IsValidURL(arg.PaymentFailureReturnUrl) {
+		return "", u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "helper.IsValidURL", arg.PaymentFailureReturnUrl),
+			unierror.ErrInvalidFailureURL,
+		)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_LINK_DIRECT_DEBIT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomEwalletPayment(t)
+
+	paymentParamsOK.ChannelCode = "BCA_ONEKLIK"
+	_, channelOK := createRandomAvailablePaymentChannel(t, payment.METHODE_TYPE_DIRECT_DEBIT, paymentParamsOK)
+
+	channelParamsOK := &repository.GetPaymentChannelByNameAndCurrencyParams{
+		Pcname:   paymentParamsOK.ChannelCode,
+		Currency: payment.CURRENCY_IDR,
+	}
+
+	testCases := []struct {
+		tname         string
+		channel       string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.LinkDirectDebitResponse, err error)
+	}{
+		{
+			tname:   "OK",
+			channel: paymentParamsOK.ChannelCode,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+						require.Equal(t, custRespOK.PaymentCustomerID, arg.Payment.CustomerID)
+						require.Equal(t, payment.METHODE_TYPE_DIRECT_DEBIT, arg.Payment.Type)
+						require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, arg.Payment.Reusability)
+						require.Equal(t, payment.STATUS_REQUIRES_ACTION, arg.Payment.Status)
+						require.Equal(t, channelOK.Currency, arg.Payment.Currency)
+						require.True(t, arg.Payment.Amount.IsZero())
+
+						res := *paymentRespOK
+						res.PaymentMethodID = arg.Payment.ID
+						res.PaymentCustomerID = arg.Payment.CustomerID
+						res.PaymentType = arg.Payment.Type
+						res.PaymentStatus = arg.Payment.Status
+						res.PaymentReusability = arg.Payment.Reusability
+						res.PaymentChannel = arg.Payment.Channel
+						return repository.CreatePaymentTxResult{Payment: &res}, nil
+					},
+				)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *pb.LinkDirectDebitResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, custRespOK.PaymentCustomerID, res.GetCustomer().GetPaymentCustomerId())
+				require.Equal(t, payment.STATUS_REQUIRES_ACTION, res.GetPaymentMethod().GetPaymentStatus())
+				require.Equal(t, payment.USAGE_TYPE_MULTIPLE_USE, res.GetPaymentMethod().GetPaymentReusability())
+			},
+		},
+		{
+			tname:   "ERR_CHANNEL_CURRENCY_NOT_SUPPORTED",
+			channel: paymentParamsOK.ChannelCode,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LinkDirectDebitResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrChannelCurrencyNotSupported)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:   "ERR_NOT_A_DIRECT_DEBIT_CHANNEL",
+			channel: "OVO",
+			stubs: func(store *mock.MockRepository) {
+				ewalletChannel := *channelOK
+				ewalletChannel.Pcname = "OVO"
+				ewalletChannel.PcType = payment.METHODE_TYPE_EWALLET
+
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(&ewalletChannel, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LinkDirectDebitResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrUnsupportedPaymentChannel)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:   "ERR_CREATE_PAYMENT_TX_INTERNAL_SERVER_ERROR",
+			channel: paymentParamsOK.ChannelCode,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetPaymentChannelByNameAndCurrency(gomock.Any(), gomock.Any()).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreatePaymentTxResult{}, sql.ErrConnDone)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LinkDirectDebitResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store)
+
+			res, err := u.LinkDirectDebit(context.TODO(), &models.LinkDirectDebitRequest{
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentReferenceId:      helper.RandomString(26),
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentChannel:          tc.channel,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+				Currency:                payment.CURRENCY_IDR,
+				Country:                 payment.COUNTRY_ID,
+				AccountMobileNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ListDeadLetterMessages lists the quarantined messages, the ones dead-lettered most recently first.
+func (u *usecaseImpl) ListDeadLetterMessages(ctx context.Context, arg *models.ListDeadLetterMessagesRequest) (*pb.ListDeadLetterMessagesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ListDeadLetterMessages")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	deadLetteredFrom := time.Unix(0, 0)
+	if arg.DeadLetteredFrom != nil {
+		deadLetteredFrom = *arg.DeadLetteredFrom
+	}
+
+	deadLetteredTo := time.Now()
+	if arg.DeadLetteredTo != nil {
+		deadLetteredTo = *arg.DeadLetteredTo
+	}
+
+	if deadLetteredFrom.After(deadLetteredTo) {
+		return nil, u.errorResponse(span, "deadLetteredFrom.After.deadLetteredTo", unierror.ErrInvalidTimeRange)
+	}
+
+	cursor := listCursor{Page: 1}
+	if arg.Cursor != nil {
+		res, err := decodeListCursor(*arg.Cursor)
+		if err != nil {
+			return nil, u.errorResponse(span, "decodeListCursor.err", err)
+		}
+		cursor = *res
+	}
+
+	pq := helper.NewPaginationQuery(int(arg.Size), cursor.Page)
+
+	listArg := repository.ListDeadLetterMessagesParams{
+		SourceTopic:      toPgText(arg.SourceTopic),
+		DeadLetterStatus: toPgText(arg.DeadLetterStatus),
+		DeadLetteredFrom: pgtype.Timestamptz{
+			Time:  deadLetteredFrom,
+			Valid: true,
+		},
+		DeadLetteredTo: pgtype.Timestamptz{
+			Time:  deadLetteredTo,
+			Valid: true,
+		},
+		BeforeDeadLetteredAt: pgtype.Timestamptz{
+			InfinityModifier: pgtype.Infinity,
+			Valid:            true,
+		},
+		PageSize: int32(pq.GetSize()) + 1,
+	}
+
+	if arg.Cursor != nil {
+		listArg.BeforeDeadLetteredAt = pgtype.Timestamptz{
+			Time:  cursor.CreatedAt,
+			Valid: true,
+		}
+		listArg.BeforeUid = cursor.Uid
+	}
+
+	list, err := u.repo.ListDeadLetterMessages(ctx, &listArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListDeadLetterMessages.err", err)
+	}
+
+	totalCount, err := u.repo.CountDeadLetterMessages(ctx, &repository.CountDeadLetterMessagesParams{
+		SourceTopic:      listArg.SourceTopic,
+		DeadLetterStatus: listArg.DeadLetterStatus,
+		DeadLetteredFrom: listArg.DeadLetteredFrom,
+		DeadLetteredTo:   listArg.DeadLetteredTo,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CountDeadLetterMessages.err", err)
+	}
+
+	hasMore := len(list) > pq.GetSize()
+	if hasMore {
+		list = list[:pq.GetSize()]
+	}
+
+	pagination := helper.NewPaginationTimeRangeResponse(deadLetteredFrom.Unix(), deadLetteredTo.Unix(), totalCount, pq)
+	pagination.HasMore = hasMore
+
+	var nextCursor *string
+	if hasMore {
+		last := list[len(list)-1]
+
+		res, err := encodeListCursor(&listCursor{
+			CreatedAt: last.DeadLetteredAt.Time,
+			Uid:       last.Uid,
+			Page:      cursor.Page + 1,
+		})
+		if err != nil {
+			return nil, u.errorResponse(span, "encodeListCursor.err", err)
+		}
+		nextCursor = &res
+	}
+
+	res := make([]*pb.DeadLetterMessage, 0, len(list))
+	for _, msg := range list {
+		headers, err := models.DecodeKafkaHeaders(msg.Headers)
+		if err != nil {
+			return nil, u.errorResponse(span, "models.DecodeKafkaHeaders.err", err)
+		}
+
+		res = append(res, mapper.DeadLetterMessageToDto(msg, headers))
+	}
+
+	return &pb.ListDeadLetterMessagesResponse{
+		List:       res,
+		Pagination: mapper.PaginationTimeRangeToDto(pagination, nextCursor),
+	}, nil
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_LIST_DEAD_LETTER_MESSAGES(t *testing.T) {
+	list := make([]*repository.DeadLetterMessage, 0, 3)
+	for i := 0; i < 3; i++ {
+		list = append(list, createRandomDeadLetterMessage(t, time.Now().Add(-time.Duration(i)*time.Minute)))
+	}
+
+	deadLetterStatus := payment.DEAD_LETTER_STATUS_QUARANTINED
+
+	cursor, err := encodeListCursor(&listCursor{
+		CreatedAt: list[1].DeadLetteredAt.Time,
+		Uid:       list[1].Uid,
+		Page:      2,
+	})
+	require.NoError(t, err)
+
+	invalidCursor := helper.RandomString(12)
+	deadLetteredFrom := time.Now()
+	deadLetteredTo := deadLetteredFrom.Add(-time.Hour)
+
+	testCases := []struct {
+		tname         string
+		body          *models.ListDeadLetterMessagesRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error)
+	}{
+		{
+			tname: "OK_FIRST_PAGE",
+			body: &models.ListDeadLetterMessagesRequest{
+				DeadLetterStatus: &deadLetterStatus,
+				Size:             2,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListDeadLetterMessagesParams) ([]*repository.DeadLetterMessage, error) {
+						require.Equal(t, deadLetterStatus, arg.DeadLetterStatus.String)
+						require.True(t, arg.DeadLetterStatus.Valid)
+						require.False(t, arg.SourceTopic.Valid)
+						require.Equal(t, pgtype.Infinity, arg.BeforeDeadLetteredAt.InfinityModifier)
+						require.Empty(t, arg.BeforeUid)
+						require.Equal(t, int32(3), arg.PageSize)
+						return list, nil
+					},
+				)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 2)
+				require.Equal(t, list[0].Uid, res.GetList()[0].GetUid())
+				require.Equal(t, list[0].DeadLetterStatus, res.GetList()[0].GetDeadLetterStatus())
+				require.Equal(t, list[0].SourceTopic, res.GetList()[0].GetSourceTopic())
+				require.Len(t, res.GetList()[0].GetHeaders(), 2)
+				require.Equal(t, list[1].Uid, res.GetList()[1].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(3), pagination.GetTotalCount())
+				require.Equal(t, int64(1), pagination.GetPage())
+				require.True(t, pagination.GetHasMore())
+				require.Equal(t, cursor, pagination.GetNextCursor())
+			},
+		},
+		{
+			tname: "OK_LAST_PAGE",
+			body: &models.ListDeadLetterMessagesRequest{
+				DeadLetterStatus: &deadLetterStatus,
+				Size:             2,
+				Cursor:           &cursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListDeadLetterMessagesParams) ([]*repository.DeadLetterMessage, error) {
+						require.True(t, list[1].DeadLetteredAt.Time.Equal(arg.BeforeDeadLetteredAt.Time))
+						require.Equal(t, list[1].Uid, arg.BeforeUid)
+						return list[2:], nil
+					},
+				)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 1)
+				require.Equal(t, list[2].Uid, res.GetList()[0].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(2), pagination.GetPage())
+				require.False(t, pagination.GetHasMore())
+				require.Nil(t, pagination.NextCursor)
+			},
+		},
+		{
+			tname: "ERR_INVALID_CURSOR",
+			body: &models.ListDeadLetterMessagesRequest{
+				Cursor: &invalidCursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidPaginationCursor)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_TIME_RANGE",
+			body: &models.ListDeadLetterMessagesRequest{
+				DeadLetteredFrom: &deadLetteredFrom,
+				DeadLetteredTo:   &deadLetteredTo,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidTimeRange)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_LIST_INTERNAL_SERVER_ERROR",
+			body:  &models.ListDeadLetterMessagesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_COUNT_INTERNAL_SERVER_ERROR",
+			body:  &models.ListDeadLetterMessagesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).Return(list, nil)
+				store.EXPECT().CountDeadLetterMessages(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDeadLetterMessagesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			res, err := u.ListDeadLetterMessages(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
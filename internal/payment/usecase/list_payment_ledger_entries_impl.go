@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (u *usecaseImpl) ListPaymentLedgerEntries(ctx context.Context, arg *models.ListPaymentLedgerEntriesRequest) (*pb.ListPaymentLedgerEntriesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ListPaymentLedgerEntries")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	pm, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	entries, err := u.repo.ListLedgerEntriesByPaymentMethodUid(ctx, pm.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListLedgerEntriesByPaymentMethodUid.err", err)
+	}
+
+	postings, err := u.repo.ListLedgerPostingsByPaymentMethodUid(ctx, pm.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListLedgerPostingsByPaymentMethodUid.err", err)
+	}
+
+	return &pb.ListPaymentLedgerEntriesResponse{
+		List: mapper.LedgerEntriesToDto(entries, postings),
+	}, nil
+}
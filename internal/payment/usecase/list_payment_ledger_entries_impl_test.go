@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_LIST_PAYMENT_LEDGER_ENTRIES(t *testing.T) {
+	_, paymentMethod := createRandomVirtualAccountBankPayment(t)
+
+	arg := repository.GetPaymentMethodCustomerParams{
+		PaymentCustomerID: paymentMethod.PaymentCustomerID,
+		PaymentMethodID:   paymentMethod.PaymentMethodID,
+	}
+
+	entry := &repository.LedgerEntry{
+		Uid:              helper.RandomString(26),
+		IdempotencyKey:   helper.StringBuilder(payment.LEDGER_ENTRY_TYPE_PAYMENT, ":", paymentMethod.Uid),
+		EntryType:        payment.LEDGER_ENTRY_TYPE_PAYMENT,
+		PaymentMethodUid: paymentMethod.Uid,
+		Currency:         paymentMethod.Currency,
+		CreatedAt:        paymentMethod.CreatedAt,
+	}
+
+	postings := []*repository.ListLedgerPostingsByPaymentMethodUidRow{
+		{
+			Uid:         helper.RandomString(26),
+			EntryUid:    entry.Uid,
+			Direction:   payment.LEDGER_DIRECTION_DEBIT,
+			Amount:      paymentMethod.PaymentAmount,
+			Currency:    paymentMethod.Currency,
+			AccountCode: payment.LEDGER_ACCOUNT_GATEWAY_CLEARING,
+			AccountType: payment.LEDGER_ACCOUNT_TYPE_ASSET,
+		},
+		{
+			Uid:         helper.RandomString(26),
+			EntryUid:    entry.Uid,
+			Direction:   payment.LEDGER_DIRECTION_CREDIT,
+			Amount:      paymentMethod.PaymentAmount,
+			Currency:    paymentMethod.Currency,
+			AccountCode: payment.LEDGER_ACCOUNT_MERCHANT_PAYABLE,
+			AccountType: payment.LEDGER_ACCOUNT_TYPE_LIABILITY,
+		},
+	}
+
+	body := &models.ListPaymentLedgerEntriesRequest{
+		PaymentCustomerId: paymentMethod.PaymentCustomerID,
+		PaymentMethodId:   paymentMethod.PaymentMethodID,
+	}
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.ListPaymentLedgerEntriesResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListLedgerEntriesByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return([]*repository.LedgerEntry{entry}, nil)
+				store.EXPECT().ListLedgerPostingsByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return(postings, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentLedgerEntriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 1)
+
+				entry := res.GetList()[0]
+				require.Equal(t, payment.LEDGER_ENTRY_TYPE_PAYMENT, entry.GetEntryType())
+				require.Nil(t, entry.RefundUid)
+				require.Len(t, entry.GetPostings(), 2)
+				require.Equal(t, payment.LEDGER_DIRECTION_DEBIT, entry.GetPostings()[0].GetDirection())
+				require.Equal(t, payment.LEDGER_DIRECTION_CREDIT, entry.GetPostings()[1].GetDirection())
+				require.Equal(t, entry.GetPostings()[0].GetAmountMinor(), entry.GetPostings()[1].GetAmountMinor())
+			},
+		},
+		{
+			tname: "OK_NOT_BOOKED",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListLedgerEntriesByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return([]*repository.LedgerEntry{}, nil)
+				store.EXPECT().ListLedgerPostingsByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return([]*repository.ListLedgerPostingsByPaymentMethodUidRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentLedgerEntriesResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetList())
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().ListLedgerEntriesByPaymentMethodUid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListLedgerPostingsByPaymentMethodUid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentLedgerEntriesResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Eq(&arg)).Times(1).Return(paymentMethod, nil)
+				store.EXPECT().ListLedgerEntriesByPaymentMethodUid(gomock.Any(), gomock.Eq(paymentMethod.Uid)).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().ListLedgerPostingsByPaymentMethodUid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentLedgerEntriesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			actualBody, actualError := u.ListPaymentLedgerEntries(context.TODO(), body)
+			tc.checkResponse(t, actualBody, actualError)
+		})
+	}
+}
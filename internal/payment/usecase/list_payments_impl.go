@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// listCursor points at the last row of the previous page, lists are ordered newest
+// first so the next page starts right after it.
+type listCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	Uid       string    `json:"uid"`
+	Page      int       `json:"page"`
+}
+
+func (u *usecaseImpl) ListPayments(ctx context.Context, arg *models.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ListPayments")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	createdFrom := time.Unix(0, 0)
+	if arg.CreatedFrom != nil {
+		createdFrom = *arg.CreatedFrom
+	}
+
+	createdTo := time.Now()
+	if arg.CreatedTo != nil {
+		createdTo = *arg.CreatedTo
+	}
+
+	if createdFrom.After(createdTo) {
+		return nil, u.errorResponse(span, "createdFrom.After.createdTo", unierror.ErrInvalidTimeRange)
+	}
+
+	cursor := listCursor{Page: 1}
+	if arg.Cursor != nil {
+		res, err := decodeListCursor(*arg.Cursor)
+		if err != nil {
+			return nil, u.errorResponse(span, "decodeListCursor.err", err)
+		}
+		cursor = *res
+	}
+
+	pq := helper.NewPaginationQuery(int(arg.Size), cursor.Page)
+
+	listArg := repository.ListPaymentMethodsParams{
+		PaymentCustomerID:  toPgText(arg.PaymentCustomerId),
+		PaymentStatus:      toPgText(arg.PaymentStatus),
+		PaymentType:        toPgText(arg.PaymentType),
+		PaymentChannel:     toPgText(arg.PaymentChannel),
+		PaymentReferenceID: toPgText(arg.PaymentReferenceId),
+		CreatedFrom: pgtype.Timestamptz{
+			Time:  createdFrom,
+			Valid: true,
+		},
+		CreatedTo: pgtype.Timestamptz{
+			Time:  createdTo,
+			Valid: true,
+		},
+		BeforeCreatedAt: pgtype.Timestamptz{
+			InfinityModifier: pgtype.Infinity,
+			Valid:            true,
+		},
+		// one extra row tells whether there is a next page without relying on the count.
+		PageSize: int32(pq.GetSize()) + 1,
+	}
+
+	if arg.Cursor != nil {
+		listArg.BeforeCreatedAt = pgtype.Timestamptz{
+			Time:  cursor.CreatedAt,
+			Valid: true,
+		}
+		listArg.BeforeUid = cursor.Uid
+	}
+
+	list, err := u.repo.ListPaymentMethods(ctx, &listArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListPaymentMethods.err", err)
+	}
+
+	totalCount, err := u.repo.CountPaymentMethods(ctx, &repository.CountPaymentMethodsParams{
+		PaymentCustomerID:  listArg.PaymentCustomerID,
+		PaymentStatus:      listArg.PaymentStatus,
+		PaymentType:        listArg.PaymentType,
+		PaymentChannel:     listArg.PaymentChannel,
+		PaymentReferenceID: listArg.PaymentReferenceID,
+		CreatedFrom:        listArg.CreatedFrom,
+		CreatedTo:          listArg.CreatedTo,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CountPaymentMethods.err", err)
+	}
+
+	hasMore := len(list) > pq.GetSize()
+	if hasMore {
+		list = list[:pq.GetSize()]
+	}
+
+	pagination := helper.NewPaginationTimeRangeResponse(createdFrom.Unix(), createdTo.Unix(), totalCount, pq)
+	pagination.HasMore = hasMore
+
+	var nextCursor *string
+	if hasMore {
+		last := list[len(list)-1]
+
+		res, err := encodeListCursor(&listCursor{
+			CreatedAt: last.CreatedAt.Time,
+			Uid:       last.Uid,
+			Page:      cursor.Page + 1,
+		})
+		if err != nil {
+			return nil, u.errorResponse(span, "encodeListCursor.err", err)
+		}
+		nextCursor = &res
+	}
+
+	return &pb.ListPaymentsResponse{
+		List:       mapper.PaymentsToDto(list),
+		Pagination: mapper.PaginationTimeRangeToDto(pagination, nextCursor),
+	}, nil
+}
+
+func encodeListCursor(arg *listCursor) (string, error) {
+	payload, err := json.Marshal(arg)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func decodeListCursor(arg string) (*listCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(arg)
+	if err != nil {
+		return nil, unierror.ErrInvalidPaginationCursor
+	}
+
+	var res listCursor
+	if err := json.Unmarshal(payload, &res); err != nil {
+		return nil, unierror.ErrInvalidPaginationCursor
+	}
+
+	if res.Uid == "" || res.CreatedAt.IsZero() || res.Page < 1 {
+		return nil, unierror.ErrInvalidPaginationCursor
+	}
+
+	return &res, nil
+}
+
+func toPgText(arg *string) pgtype.Text {
+	if arg == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{
+		String: *arg,
+		Valid:  true,
+	}
+}
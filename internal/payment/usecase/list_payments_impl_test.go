@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_LIST_PAYMENTS(t *testing.T) {
+	list := make([]*repository.PaymentMethod, 0, 3)
+	for i := 0; i < 3; i++ {
+		_, pm := createRandomVirtualAccountBankPayment(t)
+		pm.CreatedAt = pgtype.Timestamptz{
+			Time:  time.Now().Add(-time.Duration(i) * time.Minute),
+			Valid: true,
+		}
+		list = append(list, pm)
+	}
+
+	customerID := list[0].PaymentCustomerID
+	status := payment.STATUS_SUCCEEDED
+
+	cursor, err := encodeListCursor(&listCursor{
+		CreatedAt: list[1].CreatedAt.Time,
+		Uid:       list[1].Uid,
+		Page:      2,
+	})
+	require.NoError(t, err)
+
+	invalidCursor := helper.RandomString(12)
+	createdFrom := time.Now()
+	createdTo := createdFrom.Add(-time.Hour)
+
+	testCases := []struct {
+		tname         string
+		body          *models.ListPaymentsRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.ListPaymentsResponse, err error)
+	}{
+		{
+			tname: "OK_FIRST_PAGE",
+			body: &models.ListPaymentsRequest{
+				PaymentCustomerId: &customerID,
+				PaymentStatus:     &status,
+				Size:              2,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+						require.Equal(t, customerID, arg.PaymentCustomerID.String)
+						require.True(t, arg.PaymentCustomerID.Valid)
+						require.Equal(t, status, arg.PaymentStatus.String)
+						require.False(t, arg.PaymentType.Valid)
+						require.False(t, arg.PaymentChannel.Valid)
+						require.False(t, arg.PaymentReferenceID.Valid)
+						require.Equal(t, pgtype.Infinity, arg.BeforeCreatedAt.InfinityModifier)
+						require.Empty(t, arg.BeforeUid)
+						require.Equal(t, int32(3), arg.PageSize)
+						return list, nil
+					},
+				)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 2)
+				require.Equal(t, list[0].Uid, res.GetList()[0].GetUid())
+				require.Equal(t, list[1].Uid, res.GetList()[1].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(3), pagination.GetTotalCount())
+				require.Equal(t, int64(2), pagination.GetTotalPages())
+				require.Equal(t, int64(1), pagination.GetPage())
+				require.Equal(t, int64(2), pagination.GetSize())
+				require.True(t, pagination.GetHasMore())
+				require.Equal(t, cursor, pagination.GetNextCursor())
+			},
+		},
+		{
+			tname: "OK_LAST_PAGE",
+			body: &models.ListPaymentsRequest{
+				PaymentCustomerId: &customerID,
+				PaymentStatus:     &status,
+				Size:              2,
+				Cursor:            &cursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+						require.True(t, list[1].CreatedAt.Time.Equal(arg.BeforeCreatedAt.Time))
+						require.Equal(t, list[1].Uid, arg.BeforeUid)
+						return list[2:], nil
+					},
+				)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 1)
+				require.Equal(t, list[2].Uid, res.GetList()[0].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(2), pagination.GetPage())
+				require.False(t, pagination.GetHasMore())
+				require.Nil(t, pagination.NextCursor)
+			},
+		},
+		{
+			tname: "OK_DEFAULT_SIZE_EMPTY",
+			body:  &models.ListPaymentsRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+						require.False(t, arg.PaymentCustomerID.Valid)
+						require.Equal(t, int32(11), arg.PageSize)
+						return []*repository.PaymentMethod{}, nil
+					},
+				)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetList())
+				require.Equal(t, int64(0), res.GetPagination().GetTotalCount())
+				require.Equal(t, int64(10), res.GetPagination().GetSize())
+				require.Equal(t, int64(0), res.GetPagination().GetFrom())
+				require.False(t, res.GetPagination().GetHasMore())
+			},
+		},
+		{
+			tname: "ERR_INVALID_CURSOR",
+			body: &models.ListPaymentsRequest{
+				Cursor: &invalidCursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidPaginationCursor)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_TIME_RANGE",
+			body: &models.ListPaymentsRequest{
+				CreatedFrom: &createdFrom,
+				CreatedTo:   &createdTo,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidTimeRange)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_LIST_INTERNAL_SERVER_ERROR",
+			body:  &models.ListPaymentsRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_COUNT_INTERNAL_SERVER_ERROR",
+			body:  &models.ListPaymentsRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(list, nil)
+				store.EXPECT().CountPaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListPaymentsResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, gateway.NewFakeProvider())
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store)
+
+			res, err := u.ListPayments(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
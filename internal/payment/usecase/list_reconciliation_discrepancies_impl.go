@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ListReconciliationDiscrepancies lists the discrepancies found by the reconciliation job, the ones
+// seen again most recently first.
+func (u *usecaseImpl) ListReconciliationDiscrepancies(ctx context.Context, arg *models.ListReconciliationDiscrepanciesRequest) (*pb.ListReconciliationDiscrepanciesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ListReconciliationDiscrepancies")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	detectedFrom := time.Unix(0, 0)
+	if arg.DetectedFrom != nil {
+		detectedFrom = *arg.DetectedFrom
+	}
+
+	detectedTo := time.Now()
+	if arg.DetectedTo != nil {
+		detectedTo = *arg.DetectedTo
+	}
+
+	if detectedFrom.After(detectedTo) {
+		return nil, u.errorResponse(span, "detectedFrom.After.detectedTo", unierror.ErrInvalidTimeRange)
+	}
+
+	cursor := listCursor{Page: 1}
+	if arg.Cursor != nil {
+		res, err := decodeListCursor(*arg.Cursor)
+		if err != nil {
+			return nil, u.errorResponse(span, "decodeListCursor.err", err)
+		}
+		cursor = *res
+	}
+
+	pq := helper.NewPaginationQuery(int(arg.Size), cursor.Page)
+
+	listArg := repository.ListReconciliationDiscrepanciesParams{
+		DiscrepancyType: toPgText(arg.DiscrepancyType),
+		PaymentMethodID: toPgText(arg.PaymentMethodId),
+		DetectedFrom: pgtype.Timestamptz{
+			Time:  detectedFrom,
+			Valid: true,
+		},
+		DetectedTo: pgtype.Timestamptz{
+			Time:  detectedTo,
+			Valid: true,
+		},
+		BeforeDetectedAt: pgtype.Timestamptz{
+			InfinityModifier: pgtype.Infinity,
+			Valid:            true,
+		},
+		PageSize: int32(pq.GetSize()) + 1,
+	}
+
+	if arg.Cursor != nil {
+		listArg.BeforeDetectedAt = pgtype.Timestamptz{
+			Time:  cursor.CreatedAt,
+			Valid: true,
+		}
+		listArg.BeforeUid = cursor.Uid
+	}
+
+	list, err := u.repo.ListReconciliationDiscrepancies(ctx, &listArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.ListReconciliationDiscrepancies.err", err)
+	}
+
+	totalCount, err := u.repo.CountReconciliationDiscrepancies(ctx, &repository.CountReconciliationDiscrepanciesParams{
+		DiscrepancyType: listArg.DiscrepancyType,
+		PaymentMethodID: listArg.PaymentMethodID,
+		DetectedFrom:    listArg.DetectedFrom,
+		DetectedTo:      listArg.DetectedTo,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CountReconciliationDiscrepancies.err", err)
+	}
+
+	hasMore := len(list) > pq.GetSize()
+	if hasMore {
+		list = list[:pq.GetSize()]
+	}
+
+	pagination := helper.NewPaginationTimeRangeResponse(detectedFrom.Unix(), detectedTo.Unix(), totalCount, pq)
+	pagination.HasMore = hasMore
+
+	var nextCursor *string
+	if hasMore {
+		last := list[len(list)-1]
+
+		res, err := encodeListCursor(&listCursor{
+			CreatedAt: last.LastDetectedAt.Time,
+			Uid:       last.Uid,
+			Page:      cursor.Page + 1,
+		})
+		if err != nil {
+			return nil, u.errorResponse(span, "encodeListCursor.err", err)
+		}
+		nextCursor = &res
+	}
+
+	return &pb.ListReconciliationDiscrepanciesResponse{
+		List:       mapper.ReconciliationDiscrepanciesToDto(list),
+		Pagination: mapper.PaginationTimeRangeToDto(pagination, nextCursor),
+	}, nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_LIST_RECONCILIATION_DISCREPANCIES(t *testing.T) {
+	list := make([]*repository.ReconciliationDiscrepancy, 0, 3)
+	for i := 0; i < 3; i++ {
+		list = append(list, createRandomReconciliationDiscrepancy(t, time.Now().Add(-time.Duration(i)*time.Minute)))
+	}
+
+	discrepancyType := payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH
+
+	cursor, err := encodeListCursor(&listCursor{
+		CreatedAt: list[1].LastDetectedAt.Time,
+		Uid:       list[1].Uid,
+		Page:      2,
+	})
+	require.NoError(t, err)
+
+	invalidCursor := helper.RandomString(12)
+	detectedFrom := time.Now()
+	detectedTo := detectedFrom.Add(-time.Hour)
+
+	testCases := []struct {
+		tname         string
+		body          *models.ListReconciliationDiscrepanciesRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error)
+	}{
+		{
+			tname: "OK_FIRST_PAGE",
+			body: &models.ListReconciliationDiscrepanciesRequest{
+				DiscrepancyType: &discrepancyType,
+				Size:            2,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListReconciliationDiscrepanciesParams) ([]*repository.ReconciliationDiscrepancy, error) {
+						require.Equal(t, discrepancyType, arg.DiscrepancyType.String)
+						require.True(t, arg.DiscrepancyType.Valid)
+						require.False(t, arg.PaymentMethodID.Valid)
+						require.Equal(t, pgtype.Infinity, arg.BeforeDetectedAt.InfinityModifier)
+						require.Empty(t, arg.BeforeUid)
+						require.Equal(t, int32(3), arg.PageSize)
+						return list, nil
+					},
+				)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 2)
+				require.Equal(t, list[0].Uid, res.GetList()[0].GetUid())
+				require.Equal(t, list[0].DiscrepancyType, res.GetList()[0].GetDiscrepancyType())
+				require.Equal(t, list[0].ExpectedAmount.InexactFloat64(), res.GetList()[0].GetExpectedAmount())
+				require.Equal(t, list[0].ReportedAmount.InexactFloat64(), res.GetList()[0].GetReportedAmount())
+				require.Equal(t, list[1].Uid, res.GetList()[1].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(3), pagination.GetTotalCount())
+				require.Equal(t, int64(1), pagination.GetPage())
+				require.True(t, pagination.GetHasMore())
+				require.Equal(t, cursor, pagination.GetNextCursor())
+			},
+		},
+		{
+			tname: "OK_LAST_PAGE",
+			body: &models.ListReconciliationDiscrepanciesRequest{
+				DiscrepancyType: &discrepancyType,
+				Size:            2,
+				Cursor:          &cursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.ListReconciliationDiscrepanciesParams) ([]*repository.ReconciliationDiscrepancy, error) {
+						require.True(t, list[1].LastDetectedAt.Time.Equal(arg.BeforeDetectedAt.Time))
+						require.Equal(t, list[1].Uid, arg.BeforeUid)
+						return list[2:], nil
+					},
+				)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetList(), 1)
+				require.Equal(t, list[2].Uid, res.GetList()[0].GetUid())
+
+				pagination := res.GetPagination()
+				require.Equal(t, int64(2), pagination.GetPage())
+				require.False(t, pagination.GetHasMore())
+				require.Nil(t, pagination.NextCursor)
+			},
+		},
+		{
+			tname: "ERR_INVALID_CURSOR",
+			body: &models.ListReconciliationDiscrepanciesRequest{
+				Cursor: &invalidCursor,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidPaginationCursor)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_TIME_RANGE",
+			body: &models.ListReconciliationDiscrepanciesRequest{
+				DetectedFrom: &detectedFrom,
+				DetectedTo:   &detectedTo,
+			},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidTimeRange)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_LIST_INTERNAL_SERVER_ERROR",
+			body:  &models.ListReconciliationDiscrepanciesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_COUNT_INTERNAL_SERVER_ERROR",
+			body:  &models.ListReconciliationDiscrepanciesRequest{},
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).Return(list, nil)
+				store.EXPECT().CountReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListReconciliationDiscrepanciesResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			res, err := u.ListReconciliationDiscrepancies(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func createRandomReconciliationDiscrepancy(t *testing.T, detectedAt time.Time) *repository.ReconciliationDiscrepancy {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	paymentUlid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	return &repository.ReconciliationDiscrepancy{
+		Uid:                ulid.String(),
+		DiscrepancyType:    payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH,
+		DiscrepancyKey:     paymentUlid.String(),
+		PaymentMethodUid:   pgtype.Text{String: paymentUlid.String(), Valid: true},
+		PaymentMethodID:    pgtype.Text{String: helper.RandomString(26), Valid: true},
+		PaymentReferenceID: pgtype.Text{String: helper.RandomString(26), Valid: true},
+		ExpectedAmount:     decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		ReportedAmount:     decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		ExpectedStatus:     pgtype.Text{String: payment.STATUS_SUCCEEDED, Valid: true},
+		ReportedStatus:     pgtype.Text{String: payment.STATUS_SUCCEEDED, Valid: true},
+		Currency:           "IDR",
+		ReportSource:       payment.RECONCILIATION_SOURCE_GATEWAY,
+		ReportReference:    helper.RandomString(26),
+		DetectedAt:         pgtype.Timestamptz{Time: detectedAt, Valid: true},
+		LastDetectedAt:     pgtype.Timestamptz{Time: detectedAt, Valid: true},
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+)
+
+var defaultPricing = config.Pricing{
+	FeeBearer:     payment.FEE_BEARER_MERCHANT,
+	RoundingMode:  payment.ROUNDING_MODE_HALF_UP,
+	RoundingScale: 2,
+}
+
+var hundred = decimal.NewFromInt(100)
+
+// price works out the fee of a payment through the channel, the channel tax is either a flat fee
+// or a percentage of the amount. The fee is added on top of the amount when the customer bears it.
+func (u *usecaseImpl) price(channel *repository.PaymentChannel, amount decimal.Decimal) *models.PaymentPrice {
+	pricing := u.pricing()
+
+	fee := channel.Tax
+	if channel.IsTaxPercentage {
+		fee = amount.Mul(channel.Tax).Div(hundred)
+	}
+	// a fee never carries more digits than the channel currency has minor units.
+	scale := pricing.RoundingScale
+	if exponent := payment.CurrencyExponent(channel.Currency); exponent < scale {
+		scale = exponent
+	}
+	fee = roundFee(fee, pricing.RoundingMode, scale)
+
+	res := &models.PaymentPrice{
+		BaseAmount:  amount,
+		FeeAmount:   fee,
+		TotalAmount: amount,
+		FeeBearer:   payment.FEE_BEARER_MERCHANT,
+		Currency:    channel.Currency,
+	}
+
+	if pricing.FeeBearer == payment.FEE_BEARER_CUSTOMER {
+		res.TotalAmount = amount.Add(fee)
+		res.FeeBearer = payment.FEE_BEARER_CUSTOMER
+	}
+
+	return res
+}
+
+func (u *usecaseImpl) pricing() *config.Pricing {
+	if u.cfg.Services.Internal.Pricing == nil {
+		return &defaultPricing
+	}
+
+	return u.cfg.Services.Internal.Pricing
+}
+
+func roundFee(fee decimal.Decimal, mode string, scale int32) decimal.Decimal {
+	switch mode {
+	case payment.ROUNDING_MODE_HALF_EVEN:
+		return fee.RoundBank(scale)
+	case payment.ROUNDING_MODE_UP:
+		return fee.RoundCeil(scale)
+	case payment.ROUNDING_MODE_DOWN:
+		return fee.RoundFloor(scale)
+	default:
+		return fee.Round(scale)
+	}
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PRICE(t *testing.T) {
+	percentageChannel := &repository.PaymentChannel{
+		Tax:             decimal.RequireFromString("2.73"),
+		IsTaxPercentage: true,
+	}
+
+	flatChannel := &repository.PaymentChannel{
+		Tax:             decimal.NewFromInt(4000),
+		IsTaxPercentage: false,
+	}
+
+	testCases := []struct {
+		tname    string
+		pricing  *config.Pricing
+		channel  *repository.PaymentChannel
+		amount   decimal.Decimal
+		expected [3]string
+	}{
+		{
+			tname: "OK_PERCENTAGE_CUSTOMER_ROUND_UP",
+			pricing: &config.Pricing{
+				FeeBearer:     payment.FEE_BEARER_CUSTOMER,
+				RoundingMode:  payment.ROUNDING_MODE_UP,
+				RoundingScale: 0,
+			},
+			channel:  percentageChannel,
+			amount:   decimal.NewFromInt(10001),
+			expected: [3]string{"10001", "274", "10275"},
+		},
+		{
+			tname: "OK_PERCENTAGE_CUSTOMER_ROUND_DOWN",
+			pricing: &config.Pricing{
+				FeeBearer:     payment.FEE_BEARER_CUSTOMER,
+				RoundingMode:  payment.ROUNDING_MODE_DOWN,
+				RoundingScale: 0,
+			},
+			channel:  percentageChannel,
+			amount:   decimal.NewFromInt(10001),
+			expected: [3]string{"10001", "273", "10274"},
+		},
+		{
+			tname: "OK_PERCENTAGE_CUSTOMER_ROUND_HALF_UP_SCALE_2",
+			pricing: &config.Pricing{
+				FeeBearer:     payment.FEE_BEARER_CUSTOMER,
+				RoundingMode:  payment.ROUNDING_MODE_HALF_UP,
+				RoundingScale: 2,
+			},
+			channel:  percentageChannel,
+			amount:   decimal.NewFromInt(10001),
+			expected: [3]string{"10001", "273.03", "10274.03"},
+		},
+		{
+			tname: "OK_PERCENTAGE_CUSTOMER_ROUND_HALF_EVEN",
+			pricing: &config.Pricing{
+				FeeBearer:     payment.FEE_BEARER_CUSTOMER,
+				RoundingMode:  payment.ROUNDING_MODE_HALF_EVEN,
+				RoundingScale: 0,
+			},
+			channel: &repository.PaymentChannel{
+				Tax:             decimal.NewFromInt(25),
+				IsTaxPercentage: true,
+			},
+			amount:   decimal.NewFromInt(10),
+			expected: [3]string{"10", "2", "12"},
+		},
+		{
+			tname: "OK_FLAT_MERCHANT",
+			pricing: &config.Pricing{
+				FeeBearer:     payment.FEE_BEARER_MERCHANT,
+				RoundingMode:  payment.ROUNDING_MODE_UP,
+				RoundingScale: 0,
+			},
+			channel:  flatChannel,
+			amount:   decimal.NewFromInt(150000),
+			expected: [3]string{"150000", "4000", "150000"},
+		},
+		{
+			tname:    "OK_DEFAULT_PRICING",
+			channel:  flatChannel,
+			amount:   decimal.NewFromInt(150000),
+			expected: [3]string{"150000", "4000", "150000"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			cfg := createPricingConfig(tc.pricing)
+
+			u := New(tlog, cfg, nil, gateway.NewRegistry(), nil).(*usecaseImpl)
+			res := u.price(tc.channel, tc.amount)
+
+			require.Equal(t, tc.expected[0], res.BaseAmount.String())
+			require.Equal(t, tc.expected[1], res.FeeAmount.String())
+			require.Equal(t, tc.expected[2], res.TotalAmount.String())
+
+			if tc.pricing != nil && tc.pricing.FeeBearer == payment.FEE_BEARER_CUSTOMER {
+				require.Equal(t, payment.FEE_BEARER_CUSTOMER, res.FeeBearer)
+				require.True(t, res.TotalAmount.Equal(res.BaseAmount.Add(res.FeeAmount)))
+			} else {
+				require.Equal(t, payment.FEE_BEARER_MERCHANT, res.FeeBearer)
+				require.True(t, res.TotalAmount.Equal(res.BaseAmount))
+			}
+		})
+	}
+}
+
+func Test_MOCK_CREATE_PAYMENT_CUSTOMER_BEARS_FEE(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+
+	cfg := createPricingConfig(&config.Pricing{
+		FeeBearer:     payment.FEE_BEARER_CUSTOMER,
+		RoundingMode:  payment.ROUNDING_MODE_UP,
+		RoundingScale: 0,
+	})
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	workerCtrl := gomock.NewController(t)
+	defer workerCtrl.Finish()
+	wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+	provider := gateway.NewFakeProvider()
+	registry := gateway.NewRegistry()
+	registry.Register(cfg.Services.External.PaymentGateway.ID, provider)
+
+	base := paymentParamsOK.Amount
+	total := base.Add(channelOK.Tax)
+
+	stubCreatePaymentReservation(store)
+	store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+	store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+	store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+	store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+			// the gateway charges the customer the amount including the fee.
+			require.True(t, total.Equal(arg.Payment.Amount))
+
+			res := *paymentRespOK
+			res.PaymentAmount = arg.Payment.Amount
+			return repository.CreatePaymentTxResult{Payment: &res}, nil
+		},
+	)
+	store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+
+	u := New(tlog, cfg, store, registry, wkstore)
+	res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+		XIdempotencyKey:         helper.RandomString(26),
+		PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+		CustomerUid:             &custRespOK.Uid,
+		CustomerName:            custRespOK.CustomerName,
+		CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+		PaymentDescription:      paymentParamsOK.Description,
+		PaymentAmount:           paymentParamsOK.Amount,
+		Currency:                payment.DEFAULT_CURRENCY,
+		Country:                 payment.COUNTRY_ID,
+		CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+		PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+		PaymentType:             paymentRespOK.PaymentType,
+		PaymentChannel:          paymentParamsOK.ChannelCode,
+		ExpiryHour:              24 * 3,
+		PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+		PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, base.InexactFloat64(), res.GetPrice().GetBaseAmount())
+	require.Equal(t, channelOK.Tax.InexactFloat64(), res.GetPrice().GetFeeAmount())
+	require.Equal(t, total.InexactFloat64(), res.GetPrice().GetTotalAmount())
+	require.Equal(t, payment.FEE_BEARER_CUSTOMER, res.GetPrice().GetFeeBearer())
+	require.Equal(t, total.InexactFloat64(), res.GetPaymentMethod().GetPaymentAmount())
+}
+
+// createPricingConfig copies the test config with the given pricing, a nil pricing falls back to the defaults.
+func createPricingConfig(pricing *config.Pricing) *config.App {
+	internal := *conf.Services.Internal
+	internal.Pricing = pricing
+
+	services := *conf.Services
+	services.Internal = &internal
+
+	res := *conf
+	res.Services = &services
+
+	return &res
+}
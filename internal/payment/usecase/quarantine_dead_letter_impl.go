@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// QuarantineDeadLetter keeps a message read from a dead-letter topic until it is looked at, a message which
+// was already quarantined from the same dead-letter offset is left as it is.
+func (u *usecaseImpl) QuarantineDeadLetter(ctx context.Context, arg *models.QuarantineDeadLetterRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.QuarantineDeadLetter")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	headers := arg.Headers
+	if headers == nil {
+		headers = []*models.KafkaHeader{}
+	}
+
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return u.errorResponse(span, "json.Marshal.err", err)
+	}
+
+	// a tombstone has no value, the row still keeps an empty payload to replay.
+	payload := arg.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return u.errorResponse(span, "helper.GenerateULID.err", err)
+	}
+
+	_, err = u.repo.CreateDeadLetterMessage(ctx, &repository.CreateDeadLetterMessageParams{
+		Uid:                 uid.String(),
+		SourceTopic:         arg.SourceTopic,
+		SourcePartition:     arg.SourcePartition,
+		SourceOffset:        arg.SourceOffset,
+		DeadLetterTopic:     arg.DeadLetterTopic,
+		DeadLetterPartition: arg.DeadLetterPartition,
+		DeadLetterOffset:    arg.DeadLetterOffset,
+		MessageKey:          arg.MessageKey,
+		Payload:             payload,
+		Headers:             encodedHeaders,
+		ErrorMessage:        arg.ErrorMessage,
+		Attempts:            arg.Attempts,
+		FirstSeenAt:         pgtype.Timestamptz{Time: arg.FirstSeenAt, Valid: true},
+		DeadLetteredAt:      pgtype.Timestamptz{Time: arg.DeadLetteredAt, Valid: true},
+		DeadLetterStatus:    payment.DEAD_LETTER_STATUS_QUARANTINED,
+		CreatedAt:           pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return u.errorResponse(span, "u.repo.CreateDeadLetterMessage.err", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_QUARANTINE_DEAD_LETTER(t *testing.T) {
+	body := &models.QuarantineDeadLetterRequest{
+		SourceTopic:         "xendit_payment_status_update",
+		SourcePartition:     0,
+		SourceOffset:        helper.RandomInt(1, 1000),
+		DeadLetterTopic:     "payment_service_payment_status_update_dlq",
+		DeadLetterPartition: 0,
+		DeadLetterOffset:    helper.RandomInt(1, 1000),
+		Payload:             []byte(helper.RandomString(64)),
+		Headers:             []*models.KafkaHeader{{Key: "x-attempts", Value: "4"}},
+		ErrorMessage:        "m.usecase.Update.err",
+		Attempts:            4,
+		FirstSeenAt:         time.Now().Add(-time.Hour),
+		DeadLetteredAt:      time.Now(),
+	}
+
+	tombstone := *body
+	tombstone.Payload = nil
+	tombstone.Headers = nil
+
+	testCases := []struct {
+		tname         string
+		body          *models.QuarantineDeadLetterRequest
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body:  body,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().CreateDeadLetterMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreateDeadLetterMessageParams) (*repository.DeadLetterMessage, error) {
+						require.NotEmpty(t, arg.Uid)
+						require.Equal(t, body.SourceTopic, arg.SourceTopic)
+						require.Equal(t, body.DeadLetterOffset, arg.DeadLetterOffset)
+						require.Equal(t, body.Payload, arg.Payload)
+						require.JSONEq(t, `[{"key":"x-attempts","value":"4"}]`, string(arg.Headers))
+						require.Equal(t, body.Attempts, arg.Attempts)
+						require.Equal(t, payment.DEAD_LETTER_STATUS_QUARANTINED, arg.DeadLetterStatus)
+						return &repository.DeadLetterMessage{Uid: arg.Uid}, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_TOMBSTONE",
+			body:  &tombstone,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().CreateDeadLetterMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreateDeadLetterMessageParams) (*repository.DeadLetterMessage, error) {
+						require.NotNil(t, arg.Payload)
+						require.Empty(t, arg.Payload)
+						require.JSONEq(t, `[]`, string(arg.Headers))
+						return &repository.DeadLetterMessage{Uid: arg.Uid}, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_ALREADY_QUARANTINED",
+			body:  body,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().CreateDeadLetterMessage(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_INTERNAL_SERVER_ERROR",
+			body:  body,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().CreateDeadLetterMessage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store)
+
+			err := u.QuarantineDeadLetter(context.TODO(), tc.body)
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func createRandomDeadLetterMessage(t *testing.T, deadLetteredAt time.Time) *repository.DeadLetterMessage {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	return &repository.DeadLetterMessage{
+		Uid:                 ulid.String(),
+		SourceTopic:         "xendit_payment_status_update",
+		SourcePartition:     0,
+		SourceOffset:        helper.RandomInt(1, 1000),
+		DeadLetterTopic:     "payment_service_payment_status_update_dlq",
+		DeadLetterPartition: 0,
+		DeadLetterOffset:    helper.RandomInt(1, 1000),
+		MessageKey:          []byte(ulid.String()),
+		Payload:             []byte(helper.RandomString(64)),
+		Headers:             []byte(`[{"key":"x-error","value":"m.usecase.Update.err"},{"key":"x-attempts","value":"4"}]`),
+		ErrorMessage:        "m.usecase.Update.err",
+		Attempts:            4,
+		FirstSeenAt:         pgtype.Timestamptz{Time: deadLetteredAt.Add(-time.Hour), Valid: true},
+		DeadLetteredAt:      pgtype.Timestamptz{Time: deadLetteredAt, Valid: true},
+		DeadLetterStatus:    payment.DEAD_LETTER_STATUS_QUARANTINED,
+		CreatedAt:           pgtype.Timestamptz{Time: deadLetteredAt, Valid: true},
+	}
+}
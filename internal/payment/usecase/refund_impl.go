@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Refund reserves the refund amount against the payment before calling the gateway,
+// a failed gateway call marks the reserved refund as failed so the amount becomes refundable again.
+func (u *usecaseImpl) Refund(ctx context.Context, arg *models.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.Refund")
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if !payment.IsRefundReason(arg.RefundReason) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsRefundReason", arg.RefundReason),
+			unierror.ErrInvalidRefundReason,
+		)
+	}
+
+	if (arg.RefundAmount != nil && !arg.RefundAmount.IsPositive()) || (arg.RefundAmountMinor != nil && *arg.RefundAmountMinor <= 0) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.RefundAmount", arg.RefundAmount),
+			unierror.ErrInvalidRefundAmount,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	refundArg := repository.CreateRefundTxParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+		Reason:            arg.RefundReason,
+	}
+
+	if arg.RefundAmount != nil {
+		refundArg.Amount = arg.RefundAmount
+	}
+
+	if arg.RefundAmountMinor != nil {
+		refundArg.AmountMinor = arg.RefundAmountMinor
+	}
+
+	refundTx, err := u.repo.CreateRefundTx(ctx, &refundArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.CreateRefundTx.err", err)
+	}
+
+	providerRefund, err := provider.CreateRefund(gateway.ForUser(ctx, refundTx.Payment.PaymentForUserID.String), &gateway.CreateRefundParams{
+		PaymentMethodID:  refundTx.Payment.PaymentMethodID,
+		PaymentRequestID: refundTx.Payment.PaymentRequestID.String,
+		ReferenceID:      refundTx.Refund.RefundReferenceID,
+		Amount:           refundTx.Refund.RefundAmount,
+		Currency:         refundTx.Refund.Currency,
+		Reason:           refundTx.Refund.RefundReason,
+	})
+	if err != nil {
+		_, updateErr := u.repo.UpdateRefund(ctx, &repository.UpdateRefundParams{
+			Uid: refundTx.Refund.Uid,
+			RefundStatus: pgtype.Text{
+				String: payment.REFUND_STATUS_FAILED,
+				Valid:  true,
+			},
+			UpdatedAt: pgtype.Timestamptz{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if updateErr != nil {
+			u.errorResponse(span, "u.repo.UpdateRefund.err", updateErr)
+		}
+
+		return nil, u.errorResponse(span, "provider.CreateRefund.err", err)
+	}
+
+	updateArg := repository.UpdateRefundParams{
+		Uid: refundTx.Refund.Uid,
+		RefundID: pgtype.Text{
+			String: providerRefund.ID,
+			Valid:  providerRefund.ID != "",
+		},
+		RefundStatus: pgtype.Text{
+			String: providerRefund.Status,
+			Valid:  providerRefund.Status != "",
+		},
+		RefundFailureCode: pgtype.Text{
+			String: providerRefund.FailureCode,
+			Valid:  providerRefund.FailureCode != "",
+		},
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	if !providerRefund.UpdatedAt.IsZero() {
+		updateArg.UpdatedAt.Time = providerRefund.UpdatedAt
+	}
+
+	res, err := u.repo.UpdateRefund(ctx, &updateArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdateRefund.err", err)
+	}
+
+	err = u.worker.RefundStatusUpdated(ctx, &models.RefundStatusUpdatedTask{Refund: res})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.worker.RefundStatusUpdated.err", err)
+	}
+
+	return &pb.RefundPaymentResponse{Refund: mapper.RefundToDto(res)}, nil
+}
@@ -0,0 +1,435 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_REFUND_PAYMENT(t *testing.T) {
+	_, paymentSucceeded := createRandomVirtualAccountBankPayment(t)
+	paymentSucceeded.PaymentStatus = payment.STATUS_SUCCEEDED
+
+	pendingRefund := createRandomPendingRefund(t, paymentSucceeded)
+
+	partialAmount := pendingRefund.RefundAmount
+	invalidAmount := decimal.RequireFromString("100.001")
+	zeroAmountMinor := int64(0)
+
+	testCases := []struct {
+		tname         string
+		body          *models.RefundPaymentRequest
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.RefundPaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &partialAmount,
+				RefundReason:      payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreateRefundTxParams) (repository.CreateRefundTxResult, error) {
+						require.Equal(t, paymentSucceeded.PaymentMethodID, arg.PaymentMethodID)
+						require.Equal(t, paymentSucceeded.PaymentCustomerID, arg.PaymentCustomerID)
+						require.NotNil(t, arg.Amount)
+						require.True(t, arg.Amount.Equal(pendingRefund.RefundAmount))
+						return repository.CreateRefundTxResult{Payment: paymentSucceeded, Refund: pendingRefund}, nil
+					},
+				)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateRefundParams) (*repository.Refund, error) {
+						require.Equal(t, pendingRefund.Uid, arg.Uid)
+						require.True(t, arg.RefundID.Valid)
+						require.Equal(t, payment.REFUND_STATUS_PENDING, arg.RefundStatus.String)
+
+						res := *pendingRefund
+						res.RefundID = arg.RefundID
+						res.UpdatedAt = arg.UpdatedAt
+						return &res, nil
+					},
+				)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res.GetRefund())
+				require.Equal(t, pendingRefund.Uid, res.GetRefund().GetUid())
+				require.NotEmpty(t, res.GetRefund().GetRefundId())
+				require.Equal(t, payment.REFUND_STATUS_PENDING, res.GetRefund().GetRefundStatus())
+				require.Equal(t, partialAmount.InexactFloat64(), res.GetRefund().GetRefundAmount())
+				require.Equal(t, payment.ToMinorUnits(partialAmount, payment.DEFAULT_CURRENCY), res.GetRefund().GetRefundAmountMinor())
+			},
+		},
+		{
+			tname: "OK_FULL_REFUND_WITHOUT_AMOUNT",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundReason:      payment.REFUND_REASON_CANCELLATION,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreateRefundTxParams) (repository.CreateRefundTxResult, error) {
+						require.Nil(t, arg.Amount)
+						return repository.CreateRefundTxResult{Payment: paymentSucceeded, Refund: pendingRefund}, nil
+					},
+				)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(pendingRefund, nil)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res.GetRefund())
+			},
+		},
+		{
+			tname: "ERR_INVALID_REFUND_REASON",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundReason:      helper.RandomString(12),
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidRefundReason)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_REFUNDABLE",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{}, unierror.ErrPaymentNotRefundable)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentNotRefundable)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_REFUND_AMOUNT_EXCEEDED",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &partialAmount,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{}, unierror.ErrRefundAmountExceeded)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrRefundAmountExceeded)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{}, pgx.ErrNoRows)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_REFUND_AMOUNT",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &invalidAmount,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{}, unierror.ErrInvalidRefundAmount)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidRefundAmount)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_REFUND_AMOUNT_MINOR",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmountMinor: &zeroAmountMinor,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidRefundAmount)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_ERROR_MARKS_REFUND_FAILED",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &partialAmount,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{Payment: paymentSucceeded, Refund: pendingRefund}, nil)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateRefundParams) (*repository.Refund, error) {
+						require.Equal(t, pendingRefund.Uid, arg.Uid)
+						require.Equal(t, payment.REFUND_STATUS_FAILED, arg.RefundStatus.String)
+						require.False(t, arg.RefundID.Valid)
+						return pendingRefund, nil
+					},
+				)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_UPDATE_REFUND_INTERNAL_SERVER_ERROR",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &partialAmount,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{Payment: paymentSucceeded, Refund: pendingRefund}, nil)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_WORKER_ERROR",
+			body: &models.RefundPaymentRequest{
+				PaymentCustomerId: paymentSucceeded.PaymentCustomerID,
+				PaymentMethodId:   paymentSucceeded.PaymentMethodID,
+				RefundAmount:      &partialAmount,
+				RefundReason:      payment.REFUND_REASON_OTHERS,
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().CreateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.CreateRefundTxResult{Payment: paymentSucceeded, Refund: pendingRefund}, nil)
+				store.EXPECT().UpdateRefund(gomock.Any(), gomock.Any()).Times(1).Return(pendingRefund, nil)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("error"))
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundPaymentResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, provider)
+
+			res, err := u.Refund(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func Test_MOCK_UPDATE_REFUND(t *testing.T) {
+	_, paymentSucceeded := createRandomVirtualAccountBankPayment(t)
+	paymentSucceeded.PaymentStatus = payment.STATUS_SUCCEEDED
+
+	pendingRefund := createRandomPendingRefund(t, paymentSucceeded)
+
+	succeededRefund := *pendingRefund
+	succeededRefund.RefundStatus = payment.REFUND_STATUS_SUCCEEDED
+
+	updatedAt := time.Now()
+
+	testCases := []struct {
+		tname         string
+		body          *models.UpdateRefundRequest
+		stub          func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.UpdateRefundRequest{
+				RefundEvent:  "refund.succeeded",
+				RefundId:     pendingRefund.RefundID.String,
+				RefundStatus: payment.REFUND_STATUS_SUCCEEDED,
+				UpdatedAt:    &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetRefundByRefundID(gomock.Any(), gomock.Eq(pendingRefund.RefundID)).Times(1).Return(pendingRefund, nil)
+				store.EXPECT().UpdateRefundTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateRefundTxParams) (repository.UpdateRefundTxResult, error) {
+						require.Equal(t, pendingRefund.Uid, arg.UpdateParams.Uid)
+						require.Equal(t, payment.REFUND_STATUS_SUCCEEDED, arg.UpdateParams.RefundStatus.String)
+						require.True(t, arg.UpdateParams.UpdatedAt.Time.Equal(updatedAt))
+						require.False(t, arg.UpdateParams.RefundFailureCode.Valid)
+						return repository.UpdateRefundTxResult{Refund: &succeededRefund}, nil
+					},
+				)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Eq(&models.RefundStatusUpdatedTask{Refund: &succeededRefund})).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_ALREADY_SETTLED_IGNORED",
+			body: &models.UpdateRefundRequest{
+				RefundEvent:  "refund.failed",
+				RefundId:     succeededRefund.RefundID.String,
+				RefundStatus: payment.REFUND_STATUS_FAILED,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetRefundByRefundID(gomock.Any(), gomock.Eq(succeededRefund.RefundID)).Times(1).Return(&succeededRefund, nil)
+				store.EXPECT().UpdateRefundTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_REFUND_NOT_FOUND",
+			body: &models.UpdateRefundRequest{
+				RefundId:     helper.RandomString(24),
+				RefundStatus: payment.REFUND_STATUS_SUCCEEDED,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetRefundByRefundID(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().UpdateRefundTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+			},
+		},
+		{
+			tname: "ERR_WORKER_ERROR",
+			body: &models.UpdateRefundRequest{
+				RefundId:     pendingRefund.RefundID.String,
+				RefundStatus: payment.REFUND_STATUS_SUCCEEDED,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetRefundByRefundID(gomock.Any(), gomock.Eq(pendingRefund.RefundID)).Times(1).Return(pendingRefund, nil)
+				store.EXPECT().UpdateRefundTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateRefundTxResult{Refund: &succeededRefund}, nil)
+				wkstore.EXPECT().RefundStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("error"))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stub(store, wkstore)
+
+			actualError := u.UpdateRefund(context.TODO(), tc.body)
+			tc.checkResponse(t, actualError)
+		})
+	}
+}
+
+func createRandomPendingRefund(t *testing.T, paymentMethod *repository.PaymentMethod) *repository.Refund {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	return &repository.Refund{
+		Uid: ulid.String(),
+		RefundID: pgtype.Text{
+			String: helper.RandomString(24),
+			Valid:  true,
+		},
+		PaymentMethodUid:  paymentMethod.Uid,
+		PaymentMethodID:   paymentMethod.PaymentMethodID,
+		RefundReferenceID: ulid.String(),
+		RefundStatus:      payment.REFUND_STATUS_PENDING,
+		RefundAmount:      paymentMethod.PaymentAmount.Div(decimal.NewFromInt(2)).Round(0),
+		Currency:          paymentMethod.Currency,
+		RefundReason:      payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ReplayDeadLetterMessage publishes a quarantined message back onto its source topic, where it is processed
+// again from scratch. A message may be replayed more than once, each replay is counted.
+func (u *usecaseImpl) ReplayDeadLetterMessage(ctx context.Context, arg *models.ReplayDeadLetterMessageRequest) (*pb.ReplayDeadLetterMessageResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ReplayDeadLetterMessage")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	msg, err := u.repo.GetDeadLetterMessage(ctx, arg.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetDeadLetterMessage.err", err)
+	}
+
+	headers, err := models.DecodeKafkaHeaders(msg.Headers)
+	if err != nil {
+		return nil, u.errorResponse(span, "models.DecodeKafkaHeaders.err", err)
+	}
+
+	err = u.worker.ReplayDeadLetter(ctx, &models.ReplayDeadLetterTask{
+		DeadLetterMessage: msg,
+		Headers:           headers,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.worker.ReplayDeadLetter.err", err)
+	}
+
+	res, err := u.repo.MarkDeadLetterMessageReplayed(ctx, &repository.MarkDeadLetterMessageReplayedParams{
+		DeadLetterStatus: payment.DEAD_LETTER_STATUS_REPLAYED,
+		ReplayedAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Uid:              msg.Uid,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.MarkDeadLetterMessageReplayed.err", err)
+	}
+
+	return &pb.ReplayDeadLetterMessageResponse{
+		DeadLetterMessage: mapper.DeadLetterMessageToDto(res, headers),
+	}, nil
+}
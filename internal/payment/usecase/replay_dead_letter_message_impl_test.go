@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_REPLAY_DEAD_LETTER_MESSAGE(t *testing.T) {
+	msg := createRandomDeadLetterMessage(t, time.Now())
+	body := &models.ReplayDeadLetterMessageRequest{Uid: msg.Uid}
+
+	replayed := *msg
+	replayed.DeadLetterStatus = payment.DEAD_LETTER_STATUS_REPLAYED
+	replayed.ReplayCount = 1
+	replayed.ReplayedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, res *pb.ReplayDeadLetterMessageResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(msg, nil)
+				wkstore.EXPECT().ReplayDeadLetter(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, task *models.ReplayDeadLetterTask) error {
+						require.Equal(t, msg, task.DeadLetterMessage)
+						require.Len(t, task.Headers, 2)
+						return nil
+					},
+				)
+				store.EXPECT().MarkDeadLetterMessageReplayed(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.MarkDeadLetterMessageReplayedParams) (*repository.DeadLetterMessage, error) {
+						require.Equal(t, msg.Uid, arg.Uid)
+						require.Equal(t, payment.DEAD_LETTER_STATUS_REPLAYED, arg.DeadLetterStatus)
+						require.True(t, arg.ReplayedAt.Valid)
+						return &replayed, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReplayDeadLetterMessageResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.DEAD_LETTER_STATUS_REPLAYED, res.GetDeadLetterMessage().GetDeadLetterStatus())
+				require.Equal(t, int32(1), res.GetDeadLetterMessage().GetReplayCount())
+				require.NotNil(t, res.GetDeadLetterMessage().ReplayedAt)
+			},
+		},
+		{
+			tname: "ERR_NOT_FOUND",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(nil, pgx.ErrNoRows)
+				wkstore.EXPECT().ReplayDeadLetter(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MarkDeadLetterMessageReplayed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReplayDeadLetterMessageResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PUBLISH",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(msg, nil)
+				wkstore.EXPECT().ReplayDeadLetter(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("any err"))
+				store.EXPECT().MarkDeadLetterMessageReplayed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReplayDeadLetterMessageResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_MARK_REPLAYED",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().GetDeadLetterMessage(gomock.Any(), gomock.Eq(msg.Uid)).Times(1).Return(msg, nil)
+				wkstore.EXPECT().ReplayDeadLetter(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				store.EXPECT().MarkDeadLetterMessageReplayed(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReplayDeadLetterMessageResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stubs(store, wkstore)
+
+			res, err := u.ReplayDeadLetterMessage(context.TODO(), body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+var errPlatformAccountNotConfigured = errors.New("platform account is not configured")
+
+func isSplitPayment(arg *models.CreatePaymentRequest) bool {
+	return len(arg.SplitRules) > 0 || (arg.PlatformFee != nil && !arg.PlatformFee.IsZero())
+}
+
+// validateSplitParams checks the split rules of a marketplace payment, the amounts themselves are
+// checked against the price once it is known.
+func validateSplitParams(arg *models.CreatePaymentRequest) error {
+	if !isSplitPayment(arg) {
+		return nil
+	}
+
+	if arg.ForUserId == nil || *arg.ForUserId == "" {
+		return unierror.ErrForUserIDRequired
+	}
+
+	// a reusable payment is paid many times over, a split rule only holds the amounts of one payment.
+	if arg.PaymentReusability == payment.USAGE_TYPE_MULTIPLE_USE {
+		return unierror.ErrSplitNotSupportedForReusable
+	}
+
+	if arg.PlatformFee != nil && (arg.PlatformFee.IsNegative() || !payment.IsExactInCurrency(*arg.PlatformFee, arg.Currency)) {
+		return fmt.Errorf("%w: platform fee %s", unierror.ErrInvalidSplitRule, arg.PlatformFee)
+	}
+
+	for i, rule := range arg.SplitRules {
+		if rule == nil || rule.DestinationAccountId == "" {
+			return fmt.Errorf("%w: rule %d has no destination account", unierror.ErrInvalidSplitRule, i)
+		}
+
+		if (rule.PercentAmount == nil) == (rule.FlatAmount == nil) {
+			return fmt.Errorf("%w: rule %d needs either a percent or a flat amount", unierror.ErrInvalidSplitRule, i)
+		}
+
+		if rule.PercentAmount != nil && (!rule.PercentAmount.IsPositive() || rule.PercentAmount.GreaterThan(hundred)) {
+			return fmt.Errorf("%w: rule %d percent %s", unierror.ErrInvalidSplitRule, i, rule.PercentAmount)
+		}
+
+		if rule.FlatAmount != nil && (!rule.FlatAmount.IsPositive() || !payment.IsExactInCurrency(*rule.FlatAmount, arg.Currency)) {
+			return fmt.Errorf("%w: rule %d flat amount %s", unierror.ErrInvalidSplitRule, i, rule.FlatAmount)
+		}
+	}
+
+	return nil
+}
+
+// split works out the legs of a payment collected on behalf of a sub-account: the platform fee, the routes
+// of the split rules and the remainder which stays with the sub-account. The legs share out the amount
+// before the channel fee, a fee the customer pays on top goes to the gateway and never to a leg. A
+// percentage is rounded down, so the legs never add up to more than the amount.
+func (u *usecaseImpl) split(arg *models.CreatePaymentRequest, price *models.PaymentPrice) ([]*repository.CreatePaymentSplitParams, error) {
+	if arg.ForUserId == nil || *arg.ForUserId == "" {
+		return nil, nil
+	}
+
+	exponent := payment.CurrencyExponent(price.Currency)
+	remainder := price.BaseAmount
+
+	var res []*repository.CreatePaymentSplitParams
+
+	if arg.PlatformFee != nil && arg.PlatformFee.IsPositive() {
+		platformAccountID := u.cfg.Services.External.PaymentGateway.PlatformAccountID
+		if platformAccountID == "" {
+			return nil, errPlatformAccountNotConfigured
+		}
+
+		res = append(res, &repository.CreatePaymentSplitParams{
+			SplitType:            payment.SPLIT_TYPE_PLATFORM_FEE,
+			DestinationAccountID: platformAccountID,
+			SplitAmount:          *arg.PlatformFee,
+			Currency:             price.Currency,
+			SplitReferenceID:     pgtype.Text{String: fmt.Sprintf("%s-platform-fee", arg.PaymentReferenceId), Valid: true},
+		})
+		remainder = remainder.Sub(*arg.PlatformFee)
+	}
+
+	for i, rule := range arg.SplitRules {
+		var amount decimal.Decimal
+		if rule.PercentAmount != nil {
+			amount = price.BaseAmount.Mul(*rule.PercentAmount).Div(hundred).RoundFloor(exponent)
+		} else {
+			amount = *rule.FlatAmount
+		}
+
+		referenceID := fmt.Sprintf("%s-route-%d", arg.PaymentReferenceId, i+1)
+		if rule.ReferenceId != nil && *rule.ReferenceId != "" {
+			referenceID = *rule.ReferenceId
+		}
+
+		res = append(res, &repository.CreatePaymentSplitParams{
+			SplitType:            payment.SPLIT_TYPE_ROUTE,
+			DestinationAccountID: rule.DestinationAccountId,
+			SplitAmount:          amount,
+			Currency:             price.Currency,
+			SplitReferenceID:     pgtype.Text{String: referenceID, Valid: true},
+		})
+		remainder = remainder.Sub(amount)
+	}
+
+	if remainder.IsNegative() {
+		return nil, fmt.Errorf("%w: %s short", unierror.ErrSplitExceedsAmount, remainder.Neg())
+	}
+
+	res = append(res, &repository.CreatePaymentSplitParams{
+		SplitType:            payment.SPLIT_TYPE_REMAINDER,
+		DestinationAccountID: *arg.ForUserId,
+		SplitAmount:          remainder,
+		Currency:             price.Currency,
+	})
+
+	return res, nil
+}
+
+// splitRoutes returns the legs the gateway has to move away from the sub-account, the remainder stays with it.
+func splitRoutes(splits []*repository.CreatePaymentSplitParams) []*gateway.SplitRoute {
+	var res []*gateway.SplitRoute
+	for _, split := range splits {
+		if split.SplitType == payment.SPLIT_TYPE_REMAINDER {
+			continue
+		}
+
+		res = append(res, &gateway.SplitRoute{
+			DestinationAccountID: split.DestinationAccountID,
+			Amount:               split.SplitAmount,
+			Currency:             split.Currency,
+			ReferenceID:          split.SplitReferenceID.String,
+		})
+	}
+
+	return res
+}
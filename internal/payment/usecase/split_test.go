@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testPlatformAccountID = "platform-account"
+
+func Test_SPLIT(t *testing.T) {
+	forUserID := "seller-account"
+	price := &models.PaymentPrice{
+		BaseAmount:  decimal.NewFromInt(10001),
+		FeeAmount:   decimal.NewFromInt(4000),
+		TotalAmount: decimal.NewFromInt(10001),
+		FeeBearer:   payment.FEE_BEARER_MERCHANT,
+		Currency:    payment.CURRENCY_IDR,
+	}
+	customerBearsFee := &models.PaymentPrice{
+		BaseAmount:  decimal.NewFromInt(10000),
+		FeeAmount:   decimal.NewFromInt(4000),
+		TotalAmount: decimal.NewFromInt(14000),
+		FeeBearer:   payment.FEE_BEARER_CUSTOMER,
+		Currency:    payment.CURRENCY_IDR,
+	}
+
+	percent := decimal.RequireFromString("12.345")
+	flat := decimal.NewFromInt(1500)
+	fee := decimal.NewFromInt(500)
+	tooMuch := decimal.NewFromInt(9000)
+	overBase := decimal.NewFromInt(10001)
+	reference := "route-ref"
+
+	testCases := []struct {
+		tname         string
+		arg           *models.CreatePaymentRequest
+		price         *models.PaymentPrice
+		checkResponse func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error)
+	}{
+		{
+			tname: "OK_NOT_FOR_SUB_ACCOUNT",
+			arg:   &models.CreatePaymentRequest{PaymentReferenceId: "ref"},
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res)
+			},
+		},
+		{
+			tname: "OK_REMAINDER_ONLY",
+			arg:   &models.CreatePaymentRequest{PaymentReferenceId: "ref", ForUserId: &forUserID},
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.Equal(t, payment.SPLIT_TYPE_REMAINDER, res[0].SplitType)
+				require.Equal(t, forUserID, res[0].DestinationAccountID)
+				require.Equal(t, "10001", res[0].SplitAmount.String())
+			},
+		},
+		{
+			tname: "OK_FEE_PERCENT_AND_FLAT",
+			arg: &models.CreatePaymentRequest{
+				PaymentReferenceId: "ref",
+				ForUserId:          &forUserID,
+				PlatformFee:        &fee,
+				SplitRules: []*models.PaymentSplitRule{
+					{DestinationAccountId: "partner-a", PercentAmount: &percent},
+					{DestinationAccountId: "partner-b", FlatAmount: &flat, ReferenceId: &reference},
+				},
+			},
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 4)
+
+				require.Equal(t, payment.SPLIT_TYPE_PLATFORM_FEE, res[0].SplitType)
+				require.Equal(t, testPlatformAccountID, res[0].DestinationAccountID)
+				require.Equal(t, "500", res[0].SplitAmount.String())
+				require.Equal(t, "ref-platform-fee", res[0].SplitReferenceID.String)
+
+				// 12.345% of 10001 is 1234.62345, rounded down to the minor unit.
+				require.Equal(t, payment.SPLIT_TYPE_ROUTE, res[1].SplitType)
+				require.Equal(t, "partner-a", res[1].DestinationAccountID)
+				require.Equal(t, "1234.62", res[1].SplitAmount.String())
+				require.Equal(t, "ref-route-1", res[1].SplitReferenceID.String)
+
+				require.Equal(t, "partner-b", res[2].DestinationAccountID)
+				require.Equal(t, "1500", res[2].SplitAmount.String())
+				require.Equal(t, reference, res[2].SplitReferenceID.String)
+
+				require.Equal(t, payment.SPLIT_TYPE_REMAINDER, res[3].SplitType)
+				require.Equal(t, forUserID, res[3].DestinationAccountID)
+				require.Equal(t, "6766.38", res[3].SplitAmount.String())
+				require.False(t, res[3].SplitReferenceID.Valid)
+
+				total := decimal.Zero
+				for _, split := range res {
+					require.Equal(t, payment.CURRENCY_IDR, split.Currency)
+					total = total.Add(split.SplitAmount)
+				}
+				require.True(t, price.BaseAmount.Equal(total))
+			},
+		},
+		{
+			tname: "OK_CUSTOMER_BEARS_FEE",
+			arg: &models.CreatePaymentRequest{
+				PaymentReferenceId: "ref",
+				ForUserId:          &forUserID,
+				PlatformFee:        &fee,
+			},
+			price: customerBearsFee,
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+
+				// the fee paid on top of the amount is left out of the remainder.
+				require.Equal(t, payment.SPLIT_TYPE_REMAINDER, res[1].SplitType)
+				require.Equal(t, "9500", res[1].SplitAmount.String())
+				require.True(t, customerBearsFee.BaseAmount.Equal(res[0].SplitAmount.Add(res[1].SplitAmount)))
+			},
+		},
+		{
+			tname: "ERR_SPLIT_EXCEEDS_AMOUNT",
+			arg: &models.CreatePaymentRequest{
+				PaymentReferenceId: "ref",
+				ForUserId:          &forUserID,
+				PlatformFee:        &tooMuch,
+				SplitRules: []*models.PaymentSplitRule{
+					{DestinationAccountId: "partner-b", FlatAmount: &flat},
+				},
+			},
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.ErrorIs(t, err, unierror.ErrSplitExceedsAmount)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_SPLIT_EXCEEDS_AMOUNT_CUSTOMER_BEARS_FEE",
+			arg: &models.CreatePaymentRequest{
+				PaymentReferenceId: "ref",
+				ForUserId:          &forUserID,
+				PlatformFee:        &overBase,
+			},
+			price: customerBearsFee,
+			checkResponse: func(t *testing.T, res []*repository.CreatePaymentSplitParams, err error) {
+				require.ErrorIs(t, err, unierror.ErrSplitExceedsAmount)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			u := New(tlog, createSplitConfig(testPlatformAccountID), nil, gateway.NewRegistry(), nil).(*usecaseImpl)
+
+			casePrice := price
+			if tc.price != nil {
+				casePrice = tc.price
+			}
+
+			res, err := u.split(tc.arg, casePrice)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func Test_VALIDATE_SPLIT_PARAMS(t *testing.T) {
+	forUserID := "seller-account"
+	emptyForUserID := ""
+	percent := decimal.NewFromInt(10)
+	overPercent := decimal.NewFromInt(101)
+	flat := decimal.NewFromInt(1500)
+	inexactFlat := decimal.RequireFromString("1500.555")
+	fee := decimal.NewFromInt(500)
+
+	testCases := []struct {
+		tname       string
+		forUserID   *string
+		reusability string
+		fee         *decimal.Decimal
+		rules       []*models.PaymentSplitRule
+		err         error
+	}{
+		{
+			tname:       "OK_NO_SPLIT",
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+		},
+		{
+			tname:       "OK_FOR_SUB_ACCOUNT_WITHOUT_SPLIT",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_MULTIPLE_USE,
+		},
+		{
+			tname:       "OK_SPLIT",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			fee:         &fee,
+			rules:       []*models.PaymentSplitRule{{DestinationAccountId: "partner", PercentAmount: &percent}},
+		},
+		{
+			tname:       "ERR_FOR_USER_ID_REQUIRED",
+			forUserID:   &emptyForUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			fee:         &fee,
+			err:         unierror.ErrForUserIDRequired,
+		},
+		{
+			tname:       "ERR_REUSABLE",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_MULTIPLE_USE,
+			rules:       []*models.PaymentSplitRule{{DestinationAccountId: "partner", FlatAmount: &flat}},
+			err:         unierror.ErrSplitNotSupportedForReusable,
+		},
+		{
+			tname:       "ERR_NO_DESTINATION",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			rules:       []*models.PaymentSplitRule{{FlatAmount: &flat}},
+			err:         unierror.ErrInvalidSplitRule,
+		},
+		{
+			tname:       "ERR_PERCENT_AND_FLAT",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			rules:       []*models.PaymentSplitRule{{DestinationAccountId: "partner", PercentAmount: &percent, FlatAmount: &flat}},
+			err:         unierror.ErrInvalidSplitRule,
+		},
+		{
+			tname:       "ERR_PERCENT_OVER_HUNDRED",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			rules:       []*models.PaymentSplitRule{{DestinationAccountId: "partner", PercentAmount: &overPercent}},
+			err:         unierror.ErrInvalidSplitRule,
+		},
+		{
+			tname:       "ERR_FLAT_INEXACT_IN_CURRENCY",
+			forUserID:   &forUserID,
+			reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			rules:       []*models.PaymentSplitRule{{DestinationAccountId: "partner", FlatAmount: &inexactFlat}},
+			err:         unierror.ErrInvalidSplitRule,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			err := validateSplitParams(&models.CreatePaymentRequest{
+				Currency:           payment.CURRENCY_IDR,
+				PaymentReusability: tc.reusability,
+				ForUserId:          tc.forUserID,
+				PlatformFee:        tc.fee,
+				SplitRules:         tc.rules,
+			})
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func Test_MOCK_CREATE_SPLIT_PAYMENT(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+
+	cfg := createSplitConfig(testPlatformAccountID)
+	forUserID := "seller-" + helper.RandomString(10)
+	fee := decimal.NewFromInt(100)
+	percent := decimal.NewFromInt(10)
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	workerCtrl := gomock.NewController(t)
+	defer workerCtrl.Finish()
+	wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+	provider := gateway.NewFakeProvider()
+	registry := gateway.NewRegistry()
+	registry.Register(cfg.Services.External.PaymentGateway.ID, provider)
+
+	stubCreatePaymentReservation(store)
+	store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+	store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+	store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+	store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, arg *repository.CreatePaymentTxParams) (repository.CreatePaymentTxResult, error) {
+			require.Equal(t, forUserID, arg.ForUserID)
+			require.NotEmpty(t, arg.SplitRuleID)
+			require.Len(t, arg.Splits, 3)
+
+			res := *paymentRespOK
+			res.PaymentForUserID = pgtype.Text{String: arg.ForUserID, Valid: true}
+
+			splits := make([]*repository.PaymentSplit, 0, len(arg.Splits))
+			for _, split := range arg.Splits {
+				splits = append(splits, &repository.PaymentSplit{
+					Uid:                  helper.RandomString(26),
+					PaymentMethodUid:     res.Uid,
+					SplitRuleID:          pgtype.Text{String: arg.SplitRuleID, Valid: true},
+					SplitType:            split.SplitType,
+					DestinationAccountID: split.DestinationAccountID,
+					SplitAmount:          split.SplitAmount,
+					Currency:             split.Currency,
+					SplitReferenceID:     split.SplitReferenceID,
+				})
+			}
+
+			return repository.CreatePaymentTxResult{Payment: &res, Splits: splits}, nil
+		},
+	)
+	store.EXPECT().PutCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(1)
+
+	u := New(tlog, cfg, store, registry, wkstore)
+	res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+		XIdempotencyKey:         helper.RandomString(26),
+		PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+		CustomerUid:             &custRespOK.Uid,
+		CustomerName:            custRespOK.CustomerName,
+		CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+		PaymentDescription:      paymentParamsOK.Description,
+		PaymentAmount:           paymentParamsOK.Amount,
+		Currency:                payment.DEFAULT_CURRENCY,
+		Country:                 payment.COUNTRY_ID,
+		CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+		PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+		PaymentType:             paymentRespOK.PaymentType,
+		PaymentChannel:          paymentParamsOK.ChannelCode,
+		ExpiryHour:              24 * 3,
+		PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+		PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+		ForUserId:               &forUserID,
+		PlatformFee:             &fee,
+		SplitRules: []*models.PaymentSplitRule{
+			{DestinationAccountId: "partner-" + helper.RandomString(10), PercentAmount: &percent},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, forUserID, res.GetPaymentMethod().GetPaymentForUserId())
+	require.Len(t, res.GetSplits(), 3)
+
+	splitTypes := make([]string, 0, len(res.GetSplits()))
+	total := int64(0)
+	for _, split := range res.GetSplits() {
+		splitTypes = append(splitTypes, split.GetSplitType())
+		total += split.GetSplitAmountMinor()
+	}
+	require.Equal(t, []string{payment.SPLIT_TYPE_PLATFORM_FEE, payment.SPLIT_TYPE_ROUTE, payment.SPLIT_TYPE_REMAINDER}, splitTypes)
+	require.Equal(t, payment.ToMinorUnits(paymentParamsOK.Amount, payment.DEFAULT_CURRENCY), total)
+}
+
+func Test_MOCK_CREATE_SPLIT_PAYMENT_REJECTED(t *testing.T) {
+	custParamsOK, custRespOK := createRandomCustomer(t)
+	paymentParamsOK, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	channelParamsOK, channelOK := createRandomAvailablePaymentChannel(t, paymentRespOK.PaymentType, paymentParamsOK)
+
+	forUserID := "seller-" + helper.RandomString(10)
+	fee := paymentParamsOK.Amount.Add(decimal.NewFromInt(1))
+
+	testCases := []struct {
+		tname         string
+		forUserID     *string
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, res *pb.CreatePaymentResponse, err error)
+	}{
+		{
+			tname: "ERR_FOR_USER_ID_REQUIRED",
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrForUserIDRequired)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:     "ERR_SPLIT_EXCEEDS_AMOUNT",
+			forUserID: &forUserID,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().GetCreatePaymentIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, redis.Nil)
+				store.EXPECT().GetCustomerCache(gomock.Any(), gomock.Eq(custRespOK.Uid)).Times(1).Return(custRespOK, nil)
+				store.EXPECT().GetAvailablePaymentChannel(gomock.Any(), gomock.Eq(channelParamsOK)).Times(1).Return(channelOK, nil)
+				store.EXPECT().CreatePaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrSplitExceedsAmount)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			cfg := createSplitConfig(testPlatformAccountID)
+
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(cfg.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, cfg, store, registry, wkstore)
+			stubCreatePaymentReservation(store)
+			tc.stubs(store)
+
+			res, err := u.Create(context.TODO(), &models.CreatePaymentRequest{
+				XIdempotencyKey:         helper.RandomString(26),
+				PaymentReferenceId:      paymentRespOK.PaymentReferenceID,
+				CustomerUid:             &custRespOK.Uid,
+				CustomerName:            custRespOK.CustomerName,
+				CustomerPhoneNumber:     custParamsOK.CreateCustomer.PhoneNumber.String,
+				PaymentDescription:      paymentParamsOK.Description,
+				PaymentAmount:           paymentParamsOK.Amount,
+				Currency:                payment.DEFAULT_CURRENCY,
+				Country:                 payment.COUNTRY_ID,
+				CaptureMethod:           payment.CAPTURE_METHOD_AUTOMATIC,
+				PaymentReusability:      payment.USAGE_TYPE_ONE_TIME_USE,
+				PaymentType:             paymentRespOK.PaymentType,
+				PaymentChannel:          paymentParamsOK.ChannelCode,
+				ExpiryHour:              24 * 3,
+				PaymentSuccessReturnUrl: paymentParamsOK.SuccessReturnURL,
+				PaymentFailureReturnUrl: paymentParamsOK.FailureReturnURL,
+				ForUserId:               tc.forUserID,
+				PlatformFee:             &fee,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+// createSplitConfig copies the test config with the given platform account.
+func createSplitConfig(platformAccountID string) *config.App {
+	paymentGateway := *conf.Services.External.PaymentGateway
+	paymentGateway.PlatformAccountID = platformAccountID
+
+	external := *conf.Services.External
+	external.PaymentGateway = &paymentGateway
+
+	services := *conf.Services
+	services.External = &external
+
+	res := *conf
+	res.Services = &services
+
+	return &res
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
-func (u *usecaseImpl) Update(ctx context.Context, arg *models.UpdatePaymentRequest) error {
+func (u *usecaseImpl) Update(ctx context.Context, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.Update")
 	defer span.Finish()
 
@@ -48,18 +48,79 @@ func (u *usecaseImpl) Update(ctx context.Context, arg *models.UpdatePaymentReque
 
 	}
 
+	if isChildPayment(arg) {
+		return u.recordChildPayment(ctx, span, arg, &updateArg)
+	}
+
 	res, err := u.repo.UpdateTx(ctx, &repository.UpdateTxParams{
-		UpdateParams: updateArg,
+		UpdateParams:   updateArg,
+		CapturedAmount: arg.CapturedAmount,
+		PaymentEvent:   arg.PaymentEvent,
+		EventID:        arg.EventId,
 	})
 	if err != nil {
-		return u.errorResponse(span, "u.repo.UpdateTx.err", err)
+		return nil, u.errorResponse(span, "u.repo.UpdateTx.err", err)
+	}
+
+	if res.Rejection != "" {
+		return u.rejectStatusUpdate(arg, res.Payment, res.Rejection), nil
 	}
 
-	err = u.worker.PaymentStatusUpdated(ctx, &models.PaymentStatusUpdatedTask{PaymentMethod: res.Payment})
+	u.repo.PutCache(ctx, res.Payment)
+	return &models.UpdatePaymentResult{}, nil
+}
+
+// rejectStatusUpdate logs an update which was skipped because it was a duplicate, older than the stored
+// payment or not an allowed transition. The payment is already up to date, so it is not an error.
+func (u *usecaseImpl) rejectStatusUpdate(
+	arg *models.UpdatePaymentRequest,
+	pm *repository.PaymentMethod,
+	rejection string,
+) *models.UpdatePaymentResult {
+	u.log.Infof(
+		"rejected payment status update: payment_method_id: %s, event_id: %s, status: %s -> %s, reason: %s",
+		pm.PaymentMethodID, arg.EventId, pm.PaymentStatus, arg.PaymentStatus, rejection,
+	)
+
+	return &models.UpdatePaymentResult{Rejection: rejection}
+}
+
+// isChildPayment reports whether arg is a payment made to a MULTIPLE_USE payment method, which is
+// recorded on its own instead of settling the payment method.
+func isChildPayment(arg *models.UpdatePaymentRequest) bool {
+	return arg.PaymentReusability == payment.USAGE_TYPE_MULTIPLE_USE &&
+		payment.IsReusableType(arg.PaymentType) &&
+		arg.PaymentId != "" &&
+		arg.PaymentId != arg.PaymentMethodId
+}
+
+func (u *usecaseImpl) recordChildPayment(
+	ctx context.Context,
+	span opentracing.Span,
+	arg *models.UpdatePaymentRequest,
+	updateArg *repository.UpdatePaymentMethodCustomerParams,
+) (*models.UpdatePaymentResult, error) {
+	res, err := u.repo.RecordChildPaymentTx(ctx, &repository.RecordChildPaymentTxParams{
+		ParentPaymentMethodID: arg.PaymentMethodId,
+		PaymentCustomerID:     arg.PaymentCustomerId,
+		PaymentID:             arg.PaymentId,
+		PaymentRequestID:      arg.PaymentRequestId,
+		PaymentStatus:         arg.PaymentStatus,
+		PaymentFailureCode:    updateArg.PaymentFailureCode,
+		Amount:                arg.PaymentAmount,
+		UpdatedAt:             updateArg.UpdatedAt,
+		PaidAt:                updateArg.PaidAt,
+		PaymentEvent:          arg.PaymentEvent,
+		EventID:               arg.EventId,
+	})
 	if err != nil {
-		return u.errorResponse(span, "u.worker.PaymentStatusUpdated.err", err)
+		return nil, u.errorResponse(span, "u.repo.RecordChildPaymentTx.err", err)
+	}
+
+	if res.Rejection != "" {
+		return u.rejectStatusUpdate(arg, res.Payment, res.Rejection), nil
 	}
 
 	u.repo.PutCache(ctx, res.Payment)
-	return nil
+	return &models.UpdatePaymentResult{}, nil
 }
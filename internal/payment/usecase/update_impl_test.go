@@ -3,11 +3,11 @@ package usecase
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
@@ -34,12 +34,13 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 		tname         string
 		body          *models.UpdatePaymentRequest
 		stub          func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
-		checkResponse func(t *testing.T, err error)
+		checkResponse func(t *testing.T, res *models.UpdatePaymentResult, err error)
 	}{
 		{
 			tname: "OK",
 			body: &models.UpdatePaymentRequest{
-				PaymentEvent:       helper.RandomString(32),
+				PaymentEvent:       okArg.PaymentEvent,
+				EventId:            okArg.EventID,
 				PaymentType:        helper.RandomString(32),
 				PaymentCustomerId:  paymentVirtualAccountBankRespOK.PaymentCustomerID,
 				PaymentMethodId:    paymentVirtualAccountBankRespOK.PaymentMethodID,
@@ -51,17 +52,19 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 			},
 			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
 				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
-				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), EqPaymentStatusUpdatedParams(&models.PaymentStatusUpdatedTask{PaymentMethod: paymentVirtualAccountBankRespOK})).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(1)
 			},
-			checkResponse: func(t *testing.T, err error) {
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
 				require.NoError(t, err)
+				require.Empty(t, res.Rejection)
 			},
 		},
 		{
-			tname: "ERR_UPDATE_PAYMENT_METHOD_NOT_FOUND",
+			tname: "OK_REJECTED_TRANSITION",
 			body: &models.UpdatePaymentRequest{
-				PaymentEvent:       helper.RandomString(32),
+				PaymentEvent:       okArg.PaymentEvent,
+				EventId:            okArg.EventID,
 				PaymentType:        helper.RandomString(32),
 				PaymentCustomerId:  paymentVirtualAccountBankRespOK.PaymentCustomerID,
 				PaymentMethodId:    paymentVirtualAccountBankRespOK.PaymentMethodID,
@@ -72,18 +75,23 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 				PaymentFailureCode: &paymentVirtualAccountBankRespOK.PaymentDescription,
 			},
 			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
-				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{}, pgx.ErrNoRows)
-				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), EqPaymentStatusUpdatedParams(&models.PaymentStatusUpdatedTask{PaymentMethod: paymentVirtualAccountBankRespOK})).Times(0)
-				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
+				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{
+					Payment:   paymentVirtualAccountBankRespStatusSucceeded,
+					Rejection: payment.STATUS_UPDATE_REJECTED_TRANSITION,
+				}, nil)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
 			},
-			checkResponse: func(t *testing.T, err error) {
-				require.Error(t, err)
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_UPDATE_REJECTED_TRANSITION, res.Rejection)
 			},
 		},
 		{
-			tname: "ERR_UPDATE_PAYMENT_METHOD_INTERNAL_SERVER_ERROR",
+			tname: "OK_REJECTED_DUPLICATE_EVENT",
 			body: &models.UpdatePaymentRequest{
-				PaymentEvent:       helper.RandomString(32),
+				PaymentEvent:       okArg.PaymentEvent,
+				EventId:            okArg.EventID,
 				PaymentType:        helper.RandomString(32),
 				PaymentCustomerId:  paymentVirtualAccountBankRespOK.PaymentCustomerID,
 				PaymentMethodId:    paymentVirtualAccountBankRespOK.PaymentMethodID,
@@ -94,19 +102,46 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 				PaymentFailureCode: &paymentVirtualAccountBankRespOK.PaymentDescription,
 			},
 			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
-
-				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
-				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), EqPaymentStatusUpdatedParams(&models.PaymentStatusUpdatedTask{PaymentMethod: paymentVirtualAccountBankRespOK})).Times(0)
+				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{
+					Payment:   paymentVirtualAccountBankRespStatusFailed,
+					Rejection: payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT,
+				}, nil)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_UPDATE_REJECTED_DUPLICATE_EVENT, res.Rejection)
+			},
+		},
+		{
+			tname: "ERR_UPDATE_PAYMENT_METHOD_NOT_FOUND",
+			body: &models.UpdatePaymentRequest{
+				PaymentEvent:       okArg.PaymentEvent,
+				EventId:            okArg.EventID,
+				PaymentType:        helper.RandomString(32),
+				PaymentCustomerId:  paymentVirtualAccountBankRespOK.PaymentCustomerID,
+				PaymentMethodId:    paymentVirtualAccountBankRespOK.PaymentMethodID,
+				PaymentBusinessId:  helper.RandomString(32),
+				PaymentChannel:     helper.RandomString(32),
+				UpdatedAt:          &paymentVirtualAccountBankRespOK.UpdatedAt.Time,
+				PaymentStatus:      okArg.UpdateParams.PaymentStatus.String,
+				PaymentFailureCode: &paymentVirtualAccountBankRespOK.PaymentDescription,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{}, pgx.ErrNoRows)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 			},
-			checkResponse: func(t *testing.T, err error) {
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
 				require.Error(t, err)
 			},
 		},
 		{
-			tname: "ERR_UPDATE_PAYMENT_METHOD_WORKER_ERROR",
+			tname: "ERR_UPDATE_PAYMENT_METHOD_INTERNAL_SERVER_ERROR",
 			body: &models.UpdatePaymentRequest{
-				PaymentEvent:       helper.RandomString(32),
+				PaymentEvent:       okArg.PaymentEvent,
+				EventId:            okArg.EventID,
 				PaymentType:        helper.RandomString(32),
 				PaymentCustomerId:  paymentVirtualAccountBankRespOK.PaymentCustomerID,
 				PaymentMethodId:    paymentVirtualAccountBankRespOK.PaymentMethodID,
@@ -118,11 +153,11 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 			},
 			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
 
-				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{Payment: paymentVirtualAccountBankRespOK}, nil)
-				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), EqPaymentStatusUpdatedParams(&models.PaymentStatusUpdatedTask{PaymentMethod: paymentVirtualAccountBankRespOK})).Times(1).Return(errors.New("error"))
+				store.EXPECT().UpdateTx(gomock.Any(), EqUpdateTxParamsMatcher(okArg)).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
 				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentVirtualAccountBankRespOK)).Times(0)
 			},
-			checkResponse: func(t *testing.T, err error) {
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
 				require.Error(t, err)
 			},
 		},
@@ -140,11 +175,142 @@ func Test_MOCK_UPDATE_PAYMENT(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
+			tc.stub(store, wkstore)
+
+			res, err := u.Update(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func Test_MOCK_UPDATE_CHILD_PAYMENT(t *testing.T) {
+	_, paymentReusable := createRandomVirtualAccountBankPayment(t)
+	paymentReusable.PaymentReusability = payment.USAGE_TYPE_MULTIPLE_USE
+
+	paymentID := "py-" + helper.RandomString(26)
+	paymentRequestID := "pr-" + helper.RandomString(26)
+	paidAmount := paymentReusable.PaymentAmount.Add(paymentReusable.PaymentAmount)
+
+	childPayment := *paymentReusable
+	childPayment.PaymentMethodID = paymentID
+	childPayment.PaymentRequestID = pgtype.Text{String: paymentRequestID, Valid: true}
+	childPayment.PaymentReusability = payment.USAGE_TYPE_ONE_TIME_USE
+	childPayment.PaymentStatus = payment.STATUS_SUCCEEDED
+	childPayment.PaymentAmount = paidAmount
+	childPayment.PaymentParentUid = pgtype.Text{String: paymentReusable.Uid, Valid: true}
+
+	body := func(reusability string) *models.UpdatePaymentRequest {
+		return &models.UpdatePaymentRequest{
+			PaymentEvent:       "payment.succeeded",
+			PaymentType:        payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+			PaymentCustomerId:  paymentReusable.PaymentCustomerID,
+			PaymentMethodId:    paymentReusable.PaymentMethodID,
+			PaymentBusinessId:  paymentReusable.PaymentBusinessID,
+			PaymentChannel:     paymentReusable.PaymentChannel,
+			UpdatedAt:          &paymentReusable.UpdatedAt.Time,
+			PaymentStatus:      payment.STATUS_SUCCEEDED,
+			PaymentId:          paymentID,
+			PaymentRequestId:   paymentRequestID,
+			PaymentReusability: reusability,
+			PaymentAmount:      &paidAmount,
+		}
+	}
+
+	testCases := []struct {
+		tname         string
+		body          *models.UpdatePaymentRequest
+		stub          func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, res *models.UpdatePaymentResult, err error)
+	}{
+		{
+			tname: "OK",
+			body:  body(payment.USAGE_TYPE_MULTIPLE_USE),
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RecordChildPaymentTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.RecordChildPaymentTxParams) (repository.RecordChildPaymentTxResult, error) {
+						require.Equal(t, paymentReusable.PaymentMethodID, arg.ParentPaymentMethodID)
+						require.Equal(t, paymentReusable.PaymentCustomerID, arg.PaymentCustomerID)
+						require.Equal(t, paymentID, arg.PaymentID)
+						require.Equal(t, paymentRequestID, arg.PaymentRequestID)
+						require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+						require.True(t, paidAmount.Equal(*arg.Amount))
+						require.True(t, arg.PaidAt.Valid)
+						require.Equal(t, "payment.succeeded", arg.PaymentEvent)
+						return repository.RecordChildPaymentTxResult{Parent: paymentReusable, Payment: &childPayment}, nil
+					},
+				)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(&childPayment)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Rejection)
+			},
+		},
+		{
+			tname: "OK_ONE_TIME_USE_SETTLES_THE_PAYMENT_METHOD",
+			body:  body(payment.USAGE_TYPE_ONE_TIME_USE),
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().RecordChildPaymentTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateTxResult{Payment: paymentReusable}, nil)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentReusable)).Times(1)
+			},
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Rejection)
+			},
+		},
+		{
+			tname: "OK_REJECTED_STALE_UPDATE",
+			body:  body(payment.USAGE_TYPE_MULTIPLE_USE),
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().RecordChildPaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.RecordChildPaymentTxResult{
+					Parent:    paymentReusable,
+					Payment:   &childPayment,
+					Rejection: payment.STATUS_UPDATE_REJECTED_STALE,
+				}, nil)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_UPDATE_REJECTED_STALE, res.Rejection)
+			},
+		},
+		{
+			tname: "ERR_RECORD_CHILD_PAYMENT_TX_INTERNAL_SERVER_ERROR",
+			body:  body(payment.USAGE_TYPE_MULTIPLE_USE),
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().RecordChildPaymentTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.RecordChildPaymentTxResult{}, sql.ErrConnDone)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *models.UpdatePaymentResult, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
 			tc.stub(store, wkstore)
 
-			actualError := u.Update(context.TODO(), tc.body)
-			tc.checkResponse(t, actualError)
+			res, err := u.Update(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
 		})
 	}
 }
@@ -207,42 +373,6 @@ func (ex *eqUpdateTxParamsMatcher) String() string {
 	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
 }
 
-type eqPaymentStatusUpdatedParamsMatcher struct {
-	arg *models.PaymentStatusUpdatedTask
-}
-
-func EqPaymentStatusUpdatedParams(arg *models.PaymentStatusUpdatedTask) gomock.Matcher {
-	return eqPaymentStatusUpdatedParamsMatcher{arg}
-}
-
-func (ex eqPaymentStatusUpdatedParamsMatcher) Matches(x interface{}) bool {
-	arg, ok := x.(*models.PaymentStatusUpdatedTask)
-	if !ok {
-		return false
-	}
-
-	ex.arg.PaymentMethod = arg.PaymentMethod
-
-	if arg.PaymentMethod == nil {
-		return false
-	}
-
-	if !reflect.DeepEqual(ex.arg.PaymentMethod, arg.PaymentMethod) {
-		return false
-	}
-
-	return true
-}
-
-func (ex eqPaymentStatusUpdatedParamsMatcher) String() string {
-	var errMsg string
-	if ex.arg.PaymentMethod == nil {
-		errMsg += "PaymentMethod should not be empty/nil"
-	}
-
-	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
-}
-
 func createRandomUpdateArg(t *testing.T, paymentMethod *repository.PaymentMethod) *repository.UpdateTxParams {
 	return &repository.UpdateTxParams{
 		UpdateParams: repository.UpdatePaymentMethodCustomerParams{
@@ -261,5 +391,7 @@ func createRandomUpdateArg(t *testing.T, paymentMethod *repository.PaymentMethod
 				Valid: true,
 			},
 		},
+		PaymentEvent: helper.RandomString(32),
+		EventID:      helper.RandomString(32),
 	}
 }
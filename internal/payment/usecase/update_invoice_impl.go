@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// UpdateInvoice applies an invoice callback, only the callback which pays the invoice publishes
+// the invoice-paid event, so a redelivered one publishes nothing.
+func (u *usecaseImpl) UpdateInvoice(ctx context.Context, arg *models.UpdateInvoiceRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.UpdateInvoice")
+	defer span.Finish()
+
+	updateArg := repository.UpdateInvoiceTxParams{
+		InvoiceID:      arg.InvoiceId,
+		InvoiceStatus:  arg.InvoiceStatus,
+		PaymentID:      arg.PaymentId,
+		PaymentChannel: arg.PaymentChannel,
+		Amount:         arg.PaymentAmount,
+	}
+
+	if arg.UpdatedAt != nil {
+		updateArg.UpdatedAt = pgtype.Timestamptz{
+			Time:  *arg.UpdatedAt,
+			Valid: true,
+		}
+	}
+
+	if arg.PaidAt != nil {
+		updateArg.PaidAt = pgtype.Timestamptz{
+			Time:  *arg.PaidAt,
+			Valid: true,
+		}
+	} else if payment.IsInvoicePaidStatus(arg.InvoiceStatus) {
+		updateArg.PaidAt = updateArg.UpdatedAt
+	}
+
+	res, err := u.repo.UpdateInvoiceTx(ctx, &updateArg)
+	if err != nil {
+		return u.errorResponse(span, "u.repo.UpdateInvoiceTx.err", err)
+	}
+
+	if !res.Paid {
+		return nil
+	}
+
+	err = u.worker.InvoicePaid(ctx, &models.InvoicePaidTask{
+		Invoice:       res.Invoice,
+		PaymentMethod: res.Payment,
+	})
+	if err != nil {
+		return u.errorResponse(span, "u.worker.InvoicePaid.err", err)
+	}
+
+	u.repo.PutCache(ctx, res.Payment)
+	return nil
+}
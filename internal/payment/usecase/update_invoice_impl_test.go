@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_UPDATE_INVOICE(t *testing.T) {
+	_, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	paymentRespOK.PaymentStatus = payment.STATUS_SUCCEEDED
+
+	invoiceOK := createRandomInvoice(t)
+	invoiceOK.InvoiceStatus = payment.INVOICE_STATUS_PAID
+	paymentRespOK.PaymentInvoiceUid = pgtype.Text{String: invoiceOK.Uid, Valid: true}
+
+	updatedAt := time.Now().UTC()
+
+	testCases := []struct {
+		tname         string
+		body          *models.UpdateInvoiceRequest
+		stub          func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK_PAID",
+			body: &models.UpdateInvoiceRequest{
+				InvoiceId:      invoiceOK.InvoiceID,
+				InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+				PaymentId:      paymentRespOK.PaymentMethodID,
+				PaymentChannel: paymentRespOK.PaymentChannel,
+				PaymentAmount:  &paymentRespOK.PaymentAmount,
+				UpdatedAt:      &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateInvoiceTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateInvoiceTxParams) (repository.UpdateInvoiceTxResult, error) {
+						require.Equal(t, invoiceOK.InvoiceID, arg.InvoiceID)
+						require.Equal(t, payment.INVOICE_STATUS_PAID, arg.InvoiceStatus)
+						require.Equal(t, paymentRespOK.PaymentChannel, arg.PaymentChannel)
+						// a paid callback without paid_at is paid when it was updated.
+						require.True(t, arg.PaidAt.Valid)
+						require.Equal(t, updatedAt, arg.PaidAt.Time)
+
+						return repository.UpdateInvoiceTxResult{Invoice: invoiceOK, Payment: paymentRespOK, Paid: true}, nil
+					},
+				)
+				wkstore.EXPECT().InvoicePaid(gomock.Any(), gomock.Eq(&models.InvoicePaidTask{Invoice: invoiceOK, PaymentMethod: paymentRespOK})).Times(1)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Eq(paymentRespOK)).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_REDELIVERED_PAID",
+			body: &models.UpdateInvoiceRequest{
+				InvoiceId:      invoiceOK.InvoiceID,
+				InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+				PaymentChannel: paymentRespOK.PaymentChannel,
+				UpdatedAt:      &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateInvoiceTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateInvoiceTxResult{Invoice: invoiceOK, Payment: paymentRespOK}, nil)
+				wkstore.EXPECT().InvoicePaid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_EXPIRED",
+			body: &models.UpdateInvoiceRequest{
+				InvoiceId:     invoiceOK.InvoiceID,
+				InvoiceStatus: payment.INVOICE_STATUS_EXPIRED,
+				UpdatedAt:     &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateInvoiceTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateInvoiceTxParams) (repository.UpdateInvoiceTxResult, error) {
+						require.False(t, arg.PaidAt.Valid)
+
+						expiredInvoice := *invoiceOK
+						expiredInvoice.InvoiceStatus = payment.INVOICE_STATUS_EXPIRED
+						return repository.UpdateInvoiceTxResult{Invoice: &expiredInvoice}, nil
+					},
+				)
+				wkstore.EXPECT().InvoicePaid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_INVOICE_NOT_FOUND",
+			body: &models.UpdateInvoiceRequest{
+				InvoiceId:     helper.RandomString(32),
+				InvoiceStatus: payment.INVOICE_STATUS_PAID,
+				UpdatedAt:     &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateInvoiceTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateInvoiceTxResult{}, pgx.ErrNoRows)
+				wkstore.EXPECT().InvoicePaid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+			},
+		},
+		{
+			tname: "ERR_INVOICE_PAID_NOT_PUBLISHED",
+			body: &models.UpdateInvoiceRequest{
+				InvoiceId:      invoiceOK.InvoiceID,
+				InvoiceStatus:  payment.INVOICE_STATUS_PAID,
+				PaymentChannel: paymentRespOK.PaymentChannel,
+				UpdatedAt:      &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdateInvoiceTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateInvoiceTxResult{Invoice: invoiceOK, Payment: paymentRespOK, Paid: true}, nil)
+				wkstore.EXPECT().InvoicePaid(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+				store.EXPECT().PutCache(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, gateway.NewFakeProvider())
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stub(store, wkstore)
+
+			err := u.UpdateInvoice(context.TODO(), tc.body)
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func createRandomInvoice(t *testing.T) *repository.Invoice {
+	uid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, uid)
+
+	invoiceID, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, invoiceID)
+
+	_, paymentParams := createRandomVirtualAccountBankPayment(t)
+
+	return &repository.Invoice{
+		Uid:                    uid.String(),
+		InvoiceID:              invoiceID.String(),
+		InvoiceReferenceID:     helper.RandomString(26),
+		InvoiceBusinessID:      helper.RandomString(24),
+		PaymentCustomerID:      paymentParams.PaymentCustomerID,
+		InvoiceStatus:          payment.INVOICE_STATUS_PENDING,
+		InvoiceAmount:          paymentParams.PaymentAmount,
+		Currency:               paymentParams.Currency,
+		InvoiceAllowedChannels: []string{paymentParams.PaymentChannel},
+		InvoiceUrl:             helper.RandomUrl(),
+		InvoiceDescription:     paymentParams.PaymentDescription,
+		CreatedAt:              pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		ExpiresAt:              pgtype.Timestamptz{Time: time.Now().Add(72 * time.Hour), Valid: true},
+	}
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (u *usecaseImpl) UpdateRefund(ctx context.Context, arg *models.UpdateRefundRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.UpdateRefund")
+	defer span.Finish()
+
+	refund, err := u.repo.GetRefundByRefundID(ctx, pgtype.Text{
+		String: arg.RefundId,
+		Valid:  true,
+	})
+	if err != nil {
+		return u.errorResponse(span, "u.repo.GetRefundByRefundID.err", err)
+	}
+
+	// callbacks can be redelivered, a settled refund is never moved back.
+	if refund.RefundStatus == payment.REFUND_STATUS_SUCCEEDED ||
+		refund.RefundStatus == payment.REFUND_STATUS_FAILED ||
+		refund.RefundStatus == payment.REFUND_STATUS_CANCELLED {
+		return nil
+	}
+
+	updateArg := repository.UpdateRefundParams{
+		Uid: refund.Uid,
+		RefundStatus: pgtype.Text{
+			String: arg.RefundStatus,
+			Valid:  true,
+		},
+	}
+
+	if arg.RefundFailureCode != nil {
+		updateArg.RefundFailureCode = pgtype.Text{
+			String: *arg.RefundFailureCode,
+			Valid:  true,
+		}
+	}
+
+	if arg.UpdatedAt != nil {
+		updateArg.UpdatedAt = pgtype.Timestamptz{
+			Time:  *arg.UpdatedAt,
+			Valid: true,
+		}
+	}
+
+	res, err := u.repo.UpdateRefundTx(ctx, &repository.UpdateRefundTxParams{UpdateParams: updateArg})
+	if err != nil {
+		return u.errorResponse(span, "u.repo.UpdateRefundTx.err", err)
+	}
+
+	err = u.worker.RefundStatusUpdated(ctx, &models.RefundStatusUpdatedTask{Refund: res.Refund})
+	if err != nil {
+		return u.errorResponse(span, "u.worker.RefundStatusUpdated.err", err)
+	}
+
+	return nil
+}
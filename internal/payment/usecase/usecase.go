@@ -7,6 +7,7 @@ import (
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/config"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
@@ -15,23 +16,26 @@ import (
 )
 
 type usecaseImpl struct {
-	log    logger.Logger
-	cfg    *config.App
-	repo   repository.Repository
-	worker domain.ProducerWorker
+	log      logger.Logger
+	cfg      *config.App
+	repo     repository.Repository
+	registry *gateway.Registry
+	worker   domain.ProducerWorker
 }
 
 func New(
 	log logger.Logger,
 	cfg *config.App,
 	repository repository.Repository,
+	registry *gateway.Registry,
 	worker domain.ProducerWorker,
 ) domain.Usecase {
 	return &usecaseImpl{
-		log:    log.WithPrefix(fmt.Sprintf("%s-%s", "payment", constants.Usecase)),
-		cfg:    cfg,
-		repo:   repository,
-		worker: worker,
+		log:      log.WithPrefix(fmt.Sprintf("%s-%s", "payment", constants.Usecase)),
+		cfg:      cfg,
+		repo:     repository,
+		registry: registry,
+		worker:   worker,
 	}
 }
 
@@ -43,6 +47,11 @@ func (u *usecaseImpl) OnConfigUpdate(key string, config *config.App) {
 	u.log.Infof("updated configuration from '%s' key successfully applied", key)
 }
 
+// provider resolves the payment provider registered under the configured payment gateway id.
+func (u *usecaseImpl) provider() (gateway.PaymentProvider, error) {
+	return u.registry.Get(u.cfg.Services.External.PaymentGateway.ID)
+}
+
 func (u *usecaseImpl) errorResponse(span opentracing.Span, details string, err error) error {
 	errfmt := fmt.Errorf("%s: %v", details, err)
 	u.log.Warn(errfmt)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
 	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
@@ -34,7 +35,7 @@ func TestOnConfigUpdate(t *testing.T) {
 			defer workerCtrl.Finish()
 			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
 
-			u := New(tlog, conf, store, wkstore)
+			u := New(tlog, conf, store, gateway.NewRegistry(), wkstore)
 
 			u.OnConfigUpdate(tc.key, tc.config)
 		})
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ValidateDirectDebitLink hands the OTP the bank sent to the customer over to the gateway,
+// the link becomes ACTIVE and chargeable once the gateway has accepted it.
+func (u *usecaseImpl) ValidateDirectDebitLink(ctx context.Context, arg *models.ValidateDirectDebitLinkRequest) (*pb.ValidateDirectDebitLinkResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ValidateDirectDebitLink")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	if res.PaymentType != payment.METHODE_TYPE_DIRECT_DEBIT ||
+		res.PaymentReusability != payment.USAGE_TYPE_MULTIPLE_USE ||
+		res.PaymentStatus != payment.STATUS_REQUIRES_ACTION {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "res.PaymentStatus", res.PaymentStatus),
+			unierror.ErrDirectDebitLinkNotPending,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	linked, err := provider.ValidateDirectDebitLink(ctx, &gateway.ValidateDirectDebitLinkParams{
+		PaymentMethodID: res.PaymentMethodID,
+		OTPCode:         arg.OtpCode,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.ValidateDirectDebitLink.err", err)
+	}
+
+	updatedAt := time.Now()
+	if !linked.UpdatedAt.IsZero() {
+		updatedAt = linked.UpdatedAt
+	}
+
+	updateTx, err := u.repo.UpdateTx(ctx, &repository.UpdateTxParams{
+		UpdateParams: repository.UpdatePaymentMethodCustomerParams{
+			PaymentMethodID:   res.PaymentMethodID,
+			PaymentCustomerID: res.PaymentCustomerID,
+			PaymentStatus: pgtype.Text{
+				String: linked.Status,
+				Valid:  true,
+			},
+			UpdatedAt: pgtype.Timestamptz{
+				Time:  updatedAt,
+				Valid: true,
+			},
+		},
+		PaymentEvent: payment.PAYMENT_EVENT_VALIDATE_DIRECT_DEBIT,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdateTx.err", err)
+	}
+
+	u.repo.DeleteCache(ctx, updateTx.Payment.PaymentCustomerID, updateTx.Payment.PaymentMethodID)
+
+	return &pb.ValidateDirectDebitLinkResponse{
+		PaymentMethod: mapper.PaymentToDto(updateTx.Payment),
+	}, nil
+}
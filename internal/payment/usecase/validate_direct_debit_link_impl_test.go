@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_VALIDATE_DIRECT_DEBIT_LINK(t *testing.T) {
+	testCases := []struct {
+		tname         string
+		otpCode       string
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod)
+		checkResponse func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error)
+	}{
+		{
+			tname:   "OK",
+			otpCode: gateway.FakeProviderDirectDebitOTP,
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod) {
+				linkActive := *linkPending
+				linkActive.PaymentStatus = payment.STATUS_ACTIVE
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(linkPending, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+						require.Equal(t, linkPending.PaymentMethodID, arg.UpdateParams.PaymentMethodID)
+						require.Equal(t, linkPending.PaymentCustomerID, arg.UpdateParams.PaymentCustomerID)
+						require.Equal(t, payment.STATUS_ACTIVE, arg.UpdateParams.PaymentStatus.String)
+						require.True(t, arg.UpdateParams.UpdatedAt.Valid)
+						return repository.UpdateTxResult{Payment: &linkActive}, nil
+					},
+				)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Eq(linkPending.PaymentCustomerID), gomock.Eq(linkPending.PaymentMethodID)).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_ACTIVE, res.GetPaymentMethod().GetPaymentStatus())
+			},
+		},
+		{
+			tname:   "ERR_LINK_NOT_FOUND",
+			otpCode: gateway.FakeProviderDirectDebitOTP,
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:   "ERR_LINK_NOT_PENDING",
+			otpCode: gateway.FakeProviderDirectDebitOTP,
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod) {
+				linkActive := *linkPending
+				linkActive.PaymentStatus = payment.STATUS_ACTIVE
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(&linkActive, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrDirectDebitLinkNotPending)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:   "ERR_INVALID_OTP_CODE",
+			otpCode: helper.RandomStringInt(7),
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(linkPending, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidOTPCode)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname:   "ERR_UPDATE_TX_INTERNAL_SERVER_ERROR",
+			otpCode: gateway.FakeProviderDirectDebitOTP,
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, linkPending *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(linkPending, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateDirectDebitLinkResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			linkPending := createRandomProviderPendingDirectDebitLink(t, provider, helper.RandomString(26))
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, linkPending)
+
+			res, err := u.ValidateDirectDebitLink(context.TODO(), &models.ValidateDirectDebitLinkRequest{
+				PaymentCustomerId: linkPending.PaymentCustomerID,
+				PaymentMethodId:   linkPending.PaymentMethodID,
+				OtpCode:           tc.otpCode,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
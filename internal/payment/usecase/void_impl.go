@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Void releases the held funds of a manually captured card payment which has not been captured,
+// e.g. when the seller never confirms the order.
+func (u *usecaseImpl) Void(ctx context.Context, arg *models.VoidPaymentRequest) (*pb.VoidPaymentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.Void")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetPaymentMethodCustomer(ctx, &repository.GetPaymentMethodCustomerParams{
+		PaymentMethodID:   arg.PaymentMethodId,
+		PaymentCustomerID: arg.PaymentCustomerId,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPaymentMethodCustomer.err", err)
+	}
+
+	if res.PaymentStatus != payment.STATUS_AWAITING_CAPTURE {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "res.PaymentStatus", res.PaymentStatus),
+			unierror.ErrPaymentNotVoidable,
+		)
+	}
+
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	voided, err := provider.VoidPayment(gateway.ForUser(ctx, res.PaymentForUserID.String), &gateway.VoidPaymentParams{
+		PaymentMethodID:  res.PaymentMethodID,
+		PaymentRequestID: res.PaymentRequestID.String,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.VoidPayment.err", err)
+	}
+
+	updatedAt := time.Now()
+	if !voided.UpdatedAt.IsZero() {
+		updatedAt = voided.UpdatedAt
+	}
+
+	updateTx, err := u.repo.UpdateTx(ctx, &repository.UpdateTxParams{
+		UpdateParams: repository.UpdatePaymentMethodCustomerParams{
+			PaymentMethodID:   res.PaymentMethodID,
+			PaymentCustomerID: res.PaymentCustomerID,
+			PaymentStatus: pgtype.Text{
+				String: payment.STATUS_VOIDED,
+				Valid:  true,
+			},
+			UpdatedAt: pgtype.Timestamptz{
+				Time:  updatedAt,
+				Valid: true,
+			},
+		},
+		PaymentEvent: payment.PAYMENT_EVENT_VOID,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdateTx.err", err)
+	}
+
+	u.repo.DeleteCache(ctx, updateTx.Payment.PaymentCustomerID, updateTx.Payment.PaymentMethodID)
+
+	return &pb.VoidPaymentResponse{
+		PaymentMethod: mapper.PaymentToDto(updateTx.Payment),
+	}, nil
+}
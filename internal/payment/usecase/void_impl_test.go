@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_VOID_PAYMENT(t *testing.T) {
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod)
+		checkResponse func(t *testing.T, res *pb.VoidPaymentResponse, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				paymentVoided := *paymentAuthorized
+				paymentVoided.PaymentStatus = payment.STATUS_VOIDED
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdateTxParams) (repository.UpdateTxResult, error) {
+						require.Equal(t, paymentAuthorized.PaymentMethodID, arg.UpdateParams.PaymentMethodID)
+						require.Equal(t, paymentAuthorized.PaymentCustomerID, arg.UpdateParams.PaymentCustomerID)
+						require.Equal(t, payment.STATUS_VOIDED, arg.UpdateParams.PaymentStatus.String)
+						require.Nil(t, arg.CapturedAmount)
+						return repository.UpdateTxResult{Payment: &paymentVoided}, nil
+					},
+				)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Eq(paymentAuthorized.PaymentCustomerID), gomock.Eq(paymentAuthorized.PaymentMethodID)).Times(1)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VoidPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.STATUS_VOIDED, res.GetPaymentMethod().GetPaymentStatus())
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_FOUND",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VoidPaymentResponse, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PAYMENT_NOT_VOIDABLE",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				paymentSucceeded := *paymentAuthorized
+				paymentSucceeded.PaymentStatus = payment.STATUS_SUCCEEDED
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(&paymentSucceeded, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VoidPaymentResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrPaymentNotVoidable)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_ERROR",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VoidPaymentResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_UPDATE_TX_INTERNAL_SERVER_ERROR",
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider, paymentAuthorized *repository.PaymentMethod) {
+				store.EXPECT().GetPaymentMethodCustomer(gomock.Any(), gomock.Any()).Times(1).Return(paymentAuthorized, nil)
+				store.EXPECT().UpdateTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdateTxResult{}, sql.ErrConnDone)
+				store.EXPECT().DeleteCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VoidPaymentResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			paymentAuthorized := createRandomProviderCardPayment(t, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, provider, paymentAuthorized)
+
+			res, err := u.Void(context.TODO(), &models.VoidPaymentRequest{
+				PaymentCustomerId: paymentAuthorized.PaymentCustomerID,
+				PaymentMethodId:   paymentAuthorized.PaymentMethodID,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	ExpirySweeperEvent = "expiry_sweeper.reconciled"
+)
+
+// ExpirySweeper periodically reconciles outstanding payments past their expires_at with the gateway,
+// which covers payments whose callback never arrived. Only the replica holding the sweeper lock sweeps.
+type ExpirySweeper struct {
+	log      logger.Logger
+	cfg      *config.App
+	repo     repository.Repository
+	registry *gateway.Registry
+	usecase  domain.Usecase
+	metrics  *metrics.Metrics
+	token    string
+}
+
+func NewExpirySweeper(
+	log logger.Logger,
+	cfg *config.App,
+	repo repository.Repository,
+	registry *gateway.Registry,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+) domain.ExpirySweeper {
+	return &ExpirySweeper{
+		log:      log.WithPrefix(fmt.Sprintf("%s-%s", "payment-expiry-sweeper", constants.Worker)),
+		cfg:      cfg,
+		repo:     repo,
+		registry: registry,
+		usecase:  usecase,
+		metrics:  metrics,
+		token:    helper.RandomString(32),
+	}
+}
+
+// Run sweeps and prunes the processed events on their own intervals. Both run under the sweeper lock,
+// they share this loop so that one never finds the lock held by the other.
+func (s *ExpirySweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Services.Internal.ExpirySweeper.Interval)
+	defer ticker.Stop()
+
+	pruneTicker := time.NewTicker(s.cfg.Services.Internal.ProcessedEventPruner.Interval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if s.cfg.Services.Internal.ExpirySweeper.Enable {
+				if err := s.Sweep(ctx); err != nil {
+					s.log.Warnf("s.Sweep.err: %v", err)
+				}
+			}
+
+			ticker.Reset(s.cfg.Services.Internal.ExpirySweeper.Interval)
+		case <-pruneTicker.C:
+			if s.cfg.Services.Internal.ProcessedEventPruner.Enable {
+				if err := s.Prune(ctx); err != nil {
+					s.log.Warnf("s.Prune.err: %v", err)
+				}
+			}
+
+			pruneTicker.Reset(s.cfg.Services.Internal.ProcessedEventPruner.Interval)
+		}
+	}
+}
+
+// Sweep walks the overdue payments in batches of the configured size, it returns without sweeping
+// when another replica holds the sweeper lock. The lock is refreshed before every further batch,
+// so a long sweep stops as soon as the lock has passed to another replica.
+func (s *ExpirySweeper) Sweep(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExpirySweeper.Sweep")
+	defer span.Finish()
+
+	acquired, err := s.repo.AcquireExpirySweeperLock(ctx, s.token)
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("s.repo.AcquireExpirySweeperLock.err: %v", err))
+	}
+
+	if !acquired {
+		s.log.Debug("expiry sweeper lock is held by another replica, skipping sweep")
+		return nil
+	}
+	defer s.repo.ReleaseExpirySweeperLock(ctx, s.token)
+
+	arg := repository.ListOverduePaymentMethodsParams{
+		PaymentStatuses: payment.OutstandingStatuses(),
+		ExpiresBefore: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		AfterExpiresAt: pgtype.Timestamptz{
+			Time:  time.Time{},
+			Valid: true,
+		},
+		BatchSize: s.cfg.Services.Internal.ExpirySweeper.BatchSize,
+	}
+
+	for batch := 0; ; batch++ {
+		if batch > 0 {
+			refreshed, err := s.repo.RefreshExpirySweeperLock(ctx, s.token)
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("s.repo.RefreshExpirySweeperLock.err: %v", err))
+			}
+
+			if !refreshed {
+				s.log.Warn("expiry sweeper lock expired while sweeping, leaving the rest to the lock holder")
+				return nil
+			}
+		}
+
+		list, err := s.repo.ListOverduePaymentMethods(ctx, &arg)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("s.repo.ListOverduePaymentMethods.err: %v", err))
+		}
+
+		for _, pm := range list {
+			if err := s.reconcile(ctx, pm); err != nil {
+				s.log.Warnf("s.reconcile.err: payment_method_id: %s, err: %v", pm.PaymentMethodID, err)
+			}
+		}
+
+		if int32(len(list)) < arg.BatchSize {
+			return nil
+		}
+
+		last := list[len(list)-1]
+		arg.AfterExpiresAt = last.ExpiresAt
+		arg.AfterUid = last.Uid
+	}
+}
+
+// Prune forgets the events applied before the retention period, it returns without pruning when another
+// replica holds the sweeper lock. A redelivery of a pruned event is no longer dropped as a duplicate,
+// the status transition and staleness checks still reject it.
+func (s *ExpirySweeper) Prune(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExpirySweeper.Prune")
+	defer span.Finish()
+
+	retention := s.cfg.Services.Internal.ProcessedEventPruner.Retention
+	if retention <= 0 {
+		return nil
+	}
+
+	acquired, err := s.repo.AcquireExpirySweeperLock(ctx, s.token)
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("s.repo.AcquireExpirySweeperLock.err: %v", err))
+	}
+
+	if !acquired {
+		s.log.Debug("expiry sweeper lock is held by another replica, skipping prune")
+		return nil
+	}
+	defer s.repo.ReleaseExpirySweeperLock(ctx, s.token)
+
+	deleted, err := s.repo.DeleteProcessedEventsBefore(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(-retention),
+		Valid: true,
+	})
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("s.repo.DeleteProcessedEventsBefore.err: %v", err))
+	}
+
+	s.log.Debugf("pruned %d processed events older than %s", deleted, retention)
+	return nil
+}
+
+func (s *ExpirySweeper) reconcile(ctx context.Context, pm *repository.PaymentMethod) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExpirySweeper.reconcile")
+	defer span.Finish()
+
+	provider, err := s.registry.Get(s.cfg.Services.External.PaymentGateway.ID)
+	if err != nil {
+		return tracing.TraceWithError(span, err)
+	}
+
+	// a payment collected for a sub-account is only found through it.
+	ctx = gateway.ForUser(ctx, pm.PaymentForUserID.String)
+
+	var res *gateway.Payment
+
+	switch pm.PaymentType {
+	case payment.METHODE_TYPE_EWALLET:
+		res, err = provider.GetEwalletPaymentRequestByID(ctx, pm.PaymentRequestID.String)
+	case payment.METHODE_TYPE_QR_CODE:
+		res, err = provider.GetQrCodePaymentByID(ctx, pm.PaymentMethodID)
+	case payment.METHODE_TYPE_VIRTUAL_ACCOUNT:
+		res, err = provider.GetVirtualAccountBankPaymentByID(ctx, pm.PaymentMethodID)
+	case payment.METHODE_TYPE_OVER_THE_COUNTER:
+		res, err = provider.GetOverTheCounterPaymentByID(ctx, pm.PaymentMethodID)
+	case payment.METHODE_TYPE_CARD:
+		res, err = provider.GetCardPaymentRequestByID(ctx, pm.PaymentRequestID.String)
+	default:
+		s.log.Debugf("unsupported payment type for expiry sweeper: %s", pm.PaymentType)
+		return nil
+	}
+	if err != nil {
+		return tracing.TraceWithError(span, err)
+	}
+
+	// the gateway has not settled the payment yet, it is picked up again on the next sweep.
+	if res.Status == "" || res.Status == pm.PaymentStatus {
+		return nil
+	}
+
+	updateArg := models.UpdatePaymentRequest{
+		PaymentEvent:      ExpirySweeperEvent,
+		PaymentType:       pm.PaymentType,
+		PaymentCustomerId: pm.PaymentCustomerID,
+		PaymentMethodId:   pm.PaymentMethodID,
+		PaymentBusinessId: pm.PaymentBusinessID,
+		PaymentChannel:    pm.PaymentChannel,
+		PaymentStatus:     res.Status,
+	}
+
+	if res.FailureCode != "" {
+		updateArg.PaymentFailureCode = &res.FailureCode
+	}
+
+	if !res.UpdatedAt.IsZero() {
+		updateArg.UpdatedAt = &res.UpdatedAt
+	}
+
+	updated, err := s.usecase.Update(ctx, &updateArg)
+	if err != nil {
+		return tracing.TraceWithError(span, err)
+	}
+
+	// a callback of the gateway got there first.
+	if updated.Rejection != "" {
+		return nil
+	}
+
+	s.metrics.ExpiredPaymentsReconciled.Inc()
+	return nil
+}
+
+func (s *ExpirySweeper) OnConfigUpdate(key string, config *config.App) {
+	s.log.Infof("received update from '%s' key", key)
+
+	s.cfg = config
+
+	s.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
@@ -0,0 +1,380 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_EXPIRY_SWEEPER_SWEEP(t *testing.T) {
+	sweeperConf := createExpirySweeperConfig(2)
+	sweeperMetrics := metrics.New(sweeperConf)
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				succeeded := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_QR_CODE)
+				require.NoError(t, provider.SetPaymentStatus(succeeded.PaymentMethodID, payment.STATUS_SUCCEEDED))
+
+				outstanding := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+
+				expired := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+				require.NoError(t, provider.SetPaymentStatus(expired.PaymentMethodID, payment.STATUS_EXPIRED))
+
+				gomock.InOrder(
+					store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *repository.ListOverduePaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+							require.Equal(t, int32(2), arg.BatchSize)
+							require.Empty(t, arg.AfterUid)
+							require.ElementsMatch(t, []string{payment.STATUS_ACTIVE, payment.STATUS_PENDING, payment.STATUS_REQUIRES_ACTION}, arg.PaymentStatuses)
+							return []*repository.PaymentMethod{succeeded, outstanding}, nil
+						},
+					),
+					store.EXPECT().RefreshExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *repository.ListOverduePaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+							require.Equal(t, outstanding.Uid, arg.AfterUid)
+							require.Equal(t, outstanding.ExpiresAt, arg.AfterExpiresAt)
+							return []*repository.PaymentMethod{expired}, nil
+						},
+					),
+					store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+					func(_ any, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+						require.Equal(t, ExpirySweeperEvent, arg.PaymentEvent)
+						require.NotNil(t, arg.UpdatedAt)
+
+						switch arg.PaymentMethodId {
+						case succeeded.PaymentMethodID:
+							require.Equal(t, payment.STATUS_SUCCEEDED, arg.PaymentStatus)
+							require.Equal(t, succeeded.PaymentCustomerID, arg.PaymentCustomerId)
+						case expired.PaymentMethodID:
+							require.Equal(t, payment.STATUS_EXPIRED, arg.PaymentStatus)
+							require.Equal(t, expired.PaymentCustomerID, arg.PaymentCustomerId)
+						default:
+							t.Fatalf("unexpected update for payment method %s", arg.PaymentMethodId)
+						}
+
+						return &models.UpdatePaymentResult{}, nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_HELD_BY_ANOTHER_REPLICA",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(0)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_LOST_BETWEEN_BATCHES",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				first := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+				second := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.PaymentMethod{first, second}, nil),
+					store.EXPECT().RefreshExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil),
+					store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_REFRESH_LOCK",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				first := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+				second := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.PaymentMethod{first, second}, nil)
+				store.EXPECT().RefreshExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "OK_PROVIDER_ERROR_SKIPS_PAYMENT",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				failed := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_EWALLET)
+				require.NoError(t, provider.SetPaymentStatus(failed.PaymentMethodID, payment.STATUS_FAILED))
+
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.PaymentMethod{failed}, nil)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_ACQUIRE_LOCK",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(0)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_LIST_OVERDUE_PAYMENTS",
+			stubs: func(store *mock.MockRepository, usecase *wkmock.MockUsecase, provider *gateway.FakeProvider) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListOverduePaymentMethods(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1)
+				usecase.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			usecaseCtrl := gomock.NewController(t)
+			defer usecaseCtrl.Finish()
+			usecase := wkmock.NewMockUsecase(usecaseCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(sweeperConf.Services.External.PaymentGateway.ID, provider)
+
+			s := NewExpirySweeper(tlog, sweeperConf, store, registry, usecase, sweeperMetrics)
+			tc.stubs(store, usecase, provider)
+
+			err := s.Sweep(context.TODO())
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func Test_MOCK_EXPIRY_SWEEPER_PRUNE(t *testing.T) {
+	testCases := []struct {
+		tname         string
+		retention     time.Duration
+		stubs         func(store *mock.MockRepository)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname:     "OK",
+			retention: processedEventRetention,
+			stubs: func(store *mock.MockRepository) {
+				gomock.InOrder(
+					store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().DeleteProcessedEventsBefore(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, processedBefore pgtype.Timestamptz) (int64, error) {
+							require.True(t, processedBefore.Valid)
+							require.WithinDuration(t, time.Now().Add(-processedEventRetention), processedBefore.Time, time.Minute)
+							return 3, nil
+						},
+					),
+					store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname:     "OK_RETENTION_KEEPS_EVENTS_FOREVER",
+			retention: 0,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteProcessedEventsBefore(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname:     "OK_LOCK_HELD_BY_ANOTHER_REPLICA",
+			retention: processedEventRetention,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().DeleteProcessedEventsBefore(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname:     "ERR_ACQUIRE_LOCK",
+			retention: processedEventRetention,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().DeleteProcessedEventsBefore(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname:     "ERR_DELETE_PROCESSED_EVENTS",
+			retention: processedEventRetention,
+			stubs: func(store *mock.MockRepository) {
+				store.EXPECT().AcquireExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().DeleteProcessedEventsBefore(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), sql.ErrConnDone)
+				store.EXPECT().ReleaseExpirySweeperLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			sweeperConf := createExpirySweeperConfig(100)
+			sweeperConf.Services.Internal.ProcessedEventPruner.Retention = tc.retention
+
+			s := NewExpirySweeper(tlog, sweeperConf, store, gateway.NewRegistry(), nil, nil)
+			tc.stubs(store)
+
+			err := s.Prune(context.TODO())
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+const processedEventRetention = 30 * 24 * time.Hour
+
+func createExpirySweeperConfig(batchSize int32) *config.App {
+	internal := *conf.Services.Internal
+	internal.Name = "payment_expiry_sweeper_test"
+	internal.ExpirySweeper = &config.ExpirySweeper{
+		Enable:    true,
+		Interval:  time.Minute,
+		BatchSize: batchSize,
+	}
+	internal.ProcessedEventPruner = &config.ProcessedEventPruner{
+		Enable:    true,
+		Interval:  time.Hour,
+		Retention: processedEventRetention,
+	}
+
+	services := *conf.Services
+	services.Internal = &internal
+
+	res := *conf
+	res.Services = &services
+
+	return &res
+}
+
+// createRandomOverduePayment creates an active payment on the fake provider
+// and returns the overdue row the repository would hold for it.
+func createRandomOverduePayment(t *testing.T, provider *gateway.FakeProvider, typ string) *repository.PaymentMethod {
+	arg := &gateway.CreatePaymentParams{
+		CustomerPaymentID: helper.RandomString(26),
+		ReferenceID:       helper.RandomString(26),
+		Amount:            decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Expiry:            time.Now().Add(-time.Hour),
+	}
+
+	var (
+		res *gateway.Payment
+		err error
+	)
+
+	switch typ {
+	case payment.METHODE_TYPE_EWALLET:
+		arg.ChannelCode = "DANA"
+		res, err = provider.CreateEwalletPayment(context.TODO(), arg)
+	case payment.METHODE_TYPE_QR_CODE:
+		arg.ChannelCode = "QRIS"
+		res, err = provider.CreateQrCodePayment(context.TODO(), arg)
+	default:
+		arg.ChannelCode = "BCA"
+		res, err = provider.CreateVirtualAccountBankPayment(context.TODO(), arg)
+	}
+	require.NoError(t, err)
+
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	return &repository.PaymentMethod{
+		Uid:             ulid.String(),
+		PaymentMethodID: res.ID,
+		PaymentRequestID: pgtype.Text{
+			String: res.RequestID,
+			Valid:  res.RequestID != "",
+		},
+		PaymentReferenceID: res.ReferenceID,
+		PaymentBusinessID:  res.BusinessID,
+		PaymentCustomerID:  res.CustomerID,
+		PaymentType:        res.Type,
+		PaymentStatus:      res.Status,
+		PaymentReusability: res.Reusability,
+		PaymentChannel:     res.Channel,
+		PaymentAmount:      res.Amount,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  res.CreatedAt,
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  res.ExpiresAt,
+			Valid: true,
+		},
+	}
+}
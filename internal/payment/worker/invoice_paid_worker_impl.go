@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InvoicePaid publishes the payment of an invoice, it is published once per invoice whichever channel it was paid through.
+func (w *Worker) InvoicePaid(ctx context.Context, task *models.InvoicePaidTask) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Worker.InvoicePaid")
+	defer span.Finish()
+
+	arg := messages.KafkaInvoicePaid{
+		Uid:                    task.Invoice.Uid,
+		InvoiceId:              task.Invoice.InvoiceID,
+		InvoiceReferenceId:     task.Invoice.InvoiceReferenceID,
+		PaymentCustomerId:      task.Invoice.PaymentCustomerID,
+		InvoiceStatus:          task.Invoice.InvoiceStatus,
+		InvoiceAmount:          task.Invoice.InvoiceAmount.InexactFloat64(),
+		InvoiceAmountMinor:     payment.ToMinorUnits(task.Invoice.InvoiceAmount, task.Invoice.Currency),
+		Currency:               task.Invoice.Currency,
+		InvoiceAllowedChannels: task.Invoice.InvoiceAllowedChannels,
+		PaymentMethodUid:       task.PaymentMethod.Uid,
+		PaymentMethodId:        task.PaymentMethod.PaymentMethodID,
+		PaymentType:            task.PaymentMethod.PaymentType,
+		PaymentChannel:         task.PaymentMethod.PaymentChannel,
+		PaymentAmount:          task.PaymentMethod.PaymentAmount.InexactFloat64(),
+		PaymentAmountMinor:     payment.ToMinorUnits(task.PaymentMethod.PaymentAmount, task.PaymentMethod.Currency),
+		CreatedAt:              timestamppb.New(task.Invoice.CreatedAt.Time),
+		PaidAt:                 timestamppb.New(task.Invoice.PaidAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "proto.Marshal.err", err),
+			err,
+		)
+	}
+
+	message := kafka.Message{
+		Topic:   helper.StringBuilder(w.cfg.Services.Internal.ID, "_", w.cfg.Brokers.Kafka.Topics.InvoicePaid.TopicName),
+		Value:   protoMsg,
+		Time:    time.Now().UTC(),
+		Headers: tracing.GetKafkaTracingHeadersFromSpanCtx(span.Context()),
+	}
+
+	err = w.distributor.PublishMessage(ctx, message)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "w.distributor.PublishMessage.err", err),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	producerMock "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_MOCK_INVOICE_PAID(t *testing.T) {
+	_, paymentRespOK := createRandomVirtualAccountBankPayment(t)
+	invoiceRespOK := createRandomPaidInvoice(t, paymentRespOK)
+	okTopic := helper.StringBuilder(conf.Services.Internal.ID, "_", conf.Brokers.Kafka.Topics.InvoicePaid.TopicName)
+
+	okParams := createInvoiceKafkaMessageParams(t, okTopic, invoiceRespOK, paymentRespOK)
+
+	testCases := []struct {
+		tname         string
+		body          *models.InvoicePaidTask
+		stub          func(producerStore *producerMock.MockProducer)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.InvoicePaidTask{
+				Invoice:       invoiceRespOK,
+				PaymentMethod: paymentRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "NOT_OK",
+			body: &models.InvoicePaidTask{
+				Invoice:       invoiceRespOK,
+				PaymentMethod: paymentRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(errors.New("any err"))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			producerStoreCtrl := gomock.NewController(t)
+			defer producerStoreCtrl.Finish()
+			producerStore := producerMock.NewMockProducer(producerStoreCtrl)
+
+			u := New(tlog, conf, producerStore)
+			tc.stub(producerStore)
+
+			actualError := u.InvoicePaid(context.TODO(), tc.body)
+			tc.checkResponse(t, actualError)
+		})
+	}
+}
+
+func createInvoiceKafkaMessageParams(t *testing.T, topic string, invoice *repository.Invoice, paymentMethod *repository.PaymentMethod) kafka.Message {
+	arg := messages.KafkaInvoicePaid{
+		Uid:                    invoice.Uid,
+		InvoiceId:              invoice.InvoiceID,
+		InvoiceReferenceId:     invoice.InvoiceReferenceID,
+		PaymentCustomerId:      invoice.PaymentCustomerID,
+		InvoiceStatus:          invoice.InvoiceStatus,
+		InvoiceAmount:          invoice.InvoiceAmount.InexactFloat64(),
+		InvoiceAmountMinor:     payment.ToMinorUnits(invoice.InvoiceAmount, invoice.Currency),
+		Currency:               invoice.Currency,
+		InvoiceAllowedChannels: invoice.InvoiceAllowedChannels,
+		PaymentMethodUid:       paymentMethod.Uid,
+		PaymentMethodId:        paymentMethod.PaymentMethodID,
+		PaymentType:            paymentMethod.PaymentType,
+		PaymentChannel:         paymentMethod.PaymentChannel,
+		PaymentAmount:          paymentMethod.PaymentAmount.InexactFloat64(),
+		PaymentAmountMinor:     payment.ToMinorUnits(paymentMethod.PaymentAmount, paymentMethod.Currency),
+		CreatedAt:              timestamppb.New(invoice.CreatedAt.Time),
+		PaidAt:                 timestamppb.New(invoice.PaidAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, &arg)
+
+	return kafka.Message{
+		Topic: topic,
+		Value: protoMsg,
+		Time:  time.Now().UTC(),
+	}
+}
+
+func createRandomPaidInvoice(t *testing.T, paymentMethod *repository.PaymentMethod) *repository.Invoice {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	return &repository.Invoice{
+		Uid:                    ulid.String(),
+		InvoiceID:              helper.RandomString(24),
+		InvoiceReferenceID:     ulid.String(),
+		InvoiceBusinessID:      helper.RandomString(24),
+		PaymentCustomerID:      paymentMethod.PaymentCustomerID,
+		InvoiceStatus:          payment.INVOICE_STATUS_PAID,
+		InvoiceAmount:          paymentMethod.PaymentAmount,
+		Currency:               payment.DEFAULT_CURRENCY,
+		InvoiceAllowedChannels: []string{paymentMethod.PaymentChannel},
+		InvoiceUrl:             helper.RandomUrl(),
+		InvoiceDescription:     helper.RandomString(50),
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  time.Now().Add(72 * time.Hour),
+			Valid: true,
+		},
+		PaidAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+}
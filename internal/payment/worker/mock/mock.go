@@ -43,6 +43,20 @@ func (m *MockProducerWorker) EXPECT() *MockProducerWorkerMockRecorder {
 	return m.recorder
 }
 
+// InvoicePaid mocks base method.
+func (m *MockProducerWorker) InvoicePaid(ctx context.Context, task *models.InvoicePaidTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvoicePaid", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvoicePaid indicates an expected call of InvoicePaid.
+func (mr *MockProducerWorkerMockRecorder) InvoicePaid(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvoicePaid", reflect.TypeOf((*MockProducerWorker)(nil).InvoicePaid), ctx, task)
+}
+
 // OnConfigUpdate mocks base method.
 func (m *MockProducerWorker) OnConfigUpdate(key string, config *config.App) {
 	m.ctrl.T.Helper()
@@ -81,6 +95,34 @@ func (mr *MockProducerWorkerMockRecorder) PaymentStatusUpdated(ctx, task any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentStatusUpdated", reflect.TypeOf((*MockProducerWorker)(nil).PaymentStatusUpdated), ctx, task)
 }
 
+// RefundStatusUpdated mocks base method.
+func (m *MockProducerWorker) RefundStatusUpdated(ctx context.Context, task *models.RefundStatusUpdatedTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundStatusUpdated", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundStatusUpdated indicates an expected call of RefundStatusUpdated.
+func (mr *MockProducerWorkerMockRecorder) RefundStatusUpdated(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundStatusUpdated", reflect.TypeOf((*MockProducerWorker)(nil).RefundStatusUpdated), ctx, task)
+}
+
+// ReplayDeadLetter mocks base method.
+func (m *MockProducerWorker) ReplayDeadLetter(ctx context.Context, task *models.ReplayDeadLetterTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter.
+func (mr *MockProducerWorkerMockRecorder) ReplayDeadLetter(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockProducerWorker)(nil).ReplayDeadLetter), ctx, task)
+}
+
 // MockUsecase is a mock of Usecase interface.
 type MockUsecase struct {
 	ctrl     *gomock.Controller
@@ -104,6 +146,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockUsecase) Cancel(ctx context.Context, arg *models.CancelPaymentRequest) (*pb.CancelPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, arg)
+	ret0, _ := ret[0].(*pb.CancelPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockUsecaseMockRecorder) Cancel(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockUsecase)(nil).Cancel), ctx, arg)
+}
+
+// Capture mocks base method.
+func (m *MockUsecase) Capture(ctx context.Context, arg *models.CapturePaymentRequest) (*pb.CapturePaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, arg)
+	ret0, _ := ret[0].(*pb.CapturePaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockUsecaseMockRecorder) Capture(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockUsecase)(nil).Capture), ctx, arg)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(ctx context.Context, arg *models.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +191,21 @@ func (mr *MockUsecaseMockRecorder) Create(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUsecase)(nil).Create), ctx, arg)
 }
 
+// CreateInvoice mocks base method.
+func (m *MockUsecase) CreateInvoice(ctx context.Context, arg *models.CreateInvoiceRequest) (*pb.CreateInvoiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", ctx, arg)
+	ret0, _ := ret[0].(*pb.CreateInvoiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockUsecaseMockRecorder) CreateInvoice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockUsecase)(nil).CreateInvoice), ctx, arg)
+}
+
 // GetAvailableChannel mocks base method.
 func (m *MockUsecase) GetAvailableChannel(ctx context.Context, arg *models.GetPaymentChannelRequest) (*pb.GetPaymentChannelResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +251,156 @@ func (mr *MockUsecaseMockRecorder) GetByID(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsecase)(nil).GetByID), ctx, arg)
 }
 
+// GetByReferenceID mocks base method.
+func (m *MockUsecase) GetByReferenceID(ctx context.Context, arg *models.GetByReferenceIDPaymentRequest) (*pb.GetByReferenceIDPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReferenceID", ctx, arg)
+	ret0, _ := ret[0].(*pb.GetByReferenceIDPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReferenceID indicates an expected call of GetByReferenceID.
+func (mr *MockUsecaseMockRecorder) GetByReferenceID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReferenceID", reflect.TypeOf((*MockUsecase)(nil).GetByReferenceID), ctx, arg)
+}
+
+// GetCustomer mocks base method.
+func (m *MockUsecase) GetCustomer(ctx context.Context, arg *models.GetCustomerRequest) (*pb.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomer", ctx, arg)
+	ret0, _ := ret[0].(*pb.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockUsecaseMockRecorder) GetCustomer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockUsecase)(nil).GetCustomer), ctx, arg)
+}
+
+// GetDeadLetterMessage mocks base method.
+func (m *MockUsecase) GetDeadLetterMessage(ctx context.Context, arg *models.GetDeadLetterMessageRequest) (*pb.GetDeadLetterMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessage", ctx, arg)
+	ret0, _ := ret[0].(*pb.GetDeadLetterMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessage indicates an expected call of GetDeadLetterMessage.
+func (mr *MockUsecaseMockRecorder) GetDeadLetterMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessage", reflect.TypeOf((*MockUsecase)(nil).GetDeadLetterMessage), ctx, arg)
+}
+
+// GetLedgerBalances mocks base method.
+func (m *MockUsecase) GetLedgerBalances(ctx context.Context, arg *models.GetLedgerBalancesRequest) (*pb.GetLedgerBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerBalances", ctx, arg)
+	ret0, _ := ret[0].(*pb.GetLedgerBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerBalances indicates an expected call of GetLedgerBalances.
+func (mr *MockUsecaseMockRecorder) GetLedgerBalances(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerBalances", reflect.TypeOf((*MockUsecase)(nil).GetLedgerBalances), ctx, arg)
+}
+
+// GetPaymentTimeline mocks base method.
+func (m *MockUsecase) GetPaymentTimeline(ctx context.Context, arg *models.GetPaymentTimelineRequest) (*pb.GetPaymentTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentTimeline", ctx, arg)
+	ret0, _ := ret[0].(*pb.GetPaymentTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentTimeline indicates an expected call of GetPaymentTimeline.
+func (mr *MockUsecaseMockRecorder) GetPaymentTimeline(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentTimeline", reflect.TypeOf((*MockUsecase)(nil).GetPaymentTimeline), ctx, arg)
+}
+
+// LinkDirectDebit mocks base method.
+func (m *MockUsecase) LinkDirectDebit(ctx context.Context, arg *models.LinkDirectDebitRequest) (*pb.LinkDirectDebitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkDirectDebit", ctx, arg)
+	ret0, _ := ret[0].(*pb.LinkDirectDebitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkDirectDebit indicates an expected call of LinkDirectDebit.
+func (mr *MockUsecaseMockRecorder) LinkDirectDebit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDirectDebit", reflect.TypeOf((*MockUsecase)(nil).LinkDirectDebit), ctx, arg)
+}
+
+// ListDeadLetterMessages mocks base method.
+func (m *MockUsecase) ListDeadLetterMessages(ctx context.Context, arg *models.ListDeadLetterMessagesRequest) (*pb.ListDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetterMessages", ctx, arg)
+	ret0, _ := ret[0].(*pb.ListDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetterMessages indicates an expected call of ListDeadLetterMessages.
+func (mr *MockUsecaseMockRecorder) ListDeadLetterMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetterMessages", reflect.TypeOf((*MockUsecase)(nil).ListDeadLetterMessages), ctx, arg)
+}
+
+// ListPaymentLedgerEntries mocks base method.
+func (m *MockUsecase) ListPaymentLedgerEntries(ctx context.Context, arg *models.ListPaymentLedgerEntriesRequest) (*pb.ListPaymentLedgerEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentLedgerEntries", ctx, arg)
+	ret0, _ := ret[0].(*pb.ListPaymentLedgerEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentLedgerEntries indicates an expected call of ListPaymentLedgerEntries.
+func (mr *MockUsecaseMockRecorder) ListPaymentLedgerEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentLedgerEntries", reflect.TypeOf((*MockUsecase)(nil).ListPaymentLedgerEntries), ctx, arg)
+}
+
+// ListPayments mocks base method.
+func (m *MockUsecase) ListPayments(ctx context.Context, arg *models.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayments", ctx, arg)
+	ret0, _ := ret[0].(*pb.ListPaymentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayments indicates an expected call of ListPayments.
+func (mr *MockUsecaseMockRecorder) ListPayments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockUsecase)(nil).ListPayments), ctx, arg)
+}
+
+// ListReconciliationDiscrepancies mocks base method.
+func (m *MockUsecase) ListReconciliationDiscrepancies(ctx context.Context, arg *models.ListReconciliationDiscrepanciesRequest) (*pb.ListReconciliationDiscrepanciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliationDiscrepancies", ctx, arg)
+	ret0, _ := ret[0].(*pb.ListReconciliationDiscrepanciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliationDiscrepancies indicates an expected call of ListReconciliationDiscrepancies.
+func (mr *MockUsecaseMockRecorder) ListReconciliationDiscrepancies(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliationDiscrepancies", reflect.TypeOf((*MockUsecase)(nil).ListReconciliationDiscrepancies), ctx, arg)
+}
+
 // OnConfigUpdate mocks base method.
 func (m *MockUsecase) OnConfigUpdate(key string, config *config.App) {
 	m.ctrl.T.Helper()
@@ -176,20 +413,320 @@ func (mr *MockUsecaseMockRecorder) OnConfigUpdate(key, config any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockUsecase)(nil).OnConfigUpdate), key, config)
 }
 
-// Update mocks base method.
-func (m *MockUsecase) Update(ctx context.Context, arg *models.UpdatePaymentRequest) error {
+// QuarantineDeadLetter mocks base method.
+func (m *MockUsecase) QuarantineDeadLetter(ctx context.Context, arg *models.QuarantineDeadLetterRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, arg)
+	ret := m.ctrl.Call(m, "QuarantineDeadLetter", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// QuarantineDeadLetter indicates an expected call of QuarantineDeadLetter.
+func (mr *MockUsecaseMockRecorder) QuarantineDeadLetter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineDeadLetter", reflect.TypeOf((*MockUsecase)(nil).QuarantineDeadLetter), ctx, arg)
+}
+
+// Refund mocks base method.
+func (m *MockUsecase) Refund(ctx context.Context, arg *models.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, arg)
+	ret0, _ := ret[0].(*pb.RefundPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockUsecaseMockRecorder) Refund(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockUsecase)(nil).Refund), ctx, arg)
+}
+
+// ReplayDeadLetterMessage mocks base method.
+func (m *MockUsecase) ReplayDeadLetterMessage(ctx context.Context, arg *models.ReplayDeadLetterMessageRequest) (*pb.ReplayDeadLetterMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetterMessage", ctx, arg)
+	ret0, _ := ret[0].(*pb.ReplayDeadLetterMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterMessage indicates an expected call of ReplayDeadLetterMessage.
+func (mr *MockUsecaseMockRecorder) ReplayDeadLetterMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterMessage", reflect.TypeOf((*MockUsecase)(nil).ReplayDeadLetterMessage), ctx, arg)
+}
+
+// Update mocks base method.
+func (m *MockUsecase) Update(ctx context.Context, arg *models.UpdatePaymentRequest) (*models.UpdatePaymentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg)
+	ret0, _ := ret[0].(*models.UpdatePaymentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockUsecaseMockRecorder) Update(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsecase)(nil).Update), ctx, arg)
 }
 
+// UpdateInvoice mocks base method.
+func (m *MockUsecase) UpdateInvoice(ctx context.Context, arg *models.UpdateInvoiceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInvoice", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInvoice indicates an expected call of UpdateInvoice.
+func (mr *MockUsecaseMockRecorder) UpdateInvoice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInvoice", reflect.TypeOf((*MockUsecase)(nil).UpdateInvoice), ctx, arg)
+}
+
+// UpdateRefund mocks base method.
+func (m *MockUsecase) UpdateRefund(ctx context.Context, arg *models.UpdateRefundRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefund", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRefund indicates an expected call of UpdateRefund.
+func (mr *MockUsecaseMockRecorder) UpdateRefund(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefund", reflect.TypeOf((*MockUsecase)(nil).UpdateRefund), ctx, arg)
+}
+
+// ValidateDirectDebitLink mocks base method.
+func (m *MockUsecase) ValidateDirectDebitLink(ctx context.Context, arg *models.ValidateDirectDebitLinkRequest) (*pb.ValidateDirectDebitLinkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDirectDebitLink", ctx, arg)
+	ret0, _ := ret[0].(*pb.ValidateDirectDebitLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateDirectDebitLink indicates an expected call of ValidateDirectDebitLink.
+func (mr *MockUsecaseMockRecorder) ValidateDirectDebitLink(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDirectDebitLink", reflect.TypeOf((*MockUsecase)(nil).ValidateDirectDebitLink), ctx, arg)
+}
+
+// Void mocks base method.
+func (m *MockUsecase) Void(ctx context.Context, arg *models.VoidPaymentRequest) (*pb.VoidPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, arg)
+	ret0, _ := ret[0].(*pb.VoidPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockUsecaseMockRecorder) Void(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockUsecase)(nil).Void), ctx, arg)
+}
+
+// MockExpirySweeper is a mock of ExpirySweeper interface.
+type MockExpirySweeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpirySweeperMockRecorder
+}
+
+// MockExpirySweeperMockRecorder is the mock recorder for MockExpirySweeper.
+type MockExpirySweeperMockRecorder struct {
+	mock *MockExpirySweeper
+}
+
+// NewMockExpirySweeper creates a new mock instance.
+func NewMockExpirySweeper(ctrl *gomock.Controller) *MockExpirySweeper {
+	mock := &MockExpirySweeper{ctrl: ctrl}
+	mock.recorder = &MockExpirySweeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpirySweeper) EXPECT() *MockExpirySweeperMockRecorder {
+	return m.recorder
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockExpirySweeper) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockExpirySweeperMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockExpirySweeper)(nil).OnConfigUpdate), key, config)
+}
+
+// Prune mocks base method.
+func (m *MockExpirySweeper) Prune(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockExpirySweeperMockRecorder) Prune(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockExpirySweeper)(nil).Prune), ctx)
+}
+
+// Run mocks base method.
+func (m *MockExpirySweeper) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockExpirySweeperMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockExpirySweeper)(nil).Run), ctx)
+}
+
+// Sweep mocks base method.
+func (m *MockExpirySweeper) Sweep(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sweep", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sweep indicates an expected call of Sweep.
+func (mr *MockExpirySweeperMockRecorder) Sweep(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sweep", reflect.TypeOf((*MockExpirySweeper)(nil).Sweep), ctx)
+}
+
+// MockReconciler is a mock of Reconciler interface.
+type MockReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconcilerMockRecorder
+}
+
+// MockReconcilerMockRecorder is the mock recorder for MockReconciler.
+type MockReconcilerMockRecorder struct {
+	mock *MockReconciler
+}
+
+// NewMockReconciler creates a new mock instance.
+func NewMockReconciler(ctrl *gomock.Controller) *MockReconciler {
+	mock := &MockReconciler{ctrl: ctrl}
+	mock.recorder = &MockReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciler) EXPECT() *MockReconcilerMockRecorder {
+	return m.recorder
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockReconciler) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockReconcilerMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockReconciler)(nil).OnConfigUpdate), key, config)
+}
+
+// Reconcile mocks base method.
+func (m *MockReconciler) Reconcile(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconcilerMockRecorder) Reconcile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconciler)(nil).Reconcile), ctx)
+}
+
+// Run mocks base method.
+func (m *MockReconciler) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockReconcilerMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockReconciler)(nil).Run), ctx)
+}
+
+// MockOutboxRelay is a mock of OutboxRelay interface.
+type MockOutboxRelay struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRelayMockRecorder
+}
+
+// MockOutboxRelayMockRecorder is the mock recorder for MockOutboxRelay.
+type MockOutboxRelayMockRecorder struct {
+	mock *MockOutboxRelay
+}
+
+// NewMockOutboxRelay creates a new mock instance.
+func NewMockOutboxRelay(ctrl *gomock.Controller) *MockOutboxRelay {
+	mock := &MockOutboxRelay{ctrl: ctrl}
+	mock.recorder = &MockOutboxRelayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRelay) EXPECT() *MockOutboxRelayMockRecorder {
+	return m.recorder
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockOutboxRelay) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockOutboxRelayMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockOutboxRelay)(nil).OnConfigUpdate), key, config)
+}
+
+// Relay mocks base method.
+func (m *MockOutboxRelay) Relay(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockOutboxRelayMockRecorder) Relay(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockOutboxRelay)(nil).Relay), ctx)
+}
+
+// Run mocks base method.
+func (m *MockOutboxRelay) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockOutboxRelayMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockOutboxRelay)(nil).Run), ctx)
+}
+
 // MockWorker is a mock of Worker interface.
 type MockWorker struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,221 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// errOutboxMessageUnpublishable is a message which would fail to publish however many times it is retried.
+var errOutboxMessageUnpublishable = errors.New("outbox message is unpublishable")
+
+// OutboxRelay periodically publishes the messages written to the outbox in the order they were written and
+// marks them sent. Only the replica holding the relay lock publishes, a message which fails to publish
+// holds back the ones after it until it is published, or until it runs out of attempts and is parked.
+type OutboxRelay struct {
+	log      logger.Logger
+	cfg      *config.App
+	repo     repository.Repository
+	producer domain.ProducerWorker
+	metrics  *metrics.Metrics
+	token    string
+}
+
+func NewOutboxRelay(
+	log logger.Logger,
+	cfg *config.App,
+	repo repository.Repository,
+	producer domain.ProducerWorker,
+	metrics *metrics.Metrics,
+) domain.OutboxRelay {
+	return &OutboxRelay{
+		log:      log.WithPrefix(fmt.Sprintf("%s-%s", "payment-outbox-relay", constants.Worker)),
+		cfg:      cfg,
+		repo:     repo,
+		producer: producer,
+		metrics:  metrics,
+		token:    helper.RandomString(32),
+	}
+}
+
+func (s *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Services.Internal.OutboxRelay.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.cfg.Services.Internal.OutboxRelay.Enable {
+			if err := s.Relay(ctx); err != nil {
+				s.log.Warnf("s.Relay.err: %v", err)
+			}
+		}
+
+		ticker.Reset(s.cfg.Services.Internal.OutboxRelay.Interval)
+	}
+}
+
+// Relay publishes the pending messages in batches of the configured size until none is left or one fails,
+// it returns without publishing when another replica holds the relay lock. The lock is refreshed before
+// every further batch, so a long run stops as soon as the lock has passed to another replica.
+func (s *OutboxRelay) Relay(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OutboxRelay.Relay")
+	defer span.Finish()
+
+	acquired, err := s.repo.AcquireOutboxRelayLock(ctx, s.token)
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("s.repo.AcquireOutboxRelayLock.err: %v", err))
+	}
+
+	if !acquired {
+		s.log.Debug("outbox relay lock is held by another replica, skipping relay")
+		return nil
+	}
+	defer s.repo.ReleaseOutboxRelayLock(ctx, s.token)
+
+	defer s.observeLag(ctx)
+
+	arg := repository.ListPendingOutboxMessagesParams{
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		BatchSize:    s.cfg.Services.Internal.OutboxRelay.BatchSize,
+	}
+
+	for batch := 0; ; batch++ {
+		if batch > 0 {
+			refreshed, err := s.repo.RefreshOutboxRelayLock(ctx, s.token)
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("s.repo.RefreshOutboxRelayLock.err: %v", err))
+			}
+
+			if !refreshed {
+				s.log.Warn("outbox relay lock expired while relaying, leaving the rest to the lock holder")
+				return nil
+			}
+		}
+
+		list, err := s.repo.ListPendingOutboxMessages(ctx, &arg)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("s.repo.ListPendingOutboxMessages.err: %v", err))
+		}
+
+		for _, msg := range list {
+			if err := s.publish(ctx, msg); err != nil {
+				s.metrics.OutboxFailedMessages.Inc()
+
+				if !s.exhausted(msg, err) {
+					s.markFailed(ctx, msg, payment.OUTBOX_STATUS_PENDING, err)
+
+					// the messages after it are left pending so they are never published ahead of it.
+					return tracing.TraceWithError(span, fmt.Errorf("s.publish.err: uid: %s, err: %v", msg.Uid, err))
+				}
+
+				if failErr := s.markFailed(ctx, msg, payment.OUTBOX_STATUS_FAILED, err); failErr != nil {
+					return tracing.TraceWithError(span, fmt.Errorf("s.publish.err: uid: %s, err: %v", msg.Uid, err))
+				}
+
+				s.log.Errorf("parked outbox message after %d attempts: uid: %s, err: %v", msg.Attempts+1, msg.Uid, err)
+				continue
+			}
+
+			err := s.repo.MarkOutboxMessageSent(ctx, &repository.MarkOutboxMessageSentParams{
+				OutboxStatus: payment.OUTBOX_STATUS_SENT,
+				SentAt:       pgtype.Timestamptz{Time: time.Now(), Valid: true},
+				Uid:          msg.Uid,
+			})
+			if err != nil {
+				return tracing.TraceWithError(span, fmt.Errorf("s.repo.MarkOutboxMessageSent.err: %v", err))
+			}
+
+			s.metrics.OutboxPublishedMessages.Inc()
+		}
+
+		if int32(len(list)) < arg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// exhausted reports whether a message which failed to publish is given up on, either because retrying it
+// cannot help or because it has used up its attempts.
+func (s *OutboxRelay) exhausted(msg *repository.Outbox, err error) bool {
+	if errors.Is(err, errOutboxMessageUnpublishable) {
+		return true
+	}
+
+	maxAttempts := s.cfg.Services.Internal.OutboxRelay.MaxAttempts
+	return maxAttempts > 0 && msg.Attempts+1 >= maxAttempts
+}
+
+func (s *OutboxRelay) markFailed(ctx context.Context, msg *repository.Outbox, status string, cause error) error {
+	err := s.repo.MarkOutboxMessageFailed(ctx, &repository.MarkOutboxMessageFailedParams{
+		OutboxStatus: status,
+		LastError:    cause.Error(),
+		Uid:          msg.Uid,
+	})
+	if err != nil {
+		s.log.Warnf("s.repo.MarkOutboxMessageFailed.err: uid: %s, err: %v", msg.Uid, err)
+	}
+
+	return err
+}
+
+func (s *OutboxRelay) publish(ctx context.Context, msg *repository.Outbox) error {
+	switch msg.EventType {
+	case payment.OUTBOX_EVENT_PAYMENT_STATUS_UPDATED:
+		var event repository.PaymentStatusUpdatedEvent
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return fmt.Errorf("%w: json.Unmarshal.err: %v", errOutboxMessageUnpublishable, err)
+		}
+
+		return s.producer.PaymentStatusUpdated(ctx, &models.PaymentStatusUpdatedTask{
+			PaymentMethod: event.PaymentMethod,
+			Splits:        event.Splits,
+		})
+	default:
+		return fmt.Errorf("%w: unsupported outbox event type: %s", errOutboxMessageUnpublishable, msg.EventType)
+	}
+}
+
+// observeLag reports how many messages are waiting to be published and how long the oldest one has waited.
+func (s *OutboxRelay) observeLag(ctx context.Context) {
+	res, err := s.repo.GetOutboxLag(ctx, payment.OUTBOX_STATUS_PENDING)
+	if err != nil {
+		s.log.Warnf("s.repo.GetOutboxLag.err: %v", err)
+		return
+	}
+
+	lag := time.Duration(0)
+	if res.PendingCount > 0 {
+		lag = time.Since(res.OldestCreatedAt.Time)
+	}
+
+	s.metrics.OutboxPendingMessages.Set(float64(res.PendingCount))
+	s.metrics.OutboxLagSeconds.Set(lag.Seconds())
+}
+
+func (s *OutboxRelay) OnConfigUpdate(key string, config *config.App) {
+	s.log.Infof("received update from '%s' key", key)
+
+	s.cfg = config
+
+	s.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
@@ -0,0 +1,324 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payment/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_OUTBOX_RELAY(t *testing.T) {
+	relayConf := createOutboxRelayConfig(2)
+	relayMetrics := metrics.New(relayConf)
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, producer *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, firstPayment := createRandomOutboxMessage(t, 1)
+				second, secondPayment := createRandomOutboxMessage(t, 2)
+				third, thirdPayment := createRandomOutboxMessage(t, 3)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *repository.ListPendingOutboxMessagesParams) ([]*repository.Outbox, error) {
+							require.Equal(t, payment.OUTBOX_STATUS_PENDING, arg.OutboxStatus)
+							require.Equal(t, int32(2), arg.BatchSize)
+							return []*repository.Outbox{first, second}, nil
+						},
+					),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, firstPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, first)),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, secondPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, second)),
+					store.EXPECT().RefreshOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{third}, nil),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, thirdPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, third)),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Eq(payment.OUTBOX_STATUS_PENDING)).Times(1).Return(&repository.GetOutboxLagRow{}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+				store.EXPECT().MarkOutboxMessageFailed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_HELD_BY_ANOTHER_REPLICA",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().RefreshOutboxRelayLock(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(0)
+				producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_LOST_BETWEEN_BATCHES",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, firstPayment := createRandomOutboxMessage(t, 1)
+				second, secondPayment := createRandomOutboxMessage(t, 2)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{first, second}, nil),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, firstPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, first)),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, secondPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, second)),
+					store.EXPECT().RefreshOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_PARKS_EXHAUSTED_MESSAGE",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, firstPayment := createRandomOutboxMessage(t, 1)
+				first.Attempts = 2
+				second, secondPayment := createRandomOutboxMessage(t, 2)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{first, second}, nil),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, firstPayment, errors.New("broker unavailable"))),
+					store.EXPECT().MarkOutboxMessageFailed(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedFailed(t, first, payment.OUTBOX_STATUS_FAILED, "broker unavailable")),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, secondPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, second)),
+					store.EXPECT().RefreshOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_PARKS_UNSUPPORTED_EVENT_TYPE",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				msg, _ := createRandomOutboxMessage(t, 1)
+				msg.EventType = helper.RandomString(12)
+
+				store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{msg}, nil)
+				store.EXPECT().MarkOutboxMessageFailed(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedFailed(t, msg, payment.OUTBOX_STATUS_FAILED, "unsupported outbox event type"))
+				store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{}, nil)
+				store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1)
+				producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_PUBLISH_HOLDS_BACK_LATER_MESSAGES",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, firstPayment := createRandomOutboxMessage(t, 1)
+				second, _ := createRandomOutboxMessage(t, 2)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{first, second}, nil),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, firstPayment, errors.New("broker unavailable"))),
+					store.EXPECT().MarkOutboxMessageFailed(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedFailed(t, first, payment.OUTBOX_STATUS_PENDING, "broker unavailable")),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{
+						PendingCount:    2,
+						OldestCreatedAt: first.CreatedAt,
+					}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+				store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_PARK_MESSAGE",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, _ := createRandomOutboxMessage(t, 1)
+				first.EventType = helper.RandomString(12)
+				second, _ := createRandomOutboxMessage(t, 2)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{first, second}, nil),
+					store.EXPECT().MarkOutboxMessageFailed(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+				store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(0)
+				producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_REFRESH_LOCK",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				first, firstPayment := createRandomOutboxMessage(t, 1)
+				second, secondPayment := createRandomOutboxMessage(t, 2)
+
+				gomock.InOrder(
+					store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+					store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return([]*repository.Outbox{first, second}, nil),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, firstPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, first)),
+					producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(publishedPayment(t, secondPayment, nil)),
+					store.EXPECT().MarkOutboxMessageSent(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(markedSent(t, second)),
+					store.EXPECT().RefreshOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused")),
+					store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(&repository.GetOutboxLagRow{}, nil),
+					store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1),
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_ACQUIRE_LOCK",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(0)
+				producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_LIST_PENDING_MESSAGES",
+			stubs: func(store *mock.MockRepository, producer *wkmock.MockProducerWorker) {
+				store.EXPECT().AcquireOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListPendingOutboxMessages(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().GetOutboxLag(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().ReleaseOutboxRelayLock(gomock.Any(), gomock.Any()).Times(1)
+				producer.EXPECT().PaymentStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			producerCtrl := gomock.NewController(t)
+			defer producerCtrl.Finish()
+			producer := wkmock.NewMockProducerWorker(producerCtrl)
+
+			s := NewOutboxRelay(tlog, relayConf, store, producer, relayMetrics)
+			tc.stubs(store, producer)
+
+			err := s.Relay(context.TODO())
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func createOutboxRelayConfig(batchSize int32) *config.App {
+	internal := *conf.Services.Internal
+	internal.Name = "payment_outbox_relay_test"
+	internal.OutboxRelay = &config.OutboxRelay{
+		Enable:      true,
+		Interval:    time.Second,
+		BatchSize:   batchSize,
+		MaxAttempts: 3,
+	}
+
+	services := *conf.Services
+	services.Internal = &internal
+
+	res := *conf
+	res.Services = &services
+
+	return &res
+}
+
+// createRandomOutboxMessage returns a pending payment_status_updated message together with the payment it carries.
+func createRandomOutboxMessage(t *testing.T, sequence int64) (*repository.Outbox, *repository.PaymentMethod) {
+	_, pm := createRandomVirtualAccountBankPayment(t)
+
+	payload, err := json.Marshal(&repository.PaymentStatusUpdatedEvent{PaymentMethod: pm})
+	require.NoError(t, err)
+
+	return &repository.Outbox{
+		Uid:          helper.RandomString(26),
+		Sequence:     sequence,
+		AggregateUid: pm.Uid,
+		EventType:    payment.OUTBOX_EVENT_PAYMENT_STATUS_UPDATED,
+		Payload:      payload,
+		OutboxStatus: payment.OUTBOX_STATUS_PENDING,
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true},
+	}, pm
+}
+
+// publishedPayment checks the task published for an outbox message carries the payment it was written with.
+func publishedPayment(t *testing.T, pm *repository.PaymentMethod, err error) func(any, *models.PaymentStatusUpdatedTask) error {
+	return func(_ any, task *models.PaymentStatusUpdatedTask) error {
+		require.NotNil(t, task.PaymentMethod)
+		require.Equal(t, pm.Uid, task.PaymentMethod.Uid)
+		require.Equal(t, pm.PaymentMethodID, task.PaymentMethod.PaymentMethodID)
+		require.Equal(t, pm.PaymentStatus, task.PaymentMethod.PaymentStatus)
+		require.True(t, pm.PaymentAmount.Equal(task.PaymentMethod.PaymentAmount))
+		require.WithinDuration(t, pm.UpdatedAt.Time, task.PaymentMethod.UpdatedAt.Time, time.Second)
+		return err
+	}
+}
+
+func markedFailed(t *testing.T, msg *repository.Outbox, status string, cause string) func(any, *repository.MarkOutboxMessageFailedParams) error {
+	return func(_ any, arg *repository.MarkOutboxMessageFailedParams) error {
+		require.Equal(t, msg.Uid, arg.Uid)
+		require.Equal(t, status, arg.OutboxStatus)
+		require.Contains(t, arg.LastError, cause)
+		return nil
+	}
+}
+
+func markedSent(t *testing.T, msg *repository.Outbox) func(any, *repository.MarkOutboxMessageSentParams) error {
+	return func(_ any, arg *repository.MarkOutboxMessageSentParams) error {
+		require.Equal(t, msg.Uid, arg.Uid)
+		require.Equal(t, payment.OUTBOX_STATUS_SENT, arg.OutboxStatus)
+		require.True(t, arg.SentAt.Valid)
+		return nil
+	}
+}
@@ -2,12 +2,12 @@ package worker
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
 	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
 	"github.com/opentracing/opentracing-go"
@@ -20,15 +20,6 @@ func (w *Worker) PaymentStatusUpdated(ctx context.Context, task *models.PaymentS
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Worker.PaymentStatusUpdated")
 	defer span.Finish()
 
-	amount, ok := task.PaymentMethod.PaymentAmount.Float64()
-	if !ok {
-		return w.errorResponse(
-			span,
-			fmt.Sprintf("%s: %v", "task.PaymentMethod.PaymentAmount.Float64.err", task.PaymentMethod.PaymentAmount),
-			errors.New("rounded demical error"),
-		)
-	}
-
 	arg := messages.KafkaPaymentStatusUpdated{
 		Uid:                         task.PaymentMethod.Uid,
 		PaymentMethodId:             task.PaymentMethod.PaymentMethodID,
@@ -40,10 +31,16 @@ func (w *Worker) PaymentStatusUpdated(ctx context.Context, task *models.PaymentS
 		PaymentStatus:               task.PaymentMethod.PaymentStatus,
 		PaymentReusability:          task.PaymentMethod.PaymentReusability,
 		PaymentChannel:              task.PaymentMethod.PaymentChannel,
-		PaymentAmount:               amount,
+		PaymentAmount:               task.PaymentMethod.PaymentAmount.InexactFloat64(),
+		PaymentAmountMinor:          payment.ToMinorUnits(task.PaymentMethod.PaymentAmount, task.PaymentMethod.Currency),
+		Currency:                    task.PaymentMethod.Currency,
 		PaymentQrCode:               &task.PaymentMethod.PaymentQrCode.String,
 		PaymentVirtualAccountNumber: &task.PaymentMethod.PaymentVirtualAccountNumber.String,
+		PaymentCode:                 &task.PaymentMethod.PaymentCode.String,
+		PaymentParentUid:            &task.PaymentMethod.PaymentParentUid.String,
+		PaymentInvoiceUid:           &task.PaymentMethod.PaymentInvoiceUid.String,
 		PaymentUrl:                  &task.PaymentMethod.PaymentUrl.String,
+		PaymentForUserId:            &task.PaymentMethod.PaymentForUserID.String,
 		PaymentDescription:          task.PaymentMethod.PaymentDescription,
 		CreatedAt:                   timestamppb.New(task.PaymentMethod.CreatedAt.Time),
 		UpdatedAt:                   timestamppb.New(task.PaymentMethod.UpdatedAt.Time),
@@ -51,6 +48,19 @@ func (w *Worker) PaymentStatusUpdated(ctx context.Context, task *models.PaymentS
 		PaidAt:                      timestamppb.New(task.PaymentMethod.PaidAt.Time),
 	}
 
+	for _, split := range task.Splits {
+		arg.PaymentSplits = append(arg.PaymentSplits, &messages.KafkaPaymentSplit{
+			Uid:                  split.Uid,
+			SplitType:            split.SplitType,
+			DestinationAccountId: split.DestinationAccountID,
+			SplitAmount:          split.SplitAmount.InexactFloat64(),
+			SplitAmountMinor:     payment.ToMinorUnits(split.SplitAmount, split.Currency),
+			Currency:             split.Currency,
+			SplitReferenceId:     &split.SplitReferenceID.String,
+			SplitRuleId:          &split.SplitRuleID.String,
+		})
+	}
+
 	protoMsg, err := proto.Marshal(&arg)
 	if err != nil {
 		return w.errorResponse(
@@ -61,7 +71,9 @@ func (w *Worker) PaymentStatusUpdated(ctx context.Context, task *models.PaymentS
 	}
 
 	message := kafka.Message{
-		Topic:   helper.StringBuilder(w.cfg.Services.Internal.ID, "_", w.cfg.Brokers.Kafka.Topics.PaymentStatusUpdated.TopicName),
+		Topic: helper.StringBuilder(w.cfg.Services.Internal.ID, "_", w.cfg.Brokers.Kafka.Topics.PaymentStatusUpdated.TopicName),
+		// keyed by the payment so the updates of a payment land on one partition, in the order they were made.
+		Key:     []byte(task.PaymentMethod.Uid),
 		Value:   protoMsg,
 		Time:    time.Now().UTC(),
 		Headers: tracing.GetKafkaTracingHeadersFromSpanCtx(span.Context()),
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
 	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
 	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
@@ -18,7 +19,6 @@ import (
 	"github.com/segmentio/kafka-go"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
-	"github.com/xendit/xendit-go/v5/payment_method"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -30,6 +30,32 @@ func Test_MOCK_PAYMENT_METHOD_UPDATED(t *testing.T) {
 
 	okParams := createKafkaMessageParams(t, okTopic, paymentVirtualAccountBankRespOK)
 
+	_, paymentInexactAmount := createRandomVirtualAccountBankPayment(t)
+	paymentInexactAmount.PaymentAmount = decimal.RequireFromString("1234567.89")
+
+	_, paymentForSubAccount := createRandomVirtualAccountBankPayment(t)
+	paymentForSubAccount.PaymentForUserID = pgtype.Text{String: helper.RandomString(24), Valid: true}
+	splits := []*repository.PaymentSplit{
+		{
+			Uid:                  helper.RandomString(26),
+			PaymentMethodUid:     paymentForSubAccount.Uid,
+			SplitRuleID:          pgtype.Text{String: "splitru-" + helper.RandomString(26), Valid: true},
+			SplitType:            payment.SPLIT_TYPE_PLATFORM_FEE,
+			DestinationAccountID: helper.RandomString(24),
+			SplitAmount:          decimal.RequireFromString("2500.5"),
+			Currency:             payment.DEFAULT_CURRENCY,
+			SplitReferenceID:     pgtype.Text{String: helper.RandomString(26), Valid: true},
+		},
+		{
+			Uid:                  helper.RandomString(26),
+			PaymentMethodUid:     paymentForSubAccount.Uid,
+			SplitType:            payment.SPLIT_TYPE_REMAINDER,
+			DestinationAccountID: paymentForSubAccount.PaymentForUserID.String,
+			SplitAmount:          paymentForSubAccount.PaymentAmount.Sub(decimal.RequireFromString("2500.5")),
+			Currency:             payment.DEFAULT_CURRENCY,
+		},
+	}
+
 	testCases := []struct {
 		tname         string
 		body          *models.PaymentStatusUpdatedTask
@@ -48,6 +74,61 @@ func Test_MOCK_PAYMENT_METHOD_UPDATED(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			tname: "OK_AMOUNT_NOT_EXACT_AS_FLOAT",
+			body: &models.PaymentStatusUpdatedTask{
+				PaymentMethod: paymentInexactAmount,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, msgs ...kafka.Message) error {
+						require.Len(t, msgs, 1)
+
+						var res messages.KafkaPaymentStatusUpdated
+						require.NoError(t, proto.Unmarshal(msgs[0].Value, &res))
+						require.Equal(t, int64(123456789), res.GetPaymentAmountMinor())
+						require.Equal(t, payment.DEFAULT_CURRENCY, res.GetCurrency())
+						require.Equal(t, 1234567.89, res.GetPaymentAmount())
+						return nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_SPLITS",
+			body: &models.PaymentStatusUpdatedTask{
+				PaymentMethod: paymentForSubAccount,
+				Splits:        splits,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, msgs ...kafka.Message) error {
+						require.Len(t, msgs, 1)
+
+						var res messages.KafkaPaymentStatusUpdated
+						require.NoError(t, proto.Unmarshal(msgs[0].Value, &res))
+						require.Equal(t, paymentForSubAccount.PaymentForUserID.String, res.GetPaymentForUserId())
+						require.Len(t, res.GetPaymentSplits(), len(splits))
+
+						for i, split := range res.GetPaymentSplits() {
+							require.Equal(t, splits[i].Uid, split.GetUid())
+							require.Equal(t, splits[i].SplitType, split.GetSplitType())
+							require.Equal(t, splits[i].DestinationAccountID, split.GetDestinationAccountId())
+							require.Equal(t, payment.ToMinorUnits(splits[i].SplitAmount, splits[i].Currency), split.GetSplitAmountMinor())
+							require.Equal(t, splits[i].SplitRuleID.String, split.GetSplitRuleId())
+							require.Equal(t, splits[i].SplitReferenceID.String, split.GetSplitReferenceId())
+						}
+						return nil
+					},
+				)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			tname: "NOT_OK",
 			body: &models.PaymentStatusUpdatedTask{
@@ -80,8 +161,6 @@ func Test_MOCK_PAYMENT_METHOD_UPDATED(t *testing.T) {
 }
 
 func createKafkaMessageParams(t *testing.T, topic string, task *repository.PaymentMethod) kafka.Message {
-	amount, _ := task.PaymentAmount.Float64()
-
 	arg := messages.KafkaPaymentStatusUpdated{
 		Uid:                         task.Uid,
 		PaymentMethodId:             task.PaymentMethodID,
@@ -93,7 +172,9 @@ func createKafkaMessageParams(t *testing.T, topic string, task *repository.Payme
 		PaymentStatus:               task.PaymentStatus,
 		PaymentReusability:          task.PaymentReusability,
 		PaymentChannel:              task.PaymentChannel,
-		PaymentAmount:               amount,
+		PaymentAmount:               task.PaymentAmount.InexactFloat64(),
+		PaymentAmountMinor:          payment.ToMinorUnits(task.PaymentAmount, payment.DEFAULT_CURRENCY),
+		Currency:                    payment.DEFAULT_CURRENCY,
 		PaymentQrCode:               &task.PaymentQrCode.String,
 		PaymentVirtualAccountNumber: &task.PaymentVirtualAccountNumber.String,
 		PaymentUrl:                  &task.PaymentUrl.String,
@@ -109,6 +190,7 @@ func createKafkaMessageParams(t *testing.T, topic string, task *repository.Payme
 
 	return kafka.Message{
 		Topic: topic,
+		Key:   []byte(task.Uid),
 		Value: protoMsg,
 		Time:  time.Now().UTC(),
 	}
@@ -179,7 +261,7 @@ func (ex *eqKafkaMessageParamsMatcher) String() string {
 	return errMsg + fmt.Sprintf("matches arg: %v", ex.arg)
 }
 
-func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirtualAccountBankPaymentTxParams, *repository.PaymentMethod) {
+func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreatePaymentTxParams, *repository.PaymentMethod) {
 	ulid, err := helper.GenerateULID()
 	require.NoError(t, err)
 	require.NotEmpty(t, ulid)
@@ -192,17 +274,18 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 	require.NoError(t, err)
 	require.NotEmpty(t, customerPaymentMethodID)
 
-	createParams := &repository.CreateVirtualAccountBankPaymentTxParams{
-		CreateVirtualAccountBankPayment: repository.CreateVirtualAccountBankPaymentParams{
-			CustomerName:      helper.RandomString(30),
-			CustomerPaymentID: customerPaymentID.String(),
-			CustomerNumber:    "+628" + helper.RandomString(9),
-			Description:       helper.RandomString(100),
-			Amount:            float64(helper.RandomInt(100, 200000)),
-			Expiry:            time.Now().Add(24 * 3 * time.Hour),
-			ChannelCode:       payment_method.VIRTUALACCOUNTCHANNELCODE_BCA,
-			SuccessReturnURL:  helper.RandomUrl(),
-			FailureReturnURL:  helper.RandomUrl(),
+	createParams := &repository.CreatePaymentTxParams{
+		Payment: &gateway.Payment{
+			ID:          customerPaymentMethodID.String(),
+			CustomerID:  customerPaymentID.String(),
+			Type:        payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
+			Status:      payment.STATUS_ACTIVE,
+			Reusability: payment.USAGE_TYPE_ONE_TIME_USE,
+			Description: helper.RandomString(100),
+			Amount:      decimal.NewFromInt(helper.RandomInt(100, 200000)),
+			Currency:    payment.DEFAULT_CURRENCY,
+			ExpiresAt:   time.Now().Add(24 * 3 * time.Hour),
+			Channel:     "BCA",
 		},
 	}
 
@@ -215,12 +298,13 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 		},
 		PaymentReferenceID: customerPaymentMethodID.String(),
 		PaymentBusinessID:  customerPaymentMethodID.String(),
-		PaymentCustomerID:  createParams.CreateVirtualAccountBankPayment.CustomerPaymentID,
+		PaymentCustomerID:  createParams.Payment.CustomerID,
 		PaymentType:        payment.METHODE_TYPE_VIRTUAL_ACCOUNT,
 		PaymentStatus:      payment.STATUS_ACTIVE,
 		PaymentReusability: payment.USAGE_TYPE_ONE_TIME_USE,
-		PaymentChannel:     string(createParams.CreateVirtualAccountBankPayment.ChannelCode),
-		PaymentAmount:      decimal.NewFromFloat(createParams.CreateVirtualAccountBankPayment.Amount),
+		PaymentChannel:     createParams.Payment.Channel,
+		PaymentAmount:      createParams.Payment.Amount,
+		Currency:           createParams.Payment.Currency,
 		PaymentQrCode: pgtype.Text{
 			String: helper.RandomString(16),
 			Valid:  true,
@@ -233,7 +317,7 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 			String: helper.RandomUrl(),
 			Valid:  true,
 		},
-		PaymentDescription: createParams.CreateVirtualAccountBankPayment.Description,
+		PaymentDescription: createParams.Payment.Description,
 		CreatedAt: pgtype.Timestamptz{
 			Time:  time.Now(),
 			Valid: true,
@@ -243,7 +327,7 @@ func createRandomVirtualAccountBankPayment(t *testing.T) (*repository.CreateVirt
 			Valid: true,
 		},
 		ExpiresAt: pgtype.Timestamptz{
-			Time:  createParams.CreateVirtualAccountBankPayment.Expiry,
+			Time:  createParams.Payment.ExpiresAt,
 			Valid: true,
 		},
 	}
@@ -0,0 +1,521 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// reconciliationReportExt is the extension of the reports picked up from the report dir, an imported
+	// report is renamed with reconciliationDoneExt and one which could not be read with reconciliationFailedExt.
+	reconciliationReportExt = ".csv"
+	reconciliationDoneExt   = ".done"
+	reconciliationFailedExt = ".failed"
+)
+
+var (
+	errUnknownReconciliationSource = errors.New("unknown reconciliation source")
+	errReconciliationLockLost      = errors.New("reconciliation lock lost")
+)
+
+// Reconciler periodically matches the transaction report of the gateway against the payments we recorded
+// and keeps the discrepancies it finds. Only the replica holding the reconciliation lock reconciles.
+type Reconciler struct {
+	log      logger.Logger
+	cfg      *config.App
+	repo     repository.Repository
+	registry *gateway.Registry
+	metrics  *metrics.Metrics
+	token    string
+}
+
+// reconciliationReport is a report to reconcile, the period it covers is only known for a gateway report
+// or a file whose rows are dated, payments are only reported missing within it.
+type reconciliationReport struct {
+	source    string
+	reference string
+	rows      []*gateway.TransactionReportRow
+	from      time.Time
+	to        time.Time
+}
+
+func NewReconciler(
+	log logger.Logger,
+	cfg *config.App,
+	repo repository.Repository,
+	registry *gateway.Registry,
+	metrics *metrics.Metrics,
+) domain.Reconciler {
+	return &Reconciler{
+		log:      log.WithPrefix(fmt.Sprintf("%s-%s", "payment-reconciler", constants.Worker)),
+		cfg:      cfg,
+		repo:     repo,
+		registry: registry,
+		metrics:  metrics,
+		token:    helper.RandomString(32),
+	}
+}
+
+func (s *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Services.Internal.Reconciliation.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.cfg.Services.Internal.Reconciliation.Enable {
+			if err := s.Reconcile(ctx); err != nil {
+				s.log.Warnf("s.Reconcile.err: %v", err)
+			}
+		}
+
+		ticker.Reset(s.cfg.Services.Internal.Reconciliation.Interval)
+	}
+}
+
+// Reconcile imports the reports of the configured source, it returns without reconciling when another
+// replica holds the reconciliation lock.
+func (s *Reconciler) Reconcile(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Reconciler.Reconcile")
+	defer span.Finish()
+
+	acquired, err := s.repo.AcquireReconciliationLock(ctx, s.token)
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("s.repo.AcquireReconciliationLock.err: %v", err))
+	}
+
+	if !acquired {
+		s.log.Debug("reconciliation lock is held by another replica, skipping reconciliation")
+		return nil
+	}
+	defer s.repo.ReleaseReconciliationLock(ctx, s.token)
+
+	switch s.cfg.Services.Internal.Reconciliation.Source {
+	case payment.RECONCILIATION_SOURCE_GATEWAY:
+		err = s.reconcileGatewayReport(ctx)
+	case payment.RECONCILIATION_SOURCE_FILE:
+		err = s.reconcileReportFiles(ctx)
+	default:
+		return tracing.TraceWithError(span, fmt.Errorf("%w: %s", errUnknownReconciliationSource, s.cfg.Services.Internal.Reconciliation.Source))
+	}
+
+	if errors.Is(err, errReconciliationLockLost) {
+		s.log.Warn("reconciliation lock expired while reconciling, leaving the rest to the lock holder")
+		return nil
+	}
+
+	return err
+}
+
+// refreshLock extends the reconciliation lock, it returns errReconciliationLockLost once the lock has
+// passed to another replica.
+func (s *Reconciler) refreshLock(ctx context.Context) error {
+	refreshed, err := s.repo.RefreshReconciliationLock(ctx, s.token)
+	if err != nil {
+		return fmt.Errorf("s.repo.RefreshReconciliationLock.err: %v", err)
+	}
+
+	if !refreshed {
+		return errReconciliationLockLost
+	}
+
+	return nil
+}
+
+func (s *Reconciler) reconcileGatewayReport(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Reconciler.reconcileGatewayReport")
+	defer span.Finish()
+
+	provider, err := s.registry.Get(s.cfg.Services.External.PaymentGateway.ID)
+	if err != nil {
+		return tracing.TraceWithError(span, err)
+	}
+
+	to := time.Now().Add(-s.cfg.Services.Internal.Reconciliation.Delay)
+	from := to.Add(-s.cfg.Services.Internal.Reconciliation.Lookback)
+
+	reportCtx, cancel := context.WithTimeout(ctx, s.cfg.Services.Internal.Reconciliation.ReportTimeout)
+	defer cancel()
+
+	report, err := provider.GetTransactionReport(reportCtx, &gateway.GetTransactionReportParams{From: from, To: to})
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("provider.GetTransactionReport.err: %v", err))
+	}
+
+	return s.reconcile(ctx, &reconciliationReport{
+		source:    payment.RECONCILIATION_SOURCE_GATEWAY,
+		reference: report.ID,
+		rows:      report.Rows,
+		from:      from,
+		to:        to,
+	})
+}
+
+// reconcileReportFiles imports the reports dropped into the report dir in the order of their names,
+// a report is renamed once imported so it is never imported twice.
+func (s *Reconciler) reconcileReportFiles(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Reconciler.reconcileReportFiles")
+	defer span.Finish()
+
+	dir := s.cfg.Services.Internal.Reconciliation.ReportDir
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return tracing.TraceWithError(span, fmt.Errorf("os.ReadDir.err: %v", err))
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), reconciliationReportExt) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+
+		report, err := readReportFile(path)
+		if err != nil {
+			// a report which cannot be read never will be, it is set aside for someone to look at.
+			s.log.Warnf("readReportFile.err: file: %s, err: %v", name, err)
+			if err := os.Rename(path, path+reconciliationFailedExt); err != nil {
+				s.log.Warnf("os.Rename.err: file: %s, err: %v", name, err)
+			}
+			continue
+		}
+
+		// a report left unfinished is not renamed, the lock holder imports it again.
+		if err := s.reconcile(ctx, report); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("s.reconcile.err: file: %s, err: %w", name, err))
+		}
+
+		if err := os.Rename(path, path+reconciliationDoneExt); err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("os.Rename.err: %v", err))
+		}
+	}
+
+	return nil
+}
+
+func readReportFile(path string) (*reconciliationReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rows, err := gateway.ParseTransactionReport(file)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &reconciliationReport{
+		source:    payment.RECONCILIATION_SOURCE_FILE,
+		reference: filepath.Base(path),
+		rows:      rows,
+	}
+
+	// a file only covers a period when every one of its rows is dated.
+	for _, row := range rows {
+		if row.CreatedAt.IsZero() {
+			return res, nil
+		}
+
+		if res.from.IsZero() || row.CreatedAt.Before(res.from) {
+			res.from = row.CreatedAt
+		}
+
+		if row.CreatedAt.After(res.to) {
+			res.to = row.CreatedAt
+		}
+	}
+
+	if !res.to.IsZero() {
+		res.to = res.to.Add(time.Second)
+	}
+
+	return res, nil
+}
+
+// reconcile matches every row of the report to a payment and records the rows which match none as EXTRA,
+// and those which disagree with their payment on the amount or the status. Within the period of the report,
+// a payment which succeeded without being reported is recorded as MISSING. The lock is refreshed before every
+// batch of rows and of payments, so a long report stops as soon as the lock has passed to another replica.
+func (s *Reconciler) reconcile(ctx context.Context, report *reconciliationReport) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Reconciler.reconcile")
+	defer span.Finish()
+
+	reported := make(map[string]bool, len(report.rows))
+	batchSize := int(s.cfg.Services.Internal.Reconciliation.BatchSize)
+
+	for i, row := range report.rows {
+		if batchSize > 0 && i%batchSize == 0 {
+			if err := s.refreshLock(ctx); err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+		}
+
+		pm, err := s.matchPayment(ctx, row)
+		if err != nil {
+			return tracing.TraceWithError(span, err)
+		}
+
+		if pm == nil {
+			err := s.recordDiscrepancy(ctx, report, &repository.UpsertReconciliationDiscrepancyParams{
+				DiscrepancyType:    payment.RECONCILIATION_DISCREPANCY_EXTRA,
+				DiscrepancyKey:     reportRowKey(row),
+				PaymentMethodID:    textOrNull(row.PaymentMethodID),
+				PaymentRequestID:   textOrNull(row.PaymentRequestID),
+				PaymentReferenceID: textOrNull(row.ReferenceID),
+				ReportedAmount:     row.Amount,
+				ReportedStatus:     textOrNull(row.Status),
+				Currency:           row.Currency,
+			})
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+			continue
+		}
+
+		reported[pm.Uid] = true
+
+		expectedAmount := expectedPaymentAmount(pm)
+
+		if !row.Amount.Equal(expectedAmount) || (row.Currency != "" && row.Currency != pm.Currency) {
+			err := s.recordDiscrepancy(ctx, report, paymentDiscrepancy(pm, payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH, expectedAmount, row))
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+		}
+
+		if row.Status != "" && row.Status != pm.PaymentStatus {
+			err := s.recordDiscrepancy(ctx, report, paymentDiscrepancy(pm, payment.RECONCILIATION_DISCREPANCY_STATUS_MISMATCH, expectedAmount, row))
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+		}
+	}
+
+	if report.from.IsZero() || report.to.IsZero() {
+		return nil
+	}
+
+	arg := repository.ListSucceededPaymentMethodsParams{
+		PaidFrom: pgtype.Timestamptz{
+			Time:  report.from,
+			Valid: true,
+		},
+		PaidTo: pgtype.Timestamptz{
+			Time:  report.to,
+			Valid: true,
+		},
+		BatchSize: s.cfg.Services.Internal.Reconciliation.BatchSize,
+	}
+
+	for {
+		if err := s.refreshLock(ctx); err != nil {
+			return tracing.TraceWithError(span, err)
+		}
+
+		list, err := s.repo.ListSucceededPaymentMethods(ctx, &arg)
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("s.repo.ListSucceededPaymentMethods.err: %v", err))
+		}
+
+		for _, pm := range list {
+			if reported[pm.Uid] {
+				continue
+			}
+
+			err := s.recordDiscrepancy(ctx, report, paymentDiscrepancy(pm, payment.RECONCILIATION_DISCREPANCY_MISSING, expectedPaymentAmount(pm), nil))
+			if err != nil {
+				return tracing.TraceWithError(span, err)
+			}
+		}
+
+		if int32(len(list)) < arg.BatchSize {
+			return nil
+		}
+
+		arg.AfterUid = list[len(list)-1].Uid
+	}
+}
+
+// matchPayment finds the payment of a report row by the most specific of its ids, it returns nil
+// when the row matches none of our payments.
+func (s *Reconciler) matchPayment(ctx context.Context, row *gateway.TransactionReportRow) (*repository.PaymentMethod, error) {
+	lookups := []struct {
+		id  string
+		get func() (*repository.PaymentMethod, error)
+	}{
+		{row.PaymentRequestID, func() (*repository.PaymentMethod, error) {
+			return s.repo.GetPaymentMethodByPaymentRequestID(ctx, pgtype.Text{String: row.PaymentRequestID, Valid: true})
+		}},
+		{row.PaymentMethodID, func() (*repository.PaymentMethod, error) {
+			return s.repo.GetPaymentMethodByPaymentMethodID(ctx, row.PaymentMethodID)
+		}},
+		{row.ReferenceID, func() (*repository.PaymentMethod, error) {
+			return s.matchPaymentByReferenceID(ctx, row)
+		}},
+	}
+
+	for _, lookup := range lookups {
+		if lookup.id == "" {
+			continue
+		}
+
+		pm, err := lookup.get()
+		if err == nil {
+			return pm, nil
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("s.repo.GetPaymentMethod.err: %s: %v", lookup.id, err)
+		}
+	}
+
+	return nil, nil
+}
+
+// matchPaymentByReferenceID matches a row by our reference id, which several payments may share. The row
+// matches the only payment of the reference, or the only one which agrees with it on the status and the
+// amount, any other row is left unmatched.
+func (s *Reconciler) matchPaymentByReferenceID(ctx context.Context, row *gateway.TransactionReportRow) (*repository.PaymentMethod, error) {
+	list, err := s.repo.ListPaymentMethodsByReferenceID(ctx, row.ReferenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 1 {
+		return list[0], nil
+	}
+
+	var res *repository.PaymentMethod
+	for _, pm := range list {
+		if row.Status != "" && row.Status != pm.PaymentStatus {
+			continue
+		}
+
+		if !row.Amount.Equal(expectedPaymentAmount(pm)) || (row.Currency != "" && row.Currency != pm.Currency) {
+			continue
+		}
+
+		if res != nil {
+			s.log.Warnf("reconciliation row matches several payments of reference id: %s", row.ReferenceID)
+			return nil, pgx.ErrNoRows
+		}
+
+		res = pm
+	}
+
+	if res == nil {
+		return nil, pgx.ErrNoRows
+	}
+
+	return res, nil
+}
+
+func (s *Reconciler) recordDiscrepancy(ctx context.Context, report *reconciliationReport, arg *repository.UpsertReconciliationDiscrepancyParams) error {
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return err
+	}
+
+	arg.Uid = uid.String()
+	arg.ReportSource = report.source
+	arg.ReportReference = report.reference
+	arg.DetectedAt = pgtype.Timestamptz{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	if _, err := s.repo.UpsertReconciliationDiscrepancy(ctx, arg); err != nil {
+		return fmt.Errorf("s.repo.UpsertReconciliationDiscrepancy.err: %v", err)
+	}
+
+	s.log.Infof("reconciliation discrepancy: type: %s, key: %s, report: %s", arg.DiscrepancyType, arg.DiscrepancyKey, report.reference)
+	s.metrics.ReconciliationDiscrepancies.Inc()
+
+	return nil
+}
+
+// paymentDiscrepancy is a discrepancy of one of our payments, row is nil when the payment was not reported.
+func paymentDiscrepancy(pm *repository.PaymentMethod, typ string, expectedAmount decimal.Decimal, row *gateway.TransactionReportRow) *repository.UpsertReconciliationDiscrepancyParams {
+	res := &repository.UpsertReconciliationDiscrepancyParams{
+		DiscrepancyType:    typ,
+		DiscrepancyKey:     pm.Uid,
+		PaymentMethodUid:   pgtype.Text{String: pm.Uid, Valid: true},
+		PaymentMethodID:    pgtype.Text{String: pm.PaymentMethodID, Valid: true},
+		PaymentRequestID:   pm.PaymentRequestID,
+		PaymentReferenceID: pgtype.Text{String: pm.PaymentReferenceID, Valid: true},
+		ExpectedAmount:     expectedAmount,
+		ExpectedStatus:     pgtype.Text{String: pm.PaymentStatus, Valid: true},
+		Currency:           pm.Currency,
+	}
+
+	if row != nil {
+		res.ReportedAmount = row.Amount
+		res.ReportedStatus = textOrNull(row.Status)
+	}
+
+	return res
+}
+
+// expectedPaymentAmount is the amount the gateway should report for a payment, the captured amount once a part of
+// an authorized payment was captured.
+func expectedPaymentAmount(pm *repository.PaymentMethod) decimal.Decimal {
+	if pm.PaymentCapturedAmount.IsPositive() {
+		return pm.PaymentCapturedAmount
+	}
+
+	return pm.PaymentAmount
+}
+
+// reportRowKey identifies a row which matches none of our payments by the first id it carries.
+func reportRowKey(row *gateway.TransactionReportRow) string {
+	for _, id := range []string{row.PaymentRequestID, row.PaymentMethodID, row.ReferenceID} {
+		if id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+func textOrNull(arg string) pgtype.Text {
+	return pgtype.Text{String: arg, Valid: arg != ""}
+}
+
+func (s *Reconciler) OnConfigUpdate(key string, config *config.App) {
+	s.log.Infof("received update from '%s' key", key)
+
+	s.cfg = config
+
+	s.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
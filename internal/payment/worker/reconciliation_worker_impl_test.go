@@ -0,0 +1,470 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_RECONCILER_GATEWAY_REPORT(t *testing.T) {
+	reconcilerConf := createReconcilerConfig(payment.RECONCILIATION_SOURCE_GATEWAY, "", 2)
+	reconcilerMetrics := metrics.New(reconcilerConf)
+
+	testCases := []struct {
+		tname         string
+		stubs         func(store *mock.MockRepository, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				matched := createRandomSucceededPayment(t, provider)
+				missing := createRandomSucceededPayment(t, provider)
+
+				amountMismatch := createRandomSucceededPayment(t, provider)
+				reportedAmount := amountMismatch.PaymentAmount
+				amountMismatch.PaymentAmount = reportedAmount.Add(decimal.NewFromInt(1000))
+
+				statusMismatch := createRandomSucceededPayment(t, provider)
+				statusMismatch.PaymentStatus = payment.STATUS_ACTIVE
+
+				// the gateway does not report the missing payment yet, it only succeeded on our side.
+				require.NoError(t, provider.SetPaymentStatus(missing.PaymentMethodID, payment.STATUS_ACTIVE))
+
+				extra := &gateway.TransactionReportRow{
+					ReferenceID: helper.RandomString(26),
+					Status:      payment.STATUS_SUCCEEDED,
+					Amount:      decimal.NewFromInt(5000),
+				}
+				provider.AddTransactionReportRow(extra)
+
+				payments := map[string]*repository.PaymentMethod{
+					matched.PaymentMethodID:        matched,
+					amountMismatch.PaymentMethodID: amountMismatch,
+					statusMismatch.PaymentMethodID: statusMismatch,
+				}
+
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				// before each of the two batches of rows and of payments.
+				store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(4).Return(true, nil)
+				store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+					func(_ any, arg string) (*repository.PaymentMethod, error) {
+						pm, ok := payments[arg]
+						require.True(t, ok)
+						return pm, nil
+					},
+				)
+				store.EXPECT().ListPaymentMethodsByReferenceID(gomock.Any(), gomock.Eq(extra.ReferenceID)).Times(1).Return([]*repository.PaymentMethod{}, nil)
+				gomock.InOrder(
+					store.EXPECT().ListSucceededPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *repository.ListSucceededPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+							require.Equal(t, int32(2), arg.BatchSize)
+							require.Empty(t, arg.AfterUid)
+							require.Equal(t, time.Hour, arg.PaidTo.Time.Sub(arg.PaidFrom.Time))
+							return []*repository.PaymentMethod{matched, missing}, nil
+						},
+					),
+					store.EXPECT().ListSucceededPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+						func(_ any, arg *repository.ListSucceededPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+							require.Equal(t, missing.Uid, arg.AfterUid)
+							return []*repository.PaymentMethod{amountMismatch}, nil
+						},
+					),
+				)
+
+				recorded := make(map[string]*repository.UpsertReconciliationDiscrepancyParams)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(
+					func(_ any, arg *repository.UpsertReconciliationDiscrepancyParams) (*repository.ReconciliationDiscrepancy, error) {
+						require.NotEmpty(t, arg.Uid)
+						require.Equal(t, payment.RECONCILIATION_SOURCE_GATEWAY, arg.ReportSource)
+						require.NotEmpty(t, arg.ReportReference)
+						require.True(t, arg.DetectedAt.Valid)
+						recorded[arg.DiscrepancyType] = arg
+
+						if len(recorded) == 4 {
+							require.Equal(t, extra.ReferenceID, recorded[payment.RECONCILIATION_DISCREPANCY_EXTRA].DiscrepancyKey)
+							require.False(t, recorded[payment.RECONCILIATION_DISCREPANCY_EXTRA].PaymentMethodUid.Valid)
+							require.True(t, extra.Amount.Equal(recorded[payment.RECONCILIATION_DISCREPANCY_EXTRA].ReportedAmount))
+
+							require.Equal(t, amountMismatch.Uid, recorded[payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH].DiscrepancyKey)
+							require.True(t, amountMismatch.PaymentAmount.Equal(recorded[payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH].ExpectedAmount))
+							require.True(t, reportedAmount.Equal(recorded[payment.RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH].ReportedAmount))
+
+							require.Equal(t, statusMismatch.Uid, recorded[payment.RECONCILIATION_DISCREPANCY_STATUS_MISMATCH].DiscrepancyKey)
+							require.Equal(t, payment.STATUS_ACTIVE, recorded[payment.RECONCILIATION_DISCREPANCY_STATUS_MISMATCH].ExpectedStatus.String)
+							require.Equal(t, payment.STATUS_SUCCEEDED, recorded[payment.RECONCILIATION_DISCREPANCY_STATUS_MISMATCH].ReportedStatus.String)
+
+							require.Equal(t, missing.Uid, recorded[payment.RECONCILIATION_DISCREPANCY_MISSING].DiscrepancyKey)
+							require.False(t, recorded[payment.RECONCILIATION_DISCREPANCY_MISSING].ReportedStatus.Valid)
+						}
+
+						return &repository.ReconciliationDiscrepancy{Uid: arg.Uid}, nil
+					},
+				)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_HELD_BY_ANOTHER_REPLICA",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_ACQUIRE_LOCK",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_GET_TRANSACTION_REPORT",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().ListSucceededPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "ERR_GET_PAYMENT_METHOD",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				createRandomSucceededPayment(t, provider)
+
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			tname: "OK_LOCK_LOST_WHILE_RECONCILING",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				createRandomSucceededPayment(t, provider)
+
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListSucceededPaymentMethods(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_REFRESH_LOCK",
+			stubs: func(store *mock.MockRepository, provider *gateway.FakeProvider) {
+				createRandomSucceededPayment(t, provider)
+
+				store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+				store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("connection refused"))
+				store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(reconcilerConf.Services.External.PaymentGateway.ID, provider)
+
+			s := NewReconciler(tlog, reconcilerConf, store, registry, reconcilerMetrics)
+			tc.stubs(store, provider)
+
+			err := s.Reconcile(context.TODO())
+			tc.checkResponse(t, err)
+		})
+	}
+}
+
+func Test_MOCK_RECONCILER_REPORT_FILES(t *testing.T) {
+	dir := t.TempDir()
+	reconcilerConf := createReconcilerConfig(payment.RECONCILIATION_SOURCE_FILE, dir, 100)
+	reconcilerMetrics := metrics.New(reconcilerConf)
+
+	paymentMethodID := helper.RandomString(26)
+	writeReportFile(t, dir, "2026-01-01.csv",
+		"Payment Method ID,Reference ID,Status,Amount,Currency,Created\n"+
+			paymentMethodID+",,SETTLED,10000,IDR,2026-01-01T10:00:00Z\n")
+	writeReportFile(t, dir, "2026-01-02.csv", "Reference ID,Amount\n"+helper.RandomString(26)+",10000\n")
+	writeReportFile(t, dir, "notes.txt", "not a report")
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	pm := &repository.PaymentMethod{
+		Uid:             helper.RandomString(26),
+		PaymentMethodID: paymentMethodID,
+		PaymentStatus:   payment.STATUS_SUCCEEDED,
+		PaymentAmount:   decimal.NewFromInt(10000),
+		Currency:        "IDR",
+	}
+
+	store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+	store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(2).Return(true, nil)
+	store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Eq(paymentMethodID)).Times(1).Return(pm, nil)
+	store.EXPECT().ListSucceededPaymentMethods(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ any, arg *repository.ListSucceededPaymentMethodsParams) ([]*repository.PaymentMethod, error) {
+			require.True(t, time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).Equal(arg.PaidFrom.Time))
+			require.True(t, time.Date(2026, 1, 1, 10, 0, 1, 0, time.UTC).Equal(arg.PaidTo.Time))
+			return []*repository.PaymentMethod{pm}, nil
+		},
+	)
+	store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+
+	s := NewReconciler(tlog, reconcilerConf, store, gateway.NewRegistry(), reconcilerMetrics)
+	require.NoError(t, s.Reconcile(context.TODO()))
+
+	require.FileExists(t, filepath.Join(dir, "2026-01-01.csv"+reconciliationDoneExt))
+	// the second report has no status column, it cannot be reconciled.
+	require.FileExists(t, filepath.Join(dir, "2026-01-02.csv"+reconciliationFailedExt))
+	require.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
+
+func Test_MOCK_RECONCILER_REPORT_FILES_LOCK_LOST(t *testing.T) {
+	dir := t.TempDir()
+	reconcilerConf := createReconcilerConfig(payment.RECONCILIATION_SOURCE_FILE, dir, 1)
+	reconcilerConf.Services.Internal.Name = "payment_reconciler_test_report_files_lock_lost"
+
+	first := helper.RandomString(26)
+	writeReportFile(t, dir, "2026-01-01.csv",
+		"Payment Method ID,Status,Amount,Currency\n"+
+			first+",SETTLED,10000,IDR\n"+
+			helper.RandomString(26)+",SETTLED,10000,IDR\n")
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	pm := &repository.PaymentMethod{
+		Uid:             helper.RandomString(26),
+		PaymentMethodID: first,
+		PaymentStatus:   payment.STATUS_SUCCEEDED,
+		PaymentAmount:   decimal.NewFromInt(10000),
+		Currency:        "IDR",
+	}
+
+	gomock.InOrder(
+		store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+		store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil),
+		store.EXPECT().GetPaymentMethodByPaymentMethodID(gomock.Any(), gomock.Eq(first)).Times(1).Return(pm, nil),
+		store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(false, nil),
+		store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1),
+	)
+	store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+
+	s := NewReconciler(tlog, reconcilerConf, store, gateway.NewRegistry(), metrics.New(reconcilerConf))
+	require.NoError(t, s.Reconcile(context.TODO()))
+
+	// the report is left for the lock holder to import in full.
+	require.FileExists(t, filepath.Join(dir, "2026-01-01.csv"))
+	require.NoFileExists(t, filepath.Join(dir, "2026-01-01.csv"+reconciliationDoneExt))
+}
+
+func Test_MOCK_RECONCILER_REFERENCE_ID(t *testing.T) {
+	referenceID := helper.RandomString(26)
+	amount := decimal.NewFromInt(10000)
+
+	referencePayment := func(status string, amount decimal.Decimal) *repository.PaymentMethod {
+		return &repository.PaymentMethod{
+			Uid:                helper.RandomString(26),
+			PaymentMethodID:    helper.RandomString(26),
+			PaymentReferenceID: referenceID,
+			PaymentStatus:      status,
+			PaymentAmount:      amount,
+			Currency:           "IDR",
+		}
+	}
+
+	testCases := []struct {
+		tname string
+		// payments are the payments sharing the reference id of the reported row.
+		payments func() []*repository.PaymentMethod
+		// matched is the index of the payment the row matches, -1 when it is recorded as EXTRA.
+		matched int
+	}{
+		{
+			tname: "OK_ONLY_PAYMENT_OF_REFERENCE",
+			payments: func() []*repository.PaymentMethod {
+				return []*repository.PaymentMethod{referencePayment(payment.STATUS_SUCCEEDED, amount)}
+			},
+			matched: 0,
+		},
+		{
+			tname: "OK_ONLY_PAYMENT_AGREEING_WITH_ROW",
+			payments: func() []*repository.PaymentMethod {
+				return []*repository.PaymentMethod{
+					referencePayment(payment.STATUS_EXPIRED, amount),
+					referencePayment(payment.STATUS_SUCCEEDED, amount),
+					referencePayment(payment.STATUS_SUCCEEDED, amount.Add(decimal.NewFromInt(1000))),
+				}
+			},
+			matched: 1,
+		},
+		{
+			tname: "OK_EXTRA_WHEN_SEVERAL_PAYMENTS_AGREE",
+			payments: func() []*repository.PaymentMethod {
+				return []*repository.PaymentMethod{
+					referencePayment(payment.STATUS_SUCCEEDED, amount),
+					referencePayment(payment.STATUS_SUCCEEDED, amount),
+				}
+			},
+			matched: -1,
+		},
+		{
+			tname: "OK_EXTRA_WHEN_NO_PAYMENT_AGREES",
+			payments: func() []*repository.PaymentMethod {
+				return []*repository.PaymentMethod{
+					referencePayment(payment.STATUS_EXPIRED, amount),
+					referencePayment(payment.STATUS_FAILED, amount),
+				}
+			},
+			matched: -1,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			dir := t.TempDir()
+			reconcilerConf := createReconcilerConfig(payment.RECONCILIATION_SOURCE_FILE, dir, 100)
+			reconcilerConf.Services.Internal.Name = "payment_reconciler_test_reference_id_" + strings.ToLower(tc.tname)
+
+			writeReportFile(t, dir, "2026-01-01.csv",
+				"Reference ID,Status,Amount,Currency\n"+referenceID+",SETTLED,10000,IDR\n")
+
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			payments := tc.payments()
+
+			store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+			store.EXPECT().RefreshReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+			store.EXPECT().GetPaymentMethodByReferenceID(gomock.Any(), gomock.Any()).Times(0)
+			store.EXPECT().ListPaymentMethodsByReferenceID(gomock.Any(), gomock.Eq(referenceID)).Times(1).Return(payments, nil)
+			if tc.matched >= 0 {
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(0)
+			} else {
+				store.EXPECT().UpsertReconciliationDiscrepancy(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpsertReconciliationDiscrepancyParams) (*repository.ReconciliationDiscrepancy, error) {
+						require.Equal(t, payment.RECONCILIATION_DISCREPANCY_EXTRA, arg.DiscrepancyType)
+						require.Equal(t, referenceID, arg.DiscrepancyKey)
+						require.False(t, arg.PaymentMethodUid.Valid)
+						return &repository.ReconciliationDiscrepancy{Uid: arg.Uid}, nil
+					},
+				)
+			}
+			store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+
+			s := NewReconciler(tlog, reconcilerConf, store, gateway.NewRegistry(), metrics.New(reconcilerConf))
+			require.NoError(t, s.Reconcile(context.TODO()))
+			require.FileExists(t, filepath.Join(dir, "2026-01-01.csv"+reconciliationDoneExt))
+		})
+	}
+}
+
+func Test_MOCK_RECONCILER_UNKNOWN_SOURCE(t *testing.T) {
+	reconcilerConf := createReconcilerConfig(helper.RandomString(12), "", 100)
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mock.NewMockRepository(storeCtrl)
+
+	store.EXPECT().AcquireReconciliationLock(gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
+	store.EXPECT().ReleaseReconciliationLock(gomock.Any(), gomock.Any()).Times(1)
+
+	s := NewReconciler(tlog, reconcilerConf, store, gateway.NewRegistry(), metrics.New(reconcilerConf))
+	require.ErrorIs(t, s.Reconcile(context.TODO()), errUnknownReconciliationSource)
+}
+
+func createReconcilerConfig(source string, reportDir string, batchSize int32) *config.App {
+	internal := *conf.Services.Internal
+	// metrics are registered under the service name, every source gets its own.
+	internal.Name = "payment_reconciler_test_" + strings.ToLower(source)
+	internal.Reconciliation = &config.Reconciliation{
+		Enable:        true,
+		Interval:      time.Hour,
+		Source:        source,
+		ReportDir:     reportDir,
+		Lookback:      time.Hour,
+		ReportTimeout: time.Minute,
+		BatchSize:     batchSize,
+	}
+
+	services := *conf.Services
+	services.Internal = &internal
+
+	res := *conf
+	res.Services = &services
+
+	return &res
+}
+
+// createRandomSucceededPayment creates a payment the fake provider reports as succeeded
+// and returns the row the repository would hold for it.
+func createRandomSucceededPayment(t *testing.T, provider *gateway.FakeProvider) *repository.PaymentMethod {
+	pm := createRandomOverduePayment(t, provider, payment.METHODE_TYPE_VIRTUAL_ACCOUNT)
+	require.NoError(t, provider.SetPaymentStatus(pm.PaymentMethodID, payment.STATUS_SUCCEEDED))
+
+	pm.PaymentStatus = payment.STATUS_SUCCEEDED
+
+	return pm
+}
+
+func writeReportFile(t *testing.T, dir string, name string, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (w *Worker) RefundStatusUpdated(ctx context.Context, task *models.RefundStatusUpdatedTask) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Worker.RefundStatusUpdated")
+	defer span.Finish()
+
+	arg := messages.KafkaRefundStatusUpdated{
+		Uid:               task.Refund.Uid,
+		RefundId:          &task.Refund.RefundID.String,
+		PaymentMethodUid:  task.Refund.PaymentMethodUid,
+		PaymentMethodId:   task.Refund.PaymentMethodID,
+		RefundReferenceId: task.Refund.RefundReferenceID,
+		RefundStatus:      task.Refund.RefundStatus,
+		RefundAmount:      task.Refund.RefundAmount.InexactFloat64(),
+		RefundAmountMinor: payment.ToMinorUnits(task.Refund.RefundAmount, task.Refund.Currency),
+		Currency:          task.Refund.Currency,
+		RefundReason:      task.Refund.RefundReason,
+		RefundFailureCode: &task.Refund.RefundFailureCode.String,
+		CreatedAt:         timestamppb.New(task.Refund.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(task.Refund.UpdatedAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "proto.Marshal.err", err),
+			err,
+		)
+	}
+
+	message := kafka.Message{
+		Topic:   helper.StringBuilder(w.cfg.Services.Internal.ID, "_", w.cfg.Brokers.Kafka.Topics.RefundStatusUpdated.TopicName),
+		Value:   protoMsg,
+		Time:    time.Now().UTC(),
+		Headers: tracing.GetKafkaTracingHeadersFromSpanCtx(span.Context()),
+	}
+
+	err = w.distributor.PublishMessage(ctx, message)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "w.distributor.PublishMessage.err", err),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	producerMock "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/segmentio/kafka-go"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_MOCK_REFUND_STATUS_UPDATED(t *testing.T) {
+	refundRespOK := createRandomRefund(t)
+	okTopic := helper.StringBuilder(conf.Services.Internal.ID, "_", conf.Brokers.Kafka.Topics.RefundStatusUpdated.TopicName)
+
+	okParams := createRefundKafkaMessageParams(t, okTopic, refundRespOK)
+
+	testCases := []struct {
+		tname         string
+		body          *models.RefundStatusUpdatedTask
+		stub          func(producerStore *producerMock.MockProducer)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.RefundStatusUpdatedTask{
+				Refund: refundRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "NOT_OK",
+			body: &models.RefundStatusUpdatedTask{
+				Refund: refundRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(errors.New("any err"))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			producerStoreCtrl := gomock.NewController(t)
+			defer producerStoreCtrl.Finish()
+			producerStore := producerMock.NewMockProducer(producerStoreCtrl)
+
+			u := New(tlog, conf, producerStore)
+			tc.stub(producerStore)
+
+			actualError := u.RefundStatusUpdated(context.TODO(), tc.body)
+			tc.checkResponse(t, actualError)
+		})
+	}
+}
+
+func createRefundKafkaMessageParams(t *testing.T, topic string, task *repository.Refund) kafka.Message {
+	arg := messages.KafkaRefundStatusUpdated{
+		Uid:               task.Uid,
+		RefundId:          &task.RefundID.String,
+		PaymentMethodUid:  task.PaymentMethodUid,
+		PaymentMethodId:   task.PaymentMethodID,
+		RefundReferenceId: task.RefundReferenceID,
+		RefundStatus:      task.RefundStatus,
+		RefundAmount:      task.RefundAmount.InexactFloat64(),
+		RefundAmountMinor: payment.ToMinorUnits(task.RefundAmount, payment.DEFAULT_CURRENCY),
+		Currency:          payment.DEFAULT_CURRENCY,
+		RefundReason:      task.RefundReason,
+		RefundFailureCode: &task.RefundFailureCode.String,
+		CreatedAt:         timestamppb.New(task.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(task.UpdatedAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, &arg)
+
+	return kafka.Message{
+		Topic: topic,
+		Value: protoMsg,
+		Time:  time.Now().UTC(),
+	}
+}
+
+func createRandomRefund(t *testing.T) *repository.Refund {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	paymentMethodUid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, paymentMethodUid)
+
+	return &repository.Refund{
+		Uid: ulid.String(),
+		RefundID: pgtype.Text{
+			String: helper.RandomString(24),
+			Valid:  true,
+		},
+		PaymentMethodUid:  paymentMethodUid.String(),
+		PaymentMethodID:   helper.RandomString(24),
+		RefundReferenceID: ulid.String(),
+		RefundStatus:      payment.REFUND_STATUS_PENDING,
+		RefundAmount:      decimal.NewFromInt(helper.RandomInt(100, 200000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		RefundReason:      payment.REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+}
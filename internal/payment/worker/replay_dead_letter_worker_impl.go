@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+)
+
+// ReplayDeadLetter publishes a quarantined message back onto its source topic. The failure headers it picked
+// up on the way to the dead-letter topic are dropped so the consumer counts its attempts from zero again.
+func (w *Worker) ReplayDeadLetter(ctx context.Context, task *models.ReplayDeadLetterTask) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Worker.ReplayDeadLetter")
+	defer span.Finish()
+
+	headers := make([]kafka.Header, 0, len(task.Headers)+1)
+	for _, header := range task.Headers {
+		headers = append(headers, kafka.Header{Key: header.Key, Value: []byte(header.Value)})
+	}
+
+	headers = kafkaClient.OriginalHeaders(headers)
+	headers = append(headers, kafka.Header{Key: kafkaClient.HeaderReplayedFrom, Value: []byte(task.DeadLetterMessage.Uid)})
+
+	message := kafka.Message{
+		Topic:   task.DeadLetterMessage.SourceTopic,
+		Key:     task.DeadLetterMessage.MessageKey,
+		Value:   task.DeadLetterMessage.Payload,
+		Time:    time.Now().UTC(),
+		Headers: headers,
+	}
+
+	err := w.distributor.PublishMessage(ctx, message)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "w.distributor.PublishMessage.err", err),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payment/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	producerMock "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_REPLAY_DEAD_LETTER(t *testing.T) {
+	msg := createRandomDeadLetterMessage(t)
+	headers := []*models.KafkaHeader{
+		{Key: "uber-trace-id", Value: helper.RandomString(32)},
+		{Key: kafkaClient.HeaderError, Value: "any err"},
+		{Key: kafkaClient.HeaderAttempts, Value: "4"},
+	}
+
+	replayed := func(t *testing.T) func(ctx context.Context, arg kafka.Message) error {
+		return func(ctx context.Context, arg kafka.Message) error {
+			require.Equal(t, msg.SourceTopic, arg.Topic)
+			require.Equal(t, msg.MessageKey, arg.Key)
+			require.Equal(t, msg.Payload, arg.Value)
+			require.Equal(t, []kafka.Header{
+				{Key: "uber-trace-id", Value: []byte(headers[0].Value)},
+				{Key: kafkaClient.HeaderReplayedFrom, Value: []byte(msg.Uid)},
+			}, arg.Headers)
+
+			return nil
+		}
+	}
+
+	testCases := []struct {
+		tname         string
+		body          *models.ReplayDeadLetterTask
+		stub          func(t *testing.T, producerStore *producerMock.MockProducer)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.ReplayDeadLetterTask{
+				DeadLetterMessage: msg,
+				Headers:           headers,
+			},
+			stub: func(t *testing.T, producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(replayed(t))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "NOT_OK",
+			body: &models.ReplayDeadLetterTask{
+				DeadLetterMessage: msg,
+				Headers:           headers,
+			},
+			stub: func(t *testing.T, producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("any err"))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			producerStoreCtrl := gomock.NewController(t)
+			defer producerStoreCtrl.Finish()
+			producerStore := producerMock.NewMockProducer(producerStoreCtrl)
+
+			u := New(tlog, conf, producerStore)
+			tc.stub(t, producerStore)
+
+			actualError := u.ReplayDeadLetter(context.TODO(), tc.body)
+			tc.checkResponse(t, actualError)
+		})
+	}
+}
+
+func createRandomDeadLetterMessage(t *testing.T) *repository.DeadLetterMessage {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	return &repository.DeadLetterMessage{
+		Uid:                 ulid.String(),
+		SourceTopic:         "xendit_payment_status_update",
+		SourcePartition:     0,
+		SourceOffset:        helper.RandomInt(1, 1000),
+		DeadLetterTopic:     "payment_service_payment_status_update_dlq",
+		DeadLetterPartition: 0,
+		DeadLetterOffset:    helper.RandomInt(1, 1000),
+		MessageKey:          []byte(ulid.String()),
+		Payload:             []byte(helper.RandomString(64)),
+		ErrorMessage:        "any err",
+		Attempts:            4,
+		FirstSeenAt:         pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true},
+		DeadLetteredAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		DeadLetterStatus:    payment.DEAD_LETTER_STATUS_QUARANTINED,
+		CreatedAt:           pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	grpcError "github.com/handysuherman/clean-arch-payment-service/internal/pkg/grpc_error"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+type grpcHandler struct {
+	pb.UnimplementedPayoutServiceServer
+	log     logger.Logger
+	cfg     *config.App
+	v       *validator.Validate
+	usecase domain.Usecase
+	metrics *metrics.Metrics
+}
+
+func New(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+) *grpcHandler {
+	return &grpcHandler{
+		log:     log.WithPrefix(fmt.Sprintf("%s-%s", "payout", constants.Handler)),
+		cfg:     cfg,
+		usecase: usecase,
+		v:       v,
+		metrics: metrics,
+	}
+}
+
+func (h *grpcHandler) ValidateBankAccount(ctx context.Context, arg *pb.ValidateBankAccountRequest) (*pb.ValidateBankAccountResponse, error) {
+	h.metrics.ValidateBankAccountGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.ValidateBankAccount")
+	defer span.Finish()
+
+	params := models.NewValidateBankAccountRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.ValidateBankAccount(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.ValidateBankAccount.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) CreatePayout(ctx context.Context, arg *pb.CreatePayoutRequest) (*pb.CreatePayoutResponse, error) {
+	h.metrics.CreatePayoutGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.CreatePayout")
+	defer span.Finish()
+
+	params := models.NewCreatePayoutRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.CreatePayout(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.CreatePayout.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) GetPayout(ctx context.Context, arg *pb.GetPayoutRequest) (*pb.GetPayoutResponse, error) {
+	h.metrics.GetPayoutGrpcRequests.Inc()
+
+	ctx, span := tracing.StartGrpcServerTracerSpan(ctx, "grpcHandler.GetPayout")
+	defer span.Finish()
+
+	params := models.NewGetPayoutRequestParams(arg)
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return nil, h.errorResponse(span, err, "h.v.StructCtx.err", true)
+	}
+
+	res, err := h.usecase.GetPayout(ctx, params)
+	if err != nil {
+		return nil, h.errorResponse(span, err, "h.usecase.GetPayout.err", false)
+	}
+
+	h.metrics.SuccessGrpcRequest.Inc()
+	return res, nil
+}
+
+func (h *grpcHandler) errorResponse(span opentracing.Span, err error, details string, logError bool) error {
+	if logError {
+		errfmt := fmt.Errorf("%s: %v", details, err)
+		h.log.Warn(errfmt)
+		tracing.TraceWithError(span, errfmt)
+	}
+
+	h.metrics.ErrorGrpcRequest.Inc()
+	return grpcError.ErrorResponse(err)
+}
+
+func (h *grpcHandler) OnConfigUpdate(key string, config *config.App) {
+	h.log.Infof("received an update from '%s' key", key)
+
+	h.cfg = config
+
+	h.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
@@ -0,0 +1,145 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/segmentio/kafka-go"
+)
+
+// GroupIDSuffix keeps the payout consumers in their own consumer group,
+// the payment consumers never see the payout topics and the other way around.
+const GroupIDSuffix = "_payout"
+
+type messageProcessor struct {
+	metrics             *metrics.Metrics
+	log                 logger.Logger
+	v                   *validator.Validate
+	cfg                 *config.App
+	cfgManager          *config.Manager
+	usecase             domain.Usecase
+	mu                  sync.RWMutex
+	r                   *kafka.Reader
+	consumerGroupTopics []string
+	groupIDSuffix       string
+	partitions          *kafkaClient.PartitionDispatcher
+}
+
+func New(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+	topics []string,
+	cfgManager *config.Manager,
+) *messageProcessor {
+	return &messageProcessor{
+		log:                 log.WithPrefix(fmt.Sprintf("%s-%s", "payout", constants.Worker)),
+		cfg:                 cfg,
+		v:                   v,
+		usecase:             usecase,
+		metrics:             metrics,
+		consumerGroupTopics: topics,
+		cfgManager:          cfgManager,
+		groupIDSuffix:       GroupIDSuffix,
+		partitions:          kafkaClient.NewPartitionDispatcher(PoolSize),
+	}
+}
+
+// NewRetry creates the processor of the payout retry topic, it consumes in its own consumer group so a
+// message waiting for its retry never holds back the payout status updates.
+func NewRetry(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+	topics []string,
+	cfgManager *config.Manager,
+) *messageProcessor {
+	m := New(log, cfg, v, usecase, metrics, topics, cfgManager)
+	m.groupIDSuffix = GroupIDSuffix + kafkaClient.RetryGroupIDSuffix
+
+	return m
+}
+
+// ProcessMessages fetches the messages on the FetchWorkerID worker and hands them to the other workers of
+// the pool, every partition is processed by a single worker in the order it was read.
+func (m *messageProcessor) ProcessMessages(
+	ctx context.Context,
+	r *kafka.Reader,
+	wg *sync.WaitGroup,
+	workerId int,
+) {
+	defer wg.Done()
+
+	if workerId != kafkaClient.FetchWorkerID {
+		m.handleMessages(ctx, workerId)
+		return
+	}
+
+	m.mu.Lock()
+	m.r = r
+	m.mu.Unlock()
+
+	m.cfgManager.RegisterObserver(m, 3)
+	m.cfgManager.RegisterConsumerWorkerObserver(m)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		msg, err := m.reader().FetchMessage(ctx)
+		if err != nil {
+			m.log.Warnf("workerId: %v, err: %v", workerId, err)
+			continue
+		}
+
+		if err := m.partitions.Dispatch(ctx, msg); err != nil {
+			return
+		}
+	}
+}
+
+// handleMessages processes the messages of the partitions owned by workerId one after the other.
+func (m *messageProcessor) handleMessages(ctx context.Context, workerId int) {
+	messages := m.partitions.Messages(workerId)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-messages:
+			m.processMessage(ctx, msg, workerId)
+		}
+	}
+}
+
+func (m *messageProcessor) processMessage(ctx context.Context, msg kafka.Message, workerId int) {
+	m.logProcessMessage(msg, workerId)
+
+	switch msg.Topic {
+	case helper.StringBuilder(m.cfg.Services.External.PaymentGateway.ID, "_", m.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.TopicName):
+		m.processUpdatePayoutStatus(ctx, msg)
+	case helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.RetryTopicName):
+		if err := kafkaClient.WaitForRetry(ctx, msg); err != nil {
+			m.log.Warnf("workerId: %v, kafkaClient.WaitForRetry.err: %v", workerId, err)
+			return
+		}
+
+		m.processUpdatePayoutStatus(ctx, msg)
+	}
+}
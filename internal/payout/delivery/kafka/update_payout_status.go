@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/avast/retry-go"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	_kafkaMessage "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+func (m *messageProcessor) processUpdatePayoutStatus(ctx context.Context, msg kafka.Message) {
+	m.metrics.PayoutStatusUpdateKafkaMessages.Inc()
+
+	ctx, span := tracing.StartKafkaConsumerTracerSpan(ctx, msg.Headers, "messageProcessor.processUpdatePayoutStatus")
+	defer span.Finish()
+
+	var _msg _kafkaMessage.KafkaPayoutStatusUpdate
+	if err := proto.Unmarshal(msg.Value, &_msg); err != nil {
+		m.log.Warnf("proto.Unmarshal: %v", err)
+		m.failMessage(ctx, msg, err, true)
+		return
+	}
+
+	params := &models.UpdatePayoutRequest{
+		PayoutEvent:       _msg.GetPayoutEvent(),
+		PayoutId:          _msg.GetPayoutId(),
+		PayoutReferenceId: _msg.GetPayoutReferenceId(),
+		PayoutBusinessId:  _msg.GetPayoutBusinessId(),
+		PayoutStatus:      _msg.GetPayoutStatus(),
+		PayoutFailureCode: _msg.PayoutFailureCode,
+	}
+
+	if _msg.GetUpdatedAt() != nil {
+		updatedAt := _msg.GetUpdatedAt().AsTime()
+		params.UpdatedAt = &updatedAt
+	}
+
+	if _msg.GetEstimatedArrivalAt() != nil {
+		estimatedArrivalAt := _msg.GetEstimatedArrivalAt().AsTime()
+		params.EstimatedArrivalAt = &estimatedArrivalAt
+	}
+
+	if err := m.v.StructCtx(ctx, params); err != nil {
+		m.log.Warnf("validate: %v", err)
+		m.failMessage(ctx, msg, err, true)
+		return
+	}
+
+	if err := retry.Do(func() error {
+		return m.usecase.UpdatePayout(ctx, params)
+	}, append(retryOption, retry.Context(ctx))...); err != nil {
+		m.log.Warnf("m.usecase.UpdatePayout.err: %v", err)
+		m.failMessage(ctx, msg, err, false)
+		return
+	}
+
+	m.commitMessage(ctx, msg)
+}
@@ -0,0 +1,127 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	"github.com/segmentio/kafka-go"
+)
+
+var (
+	PoolSize      = 5
+	retryAttempts = 3
+	retryDelay    = 300 * time.Millisecond
+	retryOption   = []retry.Option{
+		retry.Attempts(uint(retryAttempts)),
+		retry.Delay(retryDelay),
+		retry.DelayType(retry.BackOffDelay),
+	}
+	// failedMessageRetryDelay is how long a failed message which could not be published waits before
+	// it is tried again.
+	failedMessageRetryDelay = 5 * time.Second
+)
+
+func (m *messageProcessor) OnConfigUpdate(key string, cfg *config.App) {
+	m.cfg = cfg
+}
+
+// OnConsumerWorkerUpdate receives the rebuilt payment reader, the payout readers are rebuilt
+// from its brokers and dialer so all of them follow the same brokers and TLS configuration.
+func (m *messageProcessor) OnConsumerWorkerUpdate(key string, workerConnection *kafka.Reader) {
+	switch key {
+	case m.cfg.Etcd.Keys.Configurations.Brokers, m.cfg.Etcd.Keys.TLS.Kafka:
+		m.log.Info("closing previous reader connection due to changes...")
+
+		readerCfg := workerConnection.Config()
+		readerCfg.GroupID = m.cfg.Brokers.Kafka.Config.GroupID + m.groupIDSuffix
+		readerCfg.GroupTopics = m.consumerGroupTopics
+
+		m.mu.Lock()
+		previous := m.r
+		m.r = kafka.NewReader(readerCfg)
+		m.mu.Unlock()
+
+		if err := previous.Close(); err != nil {
+			m.log.Warnf("previous.Close.err: %v", err)
+		}
+
+		m.log.Infof("worker re-connected to brokers: %v", readerCfg.Brokers[0])
+		m.log.Infof("worker re-connected with id: %v", readerCfg.GroupID)
+		m.log.Infof("worker re-subscribe to topics: %v", readerCfg.GroupTopics)
+
+		m.log.Info("reader connection successfully updated...")
+	}
+}
+
+func (m *messageProcessor) reader() *kafka.Reader {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.r
+}
+
+func (m *messageProcessor) commitMessage(ctx context.Context, msg kafka.Message) {
+	m.metrics.SuccessKafkaRequest.Inc()
+	m.log.KafkaLogCommitedMessage(msg.Topic, msg.Partition, msg.Offset)
+	if err := m.reader().CommitMessages(ctx, msg); err != nil {
+		m.log.Infof("commitMessages.err: %v", err)
+	}
+}
+
+func (m *messageProcessor) commitErrorMessage(ctx context.Context, msg kafka.Message) {
+	m.metrics.ErrorKafkaRequest.Inc()
+	m.log.KafkaLogCommitedMessage(msg.Topic, msg.Partition, msg.Offset)
+	if err := m.reader().CommitMessages(ctx, msg); err != nil {
+		m.log.Infof("commitErrorMessages.err: %v", err)
+	}
+}
+
+// failMessage moves a payout status update which could not be processed to its retry or dead-letter topic,
+// the payment consumers quarantine what reaches the dead-letter topic. A message which could not be
+// published is not committed, it is tried again until ctx is done. Its partition is processed by this
+// worker alone, so no later offset of the partition is committed meanwhile and the message is read again
+// after a restart.
+func (m *messageProcessor) failMessage(ctx context.Context, msg kafka.Message, cause error, permanent bool) {
+	deadLetter := m.cfg.Brokers.Kafka.DeadLetter
+	if deadLetter == nil || !deadLetter.Enable {
+		m.commitErrorMessage(ctx, msg)
+		return
+	}
+
+	retryTopic := helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.RetryTopicName)
+	deadLetterTopic := helper.StringBuilder(m.cfg.Services.Internal.ID, "_", m.cfg.Brokers.Kafka.Topics.PayoutStatusUpdate.DeadLetterTopicName)
+
+	failed := kafkaClient.NextFailedMessage(msg, deadLetter, retryTopic, deadLetterTopic, cause, permanent)
+	for {
+		err := retry.Do(func() error {
+			return m.cfgManager.ProducerWorker().PublishMessage(ctx, failed)
+		}, append(retryOption, retry.Context(ctx))...)
+		if err == nil {
+			break
+		}
+
+		m.log.Errorf("failed message of topic %s partition %d offset %d is left uncommitted: %v", msg.Topic, msg.Partition, msg.Offset, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(failedMessageRetryDelay):
+		}
+	}
+
+	if failed.Topic == retryTopic {
+		m.metrics.RetriedKafkaMessages.Inc()
+	} else {
+		m.metrics.DeadLetteredKafkaMessages.Inc()
+	}
+
+	m.commitErrorMessage(ctx, msg)
+}
+
+func (m *messageProcessor) logProcessMessage(msg kafka.Message, workerId int) {
+	m.log.KafkaProcessMessage(msg.Topic, msg.Partition, string(msg.Value), workerId, msg.Offset, msg.Time)
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	XCallbackToken = "x-callback-token"
+)
+
+type webhookHandler struct {
+	log     logger.Logger
+	cfg     *config.App
+	v       *validator.Validate
+	usecase domain.Usecase
+	metrics *metrics.Metrics
+}
+
+func New(
+	log logger.Logger,
+	cfg *config.App,
+	v *validator.Validate,
+	usecase domain.Usecase,
+	metrics *metrics.Metrics,
+) *webhookHandler {
+	return &webhookHandler{
+		log:     log.WithPrefix(fmt.Sprintf("%s-%s", "payout-webhook", constants.Handler)),
+		cfg:     cfg,
+		v:       v,
+		usecase: usecase,
+		metrics: metrics,
+	}
+}
+
+func (h *webhookHandler) MapRoutes(e *echo.Echo) {
+	e.POST(h.cfg.Services.External.PaymentGateway.PayoutWebhookPath, h.XenditPayoutCallback)
+}
+
+// XenditPayoutCallback receives the payout.* callbacks.
+// A callback for a payout whose id is not stored yet answers 404 so xendit retries it once the payout is submitted.
+func (h *webhookHandler) XenditPayoutCallback(c echo.Context) error {
+	h.metrics.PayoutStatusUpdateWebhookRequests.Inc()
+
+	span, ctx := opentracing.StartSpanFromContext(c.Request().Context(), "webhookHandler.XenditPayoutCallback")
+	defer span.Finish()
+
+	if err := h.verifyCallbackToken(c.Request().Header.Get(XCallbackToken)); err != nil {
+		return h.errorResponse(c, span, http.StatusUnauthorized, err, "h.verifyCallbackToken.err")
+	}
+
+	var callback xenditPayoutCallback
+	if err := c.Bind(&callback); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "c.Bind.err")
+	}
+
+	params, ok := callback.toUpdatePayoutRequest()
+	if !ok {
+		h.log.Infof("ignoring unsupported callback event: %s", callback.Event)
+		h.metrics.SuccessHttpRequest.Inc()
+		return c.NoContent(http.StatusOK)
+	}
+
+	if err := h.v.StructCtx(ctx, params); err != nil {
+		return h.errorResponse(c, span, http.StatusBadRequest, err, "h.v.StructCtx.err")
+	}
+
+	if err := h.usecase.UpdatePayout(ctx, params); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return h.errorResponse(c, span, http.StatusNotFound, err, "h.usecase.UpdatePayout.err")
+		}
+
+		return h.errorResponse(c, span, http.StatusInternalServerError, err, "h.usecase.UpdatePayout.err")
+	}
+
+	h.metrics.SuccessHttpRequest.Inc()
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *webhookHandler) OnConfigUpdate(key string, config *config.App) {
+	h.log.Infof("received an update from '%s' key", key)
+
+	h.cfg = config
+
+	h.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
+
+func (h *webhookHandler) errorResponse(c echo.Context, span opentracing.Span, status int, err error, details string) error {
+	errfmt := fmt.Errorf("%s: %v", details, err)
+	h.log.Warn(errfmt)
+	tracing.TraceWithError(span, errfmt)
+
+	h.metrics.ErrorHttpRequest.Inc()
+	return c.JSON(status, map[string]string{"error": http.StatusText(status)})
+}
+
+func (h *webhookHandler) verifyCallbackToken(token string) error {
+	expected := h.cfg.Services.Internal.CallbackTokens.Development
+	if h.cfg.Services.Internal.Environment == "production" {
+		expected = h.cfg.Services.Internal.CallbackTokens.Production
+	}
+
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return unierror.ErrInvalidCallbackToken
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/metrics"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payout/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCallbackToken     = "test-callback-token"
+	testPayoutWebhookPath = "/webhooks/xendit/payouts"
+)
+
+var (
+	testCfg = &config.App{
+		Services: &config.Services{
+			Internal: &config.Internal{
+				Name:        "payout_webhook_test",
+				Environment: "develop",
+				CallbackTokens: &config.PaymentGatewayKeys{
+					Development: testCallbackToken,
+					Production:  helper.RandomString(32),
+				},
+			},
+			External: &config.External{
+				PaymentGateway: &config.ExtSvc{
+					ID:                helper.RandomString(12),
+					PayoutWebhookPath: testPayoutWebhookPath,
+				},
+			},
+		},
+	}
+	testMetrics = metrics.New(testCfg)
+)
+
+func TestXenditPayoutCallback(t *testing.T) {
+	payoutSucceeded := `{
+		"event": "payout.succeeded",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "disb-1",
+			"reference_id": "ref-1",
+			"status": "SUCCEEDED",
+			"amount": 90000,
+			"channel_code": "ID_BCA",
+			"currency": "IDR",
+			"updated": "2024-03-01T10:00:01Z",
+			"estimated_arrival_time": "2024-03-01T11:00:00Z"
+		}
+	}`
+
+	payoutFailed := `{
+		"event": "payout.failed",
+		"business_id": "biz-1",
+		"created": "2024-03-01T10:00:00Z",
+		"data": {
+			"id": "disb-2",
+			"reference_id": "ref-2",
+			"status": "FAILED",
+			"failure_code": "INVALID_DESTINATION"
+		}
+	}`
+
+	testCases := []struct {
+		tname      string
+		token      string
+		body       string
+		stubs      func(usecase *wkmock.MockUsecase)
+		statusCode int
+	}{
+		{
+			tname: "OK_PAYOUT_SUCCEEDED",
+			token: testCallbackToken,
+			body:  payoutSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePayoutRequest) error {
+						require.Equal(t, "payout.succeeded", arg.PayoutEvent)
+						require.Equal(t, "disb-1", arg.PayoutId)
+						require.Equal(t, "ref-1", arg.PayoutReferenceId)
+						require.Equal(t, "biz-1", arg.PayoutBusinessId)
+						require.Equal(t, payment.PAYOUT_STATUS_SUCCEEDED, arg.PayoutStatus)
+						require.Nil(t, arg.PayoutFailureCode)
+						require.NotNil(t, arg.UpdatedAt)
+						require.NotNil(t, arg.EstimatedArrivalAt)
+						return nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_PAYOUT_FAILED",
+			token: testCallbackToken,
+			body:  payoutFailed,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *models.UpdatePayoutRequest) error {
+						require.Equal(t, "disb-2", arg.PayoutId)
+						require.Equal(t, payment.PAYOUT_STATUS_FAILED, arg.PayoutStatus)
+						require.Equal(t, "INVALID_DESTINATION", *arg.PayoutFailureCode)
+						require.NotNil(t, arg.UpdatedAt)
+						require.Nil(t, arg.EstimatedArrivalAt)
+						return nil
+					},
+				)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "OK_UNSUPPORTED_EVENT_IGNORED",
+			token: testCallbackToken,
+			body:  `{"event": "payment.succeeded", "data": {"id": "py-1"}}`,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			tname: "ERR_INVALID_CALLBACK_TOKEN",
+			token: helper.RandomString(16),
+			body:  payoutSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			tname: "ERR_INVALID_PAYLOAD",
+			token: testCallbackToken,
+			body:  `{"event": `,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			tname: "ERR_VALIDATION_FAILED",
+			token: testCallbackToken,
+			body:  `{"event": "payout.succeeded", "data": {"status": "SUCCEEDED"}}`,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			tname: "ERR_PAYOUT_NOT_FOUND",
+			token: testCallbackToken,
+			body:  payoutSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).Return(pgx.ErrNoRows)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			tname: "ERR_UPDATE_NOT_PERSISTED",
+			token: testCallbackToken,
+			body:  payoutSucceeded,
+			stubs: func(usecase *wkmock.MockUsecase) {
+				usecase.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("connection is already closed"))
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			usecaseCtrl := gomock.NewController(t)
+			defer usecaseCtrl.Finish()
+			usecase := wkmock.NewMockUsecase(usecaseCtrl)
+			tc.stubs(usecase)
+
+			e := echo.New()
+			h := New(logger.NewLogger(), testCfg, validator.New(), usecase, testMetrics)
+			h.MapRoutes(e)
+
+			req := httptest.NewRequest(http.MethodPost, testPayoutWebhookPath, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.token != "" {
+				req.Header.Set(XCallbackToken, tc.token)
+			}
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.statusCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+)
+
+const (
+	eventPayout = "payout."
+)
+
+type xenditPayoutCallback struct {
+	Event      string                    `json:"event"`
+	BusinessID string                    `json:"business_id"`
+	Created    *time.Time                `json:"created"`
+	Data       *xenditPayoutCallbackData `json:"data"`
+}
+
+type xenditPayoutCallbackData struct {
+	ID                   string     `json:"id"`
+	ReferenceID          string     `json:"reference_id"`
+	BusinessID           string     `json:"business_id"`
+	Status               string     `json:"status"`
+	FailureCode          *string    `json:"failure_code"`
+	Updated              *time.Time `json:"updated"`
+	EstimatedArrivalTime *time.Time `json:"estimated_arrival_time"`
+}
+
+func (c *xenditPayoutCallback) toUpdatePayoutRequest() (*models.UpdatePayoutRequest, bool) {
+	if c.Data == nil || !strings.HasPrefix(c.Event, eventPayout) {
+		return nil, false
+	}
+
+	res := &models.UpdatePayoutRequest{
+		PayoutEvent:        c.Event,
+		PayoutId:           c.Data.ID,
+		PayoutReferenceId:  c.Data.ReferenceID,
+		PayoutBusinessId:   c.Data.BusinessID,
+		PayoutStatus:       c.Data.Status,
+		PayoutFailureCode:  c.Data.FailureCode,
+		UpdatedAt:          c.Data.Updated,
+		EstimatedArrivalAt: c.Data.EstimatedArrivalTime,
+	}
+
+	if res.PayoutBusinessId == "" {
+		res.PayoutBusinessId = c.BusinessID
+	}
+
+	if res.UpdatedAt == nil {
+		res.UpdatedAt = c.Created
+	}
+
+	return res, true
+}
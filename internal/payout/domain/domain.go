@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	kafkaClient "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+)
+
+type ProducerWorker interface {
+	OnProducerWorkerUpdate(key string, workerProducerConnection *kafkaClient.ProducerImpl)
+	OnConfigUpdate(key string, config *config.App)
+
+	PayoutStatusUpdated(ctx context.Context, task *models.PayoutStatusUpdatedTask) error
+}
+
+type Usecase interface {
+	OnConfigUpdate(key string, config *config.App)
+
+	ValidateBankAccount(ctx context.Context, arg *models.ValidateBankAccountRequest) (*pb.ValidateBankAccountResponse, error)
+	CreatePayout(ctx context.Context, arg *models.CreatePayoutRequest) (*pb.CreatePayoutResponse, error)
+	GetPayout(ctx context.Context, arg *models.GetPayoutRequest) (*pb.GetPayoutResponse, error)
+	UpdatePayout(ctx context.Context, arg *models.UpdatePayoutRequest) error
+}
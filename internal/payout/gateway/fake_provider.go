@@ -0,0 +1,164 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+)
+
+var fakeProviderChannels = []string{"ID_BCA", "ID_BNI", "ID_BRI", "ID_BSI", "ID_CIMB", "ID_MANDIRI", "ID_PERMATA"}
+
+// FakeProvider is an in-memory PayoutProvider, it never leaves the process
+// which makes it suitable for tests and local development.
+type FakeProvider struct {
+	mu           sync.Mutex
+	bankAccounts map[string]string
+	payouts      map[string]*Payout
+	keys         map[string]string
+	err          error
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		bankAccounts: make(map[string]string),
+		payouts:      make(map[string]*Payout),
+		keys:         make(map[string]string),
+	}
+}
+
+// FailNext makes the next call to the provider return err.
+func (f *FakeProvider) FailNext(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+// SetBankAccount registers the name of a bank account the name check resolves.
+func (f *FakeProvider) SetBankAccount(channel string, accountNumber string, accountHolderName string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.bankAccounts[fakeBankAccountKey(channel, accountNumber)] = accountHolderName
+}
+
+// SetPayoutStatus simulates the gateway moving a payout into another status.
+func (f *FakeProvider) SetPayoutStatus(id string, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res, ok := f.payouts[id]
+	if !ok {
+		return fmt.Errorf("fake provider: payout %s not found", id)
+	}
+
+	res.Status = status
+	res.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (f *FakeProvider) ValidateBankAccount(ctx context.Context, arg *ValidateBankAccountParams) (*BankAccount, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(fakeProviderChannels, arg.Channel) {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPayoutChannel, arg.Channel)
+	}
+
+	name, ok := f.bankAccounts[fakeBankAccountKey(arg.Channel, arg.AccountNumber)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrBankAccountNotFound, arg.AccountNumber)
+	}
+
+	return &BankAccount{
+		Channel:           arg.Channel,
+		AccountNumber:     arg.AccountNumber,
+		AccountHolderName: name,
+	}, nil
+}
+
+func (f *FakeProvider) CreatePayout(ctx context.Context, arg *CreatePayoutParams) (*Payout, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	// a retried request pays out once, like the gateway does for a known idempotency key.
+	if id, ok := f.keys[arg.IdempotencyKey]; ok {
+		res := *f.payouts[id]
+		return &res, nil
+	}
+
+	if !slices.Contains(fakeProviderChannels, arg.Channel) {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrUnsupportedPayoutChannel, arg.Channel)
+	}
+
+	id, err := helper.GenerateULID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := &Payout{
+		ID:                   fmt.Sprintf("%s-%s", "disb", id.String()),
+		ReferenceID:          arg.ReferenceID,
+		BusinessID:           "fake-business",
+		Channel:              arg.Channel,
+		AccountNumber:        arg.AccountNumber,
+		AccountHolderName:    arg.AccountHolderName,
+		Amount:               arg.Amount,
+		Currency:             arg.Currency,
+		Description:          arg.Description,
+		Status:               payment.PAYOUT_STATUS_ACCEPTED,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		EstimatedArrivalTime: now.Add(time.Hour),
+	}
+
+	f.payouts[res.ID] = res
+	f.keys[arg.IdempotencyKey] = res.ID
+
+	copied := *res
+	return &copied, nil
+}
+
+func (f *FakeProvider) GetPayoutByID(ctx context.Context, arg string) (*Payout, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.takeErr(); err != nil {
+		return nil, err
+	}
+
+	res, ok := f.payouts[arg]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: payout %s not found", arg)
+	}
+
+	copied := *res
+	return &copied, nil
+}
+
+func (f *FakeProvider) takeErr() error {
+	err := f.err
+	f.err = nil
+
+	return err
+}
+
+func fakeBankAccountKey(channel string, accountNumber string) string {
+	return channel + ":" + accountNumber
+}
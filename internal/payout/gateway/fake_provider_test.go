@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProviderValidateBankAccount(t *testing.T) {
+	provider := NewFakeProvider()
+
+	accountNumber := helper.RandomStringInt(10)
+	accountHolderName := helper.RandomString(20)
+	provider.SetBankAccount("ID_BCA", accountNumber, accountHolderName)
+
+	res, err := provider.ValidateBankAccount(context.TODO(), &ValidateBankAccountParams{
+		Channel:       "ID_BCA",
+		AccountNumber: accountNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, accountHolderName, res.AccountHolderName)
+
+	res, err = provider.ValidateBankAccount(context.TODO(), &ValidateBankAccountParams{
+		Channel:       "ID_BCA",
+		AccountNumber: helper.RandomStringInt(11),
+	})
+	require.ErrorIs(t, err, unierror.ErrBankAccountNotFound)
+	require.Nil(t, res)
+
+	res, err = provider.ValidateBankAccount(context.TODO(), &ValidateBankAccountParams{
+		Channel:       helper.RandomString(6),
+		AccountNumber: accountNumber,
+	})
+	require.ErrorIs(t, err, unierror.ErrUnsupportedPayoutChannel)
+	require.Nil(t, res)
+}
+
+func TestFakeProviderCreatePayout(t *testing.T) {
+	provider := NewFakeProvider()
+
+	arg := CreatePayoutParams{
+		IdempotencyKey:    helper.RandomString(32),
+		ReferenceID:       helper.RandomString(26),
+		Channel:           "ID_BNI",
+		AccountNumber:     helper.RandomStringInt(10),
+		AccountHolderName: helper.RandomString(20),
+		Amount:            decimal.NewFromInt(150000),
+		Currency:          payment.CURRENCY_IDR,
+		Description:       helper.RandomString(32),
+	}
+
+	res, err := provider.CreatePayout(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ID)
+	require.Equal(t, payment.PAYOUT_STATUS_ACCEPTED, res.Status)
+	require.True(t, arg.Amount.Equal(res.Amount))
+
+	// the same idempotency key pays out once.
+	retried, err := provider.CreatePayout(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.Equal(t, res.ID, retried.ID)
+
+	require.NoError(t, provider.SetPayoutStatus(res.ID, payment.PAYOUT_STATUS_SUCCEEDED))
+
+	got, err := provider.GetPayoutByID(context.TODO(), res.ID)
+	require.NoError(t, err)
+	require.Equal(t, payment.PAYOUT_STATUS_SUCCEEDED, got.Status)
+
+	provider.FailNext(errors.New("gateway unavailable"))
+	_, err = provider.GetPayoutByID(context.TODO(), res.ID)
+	require.Error(t, err)
+
+	_, err = provider.GetPayoutByID(context.TODO(), res.ID)
+	require.NoError(t, err)
+}
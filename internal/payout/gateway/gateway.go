@@ -0,0 +1,58 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PayoutProvider is the provider-neutral contract every payout gateway has to satisfy, it mirrors
+// the payment one so neither the payout usecase nor its repository knows which gateway is behind it.
+type PayoutProvider interface {
+	// ValidateBankAccount looks up the name registered to a bank account at the payout channel.
+	ValidateBankAccount(ctx context.Context, arg *ValidateBankAccountParams) (*BankAccount, error)
+
+	// CreatePayout disburses to a bank account, the gateway pays out once per idempotency key.
+	CreatePayout(ctx context.Context, arg *CreatePayoutParams) (*Payout, error)
+	GetPayoutByID(ctx context.Context, arg string) (*Payout, error)
+}
+
+type ValidateBankAccountParams struct {
+	Channel       string `json:"channel"`
+	AccountNumber string `json:"accountNumber"`
+}
+
+type BankAccount struct {
+	Channel           string `json:"channel"`
+	AccountNumber     string `json:"accountNumber"`
+	AccountHolderName string `json:"accountHolderName"`
+}
+
+type CreatePayoutParams struct {
+	IdempotencyKey    string          `json:"idempotencyKey"`
+	ReferenceID       string          `json:"referenceID"`
+	Channel           string          `json:"channel"`
+	AccountNumber     string          `json:"accountNumber"`
+	AccountHolderName string          `json:"accountHolderName"`
+	Amount            decimal.Decimal `json:"amount"`
+	Currency          string          `json:"currency"`
+	Description       string          `json:"description"`
+}
+
+type Payout struct {
+	ID                   string          `json:"id"`
+	ReferenceID          string          `json:"referenceID"`
+	BusinessID           string          `json:"businessID"`
+	Channel              string          `json:"channel"`
+	AccountNumber        string          `json:"accountNumber"`
+	AccountHolderName    string          `json:"accountHolderName"`
+	Amount               decimal.Decimal `json:"amount"`
+	Currency             string          `json:"currency"`
+	Description          string          `json:"description"`
+	Status               string          `json:"status"`
+	FailureCode          string          `json:"failureCode"`
+	CreatedAt            time.Time       `json:"createdAt"`
+	UpdatedAt            time.Time       `json:"updatedAt"`
+	EstimatedArrivalTime time.Time       `json:"estimatedArrivalTime"`
+}
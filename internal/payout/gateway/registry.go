@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+)
+
+// Registry holds every payout provider the service knows about, keyed by the
+// gateway id configured under services.external.payment_gateway.id.
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]PayoutProvider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]PayoutProvider),
+	}
+}
+
+func (r *Registry) Register(id string, provider PayoutProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.providers[id] = provider
+}
+
+func (r *Registry) Get(id string) (PayoutProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.providers[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", unierror.ErrPayoutProviderNotRegistered, id)
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+func errorResponse(
+	span opentracing.Span,
+	err error,
+	fullError error,
+	returnedFormat string,
+	loggedFormat string,
+) error {
+	tracing.TraceWithError(span, fmt.Errorf("%s: %v", loggedFormat, fullError))
+	return fmt.Errorf("%s: %v", returnedFormat, err)
+}
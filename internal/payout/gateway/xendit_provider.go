@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/xendit/xendit-go/v5"
+)
+
+type XenditProviderImpl struct {
+	log          logger.Logger
+	cfg          *config.App
+	mu           sync.RWMutex
+	xenditClient *xendit.APIClient
+}
+
+func NewXenditProviderImpl(log logger.Logger, cfg *config.App) *XenditProviderImpl {
+	return &XenditProviderImpl{
+		log:          log.WithPrefix(fmt.Sprintf("%s-%s", "payout-gateway-provider", constants.Repository)),
+		cfg:          cfg,
+		xenditClient: newXenditClient(cfg),
+	}
+}
+
+// OnConfigUpdate rebuilds the gateway client, so rotated keys are used by the next call without a restart.
+func (p *XenditProviderImpl) OnConfigUpdate(key string, config *config.App) {
+	p.log.Infof("received update from '%s' key", key)
+
+	p.mu.Lock()
+	p.cfg = config
+	p.xenditClient = newXenditClient(config)
+	p.mu.Unlock()
+
+	p.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
+
+func (p *XenditProviderImpl) client() *xendit.APIClient {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.xenditClient
+}
+
+func newXenditClient(cfg *config.App) *xendit.APIClient {
+	if cfg.Services.Internal.Environment == "production" {
+		return xendit.NewClient(cfg.Services.Internal.PaymentGatewayKeys.Production)
+	}
+
+	return xendit.NewClient(cfg.Services.Internal.PaymentGatewayKeys.Development)
+}
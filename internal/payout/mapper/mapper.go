@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PayoutToDto(arg *repository.Payout) *pb.Payout {
+	return &pb.Payout{
+		Uid:                arg.Uid,
+		PayoutId:           &arg.PayoutID.String,
+		IdempotencyKey:     arg.IdempotencyKey,
+		PayoutReferenceId:  arg.PayoutReferenceID,
+		PayoutBusinessId:   &arg.PayoutBusinessID.String,
+		PayoutChannel:      arg.PayoutChannel,
+		AccountNumber:      arg.AccountNumber,
+		AccountHolderName:  arg.AccountHolderName,
+		PayoutAmount:       arg.PayoutAmount.InexactFloat64(),
+		PayoutAmountMinor:  payment.ToMinorUnits(arg.PayoutAmount, arg.Currency),
+		Currency:           arg.Currency,
+		PayoutDescription:  arg.PayoutDescription,
+		PayoutStatus:       arg.PayoutStatus,
+		PayoutFailureCode:  &arg.PayoutFailureCode.String,
+		CreatedAt:          timestamppb.New(arg.CreatedAt.Time),
+		UpdatedAt:          timestamppb.New(arg.UpdatedAt.Time),
+		EstimatedArrivalAt: timestamppb.New(arg.EstimatedArrivalAt.Time),
+	}
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/shopspring/decimal"
+)
+
+type PayoutStatusUpdatedTask struct {
+	Payout *repository.Payout `json:"payout"`
+}
+
+type ValidateBankAccountRequest struct {
+	PayoutChannel     string `json:"payout_channel" validate:"required,gt=0"`
+	AccountNumber     string `json:"account_number" validate:"required,gt=0"`
+	AccountHolderName string `json:"account_holder_name" validate:"required,gt=0"`
+}
+
+func NewValidateBankAccountRequestParams(arg *pb.ValidateBankAccountRequest) *ValidateBankAccountRequest {
+	return &ValidateBankAccountRequest{
+		PayoutChannel:     arg.GetPayoutChannel(),
+		AccountNumber:     arg.GetAccountNumber(),
+		AccountHolderName: arg.GetAccountHolderName(),
+	}
+}
+
+type CreatePayoutRequest struct {
+	IdempotencyKey    string          `json:"idempotency_key" validate:"required,gt=0"`
+	PayoutReferenceId string          `json:"payout_reference_id" validate:"required,gt=0"`
+	PayoutChannel     string          `json:"payout_channel" validate:"required,gt=0"`
+	AccountNumber     string          `json:"account_number" validate:"required,gt=0"`
+	AccountHolderName string          `json:"account_holder_name" validate:"required,gt=0"`
+	PayoutAmount      decimal.Decimal `json:"payout_amount"`
+	Currency          string          `json:"currency" validate:"required,len=3"`
+	PayoutDescription string          `json:"payout_description" validate:"required,gt=0"`
+}
+
+func NewCreatePayoutRequestParams(arg *pb.CreatePayoutRequest) *CreatePayoutRequest {
+	currency := payment.DEFAULT_CURRENCY
+	if arg.Currency != nil {
+		currency = arg.GetCurrency()
+	}
+
+	amount := decimal.NewFromFloat(arg.GetPayoutAmount())
+	if arg.PayoutAmountMinor != nil {
+		amount = payment.FromMinorUnits(arg.GetPayoutAmountMinor(), currency)
+	}
+
+	return &CreatePayoutRequest{
+		IdempotencyKey:    arg.GetIdempotencyKey(),
+		PayoutReferenceId: arg.GetPayoutReferenceId(),
+		PayoutChannel:     arg.GetPayoutChannel(),
+		AccountNumber:     arg.GetAccountNumber(),
+		AccountHolderName: arg.GetAccountHolderName(),
+		PayoutAmount:      amount,
+		Currency:          currency,
+		PayoutDescription: arg.GetPayoutDescription(),
+	}
+}
+
+type GetPayoutRequest struct {
+	Uid string `json:"uid" validate:"required,gt=0"`
+}
+
+func NewGetPayoutRequestParams(arg *pb.GetPayoutRequest) *GetPayoutRequest {
+	return &GetPayoutRequest{
+		Uid: arg.GetUid(),
+	}
+}
+
+// UpdatePayoutRequest is a payout status update the gateway sent through a webhook or kafka.
+type UpdatePayoutRequest struct {
+	PayoutEvent        string     `json:"payout_event,omitempty"`
+	PayoutId           string     `json:"payout_id" validate:"required,gt=0"`
+	PayoutReferenceId  string     `json:"payout_reference_id,omitempty"`
+	PayoutBusinessId   string     `json:"payout_business_id,omitempty"`
+	PayoutStatus       string     `json:"payout_status" validate:"required,gt=0"`
+	PayoutFailureCode  *string    `json:"payout_failure_code,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+	EstimatedArrivalAt *time.Time `json:"estimated_arrival_at,omitempty"`
+}
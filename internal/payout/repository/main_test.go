@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	postgres "github.com/handysuherman/clean-arch-payment-service/internal/pkg/databases/postgresql"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+)
+
+var (
+	testStore Repository
+	cfg       *config.App
+	tlog      logger.Logger
+	pqConn    *pgxpool.Pool
+)
+
+func TestMain(m *testing.M) {
+	logger := logger.NewLogger()
+	cm := config.NewManager(logger, 15*time.Second)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Println("Error getting current directory:", err)
+		return
+	}
+
+	cfgs, err := cm.Bootstrap(fmt.Sprintf("%v/%s", findModuleRoot(cwd), "etcd-config.yaml"))
+	if err != nil {
+		logger.Debug(err)
+		return
+	}
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	cfg = cfgs
+
+	tlog = logger
+
+	psqlOpt := &postgres.Config{
+		Host:      cfg.Databases.PostgreSQL.Host,
+		Port:      cfg.Databases.PostgreSQL.Port,
+		User:      cfg.Databases.PostgreSQL.Username,
+		DBName:    cfg.Databases.PostgreSQL.DBName,
+		Password:  cfg.Databases.PostgreSQL.Password,
+		EnableTls: cfg.Databases.PostgreSQL.EnableTLS,
+	}
+
+	if cfg.Databases.PostgreSQL.EnableTLS {
+		pqsqlTls, err := helper.Base64EncodedTLS(cfg.TLS.PostgreSQL.Ca, cfg.TLS.PostgreSQL.Cert, cfg.TLS.PostgreSQL.Key)
+		if err != nil {
+			tlog.Info(err)
+		}
+
+		pqsqlTls.ServerName = cfg.Databases.PostgreSQL.Host
+
+		psqlOpt.TLs = pqsqlTls
+	}
+
+	ctx := context.Background()
+
+	pgxConn, err := postgres.NewPgxConn(ctx, psqlOpt)
+	if err != nil {
+		tlog.Error(err)
+		return
+	}
+	pqConn = pgxConn
+	defer pgxConn.Close()
+
+	testStore = NewStore(logger, cfg, pgxConn)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payout/repository/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination internal/payout/repository/mock/mock.go -source=internal/payout/repository/repository.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	config "github.com/handysuherman/clean-arch-payment-service/internal/config"
+	repository "github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePayout mocks base method.
+func (m *MockRepository) CreatePayout(ctx context.Context, arg *repository.CreatePayoutParams) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", ctx, arg)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *MockRepositoryMockRecorder) CreatePayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockRepository)(nil).CreatePayout), ctx, arg)
+}
+
+// GetPayout mocks base method.
+func (m *MockRepository) GetPayout(ctx context.Context, uid string) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", ctx, uid)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockRepositoryMockRecorder) GetPayout(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockRepository)(nil).GetPayout), ctx, uid)
+}
+
+// GetPayoutByIdempotencyKey mocks base method.
+func (m *MockRepository) GetPayoutByIdempotencyKey(ctx context.Context, idempotencyKey string) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutByIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutByIdempotencyKey indicates an expected call of GetPayoutByIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) GetPayoutByIdempotencyKey(ctx, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutByIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetPayoutByIdempotencyKey), ctx, idempotencyKey)
+}
+
+// GetPayoutByPayoutID mocks base method.
+func (m *MockRepository) GetPayoutByPayoutID(ctx context.Context, payoutID pgtype.Text) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutByPayoutID", ctx, payoutID)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutByPayoutID indicates an expected call of GetPayoutByPayoutID.
+func (mr *MockRepositoryMockRecorder) GetPayoutByPayoutID(ctx, payoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutByPayoutID", reflect.TypeOf((*MockRepository)(nil).GetPayoutByPayoutID), ctx, payoutID)
+}
+
+// GetPayoutByPayoutIDForUpdate mocks base method.
+func (m *MockRepository) GetPayoutByPayoutIDForUpdate(ctx context.Context, payoutID pgtype.Text) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutByPayoutIDForUpdate", ctx, payoutID)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutByPayoutIDForUpdate indicates an expected call of GetPayoutByPayoutIDForUpdate.
+func (mr *MockRepositoryMockRecorder) GetPayoutByPayoutIDForUpdate(ctx, payoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutByPayoutIDForUpdate", reflect.TypeOf((*MockRepository)(nil).GetPayoutByPayoutIDForUpdate), ctx, payoutID)
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockRepository) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockRepositoryMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockRepository)(nil).OnConfigUpdate), key, config)
+}
+
+// OnPqsqlUpdate mocks base method.
+func (m *MockRepository) OnPqsqlUpdate(key string, pqsqlConnection *pgxpool.Pool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnPqsqlUpdate", key, pqsqlConnection)
+}
+
+// OnPqsqlUpdate indicates an expected call of OnPqsqlUpdate.
+func (mr *MockRepositoryMockRecorder) OnPqsqlUpdate(key, pqsqlConnection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPqsqlUpdate", reflect.TypeOf((*MockRepository)(nil).OnPqsqlUpdate), key, pqsqlConnection)
+}
+
+// UpdatePayout mocks base method.
+func (m *MockRepository) UpdatePayout(ctx context.Context, arg *repository.UpdatePayoutParams) (*repository.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayout", ctx, arg)
+	ret0, _ := ret[0].(*repository.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayout indicates an expected call of UpdatePayout.
+func (mr *MockRepositoryMockRecorder) UpdatePayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayout", reflect.TypeOf((*MockRepository)(nil).UpdatePayout), ctx, arg)
+}
+
+// UpdatePayoutStatusTx mocks base method.
+func (m *MockRepository) UpdatePayoutStatusTx(ctx context.Context, arg *repository.UpdatePayoutStatusTxParams) (repository.UpdatePayoutStatusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayoutStatusTx", ctx, arg)
+	ret0, _ := ret[0].(repository.UpdatePayoutStatusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayoutStatusTx indicates an expected call of UpdatePayoutStatusTx.
+func (mr *MockRepositoryMockRecorder) UpdatePayoutStatusTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayoutStatusTx", reflect.TypeOf((*MockRepository)(nil).UpdatePayoutStatusTx), ctx, arg)
+}
@@ -0,0 +1,250 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payout_impl.sql
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const createPayout = `-- name: CreatePayout :one
+INSERT INTO payout (
+    uid,
+    idempotency_key,
+    payout_reference_id,
+    payout_channel,
+    account_number,
+    account_holder_name,
+    payout_amount,
+    currency,
+    payout_description,
+    payout_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) ON CONFLICT (idempotency_key) DO NOTHING
+RETURNING uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at
+`
+
+type CreatePayoutParams struct {
+	Uid               string             `json:"uid"`
+	IdempotencyKey    string             `json:"idempotency_key"`
+	PayoutReferenceID string             `json:"payout_reference_id"`
+	PayoutChannel     string             `json:"payout_channel"`
+	AccountNumber     string             `json:"account_number"`
+	AccountHolderName string             `json:"account_holder_name"`
+	PayoutAmount      decimal.Decimal    `json:"payout_amount"`
+	Currency          string             `json:"currency"`
+	PayoutDescription string             `json:"payout_description"`
+	PayoutStatus      string             `json:"payout_status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreatePayout(ctx context.Context, arg *CreatePayoutParams) (*Payout, error) {
+	row := q.db.QueryRow(ctx, createPayout,
+		arg.Uid,
+		arg.IdempotencyKey,
+		arg.PayoutReferenceID,
+		arg.PayoutChannel,
+		arg.AccountNumber,
+		arg.AccountHolderName,
+		arg.PayoutAmount,
+		arg.Currency,
+		arg.PayoutDescription,
+		arg.PayoutStatus,
+		arg.CreatedAt,
+	)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
+
+const getPayout = `-- name: GetPayout :one
+SELECT uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at FROM payout WHERE uid = $1 LIMIT 1
+`
+
+func (q *Queries) GetPayout(ctx context.Context, uid string) (*Payout, error) {
+	row := q.db.QueryRow(ctx, getPayout, uid)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
+
+const getPayoutByIdempotencyKey = `-- name: GetPayoutByIdempotencyKey :one
+SELECT uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at FROM payout WHERE idempotency_key = $1 LIMIT 1
+`
+
+func (q *Queries) GetPayoutByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Payout, error) {
+	row := q.db.QueryRow(ctx, getPayoutByIdempotencyKey, idempotencyKey)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
+
+const getPayoutByPayoutID = `-- name: GetPayoutByPayoutID :one
+SELECT uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at FROM payout WHERE payout_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetPayoutByPayoutID(ctx context.Context, payoutID pgtype.Text) (*Payout, error) {
+	row := q.db.QueryRow(ctx, getPayoutByPayoutID, payoutID)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
+
+const getPayoutByPayoutIDForUpdate = `-- name: GetPayoutByPayoutIDForUpdate :one
+SELECT uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at FROM payout WHERE payout_id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPayoutByPayoutIDForUpdate(ctx context.Context, payoutID pgtype.Text) (*Payout, error) {
+	row := q.db.QueryRow(ctx, getPayoutByPayoutIDForUpdate, payoutID)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
+
+const updatePayout = `-- name: UpdatePayout :one
+UPDATE payout
+SET
+    payout_id = COALESCE($1, payout_id),
+    payout_business_id = COALESCE($2, payout_business_id),
+    payout_status = COALESCE($3, payout_status),
+    payout_failure_code = COALESCE($4, payout_failure_code),
+    updated_at = COALESCE($5, updated_at),
+    estimated_arrival_at = COALESCE($6, estimated_arrival_at)
+WHERE
+    uid = $7
+RETURNING uid, payout_id, idempotency_key, payout_reference_id, payout_business_id, payout_channel, account_number, account_holder_name, payout_amount, currency, payout_description, payout_status, payout_failure_code, created_at, updated_at, estimated_arrival_at
+`
+
+type UpdatePayoutParams struct {
+	PayoutID           pgtype.Text        `json:"payout_id"`
+	PayoutBusinessID   pgtype.Text        `json:"payout_business_id"`
+	PayoutStatus       pgtype.Text        `json:"payout_status"`
+	PayoutFailureCode  pgtype.Text        `json:"payout_failure_code"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	EstimatedArrivalAt pgtype.Timestamptz `json:"estimated_arrival_at"`
+	Uid                string             `json:"uid"`
+}
+
+func (q *Queries) UpdatePayout(ctx context.Context, arg *UpdatePayoutParams) (*Payout, error) {
+	row := q.db.QueryRow(ctx, updatePayout,
+		arg.PayoutID,
+		arg.PayoutBusinessID,
+		arg.PayoutStatus,
+		arg.PayoutFailureCode,
+		arg.UpdatedAt,
+		arg.EstimatedArrivalAt,
+		arg.Uid,
+	)
+	var i Payout
+	err := row.Scan(
+		&i.Uid,
+		&i.PayoutID,
+		&i.IdempotencyKey,
+		&i.PayoutReferenceID,
+		&i.PayoutBusinessID,
+		&i.PayoutChannel,
+		&i.AccountNumber,
+		&i.AccountHolderName,
+		&i.PayoutAmount,
+		&i.Currency,
+		&i.PayoutDescription,
+		&i.PayoutStatus,
+		&i.PayoutFailureCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EstimatedArrivalAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_CREATE_PAYOUT(t *testing.T) {
+	createRandomPayout(t)
+}
+
+func Test_REPO_CREATE_PAYOUT_IDEMPOTENCY_KEY_CONFLICT(t *testing.T) {
+	payout := createRandomPayout(t)
+
+	uid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	// a reused idempotency key inserts nothing.
+	_, err = testStore.CreatePayout(context.TODO(), &CreatePayoutParams{
+		Uid:               uid.String(),
+		IdempotencyKey:    payout.IdempotencyKey,
+		PayoutReferenceID: helper.RandomString(26),
+		PayoutChannel:     payout.PayoutChannel,
+		AccountNumber:     payout.AccountNumber,
+		AccountHolderName: payout.AccountHolderName,
+		PayoutAmount:      payout.PayoutAmount,
+		Currency:          payout.Currency,
+		PayoutDescription: payout.PayoutDescription,
+		PayoutStatus:      payment.PAYOUT_STATUS_PENDING,
+		CreatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	require.True(t, errors.Is(err, pgx.ErrNoRows))
+
+	res, err := testStore.GetPayoutByIdempotencyKey(context.TODO(), payout.IdempotencyKey)
+	require.NoError(t, err)
+	require.Equal(t, payout.Uid, res.Uid)
+}
+
+func Test_REPO_UPDATE_PAYOUT(t *testing.T) {
+	payout := createRandomPayout(t)
+
+	payoutID := helper.RandomString(26)
+	updatedAt := time.Now()
+	res, err := testStore.UpdatePayout(context.TODO(), &UpdatePayoutParams{
+		Uid:              payout.Uid,
+		PayoutID:         pgtype.Text{String: payoutID, Valid: true},
+		PayoutBusinessID: pgtype.Text{String: helper.RandomString(24), Valid: true},
+		PayoutStatus:     pgtype.Text{String: payment.PAYOUT_STATUS_ACCEPTED, Valid: true},
+		UpdatedAt:        pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payoutID, res.PayoutID.String)
+	require.Equal(t, payment.PAYOUT_STATUS_ACCEPTED, res.PayoutStatus)
+	require.WithinDuration(t, updatedAt, res.UpdatedAt.Time, time.Second)
+	require.Equal(t, payout.PayoutAmount.String(), res.PayoutAmount.String())
+
+	got, err := testStore.GetPayoutByPayoutID(context.TODO(), pgtype.Text{String: payoutID, Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, payout.Uid, got.Uid)
+}
+
+func createRandomPayout(t *testing.T) *Payout {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid.String())
+
+	arg := CreatePayoutParams{
+		Uid:               ulid.String(),
+		IdempotencyKey:    helper.RandomString(32),
+		PayoutReferenceID: helper.RandomString(26),
+		PayoutChannel:     "ID_BCA",
+		AccountNumber:     helper.RandomStringInt(10),
+		AccountHolderName: helper.RandomString(20),
+		PayoutAmount:      decimal.NewFromInt(helper.RandomInt(10000, 500000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		PayoutDescription: helper.RandomString(100),
+		PayoutStatus:      payment.PAYOUT_STATUS_PENDING,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	res, err := testStore.CreatePayout(context.TODO(), &arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+	require.Equal(t, arg.Uid, res.Uid)
+	require.Equal(t, arg.IdempotencyKey, res.IdempotencyKey)
+	require.Equal(t, arg.PayoutStatus, res.PayoutStatus)
+	require.Equal(t, arg.PayoutAmount.String(), res.PayoutAmount.String())
+	require.False(t, res.PayoutID.Valid)
+
+	return res
+}
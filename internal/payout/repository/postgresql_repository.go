@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Querier interface {
+	CreatePayout(ctx context.Context, arg *CreatePayoutParams) (*Payout, error)
+	GetPayout(ctx context.Context, uid string) (*Payout, error)
+	GetPayoutByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Payout, error)
+	GetPayoutByPayoutID(ctx context.Context, payoutID pgtype.Text) (*Payout, error)
+	GetPayoutByPayoutIDForUpdate(ctx context.Context, payoutID pgtype.Text) (*Payout, error)
+	UpdatePayout(ctx context.Context, arg *UpdatePayoutParams) (*Payout, error)
+}
+
+var _ Querier = (*Queries)(nil)
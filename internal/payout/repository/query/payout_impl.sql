@@ -0,0 +1,42 @@
+-- name: CreatePayout :one
+INSERT INTO payout (
+    uid,
+    idempotency_key,
+    payout_reference_id,
+    payout_channel,
+    account_number,
+    account_holder_name,
+    payout_amount,
+    currency,
+    payout_description,
+    payout_status,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) ON CONFLICT (idempotency_key) DO NOTHING
+RETURNING *;
+
+-- name: GetPayout :one
+SELECT * FROM payout WHERE uid = $1 LIMIT 1;
+
+-- name: GetPayoutByIdempotencyKey :one
+SELECT * FROM payout WHERE idempotency_key = $1 LIMIT 1;
+
+-- name: GetPayoutByPayoutID :one
+SELECT * FROM payout WHERE payout_id = $1 LIMIT 1;
+
+-- name: GetPayoutByPayoutIDForUpdate :one
+SELECT * FROM payout WHERE payout_id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: UpdatePayout :one
+UPDATE payout
+SET
+    payout_id = COALESCE(sqlc.narg(payout_id), payout_id),
+    payout_business_id = COALESCE(sqlc.narg(payout_business_id), payout_business_id),
+    payout_status = COALESCE(sqlc.narg(payout_status), payout_status),
+    payout_failure_code = COALESCE(sqlc.narg(payout_failure_code), payout_failure_code),
+    updated_at = COALESCE(sqlc.narg(updated_at), updated_at),
+    estimated_arrival_at = COALESCE(sqlc.narg(estimated_arrival_at), estimated_arrival_at)
+WHERE
+    uid = sqlc.arg(uid)
+RETURNING *;
\ No newline at end of file
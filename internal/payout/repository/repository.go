@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository interface {
+	Querier
+
+	UpdatePayoutStatusTx(ctx context.Context, arg *UpdatePayoutStatusTxParams) (UpdatePayoutStatusTxResult, error)
+
+	OnConfigUpdate(key string, config *config.App)
+	OnPqsqlUpdate(key string, pqsqlConnection *pgxpool.Pool)
+}
+
+type Store struct {
+	log logger.Logger
+	cfg *config.App
+	db  *pgxpool.Pool
+	*Queries
+}
+
+func NewStore(
+	log logger.Logger,
+	cfg *config.App,
+	db *pgxpool.Pool,
+) Repository {
+	return &Store{
+		log:     log.WithPrefix(fmt.Sprintf("%s-%s", "payout", constants.Repository)),
+		cfg:     cfg,
+		db:      db,
+		Queries: New(db),
+	}
+}
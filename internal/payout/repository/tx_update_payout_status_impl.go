@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+type UpdatePayoutStatusTxParams struct {
+	PayoutID           string
+	PayoutStatus       string
+	PayoutBusinessID   pgtype.Text
+	PayoutFailureCode  pgtype.Text
+	UpdatedAt          pgtype.Timestamptz
+	EstimatedArrivalAt pgtype.Timestamptz
+}
+
+type UpdatePayoutStatusTxResult struct {
+	Payout *Payout
+	// Updated is only true when the payout moved into another status, a redelivered
+	// or out of order status update leaves the payout as it is.
+	Updated bool
+}
+
+// UpdatePayoutStatusTx moves a payout into the status reported by the gateway, the row is locked
+// so concurrent webhooks and kafka messages of the same payout are applied one at a time.
+func (r *Store) UpdatePayoutStatusTx(ctx context.Context, arg *UpdatePayoutStatusTxParams) (UpdatePayoutStatusTxResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Store.UpdatePayoutStatusTx")
+	defer span.Finish()
+
+	var result UpdatePayoutStatusTxResult
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := r.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Payout, err = q.GetPayoutByPayoutIDForUpdate(ctx, pgtype.Text{String: arg.PayoutID, Valid: true})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.GetPayoutByPayoutIDForUpdate.err: %w", err))
+		}
+
+		if !payment.CanMovePayoutStatus(result.Payout.PayoutStatus, arg.PayoutStatus) {
+			return nil
+		}
+
+		result.Payout, err = q.UpdatePayout(ctx, &UpdatePayoutParams{
+			Uid:                result.Payout.Uid,
+			PayoutStatus:       pgtype.Text{String: arg.PayoutStatus, Valid: true},
+			PayoutBusinessID:   arg.PayoutBusinessID,
+			PayoutFailureCode:  arg.PayoutFailureCode,
+			UpdatedAt:          arg.UpdatedAt,
+			EstimatedArrivalAt: arg.EstimatedArrivalAt,
+		})
+		if err != nil {
+			return tracing.TraceWithError(span, fmt.Errorf("q.UpdatePayout.err: %w", err))
+		}
+
+		result.Updated = true
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_REPO_UPDATE_PAYOUT_STATUS_TX(t *testing.T) {
+	payout := createRandomPayout(t)
+
+	payoutID := helper.RandomString(26)
+	_, err := testStore.UpdatePayout(context.TODO(), &UpdatePayoutParams{
+		Uid:          payout.Uid,
+		PayoutID:     pgtype.Text{String: payoutID, Valid: true},
+		PayoutStatus: pgtype.Text{String: payment.PAYOUT_STATUS_ACCEPTED, Valid: true},
+	})
+	require.NoError(t, err)
+
+	updatedAt := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	res, err := testStore.UpdatePayoutStatusTx(context.TODO(), &UpdatePayoutStatusTxParams{
+		PayoutID:     payoutID,
+		PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+		UpdatedAt:    updatedAt,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Updated)
+	require.Equal(t, payment.PAYOUT_STATUS_SUCCEEDED, res.Payout.PayoutStatus)
+
+	// a redelivered or out of order update leaves the payout as it is.
+	redelivered, err := testStore.UpdatePayoutStatusTx(context.TODO(), &UpdatePayoutStatusTxParams{
+		PayoutID:     payoutID,
+		PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+		UpdatedAt:    updatedAt,
+	})
+	require.NoError(t, err)
+	require.False(t, redelivered.Updated)
+
+	stale, err := testStore.UpdatePayoutStatusTx(context.TODO(), &UpdatePayoutStatusTxParams{
+		PayoutID:     payoutID,
+		PayoutStatus: payment.PAYOUT_STATUS_ACCEPTED,
+	})
+	require.NoError(t, err)
+	require.False(t, stale.Updated)
+	require.Equal(t, payment.PAYOUT_STATUS_SUCCEEDED, stale.Payout.PayoutStatus)
+
+	reversed, err := testStore.UpdatePayoutStatusTx(context.TODO(), &UpdatePayoutStatusTxParams{
+		PayoutID:     payoutID,
+		PayoutStatus: payment.PAYOUT_STATUS_REVERSED,
+		PayoutFailureCode: pgtype.Text{
+			String: "INVALID_DESTINATION",
+			Valid:  true,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, reversed.Updated)
+	require.Equal(t, "INVALID_DESTINATION", reversed.Payout.PayoutFailureCode.String)
+
+	_, err = testStore.UpdatePayoutStatusTx(context.TODO(), &UpdatePayoutStatusTxParams{
+		PayoutID:     helper.RandomString(26),
+		PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
+)
+
+// CreatePayout disburses to a seller's bank account once per idempotency key, a retried request returns
+// the payout it created, and one the gateway never accepted is submitted again under the same key.
+func (u *usecaseImpl) CreatePayout(ctx context.Context, arg *models.CreatePayoutRequest) (*pb.CreatePayoutResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.CreatePayout")
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := u.validateCreatePayoutParams(span, arg); err != nil {
+		return nil, tracing.TraceWithError(span, err)
+	}
+
+	existing, err := u.repo.GetPayoutByIdempotencyKey(ctx, arg.IdempotencyKey)
+	if err == nil {
+		return u.retryPayout(ctx, span, existing, arg)
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, u.errorResponse(span, "u.repo.GetPayoutByIdempotencyKey.err", err)
+	}
+
+	bankAccount, err := u.validateBankAccount(ctx, arg.PayoutChannel, arg.AccountNumber)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.validateBankAccount.err", err)
+	}
+
+	if normalizeAccountHolderName(bankAccount.AccountHolderName) != normalizeAccountHolderName(arg.AccountHolderName) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "normalizeAccountHolderName", arg.AccountHolderName),
+			unierror.ErrBankAccountNameMismatch,
+		)
+	}
+
+	uid, err := helper.GenerateULID()
+	if err != nil {
+		return nil, u.errorResponse(span, "helper.GenerateULID.err", err)
+	}
+
+	// the payout is recorded before the gateway is called, so a crash in between leaves
+	// a pending payout the retried request submits again instead of paying out twice.
+	res, err := u.repo.CreatePayout(ctx, &repository.CreatePayoutParams{
+		Uid:               uid.String(),
+		IdempotencyKey:    arg.IdempotencyKey,
+		PayoutReferenceID: arg.PayoutReferenceId,
+		PayoutChannel:     arg.PayoutChannel,
+		AccountNumber:     arg.AccountNumber,
+		AccountHolderName: bankAccount.AccountHolderName,
+		PayoutAmount:      arg.PayoutAmount,
+		Currency:          arg.Currency,
+		PayoutDescription: arg.PayoutDescription,
+		PayoutStatus:      payment.PAYOUT_STATUS_PENDING,
+		CreatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, u.errorResponse(span, "u.repo.CreatePayout.err", err)
+		}
+
+		// a concurrent request with the same idempotency key won the insert.
+		existing, err = u.repo.GetPayoutByIdempotencyKey(ctx, arg.IdempotencyKey)
+		if err != nil {
+			return nil, u.errorResponse(span, "u.repo.GetPayoutByIdempotencyKey.err", err)
+		}
+
+		return u.retryPayout(ctx, span, existing, arg)
+	}
+
+	return u.submitPayout(ctx, span, res)
+}
+
+// retryPayout answers a request whose idempotency key is already known.
+func (u *usecaseImpl) retryPayout(
+	ctx context.Context,
+	span opentracing.Span,
+	existing *repository.Payout,
+	arg *models.CreatePayoutRequest,
+) (*pb.CreatePayoutResponse, error) {
+	if !isSamePayoutRequest(existing, arg) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "isSamePayoutRequest", arg.IdempotencyKey),
+			unierror.ErrIdempotencyKeyReused,
+		)
+	}
+
+	if existing.PayoutID.Valid || existing.PayoutStatus != payment.PAYOUT_STATUS_PENDING {
+		return &pb.CreatePayoutResponse{
+			Payout: mapper.PayoutToDto(existing),
+		}, nil
+	}
+
+	return u.submitPayout(ctx, span, existing)
+}
+
+// submitPayout hands a pending payout to the gateway, which pays out once per idempotency key.
+func (u *usecaseImpl) submitPayout(ctx context.Context, span opentracing.Span, arg *repository.Payout) (*pb.CreatePayoutResponse, error) {
+	provider, err := u.provider()
+	if err != nil {
+		return nil, u.errorResponse(span, "u.provider.err", err)
+	}
+
+	gatewayPayout, err := provider.CreatePayout(ctx, &gateway.CreatePayoutParams{
+		IdempotencyKey:    arg.IdempotencyKey,
+		ReferenceID:       arg.PayoutReferenceID,
+		Channel:           arg.PayoutChannel,
+		AccountNumber:     arg.AccountNumber,
+		AccountHolderName: arg.AccountHolderName,
+		Amount:            arg.PayoutAmount,
+		Currency:          arg.Currency,
+		Description:       arg.PayoutDescription,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "provider.CreatePayout.err", err)
+	}
+
+	updateArg := repository.UpdatePayoutParams{
+		Uid:          arg.Uid,
+		PayoutID:     pgtype.Text{String: gatewayPayout.ID, Valid: true},
+		PayoutStatus: pgtype.Text{String: gatewayPayout.Status, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: gatewayPayout.UpdatedAt, Valid: true},
+	}
+
+	if gatewayPayout.BusinessID != "" {
+		updateArg.PayoutBusinessID = pgtype.Text{String: gatewayPayout.BusinessID, Valid: true}
+	}
+
+	if gatewayPayout.FailureCode != "" {
+		updateArg.PayoutFailureCode = pgtype.Text{String: gatewayPayout.FailureCode, Valid: true}
+	}
+
+	if !gatewayPayout.EstimatedArrivalTime.IsZero() {
+		updateArg.EstimatedArrivalAt = pgtype.Timestamptz{Time: gatewayPayout.EstimatedArrivalTime, Valid: true}
+	}
+
+	res, err := u.repo.UpdatePayout(ctx, &updateArg)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.UpdatePayout.err", err)
+	}
+
+	err = u.worker.PayoutStatusUpdated(ctx, &models.PayoutStatusUpdatedTask{
+		Payout: res,
+	})
+	if err != nil {
+		return nil, u.errorResponse(span, "u.worker.PayoutStatusUpdated.err", err)
+	}
+
+	return &pb.CreatePayoutResponse{
+		Payout: mapper.PayoutToDto(res),
+	}, nil
+}
+
+func (u *usecaseImpl) validateCreatePayoutParams(span opentracing.Span, arg *models.CreatePayoutRequest) error {
+	if arg.IdempotencyKey == "" {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetIdempotencyKey", arg.IdempotencyKey),
+			unierror.ErrIdempotencyKeyRequired,
+		)
+	}
+
+	if arg.PayoutReferenceId == "" {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetPayoutReferenceId", arg.PayoutReferenceId),
+			unierror.ErrReferenceIDShouldNotBeEmpty,
+		)
+	}
+
+	if !isDigits(arg.AccountNumber) {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "isDigits", arg.AccountNumber),
+			unierror.ErrInvalidAccountNumber,
+		)
+	}
+
+	if !payment.IsSupportedCurrency(arg.Currency) {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsSupportedCurrency", arg.Currency),
+			unierror.ErrUnsupportedCurrency,
+		)
+	}
+
+	if !arg.PayoutAmount.IsPositive() || (arg.Currency == payment.CURRENCY_IDR && arg.PayoutAmount.LessThan(decimal.NewFromInt(100))) {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "arg.GetPayoutAmount", arg.PayoutAmount),
+			unierror.ErrInvalidAmount,
+		)
+	}
+
+	if !payment.IsExactInCurrency(arg.PayoutAmount, arg.Currency) {
+		return u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "payment.IsExactInCurrency", arg.PayoutAmount),
+			unierror.ErrInvalidAmountPrecision,
+		)
+	}
+
+	return nil
+}
+
+// isSamePayoutRequest reports whether a retried request asks for the payout its idempotency key created.
+func isSamePayoutRequest(existing *repository.Payout, arg *models.CreatePayoutRequest) bool {
+	return existing.PayoutReferenceID == arg.PayoutReferenceId &&
+		existing.PayoutChannel == arg.PayoutChannel &&
+		existing.AccountNumber == arg.AccountNumber &&
+		existing.PayoutAmount.Equal(arg.PayoutAmount) &&
+		existing.Currency == arg.Currency
+}
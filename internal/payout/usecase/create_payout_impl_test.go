@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payout/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_CREATE_PAYOUT(t *testing.T) {
+	argOK := createRandomPayoutRequest()
+	pendingOK := payoutFromRequest(t, argOK)
+
+	acceptedOK := *pendingOK
+	acceptedOK.PayoutID = pgtype.Text{String: helper.RandomString(26), Valid: true}
+	acceptedOK.PayoutStatus = payment.PAYOUT_STATUS_ACCEPTED
+
+	testCases := []struct {
+		tname         string
+		body          func() *models.CreatePayoutRequest
+		stubs         func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.CreatePayoutResponse, err error)
+	}{
+		{
+			tname: "OK",
+			body: func() *models.CreatePayoutRequest {
+				arg := *argOK
+				// the name check ignores case and punctuation.
+				arg.AccountHolderName = "  " + argOK.AccountHolderName + "."
+				return &arg
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.SetBankAccount(argOK.PayoutChannel, argOK.AccountNumber, argOK.AccountHolderName)
+
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.CreatePayoutParams) (*repository.Payout, error) {
+						require.NotEmpty(t, arg.Uid)
+						require.Equal(t, argOK.IdempotencyKey, arg.IdempotencyKey)
+						require.Equal(t, payment.PAYOUT_STATUS_PENDING, arg.PayoutStatus)
+						require.Equal(t, argOK.AccountHolderName, arg.AccountHolderName)
+
+						res := *pendingOK
+						res.Uid = arg.Uid
+						return &res, nil
+					},
+				)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdatePayoutParams) (*repository.Payout, error) {
+						require.True(t, arg.PayoutID.Valid)
+						require.Equal(t, payment.PAYOUT_STATUS_ACCEPTED, arg.PayoutStatus.String)
+
+						res := *pendingOK
+						res.Uid = arg.Uid
+						res.PayoutID = arg.PayoutID
+						res.PayoutStatus = arg.PayoutStatus.String
+						return &res, nil
+					},
+				)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetPayout().GetPayoutId())
+				require.Equal(t, payment.PAYOUT_STATUS_ACCEPTED, res.GetPayout().GetPayoutStatus())
+			},
+		},
+		{
+			tname: "OK_RETRIED",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(&acceptedOK, nil)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, acceptedOK.PayoutID.String, res.GetPayout().GetPayoutId())
+			},
+		},
+		{
+			tname: "OK_RETRIED_PENDING_RESUBMITTED",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				// a pending payout was never accepted by the gateway, it is submitted again without a name check.
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(pendingOK, nil)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(1).Return(&acceptedOK, nil)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payment.PAYOUT_STATUS_ACCEPTED, res.GetPayout().GetPayoutStatus())
+			},
+		},
+		{
+			tname: "OK_CONCURRENT_INSERT",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.SetBankAccount(argOK.PayoutChannel, argOK.AccountNumber, argOK.AccountHolderName)
+
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(&acceptedOK, nil)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, acceptedOK.Uid, res.GetPayout().GetUid())
+			},
+		},
+		{
+			tname: "ERR_IDEMPOTENCY_KEY_REQUIRED",
+			body: func() *models.CreatePayoutRequest {
+				arg := *argOK
+				arg.IdempotencyKey = ""
+				return &arg
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrIdempotencyKeyRequired)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_IDEMPOTENCY_KEY_REUSED",
+			body: func() *models.CreatePayoutRequest {
+				arg := *argOK
+				arg.PayoutAmount = argOK.PayoutAmount.Add(decimal.NewFromInt(1000))
+				return &arg
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(&acceptedOK, nil)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrIdempotencyKeyReused)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_INVALID_ACCOUNT_NUMBER",
+			body: func() *models.CreatePayoutRequest {
+				arg := *argOK
+				arg.AccountNumber = "12-34"
+				return &arg
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidAccountNumber)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_BANK_ACCOUNT_NOT_FOUND",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrBankAccountNotFound)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_BANK_ACCOUNT_NAME_MISMATCH",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.SetBankAccount(argOK.PayoutChannel, argOK.AccountNumber, helper.RandomString(20))
+
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrBankAccountNameMismatch)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_UNAVAILABLE",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.FailNext(errors.New("gateway unavailable"))
+
+				// the payout stays pending, so a retried request submits it again.
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(pendingOK, nil)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_CREATE_PAYOUT_INTERNAL_SERVER_ERROR",
+			body: func() *models.CreatePayoutRequest {
+				return argOK
+			},
+			stubs: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker, provider *gateway.FakeProvider) {
+				provider.SetBankAccount(argOK.PayoutChannel, argOK.AccountNumber, argOK.AccountHolderName)
+
+				store.EXPECT().GetPayoutByIdempotencyKey(gomock.Any(), gomock.Eq(argOK.IdempotencyKey)).Times(1).Return(nil, pgx.ErrNoRows)
+				store.EXPECT().CreatePayout(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().UpdatePayout(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayoutResponse, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(store, wkstore, provider)
+
+			res, err := u.CreatePayout(context.TODO(), tc.body())
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func createRandomPayoutRequest() *models.CreatePayoutRequest {
+	return &models.CreatePayoutRequest{
+		IdempotencyKey:    helper.RandomString(32),
+		PayoutReferenceId: helper.RandomString(26),
+		PayoutChannel:     "ID_BCA",
+		AccountNumber:     helper.RandomStringInt(10),
+		AccountHolderName: helper.RandomString(20),
+		PayoutAmount:      decimal.NewFromInt(helper.RandomInt(10000, 500000)),
+		Currency:          payment.CURRENCY_IDR,
+		PayoutDescription: helper.RandomString(50),
+	}
+}
+
+func payoutFromRequest(t *testing.T, arg *models.CreatePayoutRequest) *repository.Payout {
+	uid, err := helper.GenerateULID()
+	require.NoError(t, err)
+
+	return &repository.Payout{
+		Uid:               uid.String(),
+		IdempotencyKey:    arg.IdempotencyKey,
+		PayoutReferenceID: arg.PayoutReferenceId,
+		PayoutChannel:     arg.PayoutChannel,
+		AccountNumber:     arg.AccountNumber,
+		AccountHolderName: arg.AccountHolderName,
+		PayoutAmount:      arg.PayoutAmount,
+		Currency:          arg.Currency,
+		PayoutDescription: arg.PayoutDescription,
+		PayoutStatus:      payment.PAYOUT_STATUS_PENDING,
+		CreatedAt:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/mapper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (u *usecaseImpl) GetPayout(ctx context.Context, arg *models.GetPayoutRequest) (*pb.GetPayoutResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.GetPayout")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	res, err := u.repo.GetPayout(ctx, arg.Uid)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.repo.GetPayout.err", err)
+	}
+
+	return &pb.GetPayoutResponse{
+		Payout: mapper.PayoutToDto(res),
+	}, nil
+}
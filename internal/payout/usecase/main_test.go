@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+)
+
+var (
+	conf *config.App
+	tlog logger.Logger
+)
+
+func TestMain(m *testing.M) {
+	logger := logger.NewLogger()
+	cm := config.NewManager(logger, 15*time.Second)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Println("Error getting current directory:", err)
+		return
+	}
+
+	cfgs, err := cm.Bootstrap(fmt.Sprintf("%v/%s", findModuleRoot(cwd), "etcd-config.yaml"))
+	if err != nil {
+		logger.Debug(err)
+		return
+	}
+
+	tlog = logger
+	conf = cfgs
+
+	os.Exit(m.Run())
+}
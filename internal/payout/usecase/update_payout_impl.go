@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/opentracing/opentracing-go"
+)
+
+// UpdatePayout applies a payout status update, only an update which moves the payout into
+// another status publishes the payout event, so a redelivered one publishes nothing.
+func (u *usecaseImpl) UpdatePayout(ctx context.Context, arg *models.UpdatePayoutRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.UpdatePayout")
+	defer span.Finish()
+
+	updateArg := repository.UpdatePayoutStatusTxParams{
+		PayoutID:     arg.PayoutId,
+		PayoutStatus: arg.PayoutStatus,
+	}
+
+	if arg.PayoutBusinessId != "" {
+		updateArg.PayoutBusinessID = pgtype.Text{
+			String: arg.PayoutBusinessId,
+			Valid:  true,
+		}
+	}
+
+	if arg.PayoutFailureCode != nil {
+		updateArg.PayoutFailureCode = pgtype.Text{
+			String: *arg.PayoutFailureCode,
+			Valid:  true,
+		}
+	}
+
+	if arg.UpdatedAt != nil {
+		updateArg.UpdatedAt = pgtype.Timestamptz{
+			Time:  *arg.UpdatedAt,
+			Valid: true,
+		}
+	}
+
+	if arg.EstimatedArrivalAt != nil {
+		updateArg.EstimatedArrivalAt = pgtype.Timestamptz{
+			Time:  *arg.EstimatedArrivalAt,
+			Valid: true,
+		}
+	}
+
+	res, err := u.repo.UpdatePayoutStatusTx(ctx, &updateArg)
+	if err != nil {
+		return u.errorResponse(span, "u.repo.UpdatePayoutStatusTx.err", err)
+	}
+
+	if !res.Updated {
+		return nil
+	}
+
+	err = u.worker.PayoutStatusUpdated(ctx, &models.PayoutStatusUpdatedTask{
+		Payout: res.Payout,
+	})
+	if err != nil {
+		return u.errorResponse(span, "u.worker.PayoutStatusUpdated.err", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payout/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_UPDATE_PAYOUT(t *testing.T) {
+	payoutOK := payoutFromRequest(t, createRandomPayoutRequest())
+	payoutOK.PayoutID = pgtype.Text{String: helper.RandomString(26), Valid: true}
+	payoutOK.PayoutStatus = payment.PAYOUT_STATUS_SUCCEEDED
+
+	updatedAt := time.Now().UTC()
+	failureCode := "INSUFFICIENT_BALANCE"
+
+	testCases := []struct {
+		tname         string
+		body          *models.UpdatePayoutRequest
+		stub          func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.UpdatePayoutRequest{
+				PayoutId:     payoutOK.PayoutID.String,
+				PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+				UpdatedAt:    &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdatePayoutStatusTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdatePayoutStatusTxParams) (repository.UpdatePayoutStatusTxResult, error) {
+						require.Equal(t, payoutOK.PayoutID.String, arg.PayoutID)
+						require.Equal(t, payment.PAYOUT_STATUS_SUCCEEDED, arg.PayoutStatus)
+						require.Equal(t, updatedAt, arg.UpdatedAt.Time)
+						require.False(t, arg.PayoutFailureCode.Valid)
+
+						return repository.UpdatePayoutStatusTxResult{Payout: payoutOK, Updated: true}, nil
+					},
+				)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Eq(&models.PayoutStatusUpdatedTask{Payout: payoutOK})).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_FAILED",
+			body: &models.UpdatePayoutRequest{
+				PayoutId:          payoutOK.PayoutID.String,
+				PayoutStatus:      payment.PAYOUT_STATUS_FAILED,
+				PayoutFailureCode: &failureCode,
+				UpdatedAt:         &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdatePayoutStatusTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ any, arg *repository.UpdatePayoutStatusTxParams) (repository.UpdatePayoutStatusTxResult, error) {
+						require.Equal(t, failureCode, arg.PayoutFailureCode.String)
+
+						return repository.UpdatePayoutStatusTxResult{Payout: payoutOK, Updated: true}, nil
+					},
+				)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "OK_REDELIVERED",
+			body: &models.UpdatePayoutRequest{
+				PayoutId:     payoutOK.PayoutID.String,
+				PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+				UpdatedAt:    &updatedAt,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdatePayoutStatusTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdatePayoutStatusTxResult{Payout: payoutOK}, nil)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "ERR_PAYOUT_NOT_FOUND",
+			body: &models.UpdatePayoutRequest{
+				PayoutId:     helper.RandomString(26),
+				PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdatePayoutStatusTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdatePayoutStatusTxResult{}, pgx.ErrNoRows)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, pgx.ErrNoRows)
+			},
+		},
+		{
+			tname: "ERR_NOT_PUBLISHED",
+			body: &models.UpdatePayoutRequest{
+				PayoutId:     payoutOK.PayoutID.String,
+				PayoutStatus: payment.PAYOUT_STATUS_SUCCEEDED,
+			},
+			stub: func(store *mock.MockRepository, wkstore *wkmock.MockProducerWorker) {
+				store.EXPECT().UpdatePayoutStatusTx(gomock.Any(), gomock.Any()).Times(1).Return(repository.UpdatePayoutStatusTxResult{Payout: payoutOK, Updated: true}, nil)
+				wkstore.EXPECT().PayoutStatusUpdated(gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, gateway.NewFakeProvider())
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stub(store, wkstore)
+
+			err := u.UpdatePayout(context.TODO(), tc.body)
+			tc.checkResponse(t, err)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/config"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/domain"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	"github.com/opentracing/opentracing-go"
+)
+
+type usecaseImpl struct {
+	log      logger.Logger
+	cfg      *config.App
+	repo     repository.Repository
+	registry *gateway.Registry
+	worker   domain.ProducerWorker
+}
+
+func New(
+	log logger.Logger,
+	cfg *config.App,
+	repository repository.Repository,
+	registry *gateway.Registry,
+	worker domain.ProducerWorker,
+) domain.Usecase {
+	return &usecaseImpl{
+		log:      log.WithPrefix(fmt.Sprintf("%s-%s", "payout", constants.Usecase)),
+		cfg:      cfg,
+		repo:     repository,
+		registry: registry,
+		worker:   worker,
+	}
+}
+
+func (u *usecaseImpl) OnConfigUpdate(key string, config *config.App) {
+	u.log.Infof("received update from '%s' key", key)
+
+	u.cfg = config
+
+	u.log.Infof("updated configuration from '%s' key successfully applied", key)
+}
+
+// provider resolves the payout provider registered under the configured payment gateway id.
+func (u *usecaseImpl) provider() (gateway.PayoutProvider, error) {
+	return u.registry.Get(u.cfg.Services.External.PaymentGateway.ID)
+}
+
+func (u *usecaseImpl) errorResponse(span opentracing.Span, details string, err error) error {
+	errfmt := fmt.Errorf("%s: %v", details, err)
+	u.log.Warn(errfmt)
+	tracing.TraceWithError(span, errfmt)
+
+	return err
+}
+
+// normalizeAccountHolderName makes the name check ignore case, punctuation and spacing,
+// banks register names in upper case and often drop titles' dots and commas.
+func normalizeAccountHolderName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+			return unicode.ToUpper(r)
+		}
+
+		return ' '
+	}, name)
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findModuleRoot(dir string) string {
+	for {
+		_, err := os.Stat(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return ""
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/opentracing/opentracing-go"
+)
+
+// ValidateBankAccount runs the gateway's name check, a mismatched name is not an error here
+// so callers can show the registered name to the seller before they ask for a payout.
+func (u *usecaseImpl) ValidateBankAccount(ctx context.Context, arg *models.ValidateBankAccountRequest) (*pb.ValidateBankAccountResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UsecaseImpl.ValidateBankAccount")
+	defer span.Finish()
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Services.Internal.OperationTimeout)
+	defer cancel()
+
+	if !isDigits(arg.AccountNumber) {
+		return nil, u.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "isDigits", arg.AccountNumber),
+			unierror.ErrInvalidAccountNumber,
+		)
+	}
+
+	bankAccount, err := u.validateBankAccount(ctx, arg.PayoutChannel, arg.AccountNumber)
+	if err != nil {
+		return nil, u.errorResponse(span, "u.validateBankAccount.err", err)
+	}
+
+	return &pb.ValidateBankAccountResponse{
+		PayoutChannel:     bankAccount.Channel,
+		AccountNumber:     bankAccount.AccountNumber,
+		AccountHolderName: bankAccount.AccountHolderName,
+		IsNameMatched:     normalizeAccountHolderName(bankAccount.AccountHolderName) == normalizeAccountHolderName(arg.AccountHolderName),
+	}, nil
+}
+
+func (u *usecaseImpl) validateBankAccount(ctx context.Context, channel string, accountNumber string) (*gateway.BankAccount, error) {
+	provider, err := u.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.ValidateBankAccount(ctx, &gateway.ValidateBankAccountParams{
+		Channel:       channel,
+		AccountNumber: accountNumber,
+	})
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/gateway"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository/mock"
+	wkmock "github.com/handysuherman/clean-arch-payment-service/internal/payout/worker/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MOCK_VALIDATE_BANK_ACCOUNT(t *testing.T) {
+	accountNumber := helper.RandomStringInt(10)
+	accountHolderName := "Budi Santoso"
+
+	testCases := []struct {
+		tname         string
+		body          *models.ValidateBankAccountRequest
+		stubs         func(provider *gateway.FakeProvider)
+		checkResponse func(t *testing.T, res *pb.ValidateBankAccountResponse, err error)
+	}{
+		{
+			tname: "OK_NAME_MATCHED",
+			body: &models.ValidateBankAccountRequest{
+				PayoutChannel:     "ID_BCA",
+				AccountNumber:     accountNumber,
+				AccountHolderName: "budi  santoso",
+			},
+			stubs: func(provider *gateway.FakeProvider) {
+				provider.SetBankAccount("ID_BCA", accountNumber, accountHolderName)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateBankAccountResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsNameMatched())
+				require.Equal(t, accountHolderName, res.GetAccountHolderName())
+			},
+		},
+		{
+			tname: "OK_NAME_NOT_MATCHED",
+			body: &models.ValidateBankAccountRequest{
+				PayoutChannel:     "ID_BCA",
+				AccountNumber:     accountNumber,
+				AccountHolderName: "Budi Santosa",
+			},
+			stubs: func(provider *gateway.FakeProvider) {
+				provider.SetBankAccount("ID_BCA", accountNumber, accountHolderName)
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateBankAccountResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetIsNameMatched())
+				require.Equal(t, accountHolderName, res.GetAccountHolderName())
+			},
+		},
+		{
+			tname: "ERR_INVALID_ACCOUNT_NUMBER",
+			body: &models.ValidateBankAccountRequest{
+				PayoutChannel:     "ID_BCA",
+				AccountNumber:     helper.RandomString(10),
+				AccountHolderName: accountHolderName,
+			},
+			stubs: func(provider *gateway.FakeProvider) {},
+			checkResponse: func(t *testing.T, res *pb.ValidateBankAccountResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrInvalidAccountNumber)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_BANK_ACCOUNT_NOT_FOUND",
+			body: &models.ValidateBankAccountRequest{
+				PayoutChannel:     "ID_BCA",
+				AccountNumber:     accountNumber,
+				AccountHolderName: accountHolderName,
+			},
+			stubs: func(provider *gateway.FakeProvider) {},
+			checkResponse: func(t *testing.T, res *pb.ValidateBankAccountResponse, err error) {
+				require.ErrorIs(t, err, unierror.ErrBankAccountNotFound)
+				require.Nil(t, res)
+			},
+		},
+		{
+			tname: "ERR_PROVIDER_UNAVAILABLE",
+			body: &models.ValidateBankAccountRequest{
+				PayoutChannel:     "ID_BCA",
+				AccountNumber:     accountNumber,
+				AccountHolderName: accountHolderName,
+			},
+			stubs: func(provider *gateway.FakeProvider) {
+				provider.FailNext(errors.New("gateway unavailable"))
+			},
+			checkResponse: func(t *testing.T, res *pb.ValidateBankAccountResponse, err error) {
+				require.Error(t, err)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mock.NewMockRepository(storeCtrl)
+
+			workerCtrl := gomock.NewController(t)
+			defer workerCtrl.Finish()
+			wkstore := wkmock.NewMockProducerWorker(workerCtrl)
+
+			provider := gateway.NewFakeProvider()
+			registry := gateway.NewRegistry()
+			registry.Register(conf.Services.External.PaymentGateway.ID, provider)
+
+			u := New(tlog, conf, store, registry, wkstore)
+			tc.stubs(provider)
+
+			res, err := u.ValidateBankAccount(context.TODO(), tc.body)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
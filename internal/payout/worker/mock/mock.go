@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/payout/domain/domain.go
+//
+// Generated by this command:
+//
+//	mockgen -package wkmock -destination internal/payout/worker/mock/mock.go -source=internal/payout/domain/domain.go
+//
+
+// Package wkmock is a generated GoMock package.
+package wkmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	config "github.com/handysuherman/clean-arch-payment-service/internal/config"
+	models "github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	pb "github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	kafka "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProducerWorker is a mock of ProducerWorker interface.
+type MockProducerWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockProducerWorkerMockRecorder
+}
+
+// MockProducerWorkerMockRecorder is the mock recorder for MockProducerWorker.
+type MockProducerWorkerMockRecorder struct {
+	mock *MockProducerWorker
+}
+
+// NewMockProducerWorker creates a new mock instance.
+func NewMockProducerWorker(ctrl *gomock.Controller) *MockProducerWorker {
+	mock := &MockProducerWorker{ctrl: ctrl}
+	mock.recorder = &MockProducerWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProducerWorker) EXPECT() *MockProducerWorkerMockRecorder {
+	return m.recorder
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockProducerWorker) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockProducerWorkerMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockProducerWorker)(nil).OnConfigUpdate), key, config)
+}
+
+// OnProducerWorkerUpdate mocks base method.
+func (m *MockProducerWorker) OnProducerWorkerUpdate(key string, workerProducerConnection *kafka.ProducerImpl) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnProducerWorkerUpdate", key, workerProducerConnection)
+}
+
+// OnProducerWorkerUpdate indicates an expected call of OnProducerWorkerUpdate.
+func (mr *MockProducerWorkerMockRecorder) OnProducerWorkerUpdate(key, workerProducerConnection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnProducerWorkerUpdate", reflect.TypeOf((*MockProducerWorker)(nil).OnProducerWorkerUpdate), key, workerProducerConnection)
+}
+
+// PayoutStatusUpdated mocks base method.
+func (m *MockProducerWorker) PayoutStatusUpdated(ctx context.Context, task *models.PayoutStatusUpdatedTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayoutStatusUpdated", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PayoutStatusUpdated indicates an expected call of PayoutStatusUpdated.
+func (mr *MockProducerWorkerMockRecorder) PayoutStatusUpdated(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayoutStatusUpdated", reflect.TypeOf((*MockProducerWorker)(nil).PayoutStatusUpdated), ctx, task)
+}
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePayout mocks base method.
+func (m *MockUsecase) CreatePayout(ctx context.Context, arg *models.CreatePayoutRequest) (*pb.CreatePayoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", ctx, arg)
+	ret0, _ := ret[0].(*pb.CreatePayoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *MockUsecaseMockRecorder) CreatePayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockUsecase)(nil).CreatePayout), ctx, arg)
+}
+
+// GetPayout mocks base method.
+func (m *MockUsecase) GetPayout(ctx context.Context, arg *models.GetPayoutRequest) (*pb.GetPayoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", ctx, arg)
+	ret0, _ := ret[0].(*pb.GetPayoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockUsecaseMockRecorder) GetPayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockUsecase)(nil).GetPayout), ctx, arg)
+}
+
+// OnConfigUpdate mocks base method.
+func (m *MockUsecase) OnConfigUpdate(key string, config *config.App) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnConfigUpdate", key, config)
+}
+
+// OnConfigUpdate indicates an expected call of OnConfigUpdate.
+func (mr *MockUsecaseMockRecorder) OnConfigUpdate(key, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnConfigUpdate", reflect.TypeOf((*MockUsecase)(nil).OnConfigUpdate), key, config)
+}
+
+// UpdatePayout mocks base method.
+func (m *MockUsecase) UpdatePayout(ctx context.Context, arg *models.UpdatePayoutRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayout", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayout indicates an expected call of UpdatePayout.
+func (mr *MockUsecaseMockRecorder) UpdatePayout(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayout", reflect.TypeOf((*MockUsecase)(nil).UpdatePayout), ctx, arg)
+}
+
+// ValidateBankAccount mocks base method.
+func (m *MockUsecase) ValidateBankAccount(ctx context.Context, arg *models.ValidateBankAccountRequest) (*pb.ValidateBankAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBankAccount", ctx, arg)
+	ret0, _ := ret[0].(*pb.ValidateBankAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateBankAccount indicates an expected call of ValidateBankAccount.
+func (mr *MockUsecaseMockRecorder) ValidateBankAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBankAccount", reflect.TypeOf((*MockUsecase)(nil).ValidateBankAccount), ctx, arg)
+}
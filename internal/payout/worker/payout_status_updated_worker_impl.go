@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/tracing"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (w *Worker) PayoutStatusUpdated(ctx context.Context, task *models.PayoutStatusUpdatedTask) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Worker.PayoutStatusUpdated")
+	defer span.Finish()
+
+	arg := messages.KafkaPayoutStatusUpdated{
+		Uid:               task.Payout.Uid,
+		PayoutId:          &task.Payout.PayoutID.String,
+		IdempotencyKey:    task.Payout.IdempotencyKey,
+		PayoutReferenceId: task.Payout.PayoutReferenceID,
+		PayoutChannel:     task.Payout.PayoutChannel,
+		AccountNumber:     task.Payout.AccountNumber,
+		AccountHolderName: task.Payout.AccountHolderName,
+		PayoutAmount:      task.Payout.PayoutAmount.InexactFloat64(),
+		PayoutAmountMinor: payment.ToMinorUnits(task.Payout.PayoutAmount, task.Payout.Currency),
+		Currency:          task.Payout.Currency,
+		PayoutStatus:      task.Payout.PayoutStatus,
+		PayoutFailureCode: &task.Payout.PayoutFailureCode.String,
+		CreatedAt:         timestamppb.New(task.Payout.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(task.Payout.UpdatedAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "proto.Marshal.err", err),
+			err,
+		)
+	}
+
+	message := kafka.Message{
+		Topic:   helper.StringBuilder(w.cfg.Services.Internal.ID, "_", w.cfg.Brokers.Kafka.Topics.PayoutStatusUpdated.TopicName),
+		Value:   protoMsg,
+		Time:    time.Now().UTC(),
+		Headers: tracing.GetKafkaTracingHeadersFromSpanCtx(span.Context()),
+	}
+
+	err = w.distributor.PublishMessage(ctx, message)
+	if err != nil {
+		return w.errorResponse(
+			span,
+			fmt.Sprintf("%s: %v", "w.distributor.PublishMessage.err", err),
+			err,
+		)
+	}
+
+	return nil
+}
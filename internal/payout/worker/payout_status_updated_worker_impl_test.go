@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/models"
+	"github.com/handysuherman/clean-arch-payment-service/internal/payout/repository"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	producerMock "github.com/handysuherman/clean-arch-payment-service/internal/pkg/kafka/mock"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/payment"
+	messages "github.com/handysuherman/clean-arch-payment-service/internal/proto/kafka"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/segmentio/kafka-go"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_MOCK_PAYOUT_STATUS_UPDATED(t *testing.T) {
+	payoutRespOK := createRandomPayout(t)
+	okTopic := helper.StringBuilder(conf.Services.Internal.ID, "_", conf.Brokers.Kafka.Topics.PayoutStatusUpdated.TopicName)
+
+	okParams := createPayoutKafkaMessageParams(t, okTopic, payoutRespOK)
+
+	testCases := []struct {
+		tname         string
+		body          *models.PayoutStatusUpdatedTask
+		stub          func(producerStore *producerMock.MockProducer)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			tname: "OK",
+			body: &models.PayoutStatusUpdatedTask{
+				Payout: payoutRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			tname: "NOT_OK",
+			body: &models.PayoutStatusUpdatedTask{
+				Payout: payoutRespOK,
+			},
+			stub: func(producerStore *producerMock.MockProducer) {
+				producerStore.EXPECT().PublishMessage(gomock.Any(), EqKafkaMessageParamsMatcher(okParams, okTopic)).Times(1).Return(errors.New("any err"))
+			},
+			checkResponse: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			producerStoreCtrl := gomock.NewController(t)
+			defer producerStoreCtrl.Finish()
+			producerStore := producerMock.NewMockProducer(producerStoreCtrl)
+
+			u := New(tlog, conf, producerStore)
+			tc.stub(producerStore)
+
+			actualError := u.PayoutStatusUpdated(context.TODO(), tc.body)
+			tc.checkResponse(t, actualError)
+		})
+	}
+}
+
+func createPayoutKafkaMessageParams(t *testing.T, topic string, task *repository.Payout) kafka.Message {
+	arg := messages.KafkaPayoutStatusUpdated{
+		Uid:               task.Uid,
+		PayoutId:          &task.PayoutID.String,
+		IdempotencyKey:    task.IdempotencyKey,
+		PayoutReferenceId: task.PayoutReferenceID,
+		PayoutChannel:     task.PayoutChannel,
+		AccountNumber:     task.AccountNumber,
+		AccountHolderName: task.AccountHolderName,
+		PayoutAmount:      task.PayoutAmount.InexactFloat64(),
+		PayoutAmountMinor: payment.ToMinorUnits(task.PayoutAmount, task.Currency),
+		Currency:          task.Currency,
+		PayoutStatus:      task.PayoutStatus,
+		PayoutFailureCode: &task.PayoutFailureCode.String,
+		CreatedAt:         timestamppb.New(task.CreatedAt.Time),
+		UpdatedAt:         timestamppb.New(task.UpdatedAt.Time),
+	}
+
+	protoMsg, err := proto.Marshal(&arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, &arg)
+
+	return kafka.Message{
+		Topic: topic,
+		Value: protoMsg,
+		Time:  time.Now().UTC(),
+	}
+}
+
+func createRandomPayout(t *testing.T) *repository.Payout {
+	ulid, err := helper.GenerateULID()
+	require.NoError(t, err)
+	require.NotEmpty(t, ulid)
+
+	return &repository.Payout{
+		Uid: ulid.String(),
+		PayoutID: pgtype.Text{
+			String: helper.RandomString(24),
+			Valid:  true,
+		},
+		IdempotencyKey:    helper.RandomString(32),
+		PayoutReferenceID: ulid.String(),
+		PayoutChannel:     "ID_BCA",
+		AccountNumber:     helper.RandomStringInt(10),
+		AccountHolderName: helper.RandomString(20),
+		PayoutAmount:      decimal.NewFromInt(helper.RandomInt(10000, 200000)),
+		Currency:          payment.DEFAULT_CURRENCY,
+		PayoutStatus:      payment.PAYOUT_STATUS_SUCCEEDED,
+		CreatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: pgtype.Timestamptz{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+}
+
+func EqKafkaMessageParamsMatcher(arg kafka.Message, expectedTopic string) gomock.Matcher {
+	return &eqKafkaMessageParamsMatcher{arg: arg, expectedTopic: expectedTopic}
+}
+
+type eqKafkaMessageParamsMatcher struct {
+	arg           kafka.Message
+	expectedTopic string
+}
+
+func (ex *eqKafkaMessageParamsMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(kafka.Message)
+	if !ok {
+		return false
+	}
+
+	if arg.Value == nil || arg.Time.IsZero() || arg.Topic == "" {
+		return false
+	}
+
+	if arg.Topic != ex.expectedTopic {
+		return false
+	}
+
+	expected := ex.arg
+	expected.Time = arg.Time
+	expected.Headers = arg.Headers
+
+	return reflect.DeepEqual(expected, arg)
+}
+
+func (ex *eqKafkaMessageParamsMatcher) String() string {
+	return fmt.Sprintf("matches topic %s and arg: %v", ex.expectedTopic, ex.arg)
+}
@@ -25,16 +25,19 @@ type PaymentChannel struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid                string  `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	PaymentChannelName string  `protobuf:"bytes,2,opt,name=payment_channel_name,json=paymentChannelName,proto3" json:"payment_channel_name,omitempty"`
-	PaymentChannelType string  `protobuf:"bytes,3,opt,name=payment_channel_type,json=paymentChannelType,proto3" json:"payment_channel_type,omitempty"`
-	PaymentLogoSrc     string  `protobuf:"bytes,4,opt,name=payment_logo_src,json=paymentLogoSrc,proto3" json:"payment_logo_src,omitempty"`
-	MinAmount          float64 `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxAmount          float64 `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
-	Tax                float64 `protobuf:"fixed64,7,opt,name=tax,proto3" json:"tax,omitempty"`
-	IsTaxPercentage    bool    `protobuf:"varint,8,opt,name=is_tax_percentage,json=isTaxPercentage,proto3" json:"is_tax_percentage,omitempty"`
-	IsActive           bool    `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	IsAvailable        bool    `protobuf:"varint,10,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Uid                string        `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PaymentChannelName string        `protobuf:"bytes,2,opt,name=payment_channel_name,json=paymentChannelName,proto3" json:"payment_channel_name,omitempty"`
+	PaymentChannelType string        `protobuf:"bytes,3,opt,name=payment_channel_type,json=paymentChannelType,proto3" json:"payment_channel_type,omitempty"`
+	PaymentLogoSrc     string        `protobuf:"bytes,4,opt,name=payment_logo_src,json=paymentLogoSrc,proto3" json:"payment_logo_src,omitempty"`
+	MinAmount          float64       `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount          float64       `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	Tax                float64       `protobuf:"fixed64,7,opt,name=tax,proto3" json:"tax,omitempty"`
+	IsTaxPercentage    bool          `protobuf:"varint,8,opt,name=is_tax_percentage,json=isTaxPercentage,proto3" json:"is_tax_percentage,omitempty"`
+	IsActive           bool          `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsAvailable        bool          `protobuf:"varint,10,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Price              *PaymentPrice `protobuf:"bytes,11,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Currency           string        `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Country            string        `protobuf:"bytes,13,opt,name=country,proto3" json:"country,omitempty"`
 }
 
 func (x *PaymentChannel) Reset() {
@@ -139,11 +142,135 @@ func (x *PaymentChannel) GetIsAvailable() bool {
 	return false
 }
 
+func (x *PaymentChannel) GetPrice() *PaymentPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PaymentChannel) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentChannel) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type PaymentPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAmount       float64 `protobuf:"fixed64,1,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	FeeAmount        float64 `protobuf:"fixed64,2,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	TotalAmount      float64 `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	FeeBearer        string  `protobuf:"bytes,4,opt,name=fee_bearer,json=feeBearer,proto3" json:"fee_bearer,omitempty"`
+	BaseAmountMinor  int64   `protobuf:"varint,5,opt,name=base_amount_minor,json=baseAmountMinor,proto3" json:"base_amount_minor,omitempty"`
+	FeeAmountMinor   int64   `protobuf:"varint,6,opt,name=fee_amount_minor,json=feeAmountMinor,proto3" json:"fee_amount_minor,omitempty"`
+	TotalAmountMinor int64   `protobuf:"varint,7,opt,name=total_amount_minor,json=totalAmountMinor,proto3" json:"total_amount_minor,omitempty"`
+	Currency         string  `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *PaymentPrice) Reset() {
+	*x = PaymentPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_channel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPrice) ProtoMessage() {}
+
+func (x *PaymentPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_channel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPrice.ProtoReflect.Descriptor instead.
+func (*PaymentPrice) Descriptor() ([]byte, []int) {
+	return file_payment_channel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentPrice) GetBaseAmount() float64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetFeeAmount() float64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetFeeBearer() string {
+	if x != nil {
+		return x.FeeBearer
+	}
+	return ""
+}
+
+func (x *PaymentPrice) GetBaseAmountMinor() int64 {
+	if x != nil {
+		return x.BaseAmountMinor
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetFeeAmountMinor() int64 {
+	if x != nil {
+		return x.FeeAmountMinor
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetTotalAmountMinor() int64 {
+	if x != nil {
+		return x.TotalAmountMinor
+	}
+	return 0
+}
+
+func (x *PaymentPrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 var File_payment_channel_proto protoreflect.FileDescriptor
 
 var file_payment_channel_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d,
+	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x03, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d,
 	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x14,
 	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
@@ -166,11 +293,37 @@ var file_payment_channel_proto_rawDesc = []byte{
 	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
 	0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
 	0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x69, 0x6b, 0x61, 0x6e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x77, 0x64, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0xb0, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x62, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12,
+	0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69,
+	0x6e, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -185,16 +338,18 @@ func file_payment_channel_proto_rawDescGZIP() []byte {
 	return file_payment_channel_proto_rawDescData
 }
 
-var file_payment_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_payment_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_payment_channel_proto_goTypes = []interface{}{
 	(*PaymentChannel)(nil), // 0: PaymentChannel
+	(*PaymentPrice)(nil),   // 1: PaymentPrice
 }
 var file_payment_channel_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: PaymentChannel.price:type_name -> PaymentPrice
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_payment_channel_proto_init() }
@@ -215,14 +370,27 @@ func file_payment_channel_proto_init() {
 				return nil
 			}
 		}
+		file_payment_channel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_payment_channel_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_payment_channel_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,8 +24,24 @@ const _ = grpc.SupportPackageIsVersion7
 type PaymentServiceClient interface {
 	Create(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentResponse, error)
 	GetByID(ctx context.Context, in *GetByIDPaymentRequest, opts ...grpc.CallOption) (*GetByIDPaymentResponse, error)
+	GetByReferenceID(ctx context.Context, in *GetByReferenceIDPaymentRequest, opts ...grpc.CallOption) (*GetByReferenceIDPaymentResponse, error)
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
 	GetChannel(ctx context.Context, in *GetPaymentChannelRequest, opts ...grpc.CallOption) (*GetPaymentChannelResponse, error)
 	GetAvailableChannels(ctx context.Context, in *GetPaymentChannelsRequest, opts ...grpc.CallOption) (*GetPaymentChannelsResponse, error)
+	Refund(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	Cancel(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	Capture(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error)
+	Void(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error)
+	LinkDirectDebit(ctx context.Context, in *LinkDirectDebitRequest, opts ...grpc.CallOption) (*LinkDirectDebitResponse, error)
+	ValidateDirectDebitLink(ctx context.Context, in *ValidateDirectDebitLinkRequest, opts ...grpc.CallOption) (*ValidateDirectDebitLinkResponse, error)
+	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error)
+	GetLedgerBalances(ctx context.Context, in *GetLedgerBalancesRequest, opts ...grpc.CallOption) (*GetLedgerBalancesResponse, error)
+	ListPaymentLedgerEntries(ctx context.Context, in *ListPaymentLedgerEntriesRequest, opts ...grpc.CallOption) (*ListPaymentLedgerEntriesResponse, error)
+	ListReconciliationDiscrepancies(ctx context.Context, in *ListReconciliationDiscrepanciesRequest, opts ...grpc.CallOption) (*ListReconciliationDiscrepanciesResponse, error)
+	GetPaymentTimeline(ctx context.Context, in *GetPaymentTimelineRequest, opts ...grpc.CallOption) (*GetPaymentTimelineResponse, error)
+	ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error)
+	GetDeadLetterMessage(ctx context.Context, in *GetDeadLetterMessageRequest, opts ...grpc.CallOption) (*GetDeadLetterMessageResponse, error)
+	ReplayDeadLetterMessage(ctx context.Context, in *ReplayDeadLetterMessageRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessageResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -54,6 +70,24 @@ func (c *paymentServiceClient) GetByID(ctx context.Context, in *GetByIDPaymentRe
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetByReferenceID(ctx context.Context, in *GetByReferenceIDPaymentRequest, opts ...grpc.CallOption) (*GetByReferenceIDPaymentResponse, error) {
+	out := new(GetByReferenceIDPaymentResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/GetByReferenceID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ListPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) GetChannel(ctx context.Context, in *GetPaymentChannelRequest, opts ...grpc.CallOption) (*GetPaymentChannelResponse, error) {
 	out := new(GetPaymentChannelResponse)
 	err := c.cc.Invoke(ctx, "/PaymentService/GetChannel", in, out, opts...)
@@ -72,14 +106,156 @@ func (c *paymentServiceClient) GetAvailableChannels(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *paymentServiceClient) Refund(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) Cancel(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) Capture(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error) {
+	out := new(CapturePaymentResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/Capture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) Void(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error) {
+	out := new(VoidPaymentResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/Void", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) LinkDirectDebit(ctx context.Context, in *LinkDirectDebitRequest, opts ...grpc.CallOption) (*LinkDirectDebitResponse, error) {
+	out := new(LinkDirectDebitResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/LinkDirectDebit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ValidateDirectDebitLink(ctx context.Context, in *ValidateDirectDebitLinkRequest, opts ...grpc.CallOption) (*ValidateDirectDebitLinkResponse, error) {
+	out := new(ValidateDirectDebitLinkResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ValidateDirectDebitLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error) {
+	out := new(CreateInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/CreateInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetLedgerBalances(ctx context.Context, in *GetLedgerBalancesRequest, opts ...grpc.CallOption) (*GetLedgerBalancesResponse, error) {
+	out := new(GetLedgerBalancesResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/GetLedgerBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPaymentLedgerEntries(ctx context.Context, in *ListPaymentLedgerEntriesRequest, opts ...grpc.CallOption) (*ListPaymentLedgerEntriesResponse, error) {
+	out := new(ListPaymentLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ListPaymentLedgerEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListReconciliationDiscrepancies(ctx context.Context, in *ListReconciliationDiscrepanciesRequest, opts ...grpc.CallOption) (*ListReconciliationDiscrepanciesResponse, error) {
+	out := new(ListReconciliationDiscrepanciesResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ListReconciliationDiscrepancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentTimeline(ctx context.Context, in *GetPaymentTimelineRequest, opts ...grpc.CallOption) (*GetPaymentTimelineResponse, error) {
+	out := new(GetPaymentTimelineResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/GetPaymentTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error) {
+	out := new(ListDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ListDeadLetterMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetDeadLetterMessage(ctx context.Context, in *GetDeadLetterMessageRequest, opts ...grpc.CallOption) (*GetDeadLetterMessageResponse, error) {
+	out := new(GetDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/GetDeadLetterMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReplayDeadLetterMessage(ctx context.Context, in *ReplayDeadLetterMessageRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessageResponse, error) {
+	out := new(ReplayDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, "/PaymentService/ReplayDeadLetterMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility
 type PaymentServiceServer interface {
 	Create(context.Context, *CreatePaymentRequest) (*CreatePaymentResponse, error)
 	GetByID(context.Context, *GetByIDPaymentRequest) (*GetByIDPaymentResponse, error)
+	GetByReferenceID(context.Context, *GetByReferenceIDPaymentRequest) (*GetByReferenceIDPaymentResponse, error)
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
 	GetChannel(context.Context, *GetPaymentChannelRequest) (*GetPaymentChannelResponse, error)
 	GetAvailableChannels(context.Context, *GetPaymentChannelsRequest) (*GetPaymentChannelsResponse, error)
+	Refund(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	Cancel(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	Capture(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error)
+	Void(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error)
+	LinkDirectDebit(context.Context, *LinkDirectDebitRequest) (*LinkDirectDebitResponse, error)
+	ValidateDirectDebitLink(context.Context, *ValidateDirectDebitLinkRequest) (*ValidateDirectDebitLinkResponse, error)
+	CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error)
+	GetLedgerBalances(context.Context, *GetLedgerBalancesRequest) (*GetLedgerBalancesResponse, error)
+	ListPaymentLedgerEntries(context.Context, *ListPaymentLedgerEntriesRequest) (*ListPaymentLedgerEntriesResponse, error)
+	ListReconciliationDiscrepancies(context.Context, *ListReconciliationDiscrepanciesRequest) (*ListReconciliationDiscrepanciesResponse, error)
+	GetPaymentTimeline(context.Context, *GetPaymentTimelineRequest) (*GetPaymentTimelineResponse, error)
+	ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error)
+	GetDeadLetterMessage(context.Context, *GetDeadLetterMessageRequest) (*GetDeadLetterMessageResponse, error)
+	ReplayDeadLetterMessage(context.Context, *ReplayDeadLetterMessageRequest) (*ReplayDeadLetterMessageResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -93,12 +269,60 @@ func (UnimplementedPaymentServiceServer) Create(context.Context, *CreatePaymentR
 func (UnimplementedPaymentServiceServer) GetByID(context.Context, *GetByIDPaymentRequest) (*GetByIDPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetByReferenceID(context.Context, *GetByReferenceIDPaymentRequest) (*GetByReferenceIDPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByReferenceID not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
 func (UnimplementedPaymentServiceServer) GetChannel(context.Context, *GetPaymentChannelRequest) (*GetPaymentChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
 }
 func (UnimplementedPaymentServiceServer) GetAvailableChannels(context.Context, *GetPaymentChannelsRequest) (*GetPaymentChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableChannels not implemented")
 }
+func (UnimplementedPaymentServiceServer) Refund(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
+func (UnimplementedPaymentServiceServer) Cancel(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedPaymentServiceServer) Capture(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capture not implemented")
+}
+func (UnimplementedPaymentServiceServer) Void(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Void not implemented")
+}
+func (UnimplementedPaymentServiceServer) LinkDirectDebit(context.Context, *LinkDirectDebitRequest) (*LinkDirectDebitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkDirectDebit not implemented")
+}
+func (UnimplementedPaymentServiceServer) ValidateDirectDebitLink(context.Context, *ValidateDirectDebitLinkRequest) (*ValidateDirectDebitLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateDirectDebitLink not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetLedgerBalances(context.Context, *GetLedgerBalancesRequest) (*GetLedgerBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerBalances not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPaymentLedgerEntries(context.Context, *ListPaymentLedgerEntriesRequest) (*ListPaymentLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentLedgerEntries not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListReconciliationDiscrepancies(context.Context, *ListReconciliationDiscrepanciesRequest) (*ListReconciliationDiscrepanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReconciliationDiscrepancies not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentTimeline(context.Context, *GetPaymentTimelineRequest) (*GetPaymentTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentTimeline not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterMessages not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetDeadLetterMessage(context.Context, *GetDeadLetterMessageRequest) (*GetDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterMessage not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReplayDeadLetterMessage(context.Context, *ReplayDeadLetterMessageRequest) (*ReplayDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterMessage not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 
 // UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -148,6 +372,42 @@ func _PaymentService_GetByID_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetByReferenceID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByReferenceIDPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetByReferenceID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/GetByReferenceID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetByReferenceID(ctx, req.(*GetByReferenceIDPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ListPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPaymentChannelRequest)
 	if err := dec(in); err != nil {
@@ -184,6 +444,258 @@ func _PaymentService_GetAvailableChannels_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Refund(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Cancel(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_Capture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Capture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/Capture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Capture(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_Void_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Void(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/Void",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Void(ctx, req.(*VoidPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_LinkDirectDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkDirectDebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).LinkDirectDebit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/LinkDirectDebit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).LinkDirectDebit(ctx, req.(*LinkDirectDebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ValidateDirectDebitLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDirectDebitLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ValidateDirectDebitLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ValidateDirectDebitLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ValidateDirectDebitLink(ctx, req.(*ValidateDirectDebitLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/CreateInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateInvoice(ctx, req.(*CreateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetLedgerBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetLedgerBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/GetLedgerBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetLedgerBalances(ctx, req.(*GetLedgerBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPaymentLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPaymentLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ListPaymentLedgerEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPaymentLedgerEntries(ctx, req.(*ListPaymentLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListReconciliationDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationDiscrepanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListReconciliationDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ListReconciliationDiscrepancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListReconciliationDiscrepancies(ctx, req.(*ListReconciliationDiscrepanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/GetPaymentTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentTimeline(ctx, req.(*GetPaymentTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ListDeadLetterMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListDeadLetterMessages(ctx, req.(*ListDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetDeadLetterMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetDeadLetterMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/GetDeadLetterMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetDeadLetterMessage(ctx, req.(*GetDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReplayDeadLetterMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReplayDeadLetterMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentService/ReplayDeadLetterMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReplayDeadLetterMessage(ctx, req.(*ReplayDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +711,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByID",
 			Handler:    _PaymentService_GetByID_Handler,
 		},
+		{
+			MethodName: "GetByReferenceID",
+			Handler:    _PaymentService_GetByReferenceID_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _PaymentService_ListPayments_Handler,
+		},
 		{
 			MethodName: "GetChannel",
 			Handler:    _PaymentService_GetChannel_Handler,
@@ -207,6 +727,62 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableChannels",
 			Handler:    _PaymentService_GetAvailableChannels_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _PaymentService_Refund_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _PaymentService_Cancel_Handler,
+		},
+		{
+			MethodName: "Capture",
+			Handler:    _PaymentService_Capture_Handler,
+		},
+		{
+			MethodName: "Void",
+			Handler:    _PaymentService_Void_Handler,
+		},
+		{
+			MethodName: "LinkDirectDebit",
+			Handler:    _PaymentService_LinkDirectDebit_Handler,
+		},
+		{
+			MethodName: "ValidateDirectDebitLink",
+			Handler:    _PaymentService_ValidateDirectDebitLink_Handler,
+		},
+		{
+			MethodName: "CreateInvoice",
+			Handler:    _PaymentService_CreateInvoice_Handler,
+		},
+		{
+			MethodName: "GetLedgerBalances",
+			Handler:    _PaymentService_GetLedgerBalances_Handler,
+		},
+		{
+			MethodName: "ListPaymentLedgerEntries",
+			Handler:    _PaymentService_ListPaymentLedgerEntries_Handler,
+		},
+		{
+			MethodName: "ListReconciliationDiscrepancies",
+			Handler:    _PaymentService_ListReconciliationDiscrepancies_Handler,
+		},
+		{
+			MethodName: "GetPaymentTimeline",
+			Handler:    _PaymentService_GetPaymentTimeline_Handler,
+		},
+		{
+			MethodName: "ListDeadLetterMessages",
+			Handler:    _PaymentService_ListDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "GetDeadLetterMessage",
+			Handler:    _PaymentService_GetDeadLetterMessage_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterMessage",
+			Handler:    _PaymentService_ReplayDeadLetterMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment_service.proto",
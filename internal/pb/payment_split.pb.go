@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: payment_split.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PaymentSplitRule routes part of a marketplace payment to another sub-account,
+// exactly one of percent_amount, flat_amount or flat_amount_minor should be set.
+type PaymentSplitRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationAccountId string `protobuf:"bytes,1,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
+	// percent_amount is a percentage of the payment amount, the fee a customer bears is never split.
+	PercentAmount *float64 `protobuf:"fixed64,2,opt,name=percent_amount,json=percentAmount,proto3,oneof" json:"percent_amount,omitempty"`
+	FlatAmount    *float64 `protobuf:"fixed64,3,opt,name=flat_amount,json=flatAmount,proto3,oneof" json:"flat_amount,omitempty"`
+	// flat_amount_minor takes precedence over flat_amount when it is set.
+	FlatAmountMinor *int64  `protobuf:"varint,4,opt,name=flat_amount_minor,json=flatAmountMinor,proto3,oneof" json:"flat_amount_minor,omitempty"`
+	ReferenceId     *string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+}
+
+func (x *PaymentSplitRule) Reset() {
+	*x = PaymentSplitRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_split_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentSplitRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSplitRule) ProtoMessage() {}
+
+func (x *PaymentSplitRule) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_split_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSplitRule.ProtoReflect.Descriptor instead.
+func (*PaymentSplitRule) Descriptor() ([]byte, []int) {
+	return file_payment_split_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentSplitRule) GetDestinationAccountId() string {
+	if x != nil {
+		return x.DestinationAccountId
+	}
+	return ""
+}
+
+func (x *PaymentSplitRule) GetPercentAmount() float64 {
+	if x != nil && x.PercentAmount != nil {
+		return *x.PercentAmount
+	}
+	return 0
+}
+
+func (x *PaymentSplitRule) GetFlatAmount() float64 {
+	if x != nil && x.FlatAmount != nil {
+		return *x.FlatAmount
+	}
+	return 0
+}
+
+func (x *PaymentSplitRule) GetFlatAmountMinor() int64 {
+	if x != nil && x.FlatAmountMinor != nil {
+		return *x.FlatAmountMinor
+	}
+	return 0
+}
+
+func (x *PaymentSplitRule) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+// PaymentSplit is a leg of a marketplace payment, the legs add up to the charged amount.
+type PaymentSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid                  string  `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	SplitType            string  `protobuf:"bytes,2,opt,name=split_type,json=splitType,proto3" json:"split_type,omitempty"`
+	DestinationAccountId string  `protobuf:"bytes,3,opt,name=destination_account_id,json=destinationAccountId,proto3" json:"destination_account_id,omitempty"`
+	SplitAmount          float64 `protobuf:"fixed64,4,opt,name=split_amount,json=splitAmount,proto3" json:"split_amount,omitempty"`
+	SplitAmountMinor     int64   `protobuf:"varint,5,opt,name=split_amount_minor,json=splitAmountMinor,proto3" json:"split_amount_minor,omitempty"`
+	Currency             string  `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	SplitReferenceId     *string `protobuf:"bytes,7,opt,name=split_reference_id,json=splitReferenceId,proto3,oneof" json:"split_reference_id,omitempty"`
+	SplitRuleId          *string `protobuf:"bytes,8,opt,name=split_rule_id,json=splitRuleId,proto3,oneof" json:"split_rule_id,omitempty"`
+}
+
+func (x *PaymentSplit) Reset() {
+	*x = PaymentSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_split_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSplit) ProtoMessage() {}
+
+func (x *PaymentSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_split_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSplit.ProtoReflect.Descriptor instead.
+func (*PaymentSplit) Descriptor() ([]byte, []int) {
+	return file_payment_split_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentSplit) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetSplitType() string {
+	if x != nil {
+		return x.SplitType
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetDestinationAccountId() string {
+	if x != nil {
+		return x.DestinationAccountId
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetSplitAmount() float64 {
+	if x != nil {
+		return x.SplitAmount
+	}
+	return 0
+}
+
+func (x *PaymentSplit) GetSplitAmountMinor() int64 {
+	if x != nil {
+		return x.SplitAmountMinor
+	}
+	return 0
+}
+
+func (x *PaymentSplit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetSplitReferenceId() string {
+	if x != nil && x.SplitReferenceId != nil {
+		return *x.SplitReferenceId
+	}
+	return ""
+}
+
+func (x *PaymentSplit) GetSplitRuleId() string {
+	if x != nil && x.SplitRuleId != nil {
+		return *x.SplitRuleId
+	}
+	return ""
+}
+
+var File_payment_split_proto protoreflect.FileDescriptor
+
+var file_payment_split_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x02, 0x0a, 0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x66, 0x6c, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52,
+	0x0f, 0x66, 0x6c, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d,
+	0x69, 0x6e, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xe7, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42,
+	0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
+	0x6e, 0x64, 0x79, 0x73, 0x75, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payment_split_proto_rawDescOnce sync.Once
+	file_payment_split_proto_rawDescData = file_payment_split_proto_rawDesc
+)
+
+func file_payment_split_proto_rawDescGZIP() []byte {
+	file_payment_split_proto_rawDescOnce.Do(func() {
+		file_payment_split_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_split_proto_rawDescData)
+	})
+	return file_payment_split_proto_rawDescData
+}
+
+var file_payment_split_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_split_proto_goTypes = []interface{}{
+	(*PaymentSplitRule)(nil), // 0: PaymentSplitRule
+	(*PaymentSplit)(nil),     // 1: PaymentSplit
+}
+var file_payment_split_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_payment_split_proto_init() }
+func file_payment_split_proto_init() {
+	if File_payment_split_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_payment_split_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentSplitRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_split_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_payment_split_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_payment_split_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_payment_split_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_payment_split_proto_goTypes,
+		DependencyIndexes: file_payment_split_proto_depIdxs,
+		MessageInfos:      file_payment_split_proto_msgTypes,
+	}.Build()
+	File_payment_split_proto = out.File
+	file_payment_split_proto_rawDesc = nil
+	file_payment_split_proto_goTypes = nil
+	file_payment_split_proto_depIdxs = nil
+}
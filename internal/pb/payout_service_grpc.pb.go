@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.2
+// source: payout_service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PayoutServiceClient is the client API for PayoutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PayoutServiceClient interface {
+	ValidateBankAccount(ctx context.Context, in *ValidateBankAccountRequest, opts ...grpc.CallOption) (*ValidateBankAccountResponse, error)
+	CreatePayout(ctx context.Context, in *CreatePayoutRequest, opts ...grpc.CallOption) (*CreatePayoutResponse, error)
+	GetPayout(ctx context.Context, in *GetPayoutRequest, opts ...grpc.CallOption) (*GetPayoutResponse, error)
+}
+
+type payoutServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayoutServiceClient(cc grpc.ClientConnInterface) PayoutServiceClient {
+	return &payoutServiceClient{cc}
+}
+
+func (c *payoutServiceClient) ValidateBankAccount(ctx context.Context, in *ValidateBankAccountRequest, opts ...grpc.CallOption) (*ValidateBankAccountResponse, error) {
+	out := new(ValidateBankAccountResponse)
+	err := c.cc.Invoke(ctx, "/PayoutService/ValidateBankAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) CreatePayout(ctx context.Context, in *CreatePayoutRequest, opts ...grpc.CallOption) (*CreatePayoutResponse, error) {
+	out := new(CreatePayoutResponse)
+	err := c.cc.Invoke(ctx, "/PayoutService/CreatePayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) GetPayout(ctx context.Context, in *GetPayoutRequest, opts ...grpc.CallOption) (*GetPayoutResponse, error) {
+	out := new(GetPayoutResponse)
+	err := c.cc.Invoke(ctx, "/PayoutService/GetPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayoutServiceServer is the server API for PayoutService service.
+// All implementations must embed UnimplementedPayoutServiceServer
+// for forward compatibility
+type PayoutServiceServer interface {
+	ValidateBankAccount(context.Context, *ValidateBankAccountRequest) (*ValidateBankAccountResponse, error)
+	CreatePayout(context.Context, *CreatePayoutRequest) (*CreatePayoutResponse, error)
+	GetPayout(context.Context, *GetPayoutRequest) (*GetPayoutResponse, error)
+	mustEmbedUnimplementedPayoutServiceServer()
+}
+
+// UnimplementedPayoutServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPayoutServiceServer struct {
+}
+
+func (UnimplementedPayoutServiceServer) ValidateBankAccount(context.Context, *ValidateBankAccountRequest) (*ValidateBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBankAccount not implemented")
+}
+func (UnimplementedPayoutServiceServer) CreatePayout(context.Context, *CreatePayoutRequest) (*CreatePayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) GetPayout(context.Context, *GetPayoutRequest) (*GetPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) mustEmbedUnimplementedPayoutServiceServer() {}
+
+// UnsafePayoutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayoutServiceServer will
+// result in compilation errors.
+type UnsafePayoutServiceServer interface {
+	mustEmbedUnimplementedPayoutServiceServer()
+}
+
+func RegisterPayoutServiceServer(s grpc.ServiceRegistrar, srv PayoutServiceServer) {
+	s.RegisterService(&PayoutService_ServiceDesc, srv)
+}
+
+func _PayoutService_ValidateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).ValidateBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PayoutService/ValidateBankAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).ValidateBankAccount(ctx, req.(*ValidateBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_CreatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).CreatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PayoutService/CreatePayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).CreatePayout(ctx, req.(*CreatePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_GetPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).GetPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PayoutService/GetPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).GetPayout(ctx, req.(*GetPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayoutService_ServiceDesc is the grpc.ServiceDesc for PayoutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayoutService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "PayoutService",
+	HandlerType: (*PayoutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateBankAccount",
+			Handler:    _PayoutService_ValidateBankAccount_Handler,
+		},
+		{
+			MethodName: "CreatePayout",
+			Handler:    _PayoutService_CreatePayout_Handler,
+		},
+		{
+			MethodName: "GetPayout",
+			Handler:    _PayoutService_GetPayout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payout_service.proto",
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: reconciliation.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReconciliationDiscrepancy is a record of a gateway report which does not agree with the payments
+// we hold, detected_at is when it was first seen and last_detected_at when a report last showed it.
+type ReconciliationDiscrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid                 string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	DiscrepancyType     string                 `protobuf:"bytes,2,opt,name=discrepancy_type,json=discrepancyType,proto3" json:"discrepancy_type,omitempty"`
+	PaymentMethodUid    *string                `protobuf:"bytes,3,opt,name=payment_method_uid,json=paymentMethodUid,proto3,oneof" json:"payment_method_uid,omitempty"`
+	PaymentMethodId     *string                `protobuf:"bytes,4,opt,name=payment_method_id,json=paymentMethodId,proto3,oneof" json:"payment_method_id,omitempty"`
+	PaymentRequestId    *string                `protobuf:"bytes,5,opt,name=payment_request_id,json=paymentRequestId,proto3,oneof" json:"payment_request_id,omitempty"`
+	PaymentReferenceId  *string                `protobuf:"bytes,6,opt,name=payment_reference_id,json=paymentReferenceId,proto3,oneof" json:"payment_reference_id,omitempty"`
+	ExpectedAmount      float64                `protobuf:"fixed64,7,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	ExpectedAmountMinor int64                  `protobuf:"varint,8,opt,name=expected_amount_minor,json=expectedAmountMinor,proto3" json:"expected_amount_minor,omitempty"`
+	ReportedAmount      float64                `protobuf:"fixed64,9,opt,name=reported_amount,json=reportedAmount,proto3" json:"reported_amount,omitempty"`
+	ReportedAmountMinor int64                  `protobuf:"varint,10,opt,name=reported_amount_minor,json=reportedAmountMinor,proto3" json:"reported_amount_minor,omitempty"`
+	ExpectedStatus      *string                `protobuf:"bytes,11,opt,name=expected_status,json=expectedStatus,proto3,oneof" json:"expected_status,omitempty"`
+	ReportedStatus      *string                `protobuf:"bytes,12,opt,name=reported_status,json=reportedStatus,proto3,oneof" json:"reported_status,omitempty"`
+	Currency            string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	ReportSource        string                 `protobuf:"bytes,14,opt,name=report_source,json=reportSource,proto3" json:"report_source,omitempty"`
+	ReportReference     string                 `protobuf:"bytes,15,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	DetectedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	LastDetectedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_detected_at,json=lastDetectedAt,proto3" json:"last_detected_at,omitempty"`
+}
+
+func (x *ReconciliationDiscrepancy) Reset() {
+	*x = ReconciliationDiscrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reconciliation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationDiscrepancy) ProtoMessage() {}
+
+func (x *ReconciliationDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_reconciliation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationDiscrepancy.ProtoReflect.Descriptor instead.
+func (*ReconciliationDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_reconciliation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReconciliationDiscrepancy) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetDiscrepancyType() string {
+	if x != nil {
+		return x.DiscrepancyType
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetPaymentMethodUid() string {
+	if x != nil && x.PaymentMethodUid != nil {
+		return *x.PaymentMethodUid
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetPaymentMethodId() string {
+	if x != nil && x.PaymentMethodId != nil {
+		return *x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetPaymentRequestId() string {
+	if x != nil && x.PaymentRequestId != nil {
+		return *x.PaymentRequestId
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetPaymentReferenceId() string {
+	if x != nil && x.PaymentReferenceId != nil {
+		return *x.PaymentReferenceId
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetExpectedAmount() float64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationDiscrepancy) GetExpectedAmountMinor() int64 {
+	if x != nil {
+		return x.ExpectedAmountMinor
+	}
+	return 0
+}
+
+func (x *ReconciliationDiscrepancy) GetReportedAmount() float64 {
+	if x != nil {
+		return x.ReportedAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationDiscrepancy) GetReportedAmountMinor() int64 {
+	if x != nil {
+		return x.ReportedAmountMinor
+	}
+	return 0
+}
+
+func (x *ReconciliationDiscrepancy) GetExpectedStatus() string {
+	if x != nil && x.ExpectedStatus != nil {
+		return *x.ExpectedStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetReportedStatus() string {
+	if x != nil && x.ReportedStatus != nil {
+		return *x.ReportedStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetReportSource() string {
+	if x != nil {
+		return x.ReportSource
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationDiscrepancy) GetLastDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDetectedAt
+	}
+	return nil
+}
+
+var File_reconciliation_proto protoreflect.FileDescriptor
+
+var file_reconciliation_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x07, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x72,
+	0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x55,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
+	0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63,
+	0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_reconciliation_proto_rawDescOnce sync.Once
+	file_reconciliation_proto_rawDescData = file_reconciliation_proto_rawDesc
+)
+
+func file_reconciliation_proto_rawDescGZIP() []byte {
+	file_reconciliation_proto_rawDescOnce.Do(func() {
+		file_reconciliation_proto_rawDescData = protoimpl.X.CompressGZIP(file_reconciliation_proto_rawDescData)
+	})
+	return file_reconciliation_proto_rawDescData
+}
+
+var file_reconciliation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_reconciliation_proto_goTypes = []interface{}{
+	(*ReconciliationDiscrepancy)(nil), // 0: ReconciliationDiscrepancy
+	(*timestamppb.Timestamp)(nil),     // 1: google.protobuf.Timestamp
+}
+var file_reconciliation_proto_depIdxs = []int32{
+	1, // 0: ReconciliationDiscrepancy.detected_at:type_name -> google.protobuf.Timestamp
+	1, // 1: ReconciliationDiscrepancy.last_detected_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_reconciliation_proto_init() }
+func file_reconciliation_proto_init() {
+	if File_reconciliation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_reconciliation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconciliationDiscrepancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_reconciliation_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_reconciliation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_reconciliation_proto_goTypes,
+		DependencyIndexes: file_reconciliation_proto_depIdxs,
+		MessageInfos:      file_reconciliation_proto_msgTypes,
+	}.Build()
+	File_reconciliation_proto = out.File
+	file_reconciliation_proto_rawDesc = nil
+	file_reconciliation_proto_goTypes = nil
+	file_reconciliation_proto_depIdxs = nil
+}
@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_get_by_reference_id_payment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetByReferenceIDPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentReferenceId string `protobuf:"bytes,1,opt,name=payment_reference_id,json=paymentReferenceId,proto3" json:"payment_reference_id,omitempty"`
+}
+
+func (x *GetByReferenceIDPaymentRequest) Reset() {
+	*x = GetByReferenceIDPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_by_reference_id_payment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByReferenceIDPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByReferenceIDPaymentRequest) ProtoMessage() {}
+
+func (x *GetByReferenceIDPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_by_reference_id_payment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByReferenceIDPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetByReferenceIDPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_by_reference_id_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetByReferenceIDPaymentRequest) GetPaymentReferenceId() string {
+	if x != nil {
+		return x.PaymentReferenceId
+	}
+	return ""
+}
+
+type GetByReferenceIDPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*PaymentMethod `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *GetByReferenceIDPaymentResponse) Reset() {
+	*x = GetByReferenceIDPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_by_reference_id_payment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByReferenceIDPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByReferenceIDPaymentResponse) ProtoMessage() {}
+
+func (x *GetByReferenceIDPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_by_reference_id_payment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByReferenceIDPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetByReferenceIDPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_by_reference_id_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetByReferenceIDPaymentResponse) GetList() []*PaymentMethod {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_rpc_get_by_reference_id_payment_proto protoreflect.FileDescriptor
+
+var file_rpc_get_by_reference_id_payment_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x44, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x44, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x2d,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_by_reference_id_payment_proto_rawDescOnce sync.Once
+	file_rpc_get_by_reference_id_payment_proto_rawDescData = file_rpc_get_by_reference_id_payment_proto_rawDesc
+)
+
+func file_rpc_get_by_reference_id_payment_proto_rawDescGZIP() []byte {
+	file_rpc_get_by_reference_id_payment_proto_rawDescOnce.Do(func() {
+		file_rpc_get_by_reference_id_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_by_reference_id_payment_proto_rawDescData)
+	})
+	return file_rpc_get_by_reference_id_payment_proto_rawDescData
+}
+
+var file_rpc_get_by_reference_id_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_by_reference_id_payment_proto_goTypes = []interface{}{
+	(*GetByReferenceIDPaymentRequest)(nil),  // 0: GetByReferenceIDPaymentRequest
+	(*GetByReferenceIDPaymentResponse)(nil), // 1: GetByReferenceIDPaymentResponse
+	(*PaymentMethod)(nil),                   // 2: PaymentMethod
+}
+var file_rpc_get_by_reference_id_payment_proto_depIdxs = []int32{
+	2, // 0: GetByReferenceIDPaymentResponse.list:type_name -> PaymentMethod
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_by_reference_id_payment_proto_init() }
+func file_rpc_get_by_reference_id_payment_proto_init() {
+	if File_rpc_get_by_reference_id_payment_proto != nil {
+		return
+	}
+	file_payment_method_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_by_reference_id_payment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByReferenceIDPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_by_reference_id_payment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByReferenceIDPaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_by_reference_id_payment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_by_reference_id_payment_proto_goTypes,
+		DependencyIndexes: file_rpc_get_by_reference_id_payment_proto_depIdxs,
+		MessageInfos:      file_rpc_get_by_reference_id_payment_proto_msgTypes,
+	}.Build()
+	File_rpc_get_by_reference_id_payment_proto = out.File
+	file_rpc_get_by_reference_id_payment_proto_rawDesc = nil
+	file_rpc_get_by_reference_id_payment_proto_goTypes = nil
+	file_rpc_get_by_reference_id_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_get_dead_letter_message.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetDeadLetterMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *GetDeadLetterMessageRequest) Reset() {
+	*x = GetDeadLetterMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_dead_letter_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterMessageRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_dead_letter_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_dead_letter_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetDeadLetterMessageRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetDeadLetterMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterMessage *DeadLetterMessage `protobuf:"bytes,1,opt,name=dead_letter_message,json=deadLetterMessage,proto3" json:"dead_letter_message,omitempty"`
+}
+
+func (x *GetDeadLetterMessageResponse) Reset() {
+	*x = GetDeadLetterMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_dead_letter_message_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterMessageResponse) ProtoMessage() {}
+
+func (x *GetDeadLetterMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_dead_letter_message_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_dead_letter_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeadLetterMessageResponse) GetDeadLetterMessage() *DeadLetterMessage {
+	if x != nil {
+		return x.DeadLetterMessage
+	}
+	return nil
+}
+
+var File_rpc_get_dead_letter_message_proto protoreflect.FileDescriptor
+
+var file_rpc_get_dead_letter_message_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x62, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73,
+	0x75, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72,
+	0x63, 0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_dead_letter_message_proto_rawDescOnce sync.Once
+	file_rpc_get_dead_letter_message_proto_rawDescData = file_rpc_get_dead_letter_message_proto_rawDesc
+)
+
+func file_rpc_get_dead_letter_message_proto_rawDescGZIP() []byte {
+	file_rpc_get_dead_letter_message_proto_rawDescOnce.Do(func() {
+		file_rpc_get_dead_letter_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_dead_letter_message_proto_rawDescData)
+	})
+	return file_rpc_get_dead_letter_message_proto_rawDescData
+}
+
+var file_rpc_get_dead_letter_message_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_dead_letter_message_proto_goTypes = []interface{}{
+	(*GetDeadLetterMessageRequest)(nil),  // 0: GetDeadLetterMessageRequest
+	(*GetDeadLetterMessageResponse)(nil), // 1: GetDeadLetterMessageResponse
+	(*DeadLetterMessage)(nil),            // 2: DeadLetterMessage
+}
+var file_rpc_get_dead_letter_message_proto_depIdxs = []int32{
+	2, // 0: GetDeadLetterMessageResponse.dead_letter_message:type_name -> DeadLetterMessage
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_dead_letter_message_proto_init() }
+func file_rpc_get_dead_letter_message_proto_init() {
+	if File_rpc_get_dead_letter_message_proto != nil {
+		return
+	}
+	file_dead_letter_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_dead_letter_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_dead_letter_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_dead_letter_message_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_dead_letter_message_proto_goTypes,
+		DependencyIndexes: file_rpc_get_dead_letter_message_proto_depIdxs,
+		MessageInfos:      file_rpc_get_dead_letter_message_proto_msgTypes,
+	}.Build()
+	File_rpc_get_dead_letter_message_proto = out.File
+	file_rpc_get_dead_letter_message_proto_rawDesc = nil
+	file_rpc_get_dead_letter_message_proto_goTypes = nil
+	file_rpc_get_dead_letter_message_proto_depIdxs = nil
+}
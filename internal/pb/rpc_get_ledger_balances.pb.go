@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_get_ledger_balances.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetLedgerBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency     *string `protobuf:"bytes,1,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	AccountCode  *string `protobuf:"bytes,2,opt,name=account_code,json=accountCode,proto3,oneof" json:"account_code,omitempty"`
+	AccountOwner *string `protobuf:"bytes,3,opt,name=account_owner,json=accountOwner,proto3,oneof" json:"account_owner,omitempty"`
+}
+
+func (x *GetLedgerBalancesRequest) Reset() {
+	*x = GetLedgerBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_ledger_balances_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesRequest) ProtoMessage() {}
+
+func (x *GetLedgerBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_ledger_balances_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_ledger_balances_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetLedgerBalancesRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *GetLedgerBalancesRequest) GetAccountCode() string {
+	if x != nil && x.AccountCode != nil {
+		return *x.AccountCode
+	}
+	return ""
+}
+
+func (x *GetLedgerBalancesRequest) GetAccountOwner() string {
+	if x != nil && x.AccountOwner != nil {
+		return *x.AccountOwner
+	}
+	return ""
+}
+
+type GetLedgerBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*LedgerAccountBalance `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *GetLedgerBalancesResponse) Reset() {
+	*x = GetLedgerBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_ledger_balances_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesResponse) ProtoMessage() {}
+
+func (x *GetLedgerBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_ledger_balances_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_ledger_balances_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLedgerBalancesResponse) GetList() []*LedgerAccountBalance {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_rpc_get_ledger_balances_proto protoreflect.FileDescriptor
+
+var file_rpc_get_ledger_balances_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_ledger_balances_proto_rawDescOnce sync.Once
+	file_rpc_get_ledger_balances_proto_rawDescData = file_rpc_get_ledger_balances_proto_rawDesc
+)
+
+func file_rpc_get_ledger_balances_proto_rawDescGZIP() []byte {
+	file_rpc_get_ledger_balances_proto_rawDescOnce.Do(func() {
+		file_rpc_get_ledger_balances_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_ledger_balances_proto_rawDescData)
+	})
+	return file_rpc_get_ledger_balances_proto_rawDescData
+}
+
+var file_rpc_get_ledger_balances_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_ledger_balances_proto_goTypes = []interface{}{
+	(*GetLedgerBalancesRequest)(nil),  // 0: GetLedgerBalancesRequest
+	(*GetLedgerBalancesResponse)(nil), // 1: GetLedgerBalancesResponse
+	(*LedgerAccountBalance)(nil),      // 2: LedgerAccountBalance
+}
+var file_rpc_get_ledger_balances_proto_depIdxs = []int32{
+	2, // 0: GetLedgerBalancesResponse.list:type_name -> LedgerAccountBalance
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_ledger_balances_proto_init() }
+func file_rpc_get_ledger_balances_proto_init() {
+	if File_rpc_get_ledger_balances_proto != nil {
+		return
+	}
+	file_ledger_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_ledger_balances_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_ledger_balances_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_get_ledger_balances_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_ledger_balances_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_ledger_balances_proto_goTypes,
+		DependencyIndexes: file_rpc_get_ledger_balances_proto_depIdxs,
+		MessageInfos:      file_rpc_get_ledger_balances_proto_msgTypes,
+	}.Build()
+	File_rpc_get_ledger_balances_proto = out.File
+	file_rpc_get_ledger_balances_proto_rawDesc = nil
+	file_rpc_get_ledger_balances_proto_goTypes = nil
+	file_rpc_get_ledger_balances_proto_depIdxs = nil
+}
@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_link_direct_debit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LinkDirectDebitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerUid             *string `protobuf:"bytes,1,opt,name=customer_uid,json=customerUid,proto3,oneof" json:"customer_uid,omitempty"`
+	CustomerName            string  `protobuf:"bytes,2,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerPhoneNumber     string  `protobuf:"bytes,3,opt,name=customer_phone_number,json=customerPhoneNumber,proto3" json:"customer_phone_number,omitempty"`
+	PaymentReferenceId      string  `protobuf:"bytes,4,opt,name=payment_reference_id,json=paymentReferenceId,proto3" json:"payment_reference_id,omitempty"`
+	PaymentDescription      string  `protobuf:"bytes,5,opt,name=payment_description,json=paymentDescription,proto3" json:"payment_description,omitempty"`
+	PaymentChannel          string  `protobuf:"bytes,6,opt,name=payment_channel,json=paymentChannel,proto3" json:"payment_channel,omitempty"`
+	PaymentSuccessReturnUrl string  `protobuf:"bytes,7,opt,name=payment_success_return_url,json=paymentSuccessReturnUrl,proto3" json:"payment_success_return_url,omitempty"`
+	PaymentFailureReturnUrl string  `protobuf:"bytes,8,opt,name=payment_failure_return_url,json=paymentFailureReturnUrl,proto3" json:"payment_failure_return_url,omitempty"`
+	Currency                *string `protobuf:"bytes,9,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	// country defaults to the country of the currency.
+	Country *string `protobuf:"bytes,10,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	// the account details the channel asks for to send the OTP.
+	AccountMobileNumber *string `protobuf:"bytes,11,opt,name=account_mobile_number,json=accountMobileNumber,proto3,oneof" json:"account_mobile_number,omitempty"`
+	AccountCardLastFour *string `protobuf:"bytes,12,opt,name=account_card_last_four,json=accountCardLastFour,proto3,oneof" json:"account_card_last_four,omitempty"`
+	AccountCardExpiry   *string `protobuf:"bytes,13,opt,name=account_card_expiry,json=accountCardExpiry,proto3,oneof" json:"account_card_expiry,omitempty"`
+	AccountEmail        *string `protobuf:"bytes,14,opt,name=account_email,json=accountEmail,proto3,oneof" json:"account_email,omitempty"`
+}
+
+func (x *LinkDirectDebitRequest) Reset() {
+	*x = LinkDirectDebitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_link_direct_debit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkDirectDebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkDirectDebitRequest) ProtoMessage() {}
+
+func (x *LinkDirectDebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_link_direct_debit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkDirectDebitRequest.ProtoReflect.Descriptor instead.
+func (*LinkDirectDebitRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_link_direct_debit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinkDirectDebitRequest) GetCustomerUid() string {
+	if x != nil && x.CustomerUid != nil {
+		return *x.CustomerUid
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetCustomerPhoneNumber() string {
+	if x != nil {
+		return x.CustomerPhoneNumber
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetPaymentReferenceId() string {
+	if x != nil {
+		return x.PaymentReferenceId
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetPaymentDescription() string {
+	if x != nil {
+		return x.PaymentDescription
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetPaymentChannel() string {
+	if x != nil {
+		return x.PaymentChannel
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetPaymentSuccessReturnUrl() string {
+	if x != nil {
+		return x.PaymentSuccessReturnUrl
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetPaymentFailureReturnUrl() string {
+	if x != nil {
+		return x.PaymentFailureReturnUrl
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetAccountMobileNumber() string {
+	if x != nil && x.AccountMobileNumber != nil {
+		return *x.AccountMobileNumber
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetAccountCardLastFour() string {
+	if x != nil && x.AccountCardLastFour != nil {
+		return *x.AccountCardLastFour
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetAccountCardExpiry() string {
+	if x != nil && x.AccountCardExpiry != nil {
+		return *x.AccountCardExpiry
+	}
+	return ""
+}
+
+func (x *LinkDirectDebitRequest) GetAccountEmail() string {
+	if x != nil && x.AccountEmail != nil {
+		return *x.AccountEmail
+	}
+	return ""
+}
+
+type LinkDirectDebitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Customer      *Customer      `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	PaymentMethod *PaymentMethod `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+}
+
+func (x *LinkDirectDebitResponse) Reset() {
+	*x = LinkDirectDebitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_link_direct_debit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkDirectDebitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkDirectDebitResponse) ProtoMessage() {}
+
+func (x *LinkDirectDebitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_link_direct_debit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkDirectDebitResponse.ProtoReflect.Descriptor instead.
+func (*LinkDirectDebitResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_link_direct_debit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LinkDirectDebitResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *LinkDirectDebitResponse) GetPaymentMethod() *PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+var File_rpc_link_direct_debit_proto protoreflect.FileDescriptor
+
+var file_rpc_link_direct_debit_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xba, 0x06, 0x0a, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x55, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x38,
+	0x0a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x13, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x73,
+	0x74, 0x46, 0x6f, 0x75, 0x72, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x19, 0x0a, 0x17, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x66, 0x6f, 0x75, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x77, 0x0a, 0x17, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65,
+	0x62, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68,
+	0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_link_direct_debit_proto_rawDescOnce sync.Once
+	file_rpc_link_direct_debit_proto_rawDescData = file_rpc_link_direct_debit_proto_rawDesc
+)
+
+func file_rpc_link_direct_debit_proto_rawDescGZIP() []byte {
+	file_rpc_link_direct_debit_proto_rawDescOnce.Do(func() {
+		file_rpc_link_direct_debit_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_link_direct_debit_proto_rawDescData)
+	})
+	return file_rpc_link_direct_debit_proto_rawDescData
+}
+
+var file_rpc_link_direct_debit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_link_direct_debit_proto_goTypes = []interface{}{
+	(*LinkDirectDebitRequest)(nil),  // 0: LinkDirectDebitRequest
+	(*LinkDirectDebitResponse)(nil), // 1: LinkDirectDebitResponse
+	(*Customer)(nil),                // 2: Customer
+	(*PaymentMethod)(nil),           // 3: PaymentMethod
+}
+var file_rpc_link_direct_debit_proto_depIdxs = []int32{
+	2, // 0: LinkDirectDebitResponse.customer:type_name -> Customer
+	3, // 1: LinkDirectDebitResponse.payment_method:type_name -> PaymentMethod
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_link_direct_debit_proto_init() }
+func file_rpc_link_direct_debit_proto_init() {
+	if File_rpc_link_direct_debit_proto != nil {
+		return
+	}
+	file_payment_method_proto_init()
+	file_customer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_link_direct_debit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkDirectDebitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_link_direct_debit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkDirectDebitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_link_direct_debit_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_link_direct_debit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_link_direct_debit_proto_goTypes,
+		DependencyIndexes: file_rpc_link_direct_debit_proto_depIdxs,
+		MessageInfos:      file_rpc_link_direct_debit_proto_msgTypes,
+	}.Build()
+	File_rpc_link_direct_debit_proto = out.File
+	file_rpc_link_direct_debit_proto_rawDesc = nil
+	file_rpc_link_direct_debit_proto_goTypes = nil
+	file_rpc_link_direct_debit_proto_depIdxs = nil
+}
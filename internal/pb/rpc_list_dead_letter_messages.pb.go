@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_list_dead_letter_messages.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDeadLetterMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceTopic      *string                `protobuf:"bytes,1,opt,name=source_topic,json=sourceTopic,proto3,oneof" json:"source_topic,omitempty"`
+	DeadLetterStatus *string                `protobuf:"bytes,2,opt,name=dead_letter_status,json=deadLetterStatus,proto3,oneof" json:"dead_letter_status,omitempty"`
+	DeadLetteredFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dead_lettered_from,json=deadLetteredFrom,proto3,oneof" json:"dead_lettered_from,omitempty"`
+	DeadLetteredTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dead_lettered_to,json=deadLetteredTo,proto3,oneof" json:"dead_lettered_to,omitempty"`
+	Size             int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor           *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+}
+
+func (x *ListDeadLetterMessagesRequest) Reset() {
+	*x = ListDeadLetterMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_dead_letter_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterMessagesRequest) ProtoMessage() {}
+
+func (x *ListDeadLetterMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_dead_letter_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_dead_letter_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDeadLetterMessagesRequest) GetSourceTopic() string {
+	if x != nil && x.SourceTopic != nil {
+		return *x.SourceTopic
+	}
+	return ""
+}
+
+func (x *ListDeadLetterMessagesRequest) GetDeadLetterStatus() string {
+	if x != nil && x.DeadLetterStatus != nil {
+		return *x.DeadLetterStatus
+	}
+	return ""
+}
+
+func (x *ListDeadLetterMessagesRequest) GetDeadLetteredFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredFrom
+	}
+	return nil
+}
+
+func (x *ListDeadLetterMessagesRequest) GetDeadLetteredTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredTo
+	}
+	return nil
+}
+
+func (x *ListDeadLetterMessagesRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListDeadLetterMessagesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListDeadLetterMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List       []*DeadLetterMessage `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination *PaginationTimeRange `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListDeadLetterMessagesResponse) Reset() {
+	*x = ListDeadLetterMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_dead_letter_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterMessagesResponse) ProtoMessage() {}
+
+func (x *ListDeadLetterMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_dead_letter_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_dead_letter_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDeadLetterMessagesResponse) GetList() []*DeadLetterMessage {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListDeadLetterMessagesResponse) GetPagination() *PaginationTimeRange {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_rpc_list_dead_letter_messages_proto protoreflect.FileDescriptor
+
+var file_rpc_list_dead_letter_messages_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xa4, 0x03, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12,
+	0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x64, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x4d, 0x0a, 0x12, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x10, 0x64, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x49,
+	0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7e, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68,
+	0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_dead_letter_messages_proto_rawDescOnce sync.Once
+	file_rpc_list_dead_letter_messages_proto_rawDescData = file_rpc_list_dead_letter_messages_proto_rawDesc
+)
+
+func file_rpc_list_dead_letter_messages_proto_rawDescGZIP() []byte {
+	file_rpc_list_dead_letter_messages_proto_rawDescOnce.Do(func() {
+		file_rpc_list_dead_letter_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_dead_letter_messages_proto_rawDescData)
+	})
+	return file_rpc_list_dead_letter_messages_proto_rawDescData
+}
+
+var file_rpc_list_dead_letter_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_dead_letter_messages_proto_goTypes = []interface{}{
+	(*ListDeadLetterMessagesRequest)(nil),  // 0: ListDeadLetterMessagesRequest
+	(*ListDeadLetterMessagesResponse)(nil), // 1: ListDeadLetterMessagesResponse
+	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*DeadLetterMessage)(nil),              // 3: DeadLetterMessage
+	(*PaginationTimeRange)(nil),            // 4: PaginationTimeRange
+}
+var file_rpc_list_dead_letter_messages_proto_depIdxs = []int32{
+	2, // 0: ListDeadLetterMessagesRequest.dead_lettered_from:type_name -> google.protobuf.Timestamp
+	2, // 1: ListDeadLetterMessagesRequest.dead_lettered_to:type_name -> google.protobuf.Timestamp
+	3, // 2: ListDeadLetterMessagesResponse.list:type_name -> DeadLetterMessage
+	4, // 3: ListDeadLetterMessagesResponse.pagination:type_name -> PaginationTimeRange
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_dead_letter_messages_proto_init() }
+func file_rpc_list_dead_letter_messages_proto_init() {
+	if File_rpc_list_dead_letter_messages_proto != nil {
+		return
+	}
+	file_dead_letter_proto_init()
+	file_rpc_list_payments_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_dead_letter_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_dead_letter_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_list_dead_letter_messages_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_dead_letter_messages_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_dead_letter_messages_proto_goTypes,
+		DependencyIndexes: file_rpc_list_dead_letter_messages_proto_depIdxs,
+		MessageInfos:      file_rpc_list_dead_letter_messages_proto_msgTypes,
+	}.Build()
+	File_rpc_list_dead_letter_messages_proto = out.File
+	file_rpc_list_dead_letter_messages_proto_rawDesc = nil
+	file_rpc_list_dead_letter_messages_proto_goTypes = nil
+	file_rpc_list_dead_letter_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_list_reconciliation_discrepancies.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListReconciliationDiscrepanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiscrepancyType *string                `protobuf:"bytes,1,opt,name=discrepancy_type,json=discrepancyType,proto3,oneof" json:"discrepancy_type,omitempty"`
+	PaymentMethodId *string                `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3,oneof" json:"payment_method_id,omitempty"`
+	DetectedFrom    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=detected_from,json=detectedFrom,proto3,oneof" json:"detected_from,omitempty"`
+	DetectedTo      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=detected_to,json=detectedTo,proto3,oneof" json:"detected_to,omitempty"`
+	Size            int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Cursor          *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) Reset() {
+	*x = ListReconciliationDiscrepanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_reconciliation_discrepancies_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationDiscrepanciesRequest) ProtoMessage() {}
+
+func (x *ListReconciliationDiscrepanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_reconciliation_discrepancies_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationDiscrepanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_reconciliation_discrepancies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetDiscrepancyType() string {
+	if x != nil && x.DiscrepancyType != nil {
+		return *x.DiscrepancyType
+	}
+	return ""
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetPaymentMethodId() string {
+	if x != nil && x.PaymentMethodId != nil {
+		return *x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetDetectedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedFrom
+	}
+	return nil
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetDetectedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedTo
+	}
+	return nil
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListReconciliationDiscrepanciesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListReconciliationDiscrepanciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List       []*ReconciliationDiscrepancy `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Pagination *PaginationTimeRange         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListReconciliationDiscrepanciesResponse) Reset() {
+	*x = ListReconciliationDiscrepanciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_reconciliation_discrepancies_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReconciliationDiscrepanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationDiscrepanciesResponse) ProtoMessage() {}
+
+func (x *ListReconciliationDiscrepanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_reconciliation_discrepancies_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationDiscrepanciesResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_reconciliation_discrepancies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListReconciliationDiscrepanciesResponse) GetList() []*ReconciliationDiscrepancy {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListReconciliationDiscrepanciesResponse) GetPagination() *PaginationTimeRange {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_rpc_list_reconciliation_discrepancies_proto protoreflect.FileDescriptor
+
+var file_rpc_list_reconciliation_discrepancies_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x03,
+	0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0c,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x40, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x27, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x41, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x79,
+	0x73, 0x75, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x2d, 0x61,
+	0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_reconciliation_discrepancies_proto_rawDescOnce sync.Once
+	file_rpc_list_reconciliation_discrepancies_proto_rawDescData = file_rpc_list_reconciliation_discrepancies_proto_rawDesc
+)
+
+func file_rpc_list_reconciliation_discrepancies_proto_rawDescGZIP() []byte {
+	file_rpc_list_reconciliation_discrepancies_proto_rawDescOnce.Do(func() {
+		file_rpc_list_reconciliation_discrepancies_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_reconciliation_discrepancies_proto_rawDescData)
+	})
+	return file_rpc_list_reconciliation_discrepancies_proto_rawDescData
+}
+
+var file_rpc_list_reconciliation_discrepancies_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_reconciliation_discrepancies_proto_goTypes = []interface{}{
+	(*ListReconciliationDiscrepanciesRequest)(nil),  // 0: ListReconciliationDiscrepanciesRequest
+	(*ListReconciliationDiscrepanciesResponse)(nil), // 1: ListReconciliationDiscrepanciesResponse
+	(*timestamppb.Timestamp)(nil),                   // 2: google.protobuf.Timestamp
+	(*ReconciliationDiscrepancy)(nil),               // 3: ReconciliationDiscrepancy
+	(*PaginationTimeRange)(nil),                     // 4: PaginationTimeRange
+}
+var file_rpc_list_reconciliation_discrepancies_proto_depIdxs = []int32{
+	2, // 0: ListReconciliationDiscrepanciesRequest.detected_from:type_name -> google.protobuf.Timestamp
+	2, // 1: ListReconciliationDiscrepanciesRequest.detected_to:type_name -> google.protobuf.Timestamp
+	3, // 2: ListReconciliationDiscrepanciesResponse.list:type_name -> ReconciliationDiscrepancy
+	4, // 3: ListReconciliationDiscrepanciesResponse.pagination:type_name -> PaginationTimeRange
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_reconciliation_discrepancies_proto_init() }
+func file_rpc_list_reconciliation_discrepancies_proto_init() {
+	if File_rpc_list_reconciliation_discrepancies_proto != nil {
+		return
+	}
+	file_reconciliation_proto_init()
+	file_rpc_list_payments_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_reconciliation_discrepancies_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReconciliationDiscrepanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_reconciliation_discrepancies_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReconciliationDiscrepanciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_list_reconciliation_discrepancies_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_reconciliation_discrepancies_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_reconciliation_discrepancies_proto_goTypes,
+		DependencyIndexes: file_rpc_list_reconciliation_discrepancies_proto_depIdxs,
+		MessageInfos:      file_rpc_list_reconciliation_discrepancies_proto_msgTypes,
+	}.Build()
+	File_rpc_list_reconciliation_discrepancies_proto = out.File
+	file_rpc_list_reconciliation_discrepancies_proto_rawDesc = nil
+	file_rpc_list_reconciliation_discrepancies_proto_goTypes = nil
+	file_rpc_list_reconciliation_discrepancies_proto_depIdxs = nil
+}
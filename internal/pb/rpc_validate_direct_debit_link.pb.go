@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.2
+// source: rpc_validate_direct_debit_link.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidateDirectDebitLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentCustomerId string `protobuf:"bytes,1,opt,name=payment_customer_id,json=paymentCustomerId,proto3" json:"payment_customer_id,omitempty"`
+	PaymentMethodId   string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	OtpCode           string `protobuf:"bytes,3,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+}
+
+func (x *ValidateDirectDebitLinkRequest) Reset() {
+	*x = ValidateDirectDebitLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_validate_direct_debit_link_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDirectDebitLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDirectDebitLinkRequest) ProtoMessage() {}
+
+func (x *ValidateDirectDebitLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_validate_direct_debit_link_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDirectDebitLinkRequest.ProtoReflect.Descriptor instead.
+func (*ValidateDirectDebitLinkRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_validate_direct_debit_link_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidateDirectDebitLinkRequest) GetPaymentCustomerId() string {
+	if x != nil {
+		return x.PaymentCustomerId
+	}
+	return ""
+}
+
+func (x *ValidateDirectDebitLinkRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *ValidateDirectDebitLinkRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type ValidateDirectDebitLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethod *PaymentMethod `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+}
+
+func (x *ValidateDirectDebitLinkResponse) Reset() {
+	*x = ValidateDirectDebitLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_validate_direct_debit_link_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateDirectDebitLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDirectDebitLinkResponse) ProtoMessage() {}
+
+func (x *ValidateDirectDebitLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_validate_direct_debit_link_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDirectDebitLinkResponse.ProtoReflect.Descriptor instead.
+func (*ValidateDirectDebitLinkResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_validate_direct_debit_link_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidateDirectDebitLinkResponse) GetPaymentMethod() *PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+var File_rpc_validate_direct_debit_link_proto protoreflect.FileDescriptor
+
+var file_rpc_validate_direct_debit_link_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x72, 0x70, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a,
+	0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x62, 0x69, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x58, 0x0a, 0x1f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x61, 0x6e, 0x64, 0x79, 0x73, 0x75, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x2f, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x2d, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_validate_direct_debit_link_proto_rawDescOnce sync.Once
+	file_rpc_validate_direct_debit_link_proto_rawDescData = file_rpc_validate_direct_debit_link_proto_rawDesc
+)
+
+func file_rpc_validate_direct_debit_link_proto_rawDescGZIP() []byte {
+	file_rpc_validate_direct_debit_link_proto_rawDescOnce.Do(func() {
+		file_rpc_validate_direct_debit_link_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_validate_direct_debit_link_proto_rawDescData)
+	})
+	return file_rpc_validate_direct_debit_link_proto_rawDescData
+}
+
+var file_rpc_validate_direct_debit_link_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_validate_direct_debit_link_proto_goTypes = []interface{}{
+	(*ValidateDirectDebitLinkRequest)(nil),  // 0: ValidateDirectDebitLinkRequest
+	(*ValidateDirectDebitLinkResponse)(nil), // 1: ValidateDirectDebitLinkResponse
+	(*PaymentMethod)(nil),                   // 2: PaymentMethod
+}
+var file_rpc_validate_direct_debit_link_proto_depIdxs = []int32{
+	2, // 0: ValidateDirectDebitLinkResponse.payment_method:type_name -> PaymentMethod
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_validate_direct_debit_link_proto_init() }
+func file_rpc_validate_direct_debit_link_proto_init() {
+	if File_rpc_validate_direct_debit_link_proto != nil {
+		return
+	}
+	file_payment_method_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_validate_direct_debit_link_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateDirectDebitLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_validate_direct_debit_link_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateDirectDebitLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_validate_direct_debit_link_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_validate_direct_debit_link_proto_goTypes,
+		DependencyIndexes: file_rpc_validate_direct_debit_link_proto_depIdxs,
+		MessageInfos:      file_rpc_validate_direct_debit_link_proto_msgTypes,
+	}.Build()
+	File_rpc_validate_direct_debit_link_proto = out.File
+	file_rpc_validate_direct_debit_link_proto_rawDesc = nil
+	file_rpc_validate_direct_debit_link_proto_goTypes = nil
+	file_rpc_validate_direct_debit_link_proto_depIdxs = nil
+}
@@ -69,6 +69,92 @@ func GetErrorStatusCode(err error) codes.Code {
 		return codes.InvalidArgument
 	case CheckErrMessage(err, unierror.ErrReferenceIDShouldNotBeEmpty.Error()):
 		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrPaymentNotRefundable.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrRefundAmountExceeded.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvalidRefundReason.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrPaymentNotCancelable.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrInvalidPaginationCursor.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvalidTimeRange.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrDuplicateActivePayment.Error()):
+		return codes.AlreadyExists
+	case CheckErrMessage(err, unierror.ErrPaymentIdempotencyKeyReused.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrPaymentIdempotencyKeyInProgress.Error()):
+		return codes.Aborted
+	case CheckErrMessage(err, unierror.ErrInvalidRefundAmount.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvalidAmountPrecision.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrUnsupportedCurrency.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrChannelCurrencyNotSupported.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrUnsupportedCountry.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrUnsupportedCaptureMethod.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrCardTokenRequired.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrPaymentNotCapturable.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrInvalidCaptureAmount.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrPaymentNotVoidable.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrLinkedPaymentMethodRequired.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrDirectDebitNotLinked.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrDirectDebitLinkNotPending.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrInvalidOTPCode.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrUnsupportedPaymentReusability.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvoiceChannelsRequired.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvoiceChannelNotAllowed.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrForUserIDRequired.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvalidSplitRule.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrSplitExceedsAmount.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrSplitNotSupportedForReusable.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrBankAccountNotFound.Error()):
+		return codes.NotFound
+	case CheckErrMessage(err, unierror.ErrBankAccountNameMismatch.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrIdempotencyKeyRequired.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrIdempotencyKeyReused.Error()):
+		return codes.FailedPrecondition
+	case CheckErrMessage(err, unierror.ErrUnsupportedPayoutChannel.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrInvalidAccountNumber.Error()):
+		return codes.InvalidArgument
+	case CheckErrMessage(err, unierror.ErrAuthorizationHeaderIsNotProvided.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrInvalidAuthorizationHeaderFormat.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrUnsupportedAuthorizationType.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrInvalidTokenType.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrTokenExpired.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrUnableToVerifyToken.Error()):
+		return codes.Unauthenticated
+	case CheckErrMessage(err, unierror.ErrTokenAccessDenied.Error()):
+		return codes.PermissionDenied
 	}
 	return codes.Internal
 }
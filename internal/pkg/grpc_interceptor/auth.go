@@ -0,0 +1,356 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	grpcError "github.com/handysuherman/clean-arch-payment-service/internal/pkg/grpc_error"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/token"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// publicMethodPrefixes are served without a token so probes and tooling keep working.
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// AccessRule describes which callers may invoke a gRPC method.
+type AccessRule struct {
+	// SystemLevels lists the token system levels allowed to call the method.
+	SystemLevels []int32
+	// RoleLevels narrows aplikator callers down to the listed roles, any role passes when it is empty.
+	RoleLevels []int32
+	// OwnCustomer limits callers below the system level to the customer their token was issued for.
+	OwnCustomer bool
+}
+
+func (r AccessRule) allows(claimer *token.Claimer) bool {
+	if !slices.Contains(r.SystemLevels, claimer.SystemLevel) {
+		return false
+	}
+
+	if claimer.SystemLevel == constants.AplikatorLevel && len(r.RoleLevels) > 0 {
+		return slices.Contains(r.RoleLevels, claimer.RoleLevel)
+	}
+
+	return true
+}
+
+func (r AccessRule) requiresOwner(claimer *token.Claimer) bool {
+	return r.OwnCustomer && claimer.SystemLevel != constants.SystemLevel
+}
+
+// CustomerOwnerResolver returns the app uid of the customer a payment gateway customer id was issued to.
+type CustomerOwnerResolver func(ctx context.Context, paymentCustomerID string) (string, error)
+
+type customerUidGetter interface {
+	GetCustomerUid() string
+}
+
+type paymentCustomerIdGetter interface {
+	GetPaymentCustomerId() string
+}
+
+type paymentMethodGetter interface {
+	GetPaymentMethod() *pb.PaymentMethod
+}
+
+type paymentMethodLister interface {
+	GetList() []*pb.PaymentMethod
+}
+
+type payloadContextKey struct{}
+
+// PayloadFromContext returns the verified token payload the auth interceptor attached to ctx.
+func PayloadFromContext(ctx context.Context) (*token.Payload, bool) {
+	payload, ok := ctx.Value(payloadContextKey{}).(*token.Payload)
+	return payload, ok
+}
+
+// AuthInterceptor verifies the Paseto access token sent in the authorization metadata and enforces the
+// access rule registered for the called method. Methods without a rule are denied.
+type AuthInterceptor struct {
+	log      logger.Logger
+	mu       sync.RWMutex
+	maker    token.Maker
+	rules    map[string]AccessRule
+	resolver CustomerOwnerResolver
+}
+
+func NewAuthInterceptor(
+	log logger.Logger,
+	maker token.Maker,
+	rules map[string]AccessRule,
+	resolver CustomerOwnerResolver,
+) *AuthInterceptor {
+	return &AuthInterceptor{
+		log:      log.WithPrefix("grpc-auth-interceptor"),
+		maker:    maker,
+		rules:    rules,
+		resolver: resolver,
+	}
+}
+
+func (ai *AuthInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if isPublicMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	payload, rule, err := ai.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rule.requiresOwner(payload.Claimer) {
+		return handler(context.WithValue(ctx, payloadContextKey{}, payload), req)
+	}
+
+	// requests without a customer identifier, such as lookups by reference id, are checked on what they return
+	named, err := ai.verifyRequestOwner(ctx, payload.Claimer, req)
+	if err != nil {
+		return nil, ai.deny(info.FullMethod, payload.Claimer, err)
+	}
+
+	res, err := handler(context.WithValue(ctx, payloadContextKey{}, payload), req)
+	if err != nil || named {
+		return res, err
+	}
+
+	if err := ai.verifyResponseOwner(ctx, payload.Claimer, res); err != nil {
+		return nil, ai.deny(info.FullMethod, payload.Claimer, err)
+	}
+
+	return res, nil
+}
+
+func (ai *AuthInterceptor) Stream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if isPublicMethod(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	payload, rule, err := ai.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), payloadContextKey{}, payload),
+		verify: func(msg interface{}) error {
+			if !rule.requiresOwner(payload.Claimer) {
+				return nil
+			}
+
+			named, err := ai.verifyRequestOwner(ss.Context(), payload.Claimer, msg)
+			if err == nil && !named {
+				err = unierror.ErrTokenAccessDenied
+			}
+
+			if err != nil {
+				return ai.deny(info.FullMethod, payload.Claimer, err)
+			}
+
+			return nil
+		},
+	})
+}
+
+func (ai *AuthInterceptor) OnTokenUpdate(key string, maker token.Maker) {
+	ai.log.Infof("received a token maker update from '%s' key", key)
+
+	if maker == nil {
+		ai.log.Warnf("ignoring empty token maker update from '%s' key", key)
+		return
+	}
+
+	ai.mu.Lock()
+	ai.maker = maker
+	ai.mu.Unlock()
+
+	ai.log.Infof("updated token maker from '%s' key successfully applied", key)
+}
+
+func (ai *AuthInterceptor) authorize(ctx context.Context, method string) (*token.Payload, AccessRule, error) {
+	rule, ok := ai.rules[method]
+	if !ok {
+		ai.log.Warnf("no access rule registered for %s", method)
+		return nil, rule, grpcError.ErrorResponse(unierror.ErrTokenAccessDenied)
+	}
+
+	payload, err := ai.verifyToken(ctx)
+	if err != nil {
+		ai.log.Debugf("rejected unauthenticated call to %s: %v", method, err)
+		return nil, rule, grpcError.ErrorResponse(err)
+	}
+
+	if !rule.allows(payload.Claimer) {
+		return nil, rule, ai.deny(method, payload.Claimer, unierror.ErrTokenAccessDenied)
+	}
+
+	return payload, rule, nil
+}
+
+func (ai *AuthInterceptor) verifyToken(ctx context.Context) (*token.Payload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, unierror.ErrAuthorizationHeaderIsNotProvided
+	}
+
+	values := md.Get(constants.Authorization)
+	if len(values) == 0 {
+		return nil, unierror.ErrAuthorizationHeaderIsNotProvided
+	}
+
+	fields := strings.Fields(values[0])
+	if len(fields) != 2 {
+		return nil, unierror.ErrInvalidAuthorizationHeaderFormat
+	}
+
+	if !strings.EqualFold(fields[0], constants.Bearer) {
+		return nil, unierror.ErrUnsupportedAuthorizationType
+	}
+
+	ai.mu.RLock()
+	maker := ai.maker
+	ai.mu.RUnlock()
+
+	if maker == nil {
+		return nil, unierror.ErrUnableToVerifyToken
+	}
+
+	payload, err := maker.VerifyToken(fields[1], constants.AccessType)
+	if err != nil {
+		if errors.Is(err, unierror.ErrTokenExpired) || errors.Is(err, unierror.ErrInvalidTokenType) {
+			return nil, err
+		}
+		return nil, unierror.ErrUnableToVerifyToken
+	}
+
+	if payload.Claimer == nil || payload.Claimer.UID == "" {
+		return nil, unierror.ErrUnableToVerifyToken
+	}
+
+	return payload, nil
+}
+
+// verifyRequestOwner reports whether req names a customer, and fails when that customer is not the caller.
+func (ai *AuthInterceptor) verifyRequestOwner(ctx context.Context, claimer *token.Claimer, req interface{}) (bool, error) {
+	switch r := req.(type) {
+	case customerUidGetter:
+		if r.GetCustomerUid() != claimer.UID {
+			return true, unierror.ErrTokenAccessDenied
+		}
+		return true, nil
+	case paymentCustomerIdGetter:
+		return true, ai.verifyPaymentCustomer(ctx, claimer, r.GetPaymentCustomerId())
+	}
+
+	return false, nil
+}
+
+func (ai *AuthInterceptor) verifyResponseOwner(ctx context.Context, claimer *token.Claimer, res interface{}) error {
+	var list []*pb.PaymentMethod
+
+	switch r := res.(type) {
+	case paymentMethodLister:
+		list = r.GetList()
+	case paymentMethodGetter:
+		list = []*pb.PaymentMethod{r.GetPaymentMethod()}
+	default:
+		return unierror.ErrTokenAccessDenied
+	}
+
+	verified := make(map[string]struct{})
+	for _, pm := range list {
+		paymentCustomerID := pm.GetPaymentCustomerId()
+		if _, ok := verified[paymentCustomerID]; ok {
+			continue
+		}
+
+		if err := ai.verifyPaymentCustomer(ctx, claimer, paymentCustomerID); err != nil {
+			return err
+		}
+		verified[paymentCustomerID] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ai *AuthInterceptor) verifyPaymentCustomer(ctx context.Context, claimer *token.Claimer, paymentCustomerID string) error {
+	if paymentCustomerID == "" {
+		return unierror.ErrTokenAccessDenied
+	}
+
+	customerAppID, err := ai.resolver(ctx, paymentCustomerID)
+	if err != nil {
+		// an unknown customer is reported the same way as someone else's, so ids cannot be probed
+		if errors.Is(err, pgx.ErrNoRows) {
+			return unierror.ErrTokenAccessDenied
+		}
+		return err
+	}
+
+	if customerAppID != claimer.UID {
+		return unierror.ErrTokenAccessDenied
+	}
+
+	return nil
+}
+
+func (ai *AuthInterceptor) deny(method string, claimer *token.Claimer, err error) error {
+	if errors.Is(err, unierror.ErrTokenAccessDenied) {
+		ai.log.Warnf("denied %s to uid %s with system level %d and role level %d", method, claimer.UID, claimer.SystemLevel, claimer.RoleLevel)
+	} else {
+		ai.log.Errorf("unable to verify access to %s: %v", method, err)
+	}
+
+	return grpcError.ErrorResponse(err)
+}
+
+func isPublicMethod(method string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	verify func(msg interface{}) error
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.verify(m)
+}
@@ -0,0 +1,488 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/handysuherman/clean-arch-payment-service/internal/pb"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/constants"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/helper"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/logger"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/token"
+	"github.com/handysuherman/clean-arch-payment-service/internal/pkg/unierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testCreateMethod        = "/pb.PaymentService/Create"
+	testGetByIDMethod       = "/pb.PaymentService/GetByID"
+	testListPaymentsMethod  = "/pb.PaymentService/ListPayments"
+	testGetByRefMethod      = "/pb.PaymentService/GetByReferenceID"
+	testRefundMethod        = "/pb.PaymentService/Refund"
+	testDeadLetterMethod    = "/pb.PaymentService/ListDeadLetterMessages"
+	testUnregisteredMethod  = "/pb.PaymentService/Unregistered"
+	testHealthCheckMethod   = "/grpc.health.v1.Health/Check"
+	testReflectionMethod    = "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+	testResolverErrCustomer = "resolver-unavailable"
+)
+
+var errResolverUnavailable = errors.New("database unavailable")
+
+func testAccessRules() map[string]AccessRule {
+	customer := AccessRule{
+		SystemLevels: []int32{constants.StokistLevel, constants.PelangganLevel, constants.SystemLevel},
+		OwnCustomer:  true,
+	}
+	backOffice := AccessRule{
+		SystemLevels: []int32{constants.AplikatorLevel, constants.SystemLevel},
+		RoleLevels:   []int32{constants.ManagerLevel, constants.AkuntingLevel},
+	}
+
+	return map[string]AccessRule{
+		testCreateMethod:       customer,
+		testGetByIDMethod:      customer,
+		testListPaymentsMethod: customer,
+		testGetByRefMethod:     customer,
+		testRefundMethod:       backOffice,
+		testDeadLetterMethod:   {SystemLevels: []int32{constants.SystemLevel}},
+	}
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	maker := createTestMaker(t)
+	otherMaker := createTestMaker(t)
+
+	owner := helper.RandomString(26)
+	stranger := helper.RandomString(26)
+	ownPaymentCustomer := helper.RandomString(26)
+	strangerPaymentCustomer := helper.RandomString(26)
+
+	// the customers the way the create rpc stores them, under the uid of the caller who created them.
+	customers := map[string]string{
+		ownPaymentCustomer:      owner,
+		strangerPaymentCustomer: stranger,
+	}
+
+	resolver := func(_ context.Context, paymentCustomerID string) (string, error) {
+		if paymentCustomerID == testResolverErrCustomer {
+			return "", errResolverUnavailable
+		}
+
+		customerAppID, ok := customers[paymentCustomerID]
+		if !ok {
+			return "", pgx.ErrNoRows
+		}
+		return customerAppID, nil
+	}
+
+	pelanggan := &token.Claimer{UID: owner, SystemLevel: constants.PelangganLevel}
+	system := &token.Claimer{UID: helper.RandomString(26), SystemLevel: constants.SystemLevel}
+	manager := &token.Claimer{UID: helper.RandomString(26), SystemLevel: constants.AplikatorLevel, RoleLevel: constants.ManagerLevel}
+	inventory := &token.Claimer{UID: helper.RandomString(26), SystemLevel: constants.AplikatorLevel, RoleLevel: constants.InventoryLevel}
+
+	bearer := func(claimer *token.Claimer) string {
+		return constants.Bearer + " " + createTestToken(t, maker, claimer, time.Minute, constants.AccessType)
+	}
+
+	ownCreate := &pb.CreatePaymentRequest{CustomerUid: &owner}
+	ownPayment := &pb.PaymentMethod{PaymentCustomerId: ownPaymentCustomer}
+	strangerPayment := &pb.PaymentMethod{PaymentCustomerId: strangerPaymentCustomer}
+
+	testCases := []struct {
+		tname         string
+		method        string
+		authorization string
+		req           interface{}
+		res           interface{}
+		handled       bool
+		code          codes.Code
+		err           error
+	}{
+		{
+			tname:   "OK_PUBLIC_HEALTH_CHECK",
+			method:  testHealthCheckMethod,
+			req:     struct{}{},
+			handled: true,
+			code:    codes.OK,
+		},
+		{
+			tname:  "ERR_MISSING_TOKEN",
+			method: testCreateMethod,
+			req:    ownCreate,
+			code:   codes.Unauthenticated,
+			err:    unierror.ErrAuthorizationHeaderIsNotProvided,
+		},
+		{
+			tname:         "ERR_MALFORMED_AUTHORIZATION_HEADER",
+			method:        testCreateMethod,
+			authorization: constants.Bearer,
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrInvalidAuthorizationHeaderFormat,
+		},
+		{
+			tname:         "ERR_UNSUPPORTED_AUTHORIZATION_TYPE",
+			method:        testCreateMethod,
+			authorization: "Basic " + helper.RandomString(32),
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrUnsupportedAuthorizationType,
+		},
+		{
+			tname:         "ERR_MALFORMED_TOKEN",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + helper.RandomString(64),
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrUnableToVerifyToken,
+		},
+		{
+			tname:         "ERR_TOKEN_SIGNED_BY_ANOTHER_KEY",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, otherMaker, pelanggan, time.Minute, constants.AccessType),
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrUnableToVerifyToken,
+		},
+		{
+			tname:         "ERR_EXPIRED_TOKEN",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, maker, pelanggan, -time.Minute, constants.AccessType),
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrTokenExpired,
+		},
+		{
+			tname:         "ERR_REFRESH_TOKEN",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, maker, pelanggan, time.Minute, constants.RefreshType),
+			req:           ownCreate,
+			code:          codes.Unauthenticated,
+			err:           unierror.ErrInvalidTokenType,
+		},
+		{
+			tname:         "ERR_METHOD_WITHOUT_RULE",
+			method:        testUnregisteredMethod,
+			authorization: bearer(system),
+			req:           struct{}{},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_SYSTEM_LEVEL_NOT_ALLOWED",
+			method:        testDeadLetterMethod,
+			authorization: bearer(manager),
+			req:           struct{}{},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_CUSTOMER_ON_BACK_OFFICE_METHOD",
+			method:        testRefundMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.RefundPaymentRequest{PaymentCustomerId: ownPaymentCustomer},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_ROLE_LEVEL_NOT_ALLOWED",
+			method:        testRefundMethod,
+			authorization: bearer(inventory),
+			req:           &pb.RefundPaymentRequest{},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "OK_ROLE_LEVEL_ALLOWED",
+			method:        testRefundMethod,
+			authorization: bearer(manager),
+			req:           &pb.RefundPaymentRequest{PaymentCustomerId: strangerPaymentCustomer},
+			handled:       true,
+			code:          codes.OK,
+		},
+		{
+			tname:         "OK_SYSTEM_LEVEL_SKIPS_OWNER_CHECK",
+			method:        testCreateMethod,
+			authorization: bearer(system),
+			req:           &pb.CreatePaymentRequest{CustomerUid: &stranger},
+			res:           &pb.CreatePaymentResponse{PaymentMethod: strangerPayment},
+			handled:       true,
+			code:          codes.OK,
+		},
+		{
+			tname:         "OK_REQUEST_OWNER",
+			method:        testCreateMethod,
+			authorization: bearer(pelanggan),
+			req:           ownCreate,
+			res:           &pb.CreatePaymentResponse{PaymentMethod: ownPayment},
+			handled:       true,
+			code:          codes.OK,
+		},
+		{
+			tname:         "ERR_REQUEST_OWNER_MISMATCH",
+			method:        testCreateMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.CreatePaymentRequest{CustomerUid: &stranger},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "OK_OWNER_OF_PAYMENT_CUSTOMER",
+			method:        testGetByIDMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByIDPaymentRequest{PaymentCustomerId: ownPaymentCustomer},
+			res:           &pb.GetByIDPaymentResponse{PaymentMethod: ownPayment},
+			handled:       true,
+			code:          codes.OK,
+		},
+		{
+			tname:         "ERR_PAYMENT_CUSTOMER_OF_ANOTHER_UID",
+			method:        testGetByIDMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByIDPaymentRequest{PaymentCustomerId: strangerPaymentCustomer},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_UNKNOWN_PAYMENT_CUSTOMER",
+			method:        testGetByIDMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByIDPaymentRequest{PaymentCustomerId: helper.RandomString(26)},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_RESOLVE_PAYMENT_CUSTOMER",
+			method:        testGetByIDMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByIDPaymentRequest{PaymentCustomerId: testResolverErrCustomer},
+			code:          codes.Internal,
+			err:           errResolverUnavailable,
+		},
+		{
+			tname:         "OK_RESPONSE_OWNER",
+			method:        testGetByRefMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByReferenceIDPaymentRequest{PaymentReferenceId: helper.RandomString(26)},
+			res:           &pb.GetByReferenceIDPaymentResponse{List: []*pb.PaymentMethod{ownPayment, ownPayment}},
+			handled:       true,
+			code:          codes.OK,
+		},
+		{
+			tname:         "ERR_RESPONSE_OWNER_MISMATCH",
+			method:        testGetByRefMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByReferenceIDPaymentRequest{PaymentReferenceId: helper.RandomString(26)},
+			res:           &pb.GetByReferenceIDPaymentResponse{List: []*pb.PaymentMethod{ownPayment, strangerPayment}},
+			handled:       true,
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_REQUEST_WITHOUT_PAYMENT_CUSTOMER",
+			method:        testListPaymentsMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.ListPaymentsRequest{},
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+		{
+			tname:         "ERR_UNCHECKED_RESPONSE",
+			method:        testGetByRefMethod,
+			authorization: bearer(pelanggan),
+			req:           &pb.GetByReferenceIDPaymentRequest{PaymentReferenceId: helper.RandomString(26)},
+			res:           struct{}{},
+			handled:       true,
+			code:          codes.PermissionDenied,
+			err:           unierror.ErrTokenAccessDenied,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			ai := NewAuthInterceptor(logger.NewLogger(), maker, testAccessRules(), resolver)
+
+			ctx := context.Background()
+			if tc.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(constants.Authorization, tc.authorization))
+			}
+
+			handled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+
+				if !isPublicMethod(tc.method) {
+					payload, ok := PayloadFromContext(ctx)
+					require.True(t, ok)
+					require.NotEmpty(t, payload.Claimer.UID)
+				}
+
+				return tc.res, nil
+			}
+
+			res, err := ai.Unary(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.handled, handled)
+			require.Equal(t, tc.code, status.Code(err))
+
+			if tc.err != nil {
+				require.Equal(t, tc.err.Error(), status.Convert(err).Message())
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	maker := createTestMaker(t)
+	owner := helper.RandomString(26)
+	stranger := helper.RandomString(26)
+	pelanggan := &token.Claimer{UID: owner, SystemLevel: constants.PelangganLevel}
+
+	testCases := []struct {
+		tname         string
+		method        string
+		authorization string
+		msg           interface{}
+		code          codes.Code
+	}{
+		{
+			tname:  "OK_PUBLIC_REFLECTION",
+			method: testReflectionMethod,
+			msg:    struct{}{},
+			code:   codes.OK,
+		},
+		{
+			tname:  "ERR_MISSING_TOKEN",
+			method: testCreateMethod,
+			msg:    &pb.CreatePaymentRequest{CustomerUid: &owner},
+			code:   codes.Unauthenticated,
+		},
+		{
+			tname:         "OK_MESSAGE_OWNER",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, maker, pelanggan, time.Minute, constants.AccessType),
+			msg:           &pb.CreatePaymentRequest{CustomerUid: &owner},
+			code:          codes.OK,
+		},
+		{
+			tname:         "ERR_MESSAGE_OWNER_MISMATCH",
+			method:        testCreateMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, maker, pelanggan, time.Minute, constants.AccessType),
+			msg:           &pb.CreatePaymentRequest{CustomerUid: &stranger},
+			code:          codes.PermissionDenied,
+		},
+		{
+			tname:         "ERR_MESSAGE_WITHOUT_CUSTOMER",
+			method:        testGetByRefMethod,
+			authorization: constants.Bearer + " " + createTestToken(t, maker, pelanggan, time.Minute, constants.AccessType),
+			msg:           &pb.GetByReferenceIDPaymentRequest{},
+			code:          codes.PermissionDenied,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			ai := NewAuthInterceptor(logger.NewLogger(), maker, testAccessRules(), func(context.Context, string) (string, error) {
+				return "", pgx.ErrNoRows
+			})
+
+			ctx := context.Background()
+			if tc.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(constants.Authorization, tc.authorization))
+			}
+
+			handler := func(_ interface{}, ss grpc.ServerStream) error {
+				return ss.RecvMsg(tc.msg)
+			}
+
+			err := ai.Stream(nil, &fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptorOnTokenUpdate(t *testing.T) {
+	maker := createTestMaker(t)
+	rotated := createTestMaker(t)
+
+	claimer := &token.Claimer{UID: helper.RandomString(26), SystemLevel: constants.SystemLevel}
+	info := &grpc.UnaryServerInfo{FullMethod: testDeadLetterMethod}
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return struct{}{}, nil
+	}
+
+	call := func(ai *AuthInterceptor, signer token.Maker) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			constants.Authorization,
+			constants.Bearer+" "+createTestToken(t, signer, claimer, time.Minute, constants.AccessType),
+		))
+
+		_, err := ai.Unary(ctx, struct{}{}, info, handler)
+		return err
+	}
+
+	ai := NewAuthInterceptor(logger.NewLogger(), maker, testAccessRules(), nil)
+	require.NoError(t, call(ai, maker))
+	require.Equal(t, codes.Unauthenticated, status.Code(call(ai, rotated)))
+
+	// the keys are rotated, tokens signed with the previous ones are no longer accepted.
+	ai.OnTokenUpdate(helper.RandomString(12), rotated)
+	require.NoError(t, call(ai, rotated))
+	require.Equal(t, codes.Unauthenticated, status.Code(call(ai, maker)))
+
+	// an update without a maker keeps the current one.
+	ai.OnTokenUpdate(helper.RandomString(12), nil)
+	require.NoError(t, call(ai, rotated))
+}
+
+func createTestMaker(t *testing.T) token.Maker {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	nonce := make([]byte, 12)
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+
+	maker, err := token.NewPaseto(privKey, pubKey, "", base64.StdEncoding.EncodeToString(nonce))
+	require.NoError(t, err)
+
+	return maker
+}
+
+func createTestToken(t *testing.T, maker token.Maker, claimer *token.Claimer, duration time.Duration, tokenType string) string {
+	tok, _, err := maker.CreateToken(claimer, duration, tokenType)
+	require.NoError(t, err)
+
+	return tok
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(interface{}) error {
+	return nil
+}
@@ -11,4 +11,8 @@ type Topic struct {
 	TopicName         string `mapstructure:"topicName"`
 	Partitions        uint8  `mapstructure:"partitions"`
 	ReplicationFactor uint8  `mapstructure:"replicationFactor"`
+	// RetryTopicName and DeadLetterTopicName are only set on the consumed topics, a message of the topic
+	// which failed is delayed on the first and quarantined on the second.
+	RetryTopicName      string `mapstructure:"retryTopicName"`
+	DeadLetterTopicName string `mapstructure:"deadLetterTopicName"`
 }
@@ -0,0 +1,186 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// the headers a message carries once it failed, they are added next to its original headers.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderError             = "x-error"
+	HeaderAttempts          = "x-attempts"
+	HeaderFirstSeenAt       = "x-first-seen-at"
+	HeaderRetryAt           = "x-retry-at"
+	HeaderDeadLetteredAt    = "x-dead-lettered-at"
+	HeaderReplayedFrom      = "x-replayed-from"
+
+	deadLetterHeaderPrefix = "x-"
+)
+
+// RetryGroupIDSuffix keeps the consumers of a retry topic in their own consumer group, a message waiting
+// for its retry never holds back the topic it was first read from.
+const RetryGroupIDSuffix = "_retry"
+
+// DeadLetter configures the retry tier and the dead-letter topics of the consumed topics. A message which
+// failed for a reason which may go away is published to the retry topic and processed again after
+// RetryDelay, up to MaxRetries times, before it ends up in the dead-letter topic.
+type DeadLetter struct {
+	Enable     bool          `mapstructure:"enable"`
+	RetryDelay time.Duration `mapstructure:"retryDelay"`
+	MaxRetries int           `mapstructure:"maxRetries"`
+}
+
+// FailedMessage describes why and since when a message keeps failing, it travels with the message in its headers.
+type FailedMessage struct {
+	OriginalTopic     string
+	OriginalPartition int
+	OriginalOffset    int64
+	Error             string
+	Attempts          int
+	FirstSeenAt       time.Time
+	RetryAt           time.Time
+	DeadLetteredAt    time.Time
+}
+
+// ReadFailedMessage reads the failure headers of a message, a message which never failed before is
+// described by its own coordinates with no attempts.
+func ReadFailedMessage(msg kafka.Message) *FailedMessage {
+	res := &FailedMessage{
+		OriginalTopic:     msg.Topic,
+		OriginalPartition: msg.Partition,
+		OriginalOffset:    msg.Offset,
+		FirstSeenAt:       msg.Time,
+	}
+
+	for _, header := range msg.Headers {
+		value := string(header.Value)
+
+		switch header.Key {
+		case HeaderOriginalTopic:
+			res.OriginalTopic = value
+		case HeaderOriginalPartition:
+			if partition, err := strconv.Atoi(value); err == nil {
+				res.OriginalPartition = partition
+			}
+		case HeaderOriginalOffset:
+			if offset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				res.OriginalOffset = offset
+			}
+		case HeaderError:
+			res.Error = value
+		case HeaderAttempts:
+			if attempts, err := strconv.Atoi(value); err == nil {
+				res.Attempts = attempts
+			}
+		case HeaderFirstSeenAt:
+			res.FirstSeenAt = parseHeaderTime(value)
+		case HeaderRetryAt:
+			res.RetryAt = parseHeaderTime(value)
+		case HeaderDeadLetteredAt:
+			res.DeadLetteredAt = parseHeaderTime(value)
+		}
+	}
+
+	return res
+}
+
+// NewFailedMessage copies msg onto topic with the failure headers of failed in place of the ones it had.
+func NewFailedMessage(msg kafka.Message, topic string, failed *FailedMessage) kafka.Message {
+	headers := OriginalHeaders(msg.Headers)
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(failed.OriginalTopic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(strconv.Itoa(failed.OriginalPartition))},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(strconv.FormatInt(failed.OriginalOffset, 10))},
+		kafka.Header{Key: HeaderError, Value: []byte(failed.Error)},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(failed.Attempts))},
+		kafka.Header{Key: HeaderFirstSeenAt, Value: []byte(failed.FirstSeenAt.UTC().Format(time.RFC3339Nano))},
+	)
+
+	if !failed.RetryAt.IsZero() {
+		headers = append(headers, kafka.Header{Key: HeaderRetryAt, Value: []byte(failed.RetryAt.UTC().Format(time.RFC3339Nano))})
+	}
+
+	if !failed.DeadLetteredAt.IsZero() {
+		headers = append(headers, kafka.Header{Key: HeaderDeadLetteredAt, Value: []byte(failed.DeadLetteredAt.UTC().Format(time.RFC3339Nano))})
+	}
+
+	return kafka.Message{
+		Topic:   topic,
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+		Time:    time.Now().UTC(),
+	}
+}
+
+// NextFailedMessage records one more failed attempt of msg and moves it to the retry topic while the
+// failure may go away and retries are left, to the dead-letter topic otherwise.
+func NextFailedMessage(msg kafka.Message, cfg *DeadLetter, retryTopic, deadLetterTopic string, cause error, permanent bool) kafka.Message {
+	now := time.Now().UTC()
+
+	failed := ReadFailedMessage(msg)
+	failed.Error = cause.Error()
+	failed.Attempts++
+	failed.RetryAt = time.Time{}
+
+	if !permanent && failed.Attempts <= cfg.MaxRetries {
+		failed.RetryAt = now.Add(cfg.RetryDelay)
+		return NewFailedMessage(msg, retryTopic, failed)
+	}
+
+	failed.DeadLetteredAt = now
+	return NewFailedMessage(msg, deadLetterTopic, failed)
+}
+
+// WaitForRetry blocks until a message read from a retry topic is due, it returns early once ctx is done.
+func WaitForRetry(ctx context.Context, msg kafka.Message) error {
+	retryAt := ReadFailedMessage(msg).RetryAt
+	if retryAt.IsZero() {
+		return nil
+	}
+
+	wait := time.Until(retryAt)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// OriginalHeaders returns the headers a message was first published with, without the failure headers.
+func OriginalHeaders(headers []kafka.Header) []kafka.Header {
+	res := make([]kafka.Header, 0, len(headers))
+	for _, header := range headers {
+		if strings.HasPrefix(header.Key, deadLetterHeaderPrefix) {
+			continue
+		}
+
+		res = append(res, header)
+	}
+
+	return res
+}
+
+func parseHeaderTime(value string) time.Time {
+	res, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return res
+}
@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// FetchWorkerID is the worker of a pool which fetches the messages, the other workers of the pool are
+// handed the messages through a PartitionDispatcher.
+const FetchWorkerID = 0
+
+// PartitionDispatcher hands every message of a partition to the same worker. A worker processes and
+// commits the messages it is handed one after the other, so a message it holds back keeps the later
+// offsets of its partition from being committed by another worker.
+type PartitionDispatcher struct {
+	workers []chan kafka.Message
+}
+
+// NewPartitionDispatcher creates a dispatcher for the workers 1 to poolSize of a pool.
+func NewPartitionDispatcher(poolSize int) *PartitionDispatcher {
+	if poolSize < 1 {
+		poolSize = 1
+	}
+
+	workers := make([]chan kafka.Message, poolSize)
+	for i := range workers {
+		workers[i] = make(chan kafka.Message)
+	}
+
+	return &PartitionDispatcher{workers: workers}
+}
+
+// Dispatch blocks until the worker owning the partition of msg takes it, it returns early once ctx is done.
+func (d *PartitionDispatcher) Dispatch(ctx context.Context, msg kafka.Message) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case d.workers[d.worker(msg.Topic, msg.Partition)-1] <- msg:
+		return nil
+	}
+}
+
+// Messages returns the messages handed to workerID, nil for a worker the dispatcher does not know of.
+func (d *PartitionDispatcher) Messages(workerID int) <-chan kafka.Message {
+	if workerID < 1 || workerID > len(d.workers) {
+		return nil
+	}
+
+	return d.workers[workerID-1]
+}
+
+func (d *PartitionDispatcher) worker(topic string, partition int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(topic))
+	_, _ = h.Write([]byte(strconv.Itoa(partition)))
+
+	return int(h.Sum32()%uint32(len(d.workers))) + 1
+}
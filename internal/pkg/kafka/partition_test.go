@@ -0,0 +1,93 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionDispatcher(t *testing.T) {
+	poolSize := 4
+	d := NewPartitionDispatcher(poolSize)
+
+	require.Nil(t, d.Messages(FetchWorkerID))
+	require.Nil(t, d.Messages(poolSize+1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		received = map[int][]kafka.Message{}
+		wg       sync.WaitGroup
+	)
+
+	total := 0
+	for workerID := 1; workerID <= poolSize; workerID++ {
+		wg.Add(1)
+		go func(workerID int) {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case msg := <-d.Messages(workerID):
+					mu.Lock()
+					received[workerID] = append(received[workerID], msg)
+					mu.Unlock()
+				}
+			}
+		}(workerID)
+	}
+
+	for offset := int64(0); offset < 20; offset++ {
+		for _, topic := range []string{"payment_status_update", "payment_status_update_dlq"} {
+			for partition := 0; partition < 3; partition++ {
+				require.NoError(t, d.Dispatch(ctx, kafka.Message{Topic: topic, Partition: partition, Offset: offset}))
+				total++
+			}
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		n := 0
+		for _, msgs := range received {
+			n += len(msgs)
+		}
+
+		return n == total
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+
+	// every partition is handed to a single worker, in the order its offsets were dispatched.
+	owners := map[string]int{}
+	next := map[string]int64{}
+	for workerID, msgs := range received {
+		for _, msg := range msgs {
+			key := msg.Topic + "/" + strconv.Itoa(msg.Partition)
+
+			owner, ok := owners[key]
+			if ok {
+				require.Equal(t, owner, workerID)
+			}
+			owners[key] = workerID
+
+			require.Equal(t, next[key], msg.Offset)
+			next[key]++
+		}
+	}
+	require.Len(t, owners, 6)
+
+	require.ErrorIs(t, d.Dispatch(ctx, kafka.Message{Topic: "payment_status_update"}), context.Canceled)
+}
@@ -0,0 +1,21 @@
+package payment
+
+const (
+	CAPTURE_METHOD_AUTOMATIC string = "AUTOMATIC"
+	CAPTURE_METHOD_MANUAL    string = "MANUAL"
+)
+
+const (
+	CAPTURE_STATUS_PENDING   string = "PENDING"
+	CAPTURE_STATUS_SUCCEEDED string = "SUCCEEDED"
+	CAPTURE_STATUS_FAILED    string = "FAILED"
+)
+
+func IsCaptureMethod(method string) bool {
+	switch method {
+	case CAPTURE_METHOD_AUTOMATIC, CAPTURE_METHOD_MANUAL:
+		return true
+	default:
+		return false
+	}
+}
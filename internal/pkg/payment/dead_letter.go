@@ -0,0 +1,8 @@
+package payment
+
+const (
+	// DEAD_LETTER_STATUS_QUARANTINED is a dead-lettered message waiting to be looked at.
+	DEAD_LETTER_STATUS_QUARANTINED string = "QUARANTINED"
+	// DEAD_LETTER_STATUS_REPLAYED is a message which was published back onto its source topic.
+	DEAD_LETTER_STATUS_REPLAYED string = "REPLAYED"
+)
@@ -0,0 +1,6 @@
+package payment
+
+const (
+	IDEMPOTENCY_STATUS_IN_PROGRESS string = "IN_PROGRESS"
+	IDEMPOTENCY_STATUS_COMPLETED   string = "COMPLETED"
+)
@@ -0,0 +1,26 @@
+package payment
+
+const (
+	INVOICE_STATUS_PENDING string = "PENDING"
+	INVOICE_STATUS_PAID    string = "PAID"
+	INVOICE_STATUS_SETTLED string = "SETTLED"
+	INVOICE_STATUS_EXPIRED string = "EXPIRED"
+)
+
+func IsInvoiceStatus(status string) bool {
+	switch status {
+	case INVOICE_STATUS_PENDING,
+		INVOICE_STATUS_PAID,
+		INVOICE_STATUS_SETTLED,
+		INVOICE_STATUS_EXPIRED:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInvoicePaidStatus reports whether an invoice in status has been paid, a SETTLED invoice is a PAID one
+// whose funds reached the balance.
+func IsInvoicePaidStatus(status string) bool {
+	return status == INVOICE_STATUS_PAID || status == INVOICE_STATUS_SETTLED
+}
@@ -0,0 +1,57 @@
+package payment
+
+import "github.com/shopspring/decimal"
+
+const (
+	// LEDGER_ACCOUNT_GATEWAY_CLEARING holds the funds collected at the gateway until they are settled.
+	LEDGER_ACCOUNT_GATEWAY_CLEARING string = "GATEWAY_CLEARING"
+	// LEDGER_ACCOUNT_MERCHANT_PAYABLE is what the payments collected for the merchant are owed to it.
+	LEDGER_ACCOUNT_MERCHANT_PAYABLE string = "MERCHANT_PAYABLE"
+	// LEDGER_ACCOUNT_SUB_ACCOUNT_PAYABLE is what is owed to a marketplace sub-account, one per sub-account.
+	LEDGER_ACCOUNT_SUB_ACCOUNT_PAYABLE  string = "SUB_ACCOUNT_PAYABLE"
+	LEDGER_ACCOUNT_PLATFORM_FEE_REVENUE string = "PLATFORM_FEE_REVENUE"
+	LEDGER_ACCOUNT_GATEWAY_FEE_EXPENSE  string = "GATEWAY_FEE_EXPENSE"
+)
+
+const (
+	LEDGER_ACCOUNT_TYPE_ASSET     string = "ASSET"
+	LEDGER_ACCOUNT_TYPE_LIABILITY string = "LIABILITY"
+	LEDGER_ACCOUNT_TYPE_REVENUE   string = "REVENUE"
+	LEDGER_ACCOUNT_TYPE_EXPENSE   string = "EXPENSE"
+)
+
+const (
+	LEDGER_DIRECTION_DEBIT  string = "DEBIT"
+	LEDGER_DIRECTION_CREDIT string = "CREDIT"
+)
+
+const (
+	LEDGER_ENTRY_TYPE_PAYMENT string = "PAYMENT"
+	LEDGER_ENTRY_TYPE_FEE     string = "FEE"
+	LEDGER_ENTRY_TYPE_REFUND  string = "REFUND"
+)
+
+// LedgerAccountType returns the type of a ledger account, an unknown account is reported as a liability.
+func LedgerAccountType(code string) string {
+	switch code {
+	case LEDGER_ACCOUNT_GATEWAY_CLEARING:
+		return LEDGER_ACCOUNT_TYPE_ASSET
+	case LEDGER_ACCOUNT_PLATFORM_FEE_REVENUE:
+		return LEDGER_ACCOUNT_TYPE_REVENUE
+	case LEDGER_ACCOUNT_GATEWAY_FEE_EXPENSE:
+		return LEDGER_ACCOUNT_TYPE_EXPENSE
+	default:
+		return LEDGER_ACCOUNT_TYPE_LIABILITY
+	}
+}
+
+// LedgerBalance returns the balance of an account on its normal side, assets and expenses grow with
+// their debits while liabilities and revenue grow with their credits.
+func LedgerBalance(accountType string, debit decimal.Decimal, credit decimal.Decimal) decimal.Decimal {
+	switch accountType {
+	case LEDGER_ACCOUNT_TYPE_ASSET, LEDGER_ACCOUNT_TYPE_EXPENSE:
+		return debit.Sub(credit)
+	default:
+		return credit.Sub(debit)
+	}
+}
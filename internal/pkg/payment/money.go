@@ -0,0 +1,77 @@
+package payment
+
+import "github.com/shopspring/decimal"
+
+const (
+	CURRENCY_IDR string = "IDR"
+	CURRENCY_PHP string = "PHP"
+	CURRENCY_THB string = "THB"
+	CURRENCY_VND string = "VND"
+	CURRENCY_MYR string = "MYR"
+	CURRENCY_SGD string = "SGD"
+	CURRENCY_USD string = "USD"
+)
+
+// DEFAULT_CURRENCY is the currency of every amount which does not carry one.
+const DEFAULT_CURRENCY string = CURRENCY_IDR
+
+const (
+	COUNTRY_ID string = "ID"
+	COUNTRY_PH string = "PH"
+	COUNTRY_TH string = "TH"
+	COUNTRY_VN string = "VN"
+	COUNTRY_MY string = "MY"
+)
+
+// IsSupportedCurrency reports whether payments can be made in the currency, which channels accept it
+// is up to the payment_channel rows.
+func IsSupportedCurrency(currency string) bool {
+	switch currency {
+	case CURRENCY_IDR, CURRENCY_PHP, CURRENCY_THB, CURRENCY_VND, CURRENCY_MYR:
+		return true
+	default:
+		return false
+	}
+}
+
+// CurrencyCountry returns the country a payment in the currency is made in when the request does not carry one.
+func CurrencyCountry(currency string) string {
+	switch currency {
+	case CURRENCY_PHP:
+		return COUNTRY_PH
+	case CURRENCY_THB:
+		return COUNTRY_TH
+	case CURRENCY_VND:
+		return COUNTRY_VN
+	case CURRENCY_MYR:
+		return COUNTRY_MY
+	default:
+		return COUNTRY_ID
+	}
+}
+
+// CurrencyExponent returns the number of minor unit digits of an ISO 4217 currency.
+func CurrencyExponent(currency string) int32 {
+	switch currency {
+	case CURRENCY_VND:
+		return 0
+	default:
+		return 2
+	}
+}
+
+// ToMinorUnits converts an amount to the integer minor units of the currency,
+// digits beyond the currency exponent are rounded half up.
+func ToMinorUnits(amount decimal.Decimal, currency string) int64 {
+	return amount.Shift(CurrencyExponent(currency)).Round(0).IntPart()
+}
+
+// FromMinorUnits converts integer minor units of the currency back to an amount.
+func FromMinorUnits(minorUnits int64, currency string) decimal.Decimal {
+	return decimal.New(minorUnits, -CurrencyExponent(currency))
+}
+
+// IsExactInCurrency reports whether the amount fits the minor units of the currency without rounding.
+func IsExactInCurrency(amount decimal.Decimal, currency string) bool {
+	return amount.Equal(amount.Round(CurrencyExponent(currency)))
+}
@@ -0,0 +1,14 @@
+package payment
+
+const (
+	OUTBOX_STATUS_PENDING string = "PENDING"
+	OUTBOX_STATUS_SENT    string = "SENT"
+	// OUTBOX_STATUS_FAILED is a message the relay gave up on, it is left for an operator and no longer
+	// holds back the messages written after it.
+	OUTBOX_STATUS_FAILED string = "FAILED"
+)
+
+const (
+	// OUTBOX_EVENT_PAYMENT_STATUS_UPDATED is published to the payment_status_updated topic.
+	OUTBOX_EVENT_PAYMENT_STATUS_UPDATED string = "payment.status_updated"
+)
@@ -0,0 +1,44 @@
+package payment
+
+const (
+	// PAYOUT_STATUS_PENDING is the local status of a payout the gateway has not accepted yet.
+	PAYOUT_STATUS_PENDING   string = "PENDING"
+	PAYOUT_STATUS_ACCEPTED  string = "ACCEPTED"
+	PAYOUT_STATUS_REQUESTED string = "REQUESTED"
+	PAYOUT_STATUS_SUCCEEDED string = "SUCCEEDED"
+	PAYOUT_STATUS_FAILED    string = "FAILED"
+	PAYOUT_STATUS_CANCELLED string = "CANCELLED"
+	PAYOUT_STATUS_REVERSED  string = "REVERSED"
+)
+
+func IsPayoutStatus(status string) bool {
+	switch status {
+	case PAYOUT_STATUS_PENDING,
+		PAYOUT_STATUS_ACCEPTED,
+		PAYOUT_STATUS_REQUESTED,
+		PAYOUT_STATUS_SUCCEEDED,
+		PAYOUT_STATUS_FAILED,
+		PAYOUT_STATUS_CANCELLED,
+		PAYOUT_STATUS_REVERSED:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanMovePayoutStatus reports whether a payout in status from may move into status to, a payout
+// never leaves a final status except a SUCCEEDED one the receiving bank sent back, which becomes REVERSED.
+func CanMovePayoutStatus(from string, to string) bool {
+	if from == to {
+		return false
+	}
+
+	switch from {
+	case PAYOUT_STATUS_SUCCEEDED:
+		return to == PAYOUT_STATUS_REVERSED
+	case PAYOUT_STATUS_FAILED, PAYOUT_STATUS_CANCELLED, PAYOUT_STATUS_REVERSED:
+		return false
+	default:
+		return IsPayoutStatus(to)
+	}
+}
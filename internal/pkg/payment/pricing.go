@@ -0,0 +1,13 @@
+package payment
+
+const (
+	FEE_BEARER_CUSTOMER string = "CUSTOMER"
+	FEE_BEARER_MERCHANT string = "MERCHANT"
+)
+
+const (
+	ROUNDING_MODE_HALF_UP   string = "HALF_UP"
+	ROUNDING_MODE_HALF_EVEN string = "HALF_EVEN"
+	ROUNDING_MODE_UP        string = "UP"
+	ROUNDING_MODE_DOWN      string = "DOWN"
+)
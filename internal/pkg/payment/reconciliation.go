@@ -0,0 +1,15 @@
+package payment
+
+const (
+	// RECONCILIATION_DISCREPANCY_MISSING is a payment we recorded as succeeded which the gateway did not report.
+	RECONCILIATION_DISCREPANCY_MISSING string = "MISSING"
+	// RECONCILIATION_DISCREPANCY_EXTRA is a transaction the gateway reported which matches none of our payments.
+	RECONCILIATION_DISCREPANCY_EXTRA           string = "EXTRA"
+	RECONCILIATION_DISCREPANCY_AMOUNT_MISMATCH string = "AMOUNT_MISMATCH"
+	RECONCILIATION_DISCREPANCY_STATUS_MISMATCH string = "STATUS_MISMATCH"
+)
+
+const (
+	RECONCILIATION_SOURCE_GATEWAY string = "GATEWAY"
+	RECONCILIATION_SOURCE_FILE    string = "FILE"
+)
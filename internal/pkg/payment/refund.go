@@ -0,0 +1,29 @@
+package payment
+
+const (
+	REFUND_STATUS_PENDING   string = "PENDING"
+	REFUND_STATUS_SUCCEEDED string = "SUCCEEDED"
+	REFUND_STATUS_FAILED    string = "FAILED"
+	REFUND_STATUS_CANCELLED string = "CANCELLED"
+)
+
+const (
+	REFUND_REASON_FRAUDULENT            string = "FRAUDULENT"
+	REFUND_REASON_DUPLICATE             string = "DUPLICATE"
+	REFUND_REASON_REQUESTED_BY_CUSTOMER string = "REQUESTED_BY_CUSTOMER"
+	REFUND_REASON_CANCELLATION          string = "CANCELLATION"
+	REFUND_REASON_OTHERS                string = "OTHERS"
+)
+
+func IsRefundReason(reason string) bool {
+	switch reason {
+	case REFUND_REASON_FRAUDULENT,
+		REFUND_REASON_DUPLICATE,
+		REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		REFUND_REASON_CANCELLATION,
+		REFUND_REASON_OTHERS:
+		return true
+	default:
+		return false
+	}
+}
@@ -4,3 +4,23 @@ const (
 	USAGE_TYPE_MULTIPLE_USE string = "MULTIPLE_USE"
 	USAGE_TYPE_ONE_TIME_USE string = "ONE_TIME_USE"
 )
+
+func IsUsageType(usage string) bool {
+	switch usage {
+	case USAGE_TYPE_MULTIPLE_USE, USAGE_TYPE_ONE_TIME_USE:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsReusableType reports whether a payment of typ can stay open for many payments, each payment
+// made to it is recorded as a child of the reusable payment method.
+func IsReusableType(typ string) bool {
+	switch typ {
+	case METHODE_TYPE_VIRTUAL_ACCOUNT, METHODE_TYPE_QR_CODE:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,10 @@
+package payment
+
+const (
+	// SPLIT_TYPE_PLATFORM_FEE is the leg the platform account keeps.
+	SPLIT_TYPE_PLATFORM_FEE string = "PLATFORM_FEE"
+	// SPLIT_TYPE_ROUTE is a leg routed to another sub-account through a split rule.
+	SPLIT_TYPE_ROUTE string = "ROUTE"
+	// SPLIT_TYPE_REMAINDER is what is left to the sub-account the payment is collected on behalf of.
+	SPLIT_TYPE_REMAINDER string = "REMAINDER"
+)
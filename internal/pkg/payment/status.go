@@ -12,3 +12,80 @@ const (
 	STATUS_SUCCEEDED        string = "SUCCEEDED"
 	STATUS_VOIDED           string = "VOIDED"
 )
+
+// OutstandingStatuses are the statuses of a payment which can still be paid by the customer.
+func OutstandingStatuses() []string {
+	return []string{STATUS_ACTIVE, STATUS_PENDING, STATUS_REQUIRES_ACTION}
+}
+
+func IsOutstandingStatus(status string) bool {
+	switch status {
+	case STATUS_ACTIVE, STATUS_PENDING, STATUS_REQUIRES_ACTION:
+		return true
+	default:
+		return false
+	}
+}
+
+// statusTransitions are the statuses a payment may move to from each status. SUCCEEDED, FAILED, VOIDED
+// and CANCELED are final, an EXPIRED payment can still be settled by a payment the gateway accepted late.
+var statusTransitions = map[string][]string{
+	STATUS_ACTIVE: {
+		STATUS_PENDING, STATUS_REQUIRES_ACTION, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+		STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED, STATUS_INACTIVE,
+	},
+	STATUS_PENDING: {
+		STATUS_REQUIRES_ACTION, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+		STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED,
+	},
+	STATUS_REQUIRES_ACTION: {
+		STATUS_ACTIVE, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+		STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED,
+	},
+	STATUS_AWAITING_CAPTURE: {
+		STATUS_SUCCEEDED, STATUS_FAILED, STATUS_VOIDED, STATUS_EXPIRED, STATUS_CANCELED,
+	},
+	STATUS_INACTIVE: {STATUS_ACTIVE},
+	STATUS_EXPIRED:  {STATUS_SUCCEEDED, STATUS_FAILED},
+}
+
+func IsFinalStatus(status string) bool {
+	switch status {
+	case STATUS_SUCCEEDED, STATUS_FAILED, STATUS_VOIDED, STATUS_CANCELED:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionStatus reports whether a payment in status from may be updated to status to. An update
+// which keeps the status of a payment that is not final only refreshes its failure code and timestamps.
+func CanTransitionStatus(from, to string) bool {
+	if from == to {
+		return !IsFinalStatus(from)
+	}
+
+	for _, status := range statusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// the reasons a status update is rejected without being applied, a rejection is not an error.
+const (
+	STATUS_UPDATE_REJECTED_DUPLICATE_EVENT string = "DUPLICATE_EVENT"
+	STATUS_UPDATE_REJECTED_STALE           string = "STALE_UPDATE"
+	STATUS_UPDATE_REJECTED_TRANSITION      string = "INVALID_TRANSITION"
+)
+
+// the events recorded in the status history of a payment updated by an operation of the service itself,
+// the updates reported by the gateway are recorded under the event of its callback.
+const (
+	PAYMENT_EVENT_CANCEL                string = "api.cancel"
+	PAYMENT_EVENT_CAPTURE               string = "api.capture"
+	PAYMENT_EVENT_VOID                  string = "api.void"
+	PAYMENT_EVENT_VALIDATE_DIRECT_DEBIT string = "api.validate_direct_debit_link"
+)
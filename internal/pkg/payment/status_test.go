@@ -0,0 +1,110 @@
+package payment
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var allStatuses = []string{
+	STATUS_ACTIVE,
+	STATUS_AWAITING_CAPTURE,
+	STATUS_CANCELED,
+	STATUS_EXPIRED,
+	STATUS_FAILED,
+	STATUS_INACTIVE,
+	STATUS_PENDING,
+	STATUS_REQUIRES_ACTION,
+	STATUS_SUCCEEDED,
+	STATUS_VOIDED,
+}
+
+func TestCanTransitionStatus(t *testing.T) {
+	// allowed lists every transition, any pair of statuses missing from it is forbidden.
+	allowed := map[string][]string{
+		STATUS_ACTIVE: {
+			STATUS_ACTIVE, STATUS_PENDING, STATUS_REQUIRES_ACTION, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+			STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED, STATUS_INACTIVE,
+		},
+		STATUS_PENDING: {
+			STATUS_PENDING, STATUS_REQUIRES_ACTION, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+			STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED,
+		},
+		STATUS_REQUIRES_ACTION: {
+			STATUS_REQUIRES_ACTION, STATUS_ACTIVE, STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED,
+			STATUS_FAILED, STATUS_EXPIRED, STATUS_CANCELED,
+		},
+		STATUS_AWAITING_CAPTURE: {
+			STATUS_AWAITING_CAPTURE, STATUS_SUCCEEDED, STATUS_FAILED, STATUS_VOIDED, STATUS_EXPIRED, STATUS_CANCELED,
+		},
+		STATUS_INACTIVE: {STATUS_INACTIVE, STATUS_ACTIVE},
+		STATUS_EXPIRED:  {STATUS_EXPIRED, STATUS_SUCCEEDED, STATUS_FAILED},
+	}
+
+	type transitionCase struct {
+		tname string
+		from  string
+		to    string
+		want  bool
+	}
+
+	testCases := []transitionCase{}
+	for _, from := range allStatuses {
+		for _, to := range allStatuses {
+			testCases = append(testCases, transitionCase{
+				tname: from + "_TO_" + to,
+				from:  from,
+				to:    to,
+				want:  slices.Contains(allowed[from], to),
+			})
+		}
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.tname, func(t *testing.T) {
+			require.Equal(t, tc.want, CanTransitionStatus(tc.from, tc.to))
+		})
+	}
+
+	t.Run("STATUS_TRANSITIONS", func(t *testing.T) {
+		for from, to := range statusTransitions {
+			expected := make([]string, 0, len(allowed[from]))
+			for _, status := range allowed[from] {
+				if status != from {
+					expected = append(expected, status)
+				}
+			}
+
+			require.ElementsMatch(t, expected, to, from)
+		}
+
+		for from := range allowed {
+			if !IsFinalStatus(from) {
+				require.Contains(t, statusTransitions, from)
+			}
+		}
+	})
+}
+
+func TestIsFinalStatus(t *testing.T) {
+	final := []string{STATUS_SUCCEEDED, STATUS_FAILED, STATUS_VOIDED, STATUS_CANCELED}
+
+	for _, status := range allStatuses {
+		status := status
+
+		t.Run(status, func(t *testing.T) {
+			require.Equal(t, slices.Contains(final, status), IsFinalStatus(status))
+
+			// a final status is never left, nor refreshed.
+			if IsFinalStatus(status) {
+				require.NotContains(t, statusTransitions, status)
+				for _, to := range allStatuses {
+					require.False(t, CanTransitionStatus(status, to))
+				}
+			}
+		})
+	}
+}
@@ -10,3 +10,19 @@ const (
 	METHODE_TYPE_QR_CODE              string = "QR_CODE"
 	METHODE_TYPE_VIRTUAL_ACCOUNT      string = "VIRTUAL_ACCOUNT"
 )
+
+func IsMethodType(typ string) bool {
+	switch typ {
+	case METHODE_TYPE_CARD,
+		METHODE_TYPE_CRYPTOCURRENCY,
+		METHODE_TYPE_DIRECT_BANK_TRANSFER,
+		METHODE_TYPE_DIRECT_DEBIT,
+		METHODE_TYPE_EWALLET,
+		METHODE_TYPE_OVER_THE_COUNTER,
+		METHODE_TYPE_QR_CODE,
+		METHODE_TYPE_VIRTUAL_ACCOUNT:
+		return true
+	default:
+		return false
+	}
+}
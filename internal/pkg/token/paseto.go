@@ -3,7 +3,10 @@ package token
 import (
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"io"
 	"strings"
 	"time"
@@ -48,6 +51,42 @@ func NewPaseto(privKey ed25519.PrivateKey, pubKey ed25519.PublicKey, issuer stri
 	}, nil
 }
 
+// NewPasetoFromPEM creates a new PasetoMaker from base64 encoded PEM keys, a PKCS#8 ed25519 private key and
+// a PKIX ed25519 public key, the way they are stored in the tls.paseto configuration block.
+func NewPasetoFromPEM(privateKey string, publicKey string, issuer string, nonce string) (Maker, error) {
+	privKey, err := decodePEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPrivKey, err := x509.ParsePKCS8PrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ed25519PrivKey, ok := parsedPrivKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("paseto private key is not an ed25519 key")
+	}
+
+	pubKey, err := decodePEM(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPubKey, err := x509.ParsePKIXPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ed25519PubKey, ok := parsedPubKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("paseto public key is not an ed25519 key")
+	}
+
+	return NewPaseto(ed25519PrivKey, ed25519PubKey, issuer, nonce)
+}
+
 // CreateToken creates a new Paseto token with the given claimer and duration.
 // It returns the generated token string, payload, and an error if any.
 func (maker *PasetoMaker) CreateToken(claimer *Claimer, duration time.Duration, tokenType string) (string, *Payload, error) {
@@ -117,6 +156,21 @@ func (maker *PasetoMaker) VerifyToken(tokens, expectedTokenType string) (*Payloa
 	return &body, nil
 }
 
+// decodePEM returns the DER bytes of a base64 encoded PEM block.
+func decodePEM(encoded string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no PEM block found in paseto key")
+	}
+
+	return block.Bytes, nil
+}
+
 // Function to generate ChaCha20-Poly1305 nonce
 func generateChaCha20Poly1305Nonce() ([]byte, error) {
 	nonce := make([]byte, nonceSize)
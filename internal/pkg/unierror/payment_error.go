@@ -11,4 +11,36 @@ var (
 	ErrExpiryLessThan3Days             = errors.New("please set an expiry payment or at least set it more than 72 hours or ( 3 days ), error code: WK-700006")
 	ErrInvalidCustomerPhoneNumberInput = errors.New("invalid customer phone number input should be only digits, error code: WK-700007")
 	ErrReferenceIDShouldNotBeEmpty     = errors.New("reference id should not be empty, error code: WK-700008")
+	ErrPaymentProviderNotRegistered    = errors.New("payment provider is not registered, error code: WK-700009")
+	ErrInvalidCallbackToken            = errors.New("invalid callback token, error code: WK-700010")
+	ErrPaymentNotRefundable            = errors.New("only succeeded payments can be refunded, error code: WK-700011")
+	ErrRefundAmountExceeded            = errors.New("refund amount exceeds the refundable payment amount, error code: WK-700012")
+	ErrInvalidRefundReason             = errors.New("invalid refund reason, error code: WK-700013")
+	ErrPaymentNotCancelable            = errors.New("only outstanding payments can be canceled, error code: WK-700014")
+	ErrInvalidPaginationCursor         = errors.New("invalid pagination cursor, error code: WK-700015")
+	ErrInvalidTimeRange                = errors.New("created from should not be after created to, error code: WK-700016")
+	ErrDuplicateActivePayment          = errors.New("an outstanding payment already exists for this reference id, error code: WK-700017")
+	ErrInvalidRefundAmount             = errors.New("refund amount should be greater than 0 and fit the currency minor units, error code: WK-700018")
+	ErrInvalidAmountPrecision          = errors.New("amount has more decimal places than the currency minor units allow, error code: WK-700019")
+	ErrUnsupportedCurrency             = errors.New("unsupported currency, error code: WK-700020")
+	ErrChannelCurrencyNotSupported     = errors.New("payment channel does not support the currency, error code: WK-700021")
+	ErrUnsupportedCountry              = errors.New("payment channel does not support the country, error code: WK-700022")
+	ErrUnsupportedCaptureMethod        = errors.New("unsupported capture method, only CARD payments can be captured manually, error code: WK-700023")
+	ErrCardTokenRequired               = errors.New("card token should not be empty for CARD payments, error code: WK-700024")
+	ErrPaymentNotCapturable            = errors.New("only payments awaiting capture can be captured, error code: WK-700025")
+	ErrInvalidCaptureAmount            = errors.New("capture amount should be greater than 0 and not exceed the authorized amount, error code: WK-700026")
+	ErrPaymentNotVoidable              = errors.New("only payments awaiting capture can be voided, error code: WK-700027")
+	ErrLinkedPaymentMethodRequired     = errors.New("linked payment method id should not be empty for DIRECT_DEBIT payments, error code: WK-700028")
+	ErrDirectDebitNotLinked            = errors.New("direct debit account is not linked and active for this customer and channel, error code: WK-700029")
+	ErrDirectDebitLinkNotPending       = errors.New("only direct debit links requiring action can be validated, error code: WK-700030")
+	ErrInvalidOTPCode                  = errors.New("invalid otp code, error code: WK-700031")
+	ErrUnsupportedPaymentReusability   = errors.New("unsupported payment reusability, only VIRTUAL_ACCOUNT and QR_CODE payments can be MULTIPLE_USE, error code: WK-700032")
+	ErrInvoiceChannelsRequired         = errors.New("an invoice should allow at least one payment channel, error code: WK-700033")
+	ErrInvoiceChannelNotAllowed        = errors.New("invoice was paid through a payment channel it does not allow, error code: WK-700034")
+	ErrForUserIDRequired               = errors.New("for user id should not be empty when the payment has a platform fee or split rules, error code: WK-700035")
+	ErrInvalidSplitRule                = errors.New("a split rule should have a destination account and either a percentage up to 100 or a flat amount greater than 0, error code: WK-700036")
+	ErrSplitExceedsAmount              = errors.New("platform fee and split rules exceed the payment amount, error code: WK-700037")
+	ErrSplitNotSupportedForReusable    = errors.New("MULTIPLE_USE payments cannot be split, error code: WK-700038")
+	ErrPaymentIdempotencyKeyReused     = errors.New("idempotency key was already used for a different payment request, error code: WK-700039")
+	ErrPaymentIdempotencyKeyInProgress = errors.New("a payment with the same idempotency key is still being created, error code: WK-700040")
 )
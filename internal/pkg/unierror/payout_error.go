@@ -0,0 +1,13 @@
+package unierror
+
+import "errors"
+
+var (
+	ErrPayoutProviderNotRegistered = errors.New("payout provider is not registered, error code: WK-800000")
+	ErrBankAccountNotFound         = errors.New("bank account could not be found at the payout channel, error code: WK-800001")
+	ErrBankAccountNameMismatch     = errors.New("account holder name does not match the name registered to the bank account, error code: WK-800002")
+	ErrIdempotencyKeyRequired      = errors.New("idempotency key should not be empty, error code: WK-800003")
+	ErrIdempotencyKeyReused        = errors.New("idempotency key was already used for a different payout, error code: WK-800004")
+	ErrUnsupportedPayoutChannel    = errors.New("unsupported payout channel, error code: WK-800005")
+	ErrInvalidAccountNumber        = errors.New("account number should only contain digits, error code: WK-800006")
+)